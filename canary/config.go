@@ -29,8 +29,10 @@ import (
 
 	"github.com/uber-go/tally"
 	"go.temporal.io/temporal-proto/workflowservice"
+	"go.temporal.io/temporal/client"
 	"go.uber.org/zap"
 
+	"github.com/temporalio/temporal/common/auth"
 	"github.com/temporalio/temporal/common/service/config"
 )
 
@@ -74,6 +76,8 @@ type (
 	Cadence struct {
 		ServiceName     string `yaml:"service"`
 		HostNameAndPort string `yaml:"host"`
+		// TLS is the config for connecting to the frontend with TLS
+		TLS auth.ClientTLS `yaml:"tls"`
 	}
 )
 
@@ -88,10 +92,11 @@ func (c *Config) Validate() error {
 // RuntimeContext contains all the context
 // information needed to run the canary
 type RuntimeContext struct {
-	logger   *zap.Logger
-	metrics  tally.Scope
-	hostPort string
-	service  workflowservice.WorkflowServiceClient
+	logger     *zap.Logger
+	metrics    tally.Scope
+	hostPort   string
+	grpcDialer client.GRPCDialer
+	service    workflowservice.WorkflowServiceClient
 }
 
 // NewRuntimeContext builds a runtime context from the config
@@ -99,12 +104,14 @@ func NewRuntimeContext(
 	logger *zap.Logger,
 	scope tally.Scope,
 	hostPort string,
+	grpcDialer client.GRPCDialer,
 	service workflowservice.WorkflowServiceClient,
 ) *RuntimeContext {
 	return &RuntimeContext{
-		logger:   logger,
-		metrics:  scope,
-		hostPort: hostPort,
-		service:  service,
+		logger:     logger,
+		metrics:    scope,
+		hostPort:   hostPort,
+		grpcDialer: grpcDialer,
+		service:    service,
 	}
 }
@@ -51,7 +51,11 @@ func NewCanaryRunner(cfg *Config) (Runnable, error) {
 		cfg.Cadence.HostNameAndPort = ServiceHostPort
 	}
 
-	connection, err := rpc.Dial(cfg.Cadence.HostNameAndPort)
+	creds, err := rpc.NewClientCredentials(&cfg.Cadence.TLS, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS config: %v", err)
+	}
+	connection, err := rpc.Dial(cfg.Cadence.HostNameAndPort, creds)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create connection: %v", err)
 	}
@@ -59,6 +63,7 @@ func NewCanaryRunner(cfg *Config) (Runnable, error) {
 		logger,
 		metricsScope,
 		cfg.Cadence.HostNameAndPort,
+		rpc.NewSDKDialer(creds),
 		workflowservice.NewWorkflowServiceClient(connection),
 	)
 
@@ -283,6 +283,340 @@ func (c *clientImpl) RefreshWorkflowTasks(
 	return client.RefreshWorkflowTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.PauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UnpauseWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) TriggerCronRun(
+	ctx context.Context,
+	request *adminservice.TriggerCronRunRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.TriggerCronRunResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.TriggerCronRun(ctx, request, opts...)
+}
+
+func (c *clientImpl) SkipCronRuns(
+	ctx context.Context,
+	request *adminservice.SkipCronRunsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SkipCronRunsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.SkipCronRuns(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateActivityOptions(
+	ctx context.Context,
+	request *adminservice.UpdateActivityOptionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateActivityOptionsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateActivityOptions(ctx, request, opts...)
+}
+
+func (c *clientImpl) ResetActivity(
+	ctx context.Context,
+	request *adminservice.ResetActivityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResetActivityResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ResetActivity(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartBatchOperationResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.StartBatchOperation(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeBatchOperation(
+	ctx context.Context,
+	request *adminservice.DescribeBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeBatchOperationResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeBatchOperation(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListBatchOperations(
+	ctx context.Context,
+	request *adminservice.ListBatchOperationsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListBatchOperationsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ListBatchOperations(ctx, request, opts...)
+}
+
+func (c *clientImpl) StopBatchOperation(
+	ctx context.Context,
+	request *adminservice.StopBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StopBatchOperationResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.StopBatchOperation(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.DeleteWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteWorkflowExecutionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DeleteWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpsertWorkflowAttributes(
+	ctx context.Context,
+	request *adminservice.UpsertWorkflowAttributesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpsertWorkflowAttributesResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpsertWorkflowAttributes(ctx, request, opts...)
+}
+
+func (c *clientImpl) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteNamespaceResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DeleteNamespace(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeNamespaceDeletion(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceDeletionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceDeletionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeNamespaceDeletion(ctx, request, opts...)
+}
+
+func (c *clientImpl) RenameNamespace(
+	ctx context.Context,
+	request *adminservice.RenameNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RenameNamespaceResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.RenameNamespace(ctx, request, opts...)
+}
+
+func (c *clientImpl) StartMigration(
+	ctx context.Context,
+	request *adminservice.StartMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartMigrationResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.StartMigration(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeMigration(
+	ctx context.Context,
+	request *adminservice.DescribeMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeMigrationResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeMigration(ctx, request, opts...)
+}
+
+func (c *clientImpl) ExportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ExportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExportWorkflowExecutionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ExportWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ImportWorkflowExecutionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.ImportWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) StreamWorkflowExecutionHistory(
+	ctx context.Context,
+	request *adminservice.StreamWorkflowExecutionHistoryRequest,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamWorkflowExecutionHistoryClient, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	// the stream is open until the workflow execution is closed, so it is only bounded by the caller context
+	return client.StreamWorkflowExecutionHistory(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkflowExecutionResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	// the update waits for the workflow to handle it, which is bounded by the caller and the history service
+	return client.UpdateWorkflowExecution(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateWorkerVersionSets(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerVersionSetsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkerVersionSetsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateWorkerVersionSets(ctx, request, opts...)
+}
+
+func (c *clientImpl) DescribeTaskListVersions(
+	ctx context.Context,
+	request *adminservice.DescribeTaskListVersionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskListVersionsResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.DescribeTaskListVersions(ctx, request, opts...)
+}
+
+func (c *clientImpl) UpdateTaskListRateLimit(
+	ctx context.Context,
+	request *adminservice.UpdateTaskListRateLimitRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateTaskListRateLimitResponse, error) {
+	client, err := c.getRandomClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return client.UpdateTaskListRateLimit(ctx, request, opts...)
+}
+
 func (c *clientImpl) createContext(parent context.Context) (context.Context, context.CancelFunc) {
 	return context.WithTimeout(parent, c.timeout)
 }
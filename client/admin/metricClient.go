@@ -327,3 +327,411 @@ func (c *metricClient) RefreshWorkflowTasks(
 	}
 	return resp, err
 }
+
+func (c *metricClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientPauseWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientPauseWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.PauseWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientPauseWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUnpauseWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUnpauseWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUnpauseWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) TriggerCronRun(
+	ctx context.Context,
+	request *adminservice.TriggerCronRunRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.TriggerCronRunResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientTriggerCronRunScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientTriggerCronRunScope, metrics.ClientLatency)
+	resp, err := c.client.TriggerCronRun(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientTriggerCronRunScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) SkipCronRuns(
+	ctx context.Context,
+	request *adminservice.SkipCronRunsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SkipCronRunsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientSkipCronRunsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientSkipCronRunsScope, metrics.ClientLatency)
+	resp, err := c.client.SkipCronRuns(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientSkipCronRunsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateActivityOptions(
+	ctx context.Context,
+	request *adminservice.UpdateActivityOptionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateActivityOptionsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateActivityOptionsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateActivityOptionsScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateActivityOptions(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateActivityOptionsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ResetActivity(
+	ctx context.Context,
+	request *adminservice.ResetActivityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResetActivityResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientResetActivityScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientResetActivityScope, metrics.ClientLatency)
+	resp, err := c.client.ResetActivity(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientResetActivityScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartBatchOperationResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientStartBatchOperationScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientStartBatchOperationScope, metrics.ClientLatency)
+	resp, err := c.client.StartBatchOperation(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientStartBatchOperationScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeBatchOperation(
+	ctx context.Context,
+	request *adminservice.DescribeBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeBatchOperationResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeBatchOperationScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeBatchOperationScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeBatchOperation(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeBatchOperationScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ListBatchOperations(
+	ctx context.Context,
+	request *adminservice.ListBatchOperationsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListBatchOperationsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientListBatchOperationsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientListBatchOperationsScope, metrics.ClientLatency)
+	resp, err := c.client.ListBatchOperations(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientListBatchOperationsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) StopBatchOperation(
+	ctx context.Context,
+	request *adminservice.StopBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StopBatchOperationResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientStopBatchOperationScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientStopBatchOperationScope, metrics.ClientLatency)
+	resp, err := c.client.StopBatchOperation(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientStopBatchOperationScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.DeleteWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDeleteWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeleteWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.DeleteWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeleteWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpsertWorkflowAttributes(
+	ctx context.Context,
+	request *adminservice.UpsertWorkflowAttributesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpsertWorkflowAttributesResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpsertWorkflowAttributesScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpsertWorkflowAttributesScope, metrics.ClientLatency)
+	resp, err := c.client.UpsertWorkflowAttributes(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpsertWorkflowAttributesScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteNamespaceResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDeleteNamespaceScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDeleteNamespaceScope, metrics.ClientLatency)
+	resp, err := c.client.DeleteNamespace(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDeleteNamespaceScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeNamespaceDeletion(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceDeletionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceDeletionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeNamespaceDeletionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeNamespaceDeletionScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeNamespaceDeletion(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeNamespaceDeletionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) RenameNamespace(
+	ctx context.Context,
+	request *adminservice.RenameNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RenameNamespaceResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientRenameNamespaceScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientRenameNamespaceScope, metrics.ClientLatency)
+	resp, err := c.client.RenameNamespace(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientRenameNamespaceScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) StartMigration(
+	ctx context.Context,
+	request *adminservice.StartMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartMigrationResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientStartMigrationScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientStartMigrationScope, metrics.ClientLatency)
+	resp, err := c.client.StartMigration(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientStartMigrationScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeMigration(
+	ctx context.Context,
+	request *adminservice.DescribeMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeMigrationResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeMigrationScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeMigrationScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeMigration(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeMigrationScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ExportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ExportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExportWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientExportWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientExportWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.ExportWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientExportWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ImportWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientImportWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientImportWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.ImportWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientImportWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) StreamWorkflowExecutionHistory(
+	ctx context.Context,
+	request *adminservice.StreamWorkflowExecutionHistoryRequest,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamWorkflowExecutionHistoryClient, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientStreamWorkflowExecutionHistoryScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientStreamWorkflowExecutionHistoryScope, metrics.ClientLatency)
+	stream, err := c.client.StreamWorkflowExecutionHistory(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientStreamWorkflowExecutionHistoryScope, metrics.ClientFailures)
+	}
+	return stream, err
+}
+
+func (c *metricClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateWorkflowExecutionScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateWorkflowExecutionScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateWorkflowExecutionScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateWorkerVersionSets(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerVersionSetsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkerVersionSetsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateWorkerVersionSetsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateWorkerVersionSetsScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateWorkerVersionSets(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateWorkerVersionSetsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) DescribeTaskListVersions(
+	ctx context.Context,
+	request *adminservice.DescribeTaskListVersionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskListVersionsResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientDescribeTaskListVersionsScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientDescribeTaskListVersionsScope, metrics.ClientLatency)
+	resp, err := c.client.DescribeTaskListVersions(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientDescribeTaskListVersionsScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateTaskListRateLimit(
+	ctx context.Context,
+	request *adminservice.UpdateTaskListRateLimitRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateTaskListRateLimitResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.AdminClientUpdateTaskListRateLimitScope, metrics.ClientRequests)
+	sw := c.metricsClient.StartTimer(metrics.AdminClientUpdateTaskListRateLimitScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateTaskListRateLimit(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.AdminClientUpdateTaskListRateLimitScope, metrics.ClientFailures)
+	}
+	return resp, err
+}
@@ -301,3 +301,387 @@ func (c *retryableClient) RefreshWorkflowTasks(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+
+	var resp *adminservice.PauseWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.PauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+
+	var resp *adminservice.UnpauseWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) TriggerCronRun(
+	ctx context.Context,
+	request *adminservice.TriggerCronRunRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.TriggerCronRunResponse, error) {
+
+	var resp *adminservice.TriggerCronRunResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.TriggerCronRun(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) SkipCronRuns(
+	ctx context.Context,
+	request *adminservice.SkipCronRunsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SkipCronRunsResponse, error) {
+
+	var resp *adminservice.SkipCronRunsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.SkipCronRuns(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateActivityOptions(
+	ctx context.Context,
+	request *adminservice.UpdateActivityOptionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateActivityOptionsResponse, error) {
+
+	var resp *adminservice.UpdateActivityOptionsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateActivityOptions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ResetActivity(
+	ctx context.Context,
+	request *adminservice.ResetActivityRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ResetActivityResponse, error) {
+
+	var resp *adminservice.ResetActivityResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ResetActivity(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartBatchOperationResponse, error) {
+
+	var resp *adminservice.StartBatchOperationResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.StartBatchOperation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeBatchOperation(
+	ctx context.Context,
+	request *adminservice.DescribeBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeBatchOperationResponse, error) {
+
+	var resp *adminservice.DescribeBatchOperationResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeBatchOperation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListBatchOperations(
+	ctx context.Context,
+	request *adminservice.ListBatchOperationsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListBatchOperationsResponse, error) {
+
+	var resp *adminservice.ListBatchOperationsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ListBatchOperations(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StopBatchOperation(
+	ctx context.Context,
+	request *adminservice.StopBatchOperationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StopBatchOperationResponse, error) {
+
+	var resp *adminservice.StopBatchOperationResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.StopBatchOperation(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.DeleteWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteWorkflowExecutionResponse, error) {
+
+	var resp *adminservice.DeleteWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DeleteWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpsertWorkflowAttributes(
+	ctx context.Context,
+	request *adminservice.UpsertWorkflowAttributesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpsertWorkflowAttributesResponse, error) {
+
+	var resp *adminservice.UpsertWorkflowAttributesResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpsertWorkflowAttributes(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteNamespaceResponse, error) {
+
+	var resp *adminservice.DeleteNamespaceResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DeleteNamespace(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeNamespaceDeletion(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceDeletionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeNamespaceDeletionResponse, error) {
+
+	var resp *adminservice.DescribeNamespaceDeletionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeNamespaceDeletion(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) RenameNamespace(
+	ctx context.Context,
+	request *adminservice.RenameNamespaceRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.RenameNamespaceResponse, error) {
+
+	var resp *adminservice.RenameNamespaceResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RenameNamespace(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StartMigration(
+	ctx context.Context,
+	request *adminservice.StartMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.StartMigrationResponse, error) {
+
+	var resp *adminservice.StartMigrationResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.StartMigration(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeMigration(
+	ctx context.Context,
+	request *adminservice.DescribeMigrationRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeMigrationResponse, error) {
+
+	var resp *adminservice.DescribeMigrationResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeMigration(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ExportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ExportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExportWorkflowExecutionResponse, error) {
+
+	var resp *adminservice.ExportWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ExportWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ImportWorkflowExecutionResponse, error) {
+
+	var resp *adminservice.ImportWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.ImportWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) StreamWorkflowExecutionHistory(
+	ctx context.Context,
+	request *adminservice.StreamWorkflowExecutionHistoryRequest,
+	opts ...grpc.CallOption,
+) (adminservice.AdminService_StreamWorkflowExecutionHistoryClient, error) {
+
+	var stream adminservice.AdminService_StreamWorkflowExecutionHistoryClient
+	op := func() error {
+		var err error
+		stream, err = c.client.StreamWorkflowExecutionHistory(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return stream, err
+}
+
+func (c *retryableClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowExecutionRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkflowExecutionResponse, error) {
+
+	var resp *adminservice.UpdateWorkflowExecutionResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateWorkerVersionSets(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerVersionSetsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateWorkerVersionSetsResponse, error) {
+
+	var resp *adminservice.UpdateWorkerVersionSetsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateWorkerVersionSets(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) DescribeTaskListVersions(
+	ctx context.Context,
+	request *adminservice.DescribeTaskListVersionsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeTaskListVersionsResponse, error) {
+
+	var resp *adminservice.DescribeTaskListVersionsResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.DescribeTaskListVersions(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) UpdateTaskListRateLimit(
+	ctx context.Context,
+	request *adminservice.UpdateTaskListRateLimitRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateTaskListRateLimitResponse, error) {
+
+	var resp *adminservice.UpdateTaskListRateLimitResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateTaskListRateLimit(ctx, request, opts...)
+		return err
+	}
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -260,3 +260,21 @@ func (c *metricClient) emitForwardedFromStats(scope int, forwardedFrom string, t
 		}
 	}
 }
+
+func (c *metricClient) UpdateTaskListRateLimit(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskListRateLimitRequest,
+	opts ...grpc.CallOption) (*matchingservice.UpdateTaskListRateLimitResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.MatchingClientUpdateTaskListRateLimitScope, metrics.ClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientUpdateTaskListRateLimitScope, metrics.ClientLatency)
+	resp, err := c.client.UpdateTaskListRateLimit(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientUpdateTaskListRateLimitScope, metrics.ClientFailures)
+	}
+
+	return resp, err
+}
@@ -193,3 +193,19 @@ func (c *retryableClient) ListTaskListPartitions(
 	err := backoff.Retry(op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) UpdateTaskListRateLimit(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskListRateLimitRequest,
+	opts ...grpc.CallOption) (*matchingservice.UpdateTaskListRateLimitResponse, error) {
+
+	var resp *matchingservice.UpdateTaskListRateLimitResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.UpdateTaskListRateLimit(ctx, request, opts...)
+		return err
+	}
+
+	err := backoff.Retry(op, c.policy, c.isRetryable)
+	return resp, err
+}
@@ -47,6 +47,7 @@ import (
 	persistenceClient "github.com/temporalio/temporal/common/persistence/client"
 	"github.com/temporalio/temporal/common/primitives"
 	"github.com/temporalio/temporal/common/resource"
+	"github.com/temporalio/temporal/common/rpc"
 	"github.com/temporalio/temporal/common/service/config"
 	"github.com/temporalio/temporal/common/service/config/ringpop"
 	"github.com/temporalio/temporal/common/service/dynamicconfig"
@@ -120,7 +121,11 @@ func (s *server) startService() common.Daemon {
 
 	svcCfg := s.cfg.Services[s.name]
 	params.MetricScope = svcCfg.Metrics.NewScope(params.Logger)
-	params.RPCFactory = svcCfg.RPC.NewFactory(params.Name, params.Logger)
+	systemCredentials, err := authorization.NewSystemCredentials(s.cfg.Authorization)
+	if err != nil {
+		log.Fatalf("error loading system credentials: %v", err)
+	}
+	params.RPCFactory = svcCfg.RPC.NewFactory(params.Name, params.Logger, &s.cfg.TLS, systemCredentials)
 
 	// Ringpop uses a different port to register handlers, this map is needed to resolve
 	// services to correct addresses used by clients through ServiceResolver lookup API
@@ -166,11 +171,15 @@ func (s *server) startService() common.Daemon {
 	if s.cfg.PublicClient.HostPort == "" {
 		log.Fatalf("need to provide an endpoint config for PublicClient")
 	} else {
-		var err error
+		frontendCredentials, err := s.cfg.TLS.NewFrontendClientCredentials()
+		if err != nil {
+			log.Fatalf("failed to load frontend client TLS config: %v", err)
+		}
 		params.PublicClient, err = sdkclient.NewClient(sdkclient.Options{
 			HostPort:     s.cfg.PublicClient.HostPort,
 			Namespace:    common.SystemLocalNamespace,
 			MetricsScope: params.MetricScope,
+			GRPCDialer:   rpc.NewSDKDialer(frontendCredentials, rpc.PerRPCCredentialsOptions(systemCredentials)...),
 		})
 		if err != nil {
 			log.Fatalf("failed to create public client: %v", err)
@@ -225,7 +234,14 @@ func (s *server) startService() common.Daemon {
 
 	params.PersistenceConfig.TransactionSizeLimit = dc.GetIntProperty(dynamicconfig.TransactionSizeLimit, common.DefaultTransactionSizeLimit)
 
-	params.Authorizer = authorization.NewNopAuthorizer()
+	if s.cfg.Authorization != nil {
+		params.Authorizer, err = authorization.NewJWTAuthorizer(s.cfg.Authorization)
+		if err != nil {
+			log.Fatalf("error creating JWT authorizer: %v", err)
+		}
+	} else {
+		params.Authorizer = authorization.NewNopAuthorizer()
+	}
 
 	params.Logger.Info("Starting service " + s.name)
 
@@ -27,8 +27,10 @@ package main
 import (
 	"os"
 
+	_ "github.com/temporalio/temporal/common/persistence/sql/sqlplugin/memory"   // needed to load memory plugin
 	_ "github.com/temporalio/temporal/common/persistence/sql/sqlplugin/mysql"    // needed to load mysql plugin
 	_ "github.com/temporalio/temporal/common/persistence/sql/sqlplugin/postgres" // needed to load postgres plugin
+	_ "github.com/temporalio/temporal/common/persistence/sql/sqlplugin/sqlite"   // needed to load sqlite plugin
 	"github.com/temporalio/temporal/tools/sql"
 )
 
@@ -44,3 +44,41 @@ type (
 		ServerName string `yaml:"serverName"`
 	}
 )
+
+type (
+	// ServerTLS describes the TLS configuration of a gRPC server
+	ServerTLS struct {
+		// CertFile and KeyFile are the PEM encoded certificate and key of the server,
+		// TLS is disabled when CertFile is empty
+		CertFile string `yaml:"certFile"`
+		KeyFile  string `yaml:"keyFile"`
+		// ClientCAFiles are the PEM encoded CAs client certificates are verified with
+		ClientCAFiles []string `yaml:"clientCaFiles"`
+		// RequireClientAuth enables mutual TLS, clients without a certificate signed
+		// by one of ClientCAFiles are rejected
+		RequireClientAuth bool `yaml:"requireClientAuth"`
+	}
+
+	// ClientTLS describes the TLS configuration of a gRPC client
+	ClientTLS struct {
+		Enabled bool `yaml:"enabled"`
+
+		// CertFile and KeyFile are the PEM encoded certificate and key presented to
+		// servers which require client authentication
+		CertFile string `yaml:"certFile"`
+		KeyFile  string `yaml:"keyFile"`
+		// RootCAFiles are the PEM encoded CAs server certificates are verified with,
+		// the system roots are used when empty
+		RootCAFiles []string `yaml:"rootCaFiles"`
+		// ServerName overrides the host name server certificates are verified against
+		ServerName string `yaml:"serverName"`
+		// DisableHostVerification skips verifying the host name of server certificates,
+		// their chain is still verified
+		DisableHostVerification bool `yaml:"disableHostVerification"`
+	}
+)
+
+// IsEnabled returns true when the server is configured to serve TLS
+func (s *ServerTLS) IsEnabled() bool {
+	return s != nil && s.CertFile != ""
+}
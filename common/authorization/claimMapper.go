@@ -0,0 +1,107 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// DefaultPermissionsClaimName is the claim the permissions of a caller are read from
+	DefaultPermissionsClaimName = "permissions"
+)
+
+type (
+	// Claims are the identity and the per namespace roles of a caller
+	Claims struct {
+		Subject    string
+		Namespaces map[string]Role
+	}
+
+	// ClaimMapper maps the claims of a verified token to the roles of the caller
+	ClaimMapper interface {
+		GetClaims(tokenClaims map[string]interface{}) (*Claims, error)
+	}
+
+	defaultClaimMapper struct {
+		permissionsClaimName string
+	}
+)
+
+// NewDefaultClaimMapper returns a claim mapper which reads a list of "<namespace>:<role>"
+// permissions from the given claim, the "*" namespace grants the role on every namespace
+func NewDefaultClaimMapper(permissionsClaimName string) ClaimMapper {
+	if permissionsClaimName == "" {
+		permissionsClaimName = DefaultPermissionsClaimName
+	}
+	return &defaultClaimMapper{permissionsClaimName: permissionsClaimName}
+}
+
+func (m *defaultClaimMapper) GetClaims(tokenClaims map[string]interface{}) (*Claims, error) {
+	claims := &Claims{Namespaces: make(map[string]Role)}
+	claims.Subject, _ = tokenClaims["sub"].(string)
+
+	permissions, ok := tokenClaims[m.permissionsClaimName]
+	if !ok {
+		return claims, nil
+	}
+	values, ok := permissions.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("claim %v is not a list", m.permissionsClaimName)
+	}
+	for _, value := range values {
+		permission, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid permission: %v", value)
+		}
+		separator := strings.LastIndex(permission, ":")
+		if separator <= 0 {
+			return nil, fmt.Errorf("invalid permission: %v", permission)
+		}
+		namespace := permission[:separator]
+		role, ok := ParseRole(permission[separator+1:])
+		if !ok {
+			return nil, fmt.Errorf("invalid role in permission: %v", permission)
+		}
+		if role > claims.Namespaces[namespace] {
+			claims.Namespaces[namespace] = role
+		}
+	}
+	return claims, nil
+}
+
+// GetRole returns the role of the caller on a namespace, an empty namespace stands for
+// the APIs which are not scoped to a namespace and only the system role applies to it
+func (c *Claims) GetRole(namespace string) Role {
+	role := c.Namespaces[SystemNamespace]
+	if namespace == "" {
+		return role
+	}
+	if namespaceRole := c.Namespaces[namespace]; namespaceRole > role {
+		role = namespaceRole
+	}
+	return role
+}
@@ -0,0 +1,178 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+
+	"github.com/temporalio/temporal/common/service/config"
+)
+
+const (
+	// SystemKeyID is the key ID of the tokens the server issues to its internal clients
+	SystemKeyID = "temporal-system"
+	// SystemSubject is the subject of the tokens the server issues to its internal clients
+	SystemSubject = "temporal-system"
+
+	systemTokenTTL = time.Hour
+)
+
+type (
+	tokenCredentials struct {
+		token string
+	}
+
+	systemCredentials struct {
+		secret               []byte
+		issuer               string
+		audience             string
+		permissionsClaimName string
+		now                  func() time.Time
+
+		sync.Mutex
+		token     string
+		refreshAt time.Time
+	}
+)
+
+var _ credentials.PerRPCCredentials = (*tokenCredentials)(nil)
+var _ credentials.PerRPCCredentials = (*systemCredentials)(nil)
+
+// NewTokenCredentials returns credentials which send the given bearer token with every call
+func NewTokenCredentials(token string) credentials.PerRPCCredentials {
+	return &tokenCredentials{token: token}
+}
+
+func (c *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{AuthorizationHeader: "Bearer " + c.token}, nil
+}
+
+// RequireTransportSecurity is false so that a token can be used against a local
+// server which does not use TLS
+func (c *tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// NewSystemCredentials returns the credentials internal clients call the frontend with, every
+// call carries a short lived token with the admin role on all namespaces which is signed with
+// the system key. Nil is returned when authorization or the system key is not configured.
+func NewSystemCredentials(cfg *config.Authorization) (credentials.PerRPCCredentials, error) {
+	if cfg == nil || cfg.SystemKeyFile == "" {
+		return nil, nil
+	}
+	content, err := ioutil.ReadFile(cfg.SystemKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read system key file: %v", err)
+	}
+	key, err := parseStaticKey(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid system key file %v: %v", cfg.SystemKeyFile, err)
+	}
+	secret, ok := key.([]byte)
+	if !ok {
+		return nil, errors.New("system key must be an HMAC secret")
+	}
+	permissionsClaimName := cfg.PermissionsClaimName
+	if permissionsClaimName == "" {
+		permissionsClaimName = DefaultPermissionsClaimName
+	}
+	return &systemCredentials{
+		secret:               secret,
+		issuer:               cfg.Issuer,
+		audience:             cfg.Audience,
+		permissionsClaimName: permissionsClaimName,
+		now:                  time.Now,
+	}, nil
+}
+
+func (c *systemCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	token, err := c.getToken()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{AuthorizationHeader: "Bearer " + token}, nil
+}
+
+// RequireTransportSecurity is false so that clusters which do not use TLS between their
+// services can still authorize internal calls
+func (c *systemCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+func (c *systemCredentials) getToken() (string, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	now := c.now()
+	if c.token != "" && now.Before(c.refreshAt) {
+		return c.token, nil
+	}
+	claims := map[string]interface{}{
+		"sub":                  SystemSubject,
+		"iat":                  now.Unix(),
+		"exp":                  now.Add(systemTokenTTL).Unix(),
+		c.permissionsClaimName: []string{SystemNamespace + ":" + RoleAdmin.String()},
+	}
+	if c.issuer != "" {
+		claims["iss"] = c.issuer
+	}
+	if c.audience != "" {
+		claims["aud"] = c.audience
+	}
+	token, err := signHMACToken(SystemKeyID, c.secret, claims)
+	if err != nil {
+		return "", err
+	}
+	c.token = token
+	// refresh well before the token expires so that calls in flight never carry an expired token
+	c.refreshAt = now.Add(systemTokenTTL / 2)
+	return token, nil
+}
+
+func signHMACToken(keyID string, secret []byte, claims map[string]interface{}) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT", "kid": keyID})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
@@ -0,0 +1,229 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	// register the hash functions used by the supported signing algorithms
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+)
+
+type (
+	tokenHeader struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+
+	signingAlgorithm struct {
+		hash crypto.Hash
+		// verify checks the signature with a key of the type the algorithm expects
+		verify func(hash crypto.Hash, key interface{}, signed []byte, signature []byte) error
+	}
+)
+
+var (
+	errMalformedToken    = errors.New("malformed token")
+	errInvalidSignature  = errors.New("invalid token signature")
+	errUnexpectedKeyType = errors.New("key type does not match the token algorithm")
+
+	signingAlgorithms = map[string]signingAlgorithm{
+		"RS256": {hash: crypto.SHA256, verify: verifyRSA},
+		"RS384": {hash: crypto.SHA384, verify: verifyRSA},
+		"RS512": {hash: crypto.SHA512, verify: verifyRSA},
+		"ES256": {hash: crypto.SHA256, verify: verifyECDSA},
+		"ES384": {hash: crypto.SHA384, verify: verifyECDSA},
+		"ES512": {hash: crypto.SHA512, verify: verifyECDSA},
+		"HS256": {hash: crypto.SHA256, verify: verifyHMAC},
+		"HS384": {hash: crypto.SHA384, verify: verifyHMAC},
+		"HS512": {hash: crypto.SHA512, verify: verifyHMAC},
+	}
+)
+
+// parseToken verifies the signature of a compact serialized JWT and returns its claims
+func parseToken(token string, keyProvider TokenKeyProvider) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errMalformedToken
+	}
+
+	var header tokenHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, err
+	}
+	algorithm, ok := signingAlgorithms[header.Alg]
+	if !ok {
+		return nil, fmt.Errorf("unsupported token algorithm: %v", header.Alg)
+	}
+	key, err := keyProvider.GetKey(header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errMalformedToken
+	}
+	signed := []byte(parts[0] + "." + parts[1])
+	if err := algorithm.verify(algorithm.hash, key, signed, signature); err != nil {
+		return nil, err
+	}
+
+	claims := make(map[string]interface{})
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// validateClaims checks the registered time, issuer and audience claims of a token
+func validateClaims(
+	claims map[string]interface{},
+	issuer string,
+	audience string,
+	requireExpiration bool,
+	now time.Time,
+) error {
+	exp, ok := numericClaim(claims, "exp")
+	if !ok && requireExpiration {
+		return errors.New("token has no expiration time")
+	}
+	if ok && now.Unix() >= exp {
+		return errors.New("token is expired")
+	}
+	if nbf, ok := numericClaim(claims, "nbf"); ok && now.Unix() < nbf {
+		return errors.New("token is not valid yet")
+	}
+	if issuer != "" {
+		if iss, _ := claims["iss"].(string); iss != issuer {
+			return fmt.Errorf("unexpected token issuer: %v", iss)
+		}
+	}
+	if audience != "" && !hasAudience(claims["aud"], audience) {
+		return errors.New("token is not issued for this audience")
+	}
+	return nil
+}
+
+func decodeSegment(segment string, target interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return errMalformedToken
+	}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(target); err != nil {
+		return errMalformedToken
+	}
+	return nil
+}
+
+func numericClaim(claims map[string]interface{}, name string) (int64, bool) {
+	number, ok := claims[name].(json.Number)
+	if !ok {
+		return 0, false
+	}
+	if value, err := number.Int64(); err == nil {
+		return value, true
+	}
+	value, err := number.Float64()
+	if err != nil {
+		return 0, false
+	}
+	return int64(value), true
+}
+
+func hasAudience(aud interface{}, audience string) bool {
+	switch value := aud.(type) {
+	case string:
+		return value == audience
+	case []interface{}:
+		for _, v := range value {
+			if s, ok := v.(string); ok && s == audience {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func digest(hash crypto.Hash, signed []byte) []byte {
+	hasher := hash.New()
+	hasher.Write(signed)
+	return hasher.Sum(nil)
+}
+
+func verifyRSA(hash crypto.Hash, key interface{}, signed []byte, signature []byte) error {
+	publicKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return errUnexpectedKeyType
+	}
+	if err := rsa.VerifyPKCS1v15(publicKey, hash, digest(hash, signed), signature); err != nil {
+		return errInvalidSignature
+	}
+	return nil
+}
+
+func verifyECDSA(hash crypto.Hash, key interface{}, signed []byte, signature []byte) error {
+	publicKey, ok := key.(*ecdsa.PublicKey)
+	if !ok {
+		return errUnexpectedKeyType
+	}
+	// the signature is the concatenation of the fixed size R and S values
+	size := (publicKey.Curve.Params().BitSize + 7) / 8
+	if len(signature) != 2*size {
+		return errInvalidSignature
+	}
+	r := new(big.Int).SetBytes(signature[:size])
+	s := new(big.Int).SetBytes(signature[size:])
+	if !ecdsa.Verify(publicKey, digest(hash, signed), r, s) {
+		return errInvalidSignature
+	}
+	return nil
+}
+
+func verifyHMAC(hash crypto.Hash, key interface{}, signed []byte, signature []byte) error {
+	secret, ok := key.([]byte)
+	if !ok {
+		return errUnexpectedKeyType
+	}
+	mac := hmac.New(hash.New, secret)
+	mac.Write(signed)
+	if !hmac.Equal(mac.Sum(nil), signature) {
+		return errInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/temporalio/temporal/common/service/config"
+)
+
+const (
+	// AuthorizationHeader is the gRPC metadata key of the bearer token
+	AuthorizationHeader = "authorization"
+
+	bearerPrefix = "bearer "
+)
+
+type (
+	jwtAuthorizer struct {
+		keyProvider TokenKeyProvider
+		claimMapper ClaimMapper
+		issuer      string
+		audience    string
+		// requireExpiration denies tokens without an "exp" claim
+		requireExpiration bool
+		now               func() time.Time
+	}
+)
+
+var errMissingToken = errors.New("missing bearer token")
+
+// NewJWTAuthorizer creates an authorizer which verifies the bearer token of every call
+// and allows it when the token grants the required role on the namespace of the call
+func NewJWTAuthorizer(cfg *config.Authorization) (Authorizer, error) {
+	staticKeyFiles := make(map[string]string, len(cfg.StaticKeyFiles)+1)
+	for keyID, path := range cfg.StaticKeyFiles {
+		staticKeyFiles[keyID] = path
+	}
+	if cfg.SystemKeyFile != "" {
+		if _, ok := staticKeyFiles[SystemKeyID]; ok {
+			return nil, fmt.Errorf("key ID %v is reserved for the system key", SystemKeyID)
+		}
+		staticKeyFiles[SystemKeyID] = cfg.SystemKeyFile
+	}
+	keyProvider, err := NewFileTokenKeyProvider(cfg.JWKSFiles, staticKeyFiles)
+	if err != nil {
+		return nil, err
+	}
+	return NewJWTAuthorizerWithProviders(
+		keyProvider,
+		NewDefaultClaimMapper(cfg.PermissionsClaimName),
+		cfg.Issuer,
+		cfg.Audience,
+		!cfg.AllowTokensWithoutExpiration,
+	), nil
+}
+
+// NewJWTAuthorizerWithProviders creates a JWT authorizer with the given key provider and claim mapper
+func NewJWTAuthorizerWithProviders(
+	keyProvider TokenKeyProvider,
+	claimMapper ClaimMapper,
+	issuer string,
+	audience string,
+	requireExpiration bool,
+) Authorizer {
+	return &jwtAuthorizer{
+		keyProvider:       keyProvider,
+		claimMapper:       claimMapper,
+		issuer:            issuer,
+		audience:          audience,
+		requireExpiration: requireExpiration,
+		now:               time.Now,
+	}
+}
+
+func (a *jwtAuthorizer) Authorize(
+	ctx context.Context,
+	attributes *Attributes,
+) (Result, error) {
+	claims, err := a.getClaims(ctx)
+	if err != nil {
+		return Result{Decision: DecisionDeny}, nil
+	}
+	if claims.Subject != "" && attributes.Actor == "" {
+		attributes.Actor = claims.Subject
+	}
+	if claims.GetRole(attributes.Namespace) < GetRequiredRole(attributes.APIName) {
+		return Result{Decision: DecisionDeny}, nil
+	}
+	return Result{Decision: DecisionAllow}, nil
+}
+
+func (a *jwtAuthorizer) getClaims(ctx context.Context) (*Claims, error) {
+	token, err := getBearerToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tokenClaims, err := parseToken(token, a.keyProvider)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateClaims(tokenClaims, a.issuer, a.audience, a.requireExpiration, a.now()); err != nil {
+		return nil, err
+	}
+	return a.claimMapper.GetClaims(tokenClaims)
+}
+
+func getBearerToken(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", errMissingToken
+	}
+	for _, value := range md.Get(AuthorizationHeader) {
+		if len(value) > len(bearerPrefix) && strings.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+			return strings.TrimSpace(value[len(bearerPrefix):]), nil
+		}
+	}
+	return "", errMissingToken
+}
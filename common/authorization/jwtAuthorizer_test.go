@@ -0,0 +1,378 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/temporalio/temporal/common/service/config"
+)
+
+type (
+	jwtAuthorizerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		keyDir     string
+		rsaKey     *rsa.PrivateKey
+		ecKey      *ecdsa.PrivateKey
+		hmacSecret []byte
+		authorizer Authorizer
+	}
+)
+
+const (
+	testIssuer   = "test-issuer"
+	testAudience = "temporal"
+)
+
+func TestJWTAuthorizerSuite(t *testing.T) {
+	s := new(jwtAuthorizerSuite)
+	suite.Run(t, s)
+}
+
+func (s *jwtAuthorizerSuite) SetupSuite() {
+	var err error
+	s.keyDir, err = ioutil.TempDir("", "jwtAuthorizerSuite")
+	s.Require().NoError(err)
+	s.rsaKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+	s.ecKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.Require().NoError(err)
+	s.hmacSecret = []byte("test-hmac-secret")
+}
+
+func (s *jwtAuthorizerSuite) TearDownSuite() {
+	os.RemoveAll(s.keyDir)
+}
+
+func (s *jwtAuthorizerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	jwks, err := json.Marshal(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "rsa-key",
+			"n":   base64.RawURLEncoding.EncodeToString(s.rsaKey.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(s.rsaKey.E)).Bytes()),
+		}},
+	})
+	s.NoError(err)
+	jwksFile := s.writeFile("keys.json", jwks)
+
+	ecKey, err := x509.MarshalPKIXPublicKey(&s.ecKey.PublicKey)
+	s.NoError(err)
+	ecKeyFile := s.writeFile("ec.pem", pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: ecKey}))
+	hmacFile := s.writeFile("hmac.secret", append(s.hmacSecret, '\n'))
+
+	s.authorizer, err = NewJWTAuthorizer(&config.Authorization{
+		JWKSFiles:      []string{jwksFile},
+		StaticKeyFiles: map[string]string{"ec-key": ecKeyFile, "hmac-key": hmacFile},
+		Issuer:         testIssuer,
+		Audience:       testAudience,
+	})
+	s.NoError(err)
+}
+
+func (s *jwtAuthorizerSuite) TestAuthorize_RolesPerNamespace() {
+	token := s.signRSA(s.claims("ns1:reader", "ns2:writer"))
+
+	s.assertDecision(DecisionAllow, token, "DescribeWorkflowExecution", "ns1")
+	s.assertDecision(DecisionDeny, token, "StartWorkflowExecution", "ns1")
+	s.assertDecision(DecisionAllow, token, "StartWorkflowExecution", "ns2")
+	s.assertDecision(DecisionDeny, token, "UpdateNamespace", "ns2")
+	s.assertDecision(DecisionDeny, token, "DescribeWorkflowExecution", "ns3")
+	s.assertDecision(DecisionDeny, token, "ListNamespaces", "")
+}
+
+func (s *jwtAuthorizerSuite) TestAuthorize_SystemRole() {
+	token := s.signRSA(s.claims("*:admin"))
+
+	s.assertDecision(DecisionAllow, token, "UpdateNamespace", "ns1")
+	s.assertDecision(DecisionAllow, token, "ListNamespaces", "")
+	s.assertDecision(DecisionAllow, token, "AdminDescribeCluster", "")
+
+	token = s.signRSA(s.claims("ns1:admin"))
+	s.assertDecision(DecisionAllow, token, "AdminDeleteWorkflowExecution", "ns1")
+	s.assertDecision(DecisionDeny, token, "AdminDescribeCluster", "")
+	// admin APIs which are not classified are authorized against the system namespace
+	s.assertDecision(DecisionDeny, token, "AdminReapplyEvents", "")
+	s.assertDecision(DecisionDeny, token, "AdminRefreshWorkflowTasks", "")
+}
+
+func (s *jwtAuthorizerSuite) TestAuthorize_StartMigration() {
+	// a migration is authorized on both the source and the target namespace
+	token := s.signRSA(s.claims("target:admin", "source:writer"))
+	s.assertDecision(DecisionAllow, token, "AdminStartMigration", "target")
+	s.assertDecision(DecisionDeny, token, "AdminStartMigration", "source")
+	s.assertDecision(DecisionDeny, token, "AdminStartMigration", "other")
+}
+
+func (s *jwtAuthorizerSuite) TestAuthorize_SigningAlgorithms() {
+	s.assertDecision(DecisionAllow, s.signECDSA(s.claims("ns1:writer")), "StartWorkflowExecution", "ns1")
+	s.assertDecision(DecisionAllow, s.signHMAC("hmac-key", s.hmacSecret, s.claims("ns1:writer")), "StartWorkflowExecution", "ns1")
+	s.assertDecision(DecisionDeny, s.signHMAC("hmac-key", []byte("wrong-secret"), s.claims("ns1:writer")), "StartWorkflowExecution", "ns1")
+	// an HMAC token must not be verified with the bytes of a public key
+	s.assertDecision(DecisionDeny, s.signHMAC("rsa-key", s.rsaKey.N.Bytes(), s.claims("ns1:writer")), "StartWorkflowExecution", "ns1")
+}
+
+func (s *jwtAuthorizerSuite) TestAuthorize_InvalidClaims() {
+	claims := s.claims("ns1:admin")
+	claims["exp"] = time.Now().Add(-time.Minute).Unix()
+	s.assertDecision(DecisionDeny, s.signRSA(claims), "DescribeNamespace", "ns1")
+
+	claims = s.claims("ns1:admin")
+	delete(claims, "exp")
+	s.assertDecision(DecisionDeny, s.signRSA(claims), "DescribeNamespace", "ns1")
+
+	claims = s.claims("ns1:admin")
+	claims["nbf"] = time.Now().Add(time.Hour).Unix()
+	s.assertDecision(DecisionDeny, s.signRSA(claims), "DescribeNamespace", "ns1")
+
+	claims = s.claims("ns1:admin")
+	claims["iss"] = "other-issuer"
+	s.assertDecision(DecisionDeny, s.signRSA(claims), "DescribeNamespace", "ns1")
+
+	claims = s.claims("ns1:admin")
+	claims["aud"] = []string{"other", testAudience}
+	s.assertDecision(DecisionAllow, s.signRSA(claims), "DescribeNamespace", "ns1")
+	claims["aud"] = "other"
+	s.assertDecision(DecisionDeny, s.signRSA(claims), "DescribeNamespace", "ns1")
+
+	claims = s.claims()
+	claims["permissions"] = []string{"ns1:owner"}
+	s.assertDecision(DecisionDeny, s.signRSA(claims), "DescribeNamespace", "ns1")
+}
+
+func (s *jwtAuthorizerSuite) TestAuthorize_AllowTokensWithoutExpiration() {
+	authorizer, err := NewJWTAuthorizer(&config.Authorization{
+		StaticKeyFiles:               map[string]string{"hmac-key": s.writeFile("hmac.secret", s.hmacSecret)},
+		AllowTokensWithoutExpiration: true,
+	})
+	s.NoError(err)
+
+	claims := s.claims("ns1:reader")
+	delete(claims, "exp")
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(AuthorizationHeader, "Bearer "+s.signHMAC("hmac-key", s.hmacSecret, claims)))
+	result, err := authorizer.Authorize(ctx, &Attributes{APIName: "DescribeNamespace", Namespace: "ns1"})
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	// an expired token is still denied
+	claims["exp"] = time.Now().Add(-time.Minute).Unix()
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(AuthorizationHeader, "Bearer "+s.signHMAC("hmac-key", s.hmacSecret, claims)))
+	result, err = authorizer.Authorize(ctx, &Attributes{APIName: "DescribeNamespace", Namespace: "ns1"})
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+
+func (s *jwtAuthorizerSuite) TestAuthorize_MissingToken() {
+	result, err := s.authorizer.Authorize(context.Background(), &Attributes{APIName: "DescribeNamespace", Namespace: "ns1"})
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	s.assertDecision(DecisionDeny, "not-a-token", "DescribeNamespace", "ns1")
+}
+
+func (s *jwtAuthorizerSuite) TestAuthorize_SetsActor() {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(AuthorizationHeader, "Bearer "+s.signRSA(s.claims("ns1:reader"))))
+	attr := &Attributes{APIName: "DescribeNamespace", Namespace: "ns1"}
+	result, err := s.authorizer.Authorize(ctx, attr)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+	s.Equal("test-subject", attr.Actor)
+}
+
+func (s *jwtAuthorizerSuite) TestNewFileTokenKeyProvider_NoKeys() {
+	_, err := NewFileTokenKeyProvider(nil, nil)
+	s.Error(err)
+}
+
+func (s *jwtAuthorizerSuite) TestAuthorize_SystemCredentials() {
+	cfg := &config.Authorization{
+		StaticKeyFiles: map[string]string{"hmac-key": s.writeFile("hmac.secret", s.hmacSecret)},
+		SystemKeyFile:  s.writeFile("system.secret", []byte("system-secret\n")),
+		Issuer:         testIssuer,
+		Audience:       testAudience,
+	}
+	authorizer, err := NewJWTAuthorizer(cfg)
+	s.NoError(err)
+	creds, err := NewSystemCredentials(cfg)
+	s.NoError(err)
+	s.NotNil(creds)
+
+	md, err := creds.GetRequestMetadata(context.Background())
+	s.NoError(err)
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(md))
+	for _, attr := range []*Attributes{
+		{APIName: "AdminCloseShard"},
+		{APIName: "RegisterNamespace", Namespace: "ns1"},
+		{APIName: "StartWorkflowExecution", Namespace: "temporal-system"},
+	} {
+		result, err := authorizer.Authorize(ctx, attr)
+		s.NoError(err)
+		s.Equal(DecisionAllow, result.Decision, attr.APIName)
+		s.Equal(SystemSubject, attr.Actor)
+	}
+
+	// a server with another system key does not trust the token
+	other, err := NewJWTAuthorizer(&config.Authorization{SystemKeyFile: s.writeFile("other.secret", []byte("other-secret"))})
+	s.NoError(err)
+	result, err := other.Authorize(ctx, &Attributes{APIName: "AdminCloseShard"})
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	cfg.StaticKeyFiles[SystemKeyID] = cfg.SystemKeyFile
+	_, err = NewJWTAuthorizer(cfg)
+	s.Error(err)
+
+	creds, err = NewSystemCredentials(&config.Authorization{})
+	s.NoError(err)
+	s.Nil(creds)
+}
+
+func (s *jwtAuthorizerSuite) TestTokenCredentials() {
+	md, err := NewTokenCredentials("token").GetRequestMetadata(context.Background())
+	s.NoError(err)
+	s.Equal(map[string]string{AuthorizationHeader: "Bearer token"}, md)
+}
+
+func (s *jwtAuthorizerSuite) TestGetRequiredRole() {
+	s.Equal(RoleReader, GetRequiredRole("GetWorkflowExecutionHistory"))
+	s.Equal(RoleWriter, GetRequiredRole("SignalWorkflowExecution"))
+	s.Equal(RoleAdmin, GetRequiredRole("RegisterNamespace"))
+	s.Equal(RoleAdmin, GetRequiredRole("AdminDescribeWorkflowExecution"))
+}
+
+func (s *jwtAuthorizerSuite) TestGetRequiredRole_AdminAPIs() {
+	s.Equal(RoleWriter, GetRequiredRole("AdminPauseWorkflowExecution"))
+	s.Equal(RoleWriter, GetRequiredRole("AdminUnpauseWorkflowExecution"))
+	s.Equal(RoleWriter, GetRequiredRole("AdminTriggerCronRun"))
+	s.Equal(RoleWriter, GetRequiredRole("AdminSkipCronRuns"))
+	s.Equal(RoleWriter, GetRequiredRole("AdminUpdateActivityOptions"))
+	s.Equal(RoleWriter, GetRequiredRole("AdminResetActivity"))
+	s.Equal(RoleWriter, GetRequiredRole("AdminStartBatchOperation"))
+	s.Equal(RoleWriter, GetRequiredRole("AdminStopBatchOperation"))
+	s.Equal(RoleReader, GetRequiredRole("AdminDescribeBatchOperation"))
+	s.Equal(RoleReader, GetRequiredRole("AdminListBatchOperations"))
+	s.Equal(RoleAdmin, GetRequiredRole("AdminDeleteWorkflowExecution"))
+	s.Equal(RoleWriter, GetRequiredRole("AdminUpsertWorkflowAttributes"))
+	s.Equal(RoleAdmin, GetRequiredRole("AdminDeleteNamespace"))
+	s.Equal(RoleReader, GetRequiredRole("AdminDescribeNamespaceDeletion"))
+	s.Equal(RoleAdmin, GetRequiredRole("AdminRenameNamespace"))
+	s.Equal(RoleAdmin, GetRequiredRole("AdminStartMigration"))
+	s.Equal(RoleAdmin, GetRequiredRole("AdminDescribeMigration"))
+	s.Equal(RoleReader, GetRequiredRole("AdminExportWorkflowExecution"))
+	s.Equal(RoleAdmin, GetRequiredRole("AdminImportWorkflowExecution"))
+	s.Equal(RoleReader, GetRequiredRole("AdminStreamWorkflowExecutionHistory"))
+	s.Equal(RoleWriter, GetRequiredRole("AdminUpdateWorkflowExecution"))
+	s.Equal(RoleAdmin, GetRequiredRole("AdminUpdateWorkerVersionSets"))
+	s.Equal(RoleReader, GetRequiredRole("AdminDescribeTaskListVersions"))
+	s.Equal(RoleAdmin, GetRequiredRole("AdminUpdateTaskListRateLimit"))
+	s.Equal(RoleAdmin, GetRequiredRole("AdminUnknownAPI"))
+}
+
+func (s *jwtAuthorizerSuite) assertDecision(expected Decision, token string, apiName string, namespace string) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(AuthorizationHeader, "Bearer "+token))
+	result, err := s.authorizer.Authorize(ctx, &Attributes{APIName: apiName, Namespace: namespace})
+	s.NoError(err)
+	s.Equal(expected, result.Decision, "%v on %v", apiName, namespace)
+}
+
+func (s *jwtAuthorizerSuite) claims(permissions ...string) map[string]interface{} {
+	return map[string]interface{}{
+		"sub":         "test-subject",
+		"iss":         testIssuer,
+		"aud":         testAudience,
+		"exp":         time.Now().Add(time.Hour).Unix(),
+		"permissions": permissions,
+	}
+}
+
+func (s *jwtAuthorizerSuite) signRSA(claims map[string]interface{}) string {
+	signed := s.encode("RS256", "rsa-key", claims)
+	hash := sha256Digest(signed)
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.rsaKey, crypto.SHA256, hash)
+	s.NoError(err)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func (s *jwtAuthorizerSuite) signECDSA(claims map[string]interface{}) string {
+	signed := s.encode("ES256", "ec-key", claims)
+	r, sig, err := ecdsa.Sign(rand.Reader, s.ecKey, sha256Digest(signed))
+	s.NoError(err)
+	signature := make([]byte, 64)
+	rBytes, sBytes := r.Bytes(), sig.Bytes()
+	copy(signature[32-len(rBytes):32], rBytes)
+	copy(signature[64-len(sBytes):], sBytes)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func (s *jwtAuthorizerSuite) signHMAC(keyID string, secret []byte, claims map[string]interface{}) string {
+	signed := s.encode("HS256", keyID, claims)
+	mac := hmac.New(crypto.SHA256.New, secret)
+	mac.Write([]byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (s *jwtAuthorizerSuite) encode(alg string, keyID string, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": keyID, "typ": "JWT"})
+	s.NoError(err)
+	payload, err := json.Marshal(claims)
+	s.NoError(err)
+	return base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+}
+
+func (s *jwtAuthorizerSuite) writeFile(name string, content []byte) string {
+	path := filepath.Join(s.keyDir, name)
+	s.NoError(ioutil.WriteFile(path, content, 0600))
+	return path
+}
+
+func sha256Digest(signed string) []byte {
+	hasher := crypto.SHA256.New()
+	hasher.Write([]byte(signed))
+	return hasher.Sum(nil)
+}
@@ -0,0 +1,151 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"strings"
+)
+
+const (
+	// RoleUndefined grants no access
+	RoleUndefined Role = iota
+	// RoleReader can call the read only APIs
+	RoleReader
+	// RoleWriter can additionally start, signal and process workflows
+	RoleWriter
+	// RoleAdmin can additionally manage namespaces and call the admin APIs
+	RoleAdmin
+)
+
+const (
+	// SystemNamespace is the namespace of permissions which apply to every namespace
+	// and to the APIs which are not scoped to a namespace
+	SystemNamespace = "*"
+
+	// AdminAPIPrefix prefixes the API names of the AdminService APIs
+	AdminAPIPrefix = "Admin"
+)
+
+type (
+	// Role is the access level of a caller on a namespace, every role includes the roles below it
+	Role int
+)
+
+var (
+	roleNames = map[Role]string{
+		RoleReader: "reader",
+		RoleWriter: "writer",
+		RoleAdmin:  "admin",
+	}
+
+	readOnlyAPIs = map[string]struct{}{
+		"CountWorkflowExecutions":        {},
+		"DescribeNamespace":              {},
+		"DescribeTaskList":               {},
+		"DescribeWorkflowExecution":      {},
+		"GetClusterInfo":                 {},
+		"GetSearchAttributes":            {},
+		"GetWorkflowExecutionHistory":    {},
+		"ListArchivedWorkflowExecutions": {},
+		"ListClosedWorkflowExecutions":   {},
+		"ListNamespaces":                 {},
+		"ListOpenWorkflowExecutions":     {},
+		"ListTaskListPartitions":         {},
+		"ListWorkflowExecutions":         {},
+		"QueryWorkflow":                  {},
+		"ScanWorkflowExecutions":         {},
+	}
+
+	namespaceAdminAPIs = map[string]struct{}{
+		"DeprecateNamespace": {},
+		"RegisterNamespace":  {},
+		"UpdateNamespace":    {},
+	}
+
+	// adminAPIRoles classifies the admin APIs which operate on a single namespace and are
+	// authorized against it. Every admin API which is not listed is authorized against the
+	// system namespace and requires the admin role on it. An API added to the AdminService
+	// must be classified here when it is added.
+	adminAPIRoles = map[string]Role{
+		"AdminPauseWorkflowExecution":         RoleWriter,
+		"AdminUnpauseWorkflowExecution":       RoleWriter,
+		"AdminTriggerCronRun":                 RoleWriter,
+		"AdminSkipCronRuns":                   RoleWriter,
+		"AdminUpdateActivityOptions":          RoleWriter,
+		"AdminResetActivity":                  RoleWriter,
+		"AdminStartBatchOperation":            RoleWriter,
+		"AdminStopBatchOperation":             RoleWriter,
+		"AdminDescribeBatchOperation":         RoleReader,
+		"AdminListBatchOperations":            RoleReader,
+		"AdminDeleteWorkflowExecution":        RoleAdmin,
+		"AdminUpsertWorkflowAttributes":       RoleWriter,
+		"AdminDeleteNamespace":                RoleAdmin,
+		"AdminDescribeNamespaceDeletion":      RoleReader,
+		"AdminRenameNamespace":                RoleAdmin,
+		"AdminStartMigration":                 RoleAdmin,
+		"AdminDescribeMigration":              RoleAdmin,
+		"AdminExportWorkflowExecution":        RoleReader,
+		"AdminImportWorkflowExecution":        RoleAdmin,
+		"AdminStreamWorkflowExecutionHistory": RoleReader,
+		"AdminUpdateWorkflowExecution":        RoleWriter,
+		"AdminUpdateWorkerVersionSets":        RoleAdmin,
+		"AdminDescribeTaskListVersions":       RoleReader,
+		"AdminUpdateTaskListRateLimit":        RoleAdmin,
+	}
+)
+
+// ParseRole returns the role with the given name
+func ParseRole(name string) (Role, bool) {
+	for role, roleName := range roleNames {
+		if strings.EqualFold(name, roleName) {
+			return role, true
+		}
+	}
+	return RoleUndefined, false
+}
+
+func (r Role) String() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+	return "undefined"
+}
+
+// GetRequiredRole returns the role a caller needs on the namespace of an API call
+func GetRequiredRole(apiName string) Role {
+	if strings.HasPrefix(apiName, AdminAPIPrefix) {
+		if role, ok := adminAPIRoles[apiName]; ok {
+			return role
+		}
+		return RoleAdmin
+	}
+	if _, ok := namespaceAdminAPIs[apiName]; ok {
+		return RoleAdmin
+	}
+	if _, ok := readOnlyAPIs[apiName]; ok {
+		return RoleReader
+	}
+	return RoleWriter
+}
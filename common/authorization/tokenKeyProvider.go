@@ -0,0 +1,212 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+)
+
+type (
+	// TokenKeyProvider returns the keys the signatures of bearer tokens are verified with
+	TokenKeyProvider interface {
+		// GetKey returns the key for the "kid" header of a token, it is a *rsa.PublicKey,
+		// an *ecdsa.PublicKey or a []byte HMAC secret
+		GetKey(keyID string) (interface{}, error)
+	}
+
+	fileTokenKeyProvider struct {
+		keys map[string]interface{}
+	}
+
+	jsonWebKeySet struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+
+	jsonWebKey struct {
+		Kty string `json:"kty"`
+		Kid string `json:"kid"`
+		Crv string `json:"crv"`
+		N   string `json:"n"`
+		E   string `json:"e"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+		K   string `json:"k"`
+	}
+)
+
+// NewFileTokenKeyProvider returns a key provider which loads the keys from local JSON Web Key Set
+// files and from static key files, static key files are mapped by key ID
+func NewFileTokenKeyProvider(jwksFiles []string, staticKeyFiles map[string]string) (TokenKeyProvider, error) {
+	provider := &fileTokenKeyProvider{keys: make(map[string]interface{})}
+	for _, path := range jwksFiles {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read JWKS file: %v", err)
+		}
+		var keySet jsonWebKeySet
+		if err := json.Unmarshal(content, &keySet); err != nil {
+			return nil, fmt.Errorf("unable to parse JWKS file %v: %v", path, err)
+		}
+		for _, jwk := range keySet.Keys {
+			key, err := jwk.toKey()
+			if err != nil {
+				return nil, fmt.Errorf("invalid key %v in JWKS file %v: %v", jwk.Kid, path, err)
+			}
+			if err := provider.addKey(jwk.Kid, key); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for keyID, path := range staticKeyFiles {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read key file: %v", err)
+		}
+		key, err := parseStaticKey(content)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key file %v: %v", path, err)
+		}
+		if err := provider.addKey(keyID, key); err != nil {
+			return nil, err
+		}
+	}
+	if len(provider.keys) == 0 {
+		return nil, fmt.Errorf("no keys to verify tokens with are configured")
+	}
+	return provider, nil
+}
+
+func (p *fileTokenKeyProvider) GetKey(keyID string) (interface{}, error) {
+	if keyID == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, nil
+		}
+	}
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown key: %v", keyID)
+	}
+	return key, nil
+}
+
+func (p *fileTokenKeyProvider) addKey(keyID string, key interface{}) error {
+	if _, ok := p.keys[keyID]; ok {
+		return fmt.Errorf("key %v is configured more than once", keyID)
+	}
+	p.keys[keyID] = key
+	return nil
+}
+
+func (k *jsonWebKey) toKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve: %v", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("point is not on curve %v", k.Crv)
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "oct":
+		return base64.RawURLEncoding.DecodeString(k.K)
+	default:
+		return nil, fmt.Errorf("unsupported key type: %v", k.Kty)
+	}
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf("missing key parameter")
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+func parseStaticKey(content []byte) (interface{}, error) {
+	block, _ := pem.Decode(content)
+	if block == nil {
+		secret := bytes.TrimSpace(content)
+		if len(secret) == 0 {
+			return nil, fmt.Errorf("empty HMAC secret")
+		}
+		return secret, nil
+	}
+	if block.Type == "CERTIFICATE" {
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return cert.PublicKey, nil
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	switch key.(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", key)
+	}
+}
@@ -25,6 +25,8 @@
 package backoff
 
 import (
+	"math/rand"
+	"strings"
 	"time"
 
 	"github.com/robfig/cron"
@@ -35,13 +37,120 @@ import (
 // NoBackoff is used to represent backoff when no cron backoff is needed
 const NoBackoff = time.Duration(-1)
 
+// Options which can prefix the cron spec, e.g. "CRON_TZ=Europe/Berlin CRON_JITTER=5m CRON_OVERLAP=skip 0 10 * * *"
+const (
+	cronTimeZoneOption     = "CRON_TZ="
+	cronTimeZoneOptionAlt  = "TZ="
+	cronJitterOption       = "CRON_JITTER="
+	cronOverlapOption      = "CRON_OVERLAP="
+	cronOptionSeparator    = " "
+	cronOptionKeyValueChar = "="
+)
+
+// CronOverlapPolicy defines what happens when a cron run is still running at the time of the next schedule
+type CronOverlapPolicy string
+
+// Supported cron overlap policies
+const (
+	// CronOverlapPolicySkip skips the schedules missed while the run was running, this is the default
+	CronOverlapPolicySkip CronOverlapPolicy = "skip"
+	// CronOverlapPolicyBufferOne starts the next run right after the overrunning run closes
+	CronOverlapPolicyBufferOne CronOverlapPolicy = "buffer_one"
+	// CronOverlapPolicyCancelPrevious requests cancellation of the overrunning run at the time of the next schedule
+	CronOverlapPolicyCancelPrevious CronOverlapPolicy = "cancel_previous"
+	// CronOverlapPolicyTerminatePrevious terminates the overrunning run at the time of the next schedule
+	CronOverlapPolicyTerminatePrevious CronOverlapPolicy = "terminate_previous"
+)
+
+type (
+	// CronSchedule is a parsed cron schedule together with its options
+	CronSchedule struct {
+		schedule      cron.Schedule
+		location      *time.Location
+		jitter        time.Duration
+		overlapPolicy CronOverlapPolicy
+	}
+)
+
+// ParseCronSchedule parses a cron schedule spec which can be prefixed with
+// CRON_TZ (or TZ), CRON_JITTER and CRON_OVERLAP options
+func ParseCronSchedule(cronSchedule string) (*CronSchedule, error) {
+	result := &CronSchedule{
+		location:      time.UTC,
+		overlapPolicy: CronOverlapPolicySkip,
+	}
+
+	spec := strings.TrimSpace(cronSchedule)
+	for {
+		fields := strings.SplitN(spec, cronOptionSeparator, 2)
+		if len(fields) != 2 || !strings.Contains(fields[0], cronOptionKeyValueChar) {
+			break
+		}
+		option := fields[0]
+		spec = strings.TrimSpace(fields[1])
+
+		switch {
+		case strings.HasPrefix(option, cronTimeZoneOption), strings.HasPrefix(option, cronTimeZoneOptionAlt):
+			location, err := time.LoadLocation(option[strings.Index(option, cronOptionKeyValueChar)+1:])
+			if err != nil {
+				return nil, serviceerror.NewInvalidArgument("Invalid CronSchedule time zone.")
+			}
+			result.location = location
+		case strings.HasPrefix(option, cronJitterOption):
+			jitter, err := time.ParseDuration(strings.TrimPrefix(option, cronJitterOption))
+			if err != nil || jitter < 0 {
+				return nil, serviceerror.NewInvalidArgument("Invalid CronSchedule jitter.")
+			}
+			result.jitter = jitter
+		case strings.HasPrefix(option, cronOverlapOption):
+			policy := CronOverlapPolicy(strings.TrimPrefix(option, cronOverlapOption))
+			switch policy {
+			case CronOverlapPolicySkip,
+				CronOverlapPolicyBufferOne,
+				CronOverlapPolicyCancelPrevious,
+				CronOverlapPolicyTerminatePrevious:
+				result.overlapPolicy = policy
+			default:
+				return nil, serviceerror.NewInvalidArgument("Invalid CronSchedule overlap policy.")
+			}
+		default:
+			return nil, serviceerror.NewInvalidArgument("Invalid CronSchedule option.")
+		}
+	}
+
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument("Invalid CronSchedule.")
+	}
+	result.schedule = schedule
+	return result, nil
+}
+
+// Next returns the first schedule time after the given time
+func (s *CronSchedule) Next(t time.Time) time.Time {
+	return s.schedule.Next(t.In(s.location))
+}
+
+// OverlapPolicy returns the overlap policy of the cron schedule
+func (s *CronSchedule) OverlapPolicy() CronOverlapPolicy {
+	return s.overlapPolicy
+}
+
+// Jitter returns a random duration in [0, jitter) of the cron schedule
+func (s *CronSchedule) Jitter() time.Duration {
+	if s.jitter <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(s.jitter)))
+}
+
 // ValidateSchedule validates a cron schedule spec
 func ValidateSchedule(cronSchedule string) error {
 	if cronSchedule == "" {
 		return nil
 	}
-	if _, err := cron.ParseStandard(cronSchedule); err != nil {
-		return serviceerror.NewInvalidArgument("Invalid CronSchedule.")
+	if _, err := ParseCronSchedule(cronSchedule); err != nil {
+		return err
 	}
 	return nil
 }
@@ -53,18 +162,20 @@ func GetBackoffForNextSchedule(cronSchedule string, startTime time.Time, closeTi
 		return NoBackoff
 	}
 
-	schedule, err := cron.ParseStandard(cronSchedule)
+	schedule, err := ParseCronSchedule(cronSchedule)
 	if err != nil {
 		return NoBackoff
 	}
-	startUTCTime := startTime.In(time.UTC)
-	closeUTCTime := closeTime.In(time.UTC)
-	nextScheduleTime := schedule.Next(startUTCTime)
+	nextScheduleTime := schedule.Next(startTime)
+	if nextScheduleTime.Before(closeTime) && schedule.OverlapPolicy() != CronOverlapPolicySkip {
+		// the run overran its next schedule, start the next run right away
+		return 0
+	}
 	// Calculate the next schedule start time which is nearest to the close time
-	for nextScheduleTime.Before(closeUTCTime) {
+	for nextScheduleTime.Before(closeTime) {
 		nextScheduleTime = schedule.Next(nextScheduleTime)
 	}
-	backoffInterval := nextScheduleTime.Sub(closeUTCTime)
+	backoffInterval := nextScheduleTime.Sub(closeTime) + schedule.Jitter()
 	roundedInterval := time.Second * time.Duration(convert.Int64Ceil(backoffInterval.Seconds()))
 	return roundedInterval
 }
@@ -78,3 +189,34 @@ func GetBackoffForNextScheduleInSeconds(cronSchedule string, startTime time.Time
 	}
 	return convert.Int32Ceil(backoffDuration.Seconds())
 }
+
+// GetNextScheduleTime returns the schedule time of the run after skipping the given number of
+// upcoming schedules following the given time, zero time is returned if there is no valid cron schedule
+func GetNextScheduleTime(cronSchedule string, after time.Time, skip int) time.Time {
+	if len(cronSchedule) == 0 {
+		return time.Time{}
+	}
+
+	schedule, err := ParseCronSchedule(cronSchedule)
+	if err != nil {
+		return time.Time{}
+	}
+	nextScheduleTime := schedule.Next(after)
+	for i := 0; i < skip; i++ {
+		nextScheduleTime = schedule.Next(nextScheduleTime)
+	}
+	return nextScheduleTime
+}
+
+// GetCronOverlapPolicy returns the overlap policy of a cron schedule, skip if it is not set or the schedule is invalid
+func GetCronOverlapPolicy(cronSchedule string) CronOverlapPolicy {
+	if len(cronSchedule) == 0 {
+		return CronOverlapPolicySkip
+	}
+
+	schedule, err := ParseCronSchedule(cronSchedule)
+	if err != nil {
+		return CronOverlapPolicySkip
+	}
+	return schedule.OverlapPolicy()
+}
@@ -51,6 +51,14 @@ var crontests = []struct {
 	{"@every 5h", "2018-12-17T08:00:00+00:00", "2018-12-17T09:00:00+00:00", time.Hour * 4},
 	{"@every 5h", "2018-12-17T08:00:00+00:00", "2018-12-18T00:00:00+00:00", time.Hour * 4},
 	{"0 3 * * 0-6", "2018-12-17T08:00:00-08:00", "", time.Hour * 11},
+	{"CRON_TZ=America/Los_Angeles 0 10 * * *", "2018-12-17T08:00:00+00:00", "", time.Hour * 10},
+	{"TZ=Asia/Tokyo 0 10 * * *", "2018-12-17T00:00:00+00:00", "", time.Hour},
+	{"CRON_TZ=Invalid/Zone 0 10 * * *", "2018-12-17T08:00:00+00:00", "", NoBackoff},
+	{"CRON_OVERLAP=skip 0 10 * * *", "2018-12-17T08:00:00+00:00", "2018-12-17T11:00:00+00:00", time.Hour * 23},
+	{"CRON_OVERLAP=buffer_one 0 10 * * *", "2018-12-17T08:00:00+00:00", "2018-12-17T11:00:00+00:00", 0},
+	{"CRON_OVERLAP=terminate_previous 0 10 * * *", "2018-12-17T08:00:00+00:00", "2018-12-17T09:00:00+00:00", time.Hour},
+	{"CRON_OVERLAP=invalid 0 10 * * *", "2018-12-17T08:00:00+00:00", "", NoBackoff},
+	{"CRON_JITTER=invalid 0 10 * * *", "2018-12-17T08:00:00+00:00", "", NoBackoff},
 }
 
 func TestCron(t *testing.T) {
@@ -70,3 +78,25 @@ func TestCron(t *testing.T) {
 		})
 	}
 }
+
+func TestCronJitter(t *testing.T) {
+	start, _ := time.Parse(time.RFC3339, "2018-12-17T08:00:00+00:00")
+	for i := 0; i < 100; i++ {
+		backoff := GetBackoffForNextSchedule("CRON_JITTER=10m 0 10 * * *", start, start)
+		assert.True(t, backoff >= time.Hour*2 && backoff <= time.Hour*2+time.Minute*10, "unexpected backoff %s", backoff)
+	}
+}
+
+func TestGetNextScheduleTime(t *testing.T) {
+	after, _ := time.Parse(time.RFC3339, "2018-12-17T08:00:00+00:00")
+	expected, _ := time.Parse(time.RFC3339, "2018-12-17T10:00:00+00:00")
+	assert.True(t, expected.Equal(GetNextScheduleTime("0 10 * * *", after, 0)))
+	assert.True(t, expected.Add(time.Hour*48).Equal(GetNextScheduleTime("0 10 * * *", after, 2)))
+	assert.True(t, GetNextScheduleTime("", after, 0).IsZero())
+}
+
+func TestGetCronOverlapPolicy(t *testing.T) {
+	assert.Equal(t, CronOverlapPolicySkip, GetCronOverlapPolicy(""))
+	assert.Equal(t, CronOverlapPolicySkip, GetCronOverlapPolicy("0 10 * * *"))
+	assert.Equal(t, CronOverlapPolicyCancelPrevious, GetCronOverlapPolicy("CRON_TZ=UTC CRON_OVERLAP=cancel_previous 0 10 * * *"))
+}
@@ -38,6 +38,7 @@ import (
 	namespacepb "go.temporal.io/temporal-proto/namespace"
 	"go.temporal.io/temporal-proto/serviceerror"
 
+	namespacegenpb "github.com/temporalio/temporal/.gen/proto/namespace"
 	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
 	"github.com/temporalio/temporal/common"
 	"github.com/temporalio/temporal/common/clock"
@@ -455,10 +456,8 @@ func (c *namespaceCache) refreshNamespacesLocked() error {
 	nextEntries := []*NamespaceCacheEntry{}
 
 	// make a copy of the existing namespace cache, so we can calculate diff and do compare and swap
-	newCacheNameToID := newNamespaceCache()
 	newCacheByID := newNamespaceCache()
 	for _, namespace := range c.GetAllNamespace() {
-		newCacheNameToID.Put(namespace.info.Name, primitives.UUIDString(namespace.info.Id))
 		newCacheByID.Put(primitives.UUIDString(namespace.info.Id), namespace)
 	}
 
@@ -476,7 +475,6 @@ UpdateLoop:
 		if err != nil {
 			return err
 		}
-		c.updateNameToIDCache(newCacheNameToID, nextEntry.info.Name, primitives.UUIDString(nextEntry.info.Id))
 
 		if prevEntry != nil {
 			prevEntries = append(prevEntries, prevEntry)
@@ -484,6 +482,10 @@ UpdateLoop:
 		}
 	}
 
+	// the name to id cache is rebuilt rather than copied, so that
+	// old names of renamed namespaces and expired aliases are dropped
+	newCacheNameToID := c.buildNameToIDCache(newCacheByID)
+
 	// NOTE: READ REF BEFORE MODIFICATION
 	// ref: historyEngine.go registerNamespaceFailoverCallback function
 	c.callbackLock.Lock()
@@ -504,13 +506,39 @@ func (c *namespaceCache) checkNamespaceExists(
 	return err
 }
 
-func (c *namespaceCache) updateNameToIDCache(
-	cacheNameToID Cache,
-	name string,
-	id string,
-) {
+func (c *namespaceCache) buildNameToIDCache(
+	cacheByID Cache,
+) Cache {
+
+	cacheNameToID := newNamespaceCache()
+	now := c.timeSource.Now().UnixNano()
+	var aliases []*namespacegenpb.NamespaceAlias
+	var aliasIDs []string
+
+	ite := cacheByID.Iterator()
+	defer ite.Close()
+	for ite.HasNext() {
+		entry := ite.Next()
+		id := entry.Key().(string)
+		namespace := entry.Value().(*NamespaceCacheEntry)
+		namespace.RLock()
+		cacheNameToID.Put(namespace.info.Name, id)
+		for _, alias := range namespace.info.Aliases {
+			if alias.GetExpireTimeNanos() > now {
+				aliases = append(aliases, alias)
+				aliasIDs = append(aliasIDs, id)
+			}
+		}
+		namespace.RUnlock()
+	}
 
-	cacheNameToID.Put(name, id)
+	// a namespace name always takes precedence over an alias
+	for i, alias := range aliases {
+		if _, err := cacheNameToID.PutIfNotExist(alias.GetName(), aliasIDs[i]); err != nil {
+			c.logger.Warn("Unable to cache namespace alias", tag.WorkflowNamespace(alias.GetName()), tag.Error(err))
+		}
+	}
+	return cacheNameToID
 }
 
 func (c *namespaceCache) updateIDToNamespaceCache(
@@ -27,13 +27,17 @@ package cache
 import (
 	"sync"
 	"testing"
+	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
 	namespacepb "go.temporal.io/temporal-proto/namespace"
+	"go.temporal.io/temporal-proto/serviceerror"
 
+	namespacegenpb "github.com/temporalio/temporal/.gen/proto/namespace"
 	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
 	"github.com/temporalio/temporal/common/cluster"
 	"github.com/temporalio/temporal/common/log"
@@ -285,6 +289,118 @@ func (s *namespaceCacheSuite) TestGetNamespace_NonLoaded_GetByID() {
 	s.Equal(entry, entryByID)
 }
 
+func (s *namespaceCacheSuite) TestGetNamespace_Alias() {
+	s.clusterMetadata.On("IsGlobalNamespaceEnabled").Return(true)
+	s.metadataMgr.On("GetMetadata").Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil)
+	namespaceRecord := &persistence.GetNamespaceResponse{
+		Namespace: &persistenceblobs.NamespaceDetail{
+			Info: &persistenceblobs.NamespaceInfo{
+				Id:   uuid.NewRandom(),
+				Name: "some random namespace name",
+				Data: make(map[string]string),
+				Aliases: []*namespacegenpb.NamespaceAlias{
+					{Name: "some old namespace name", ExpireTimeNanos: time.Now().Add(time.Hour).UnixNano()},
+					{Name: "some expired namespace name", ExpireTimeNanos: time.Now().Add(-time.Hour).UnixNano()},
+				},
+			},
+			Config: &persistenceblobs.NamespaceConfig{
+				RetentionDays: 1,
+				BadBinaries: &namespacepb.BadBinaries{
+					Binaries: map[string]*namespacepb.BadBinaryInfo{},
+				}},
+			ReplicationConfig: &persistenceblobs.NamespaceReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters:          []string{cluster.TestCurrentClusterName},
+			},
+		},
+		NotificationVersion: 0,
+	}
+	entry := s.buildEntryFromRecord(namespaceRecord)
+
+	s.metadataMgr.On("ListNamespaces", &persistence.ListNamespacesRequest{
+		PageSize:      namespaceCacheRefreshPageSize,
+		NextPageToken: nil,
+	}).Return(&persistence.ListNamespacesResponse{
+		Namespaces:    []*persistence.GetNamespaceResponse{namespaceRecord},
+		NextPageToken: nil,
+	}, nil).Once()
+	s.metadataMgr.On("GetNamespace", &persistence.GetNamespaceRequest{Name: "some expired namespace name"}).
+		Return(nil, serviceerror.NewNotFound("some random error message")).Once()
+
+	s.namespaceCache.Start()
+	defer s.namespaceCache.Stop()
+
+	entryByAlias, err := s.namespaceCache.GetNamespace("some old namespace name")
+	s.Nil(err)
+	s.Equal(entry, entryByAlias)
+	s.Equal("some random namespace name", entryByAlias.GetInfo().Name)
+
+	entryByAlias, err = s.namespaceCache.GetNamespace("some expired namespace name")
+	s.IsType(&serviceerror.NotFound{}, err)
+	s.Nil(entryByAlias)
+}
+
+func (s *namespaceCacheSuite) TestGetNamespace_Renamed() {
+	s.clusterMetadata.On("IsGlobalNamespaceEnabled").Return(true)
+	namespaceRecordOld := &persistence.GetNamespaceResponse{
+		Namespace: &persistenceblobs.NamespaceDetail{
+			Info: &persistenceblobs.NamespaceInfo{Id: uuid.NewRandom(), Name: "some random namespace name", Data: make(map[string]string)},
+			Config: &persistenceblobs.NamespaceConfig{
+				RetentionDays: 1,
+				BadBinaries: &namespacepb.BadBinaries{
+					Binaries: map[string]*namespacepb.BadBinaryInfo{},
+				}},
+			ReplicationConfig: &persistenceblobs.NamespaceReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters:          []string{cluster.TestCurrentClusterName},
+			},
+		},
+		NotificationVersion: 0,
+	}
+	namespaceRecordNew := &persistence.GetNamespaceResponse{
+		Namespace: &persistenceblobs.NamespaceDetail{
+			Info:              proto.Clone(namespaceRecordOld.Namespace.Info).(*persistenceblobs.NamespaceInfo),
+			Config:            namespaceRecordOld.Namespace.Config,
+			ReplicationConfig: namespaceRecordOld.Namespace.ReplicationConfig,
+			ConfigVersion:     1,
+		},
+		NotificationVersion: 1,
+	}
+	namespaceRecordNew.Namespace.Info.Name = "other random namespace name"
+	entryNew := s.buildEntryFromRecord(namespaceRecordNew)
+
+	s.metadataMgr.On("GetMetadata").Return(&persistence.GetMetadataResponse{NotificationVersion: 1}, nil).Once()
+	s.metadataMgr.On("ListNamespaces", &persistence.ListNamespacesRequest{
+		PageSize:      namespaceCacheRefreshPageSize,
+		NextPageToken: nil,
+	}).Return(&persistence.ListNamespacesResponse{
+		Namespaces:    []*persistence.GetNamespaceResponse{namespaceRecordOld},
+		NextPageToken: nil,
+	}, nil).Once()
+	s.metadataMgr.On("GetMetadata").Return(&persistence.GetMetadataResponse{NotificationVersion: 2}, nil).Once()
+	s.metadataMgr.On("ListNamespaces", &persistence.ListNamespacesRequest{
+		PageSize:      namespaceCacheRefreshPageSize,
+		NextPageToken: nil,
+	}).Return(&persistence.ListNamespacesResponse{
+		Namespaces:    []*persistence.GetNamespaceResponse{namespaceRecordNew},
+		NextPageToken: nil,
+	}, nil).Once()
+	s.metadataMgr.On("GetNamespace", &persistence.GetNamespaceRequest{Name: "some random namespace name"}).
+		Return(nil, serviceerror.NewNotFound("some random error message")).Once()
+
+	s.namespaceCache.Start()
+	defer s.namespaceCache.Stop()
+	_, err := s.namespaceCache.GetNamespace("some random namespace name")
+	s.Nil(err)
+
+	s.Nil(s.namespaceCache.refreshNamespaces())
+	entryByName, err := s.namespaceCache.GetNamespace("other random namespace name")
+	s.Nil(err)
+	s.Equal(entryNew, entryByName)
+	_, err = s.namespaceCache.GetNamespace("some random namespace name")
+	s.IsType(&serviceerror.NotFound{}, err)
+}
+
 func (s *namespaceCacheSuite) TestRegisterCallback_CatchUp() {
 	namespaceNotificationVersion := int64(0)
 	namespaceRecord1 := &persistence.GetNamespaceResponse{
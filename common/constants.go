@@ -51,6 +51,12 @@ const (
 	EndMessageID int64 = 1<<63 - 1
 )
 
+const (
+	// SystemSignalNamePrefix is reserved for the server, clients and decisions can not send signals
+	// with this prefix
+	SystemSignalNamePrefix = "__temporal_"
+)
+
 const (
 	// FrontendServiceName is the name of the frontend service
 	FrontendServiceName = "frontend"
@@ -70,6 +76,10 @@ const (
 	EncodingTypeUnknown EncodingType = "unknow"
 	EncodingTypeEmpty   EncodingType = ""
 	EncodingTypeProto3  EncodingType = "proto3"
+	// EncodingTypeEncrypted wraps one of the encodings above, see persistence.PayloadSerializer
+	EncodingTypeEncrypted EncodingType = "encrypted"
+	// EncodingTypeCompressed wraps one of the encodings above, see persistence.PayloadSerializer
+	EncodingTypeCompressed EncodingType = "compressed"
 )
 
 func (e EncodingType) String() string {
@@ -162,3 +172,35 @@ const (
 	// TaskTypeReplication is the task type for replication task
 	TaskTypeReplication
 )
+
+// Workflow updates are delivered to the worker as queries of a decision task, their results are recorded in the
+// mutable state instead of history
+const (
+	// UpdateQueryTypePrefix prefixes the name of an update in the query type it is delivered with
+	UpdateQueryTypePrefix = "__update:"
+)
+
+// Worker version sets key the task lists decision tasks are dispatched through
+const (
+	// WorkerBuildIDVersionSetPrefix prefixes the build ID of a worker outside of the declared worker version sets
+	// in the key of its task list, so that the key never matches the id of a set. Build IDs with this prefix are
+	// rejected in the declared sets.
+	WorkerBuildIDVersionSetPrefix = "__build:"
+)
+
+// Task priorities order the dispatch of tasks sharing a task list, lower values are dispatched first
+const (
+	// TaskPriorityUnspecified means the task takes the priority of its workflow, normal if that is not set either
+	TaskPriorityUnspecified int32 = iota
+	// TaskPriorityHigh is the priority of urgent workloads
+	TaskPriorityHigh
+	// TaskPriorityNormal is the default priority
+	TaskPriorityNormal
+	// TaskPriorityLow is the priority of bulk workloads
+	TaskPriorityLow
+)
+
+const (
+	// ActivityPriorityHeaderField is the reserved activity header field carrying the name of the activity task priority
+	ActivityPriorityHeaderField = "__priority"
+)
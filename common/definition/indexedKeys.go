@@ -51,6 +51,9 @@ const (
 	CustomDoubleField     = "CustomDoubleField"
 	CustomDatetimeField   = "CustomDatetimeField"
 	TemporalChangeVersion = "TemporalChangeVersion"
+	TemporalPaused        = "TemporalPaused"
+	TemporalPauseReason   = "TemporalPauseReason"
+	TemporalPauseIdentity = "TemporalPauseIdentity"
 )
 
 // valid non-indexed fields on ES
@@ -74,6 +77,9 @@ func createDefaultIndexedKeys() map[string]interface{} {
 		CustomDatetimeField:   commonpb.IndexedValueType_Datetime,
 		TemporalChangeVersion: commonpb.IndexedValueType_Keyword,
 		BinaryChecksums:       commonpb.IndexedValueType_Keyword,
+		TemporalPaused:        commonpb.IndexedValueType_Bool,
+		TemporalPauseReason:   commonpb.IndexedValueType_String,
+		TemporalPauseIdentity: commonpb.IndexedValueType_Keyword,
 	}
 	for k, v := range systemIndexedKeys {
 		defaultIndexedKeys[k] = v
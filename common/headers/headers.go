@@ -26,6 +26,7 @@ package headers
 
 import (
 	"context"
+	"strconv"
 
 	"google.golang.org/grpc/metadata"
 )
@@ -41,6 +42,18 @@ const (
 
 	// ClientImplHeaderName refers to the name of the gRPC metadata header that contains the client implementation.
 	ClientImplHeaderName = "temporal-client-name"
+
+	// StartDelayHeaderName refers to the name of the gRPC metadata header that contains the number of seconds
+	// the first decision task of a started workflow is delayed by.
+	StartDelayHeaderName = "temporal-start-delay-seconds"
+
+	// PriorityHeaderName refers to the name of the gRPC metadata header that contains the priority
+	// (high, normal or low) of a started workflow.
+	PriorityHeaderName = "temporal-priority"
+
+	// WorkerBuildIDHeaderName refers to the name of the gRPC metadata header that contains the build ID
+	// of the worker that polls for or completes a task.
+	WorkerBuildIDHeaderName = "temporal-worker-build-id"
 )
 
 var (
@@ -75,10 +88,20 @@ func GetValues(ctx context.Context, headerNames ...string) []string {
 // It copies all version headers to outgoing context only if they are exist in incoming context
 // and doesn't exist in outgoing context already.
 func PropagateVersions(ctx context.Context) context.Context {
+	return propagate(ctx, ClientVersionHeaderName, ClientFeatureVersionHeaderName, ClientImplHeaderName)
+}
+
+// PropagateStartDelay propagates start delay header from incoming context to outgoing context,
+// it is used when a start request is redirected to the frontend of another cluster.
+func PropagateStartDelay(ctx context.Context) context.Context {
+	return propagate(ctx, StartDelayHeaderName)
+}
+
+func propagate(ctx context.Context, headerNames ...string) context.Context {
 	if mdIncoming, ok := metadata.FromIncomingContext(ctx); ok {
 		var headersToAppend []string
 		mdOutgoing, mdOutgoingExist := metadata.FromOutgoingContext(ctx)
-		for _, headerName := range []string{ClientVersionHeaderName, ClientFeatureVersionHeaderName, ClientImplHeaderName} {
+		for _, headerName := range headerNames {
 			if incomingValue := mdIncoming.Get(headerName); len(incomingValue) > 0 {
 				if mdOutgoingExist {
 					if outgoingValue := mdOutgoing.Get(headerName); len(outgoingValue) > 0 {
@@ -109,6 +132,21 @@ func SetCLIVersions(ctx context.Context) context.Context {
 	return metadata.NewOutgoingContext(ctx, cliVersionHeaders)
 }
 
+// SetStartDelay appends start delay header to the outgoing context.
+func SetStartDelay(ctx context.Context, delaySeconds int32) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, StartDelayHeaderName, strconv.Itoa(int(delaySeconds)))
+}
+
+// SetPriority appends priority header to the outgoing context.
+func SetPriority(ctx context.Context, priority string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, PriorityHeaderName, priority)
+}
+
+// SetWorkerBuildID appends worker build ID header to the outgoing context.
+func SetWorkerBuildID(ctx context.Context, buildID string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, WorkerBuildIDHeaderName, buildID)
+}
+
 // SetVersionsForTests sets headers as they would be received from the client.
 // Must be used in tests only.
 func SetVersionsForTests(ctx context.Context, clientVersion, clientImpl, clientFeatureVersion string) context.Context {
@@ -127,3 +127,19 @@ func (s *HeadersSuite) TestPropagateHeaders_EmptyIncomingContext() {
 	s.Equal("21.04.16", md.Get(ClientFeatureVersionHeaderName)[0])
 	s.Equal("28.08.14", md.Get(ClientImplHeaderName)[0])
 }
+
+func (s *HeadersSuite) TestPropagateStartDelay() {
+	ctx := context.Background()
+	ctx = metadata.NewIncomingContext(ctx, metadata.New(map[string]string{
+		ClientVersionHeaderName: "22.08.78",
+		StartDelayHeaderName:    "10",
+	}))
+
+	ctx = PropagateStartDelay(ctx)
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	s.True(ok)
+
+	s.Equal("10", md.Get(StartDelayHeaderName)[0])
+	s.Empty(md.Get(ClientVersionHeaderName))
+}
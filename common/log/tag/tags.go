@@ -169,6 +169,11 @@ func QueryID(queryID string) Tag {
 	return newStringTag("query-id", queryID)
 }
 
+// UpdateID returns tag for UpdateID
+func UpdateID(updateID string) Tag {
+	return newStringTag("update-id", updateID)
+}
+
 // BlobSizeViolationOperation returns tag for BlobSizeViolationOperation
 func BlobSizeViolationOperation(operation string) Tag {
 	return newStringTag("blob-size-violation-operation", operation)
@@ -380,6 +385,11 @@ func HostID(hid string) Tag {
 	return newStringTag("hostId", hid)
 }
 
+// Identity returns tag for Identity
+func Identity(identity string) Tag {
+	return newStringTag("identity", identity)
+}
+
 // Key returns tag for Key
 func Key(k string) Tag {
 	return newStringTag("key", k)
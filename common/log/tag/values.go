@@ -41,6 +41,12 @@ var (
 	WorkflowActionWorkflowRecordMarker           = workflowAction("add-workflow-marker-record-event")
 	WorkflowActionUpsertWorkflowSearchAttributes = workflowAction("add-workflow-upsert-search-attributes-event")
 
+	// workflow state not recorded in history
+	WorkflowActionWorkflowPaused          = workflowAction("update-workflow-paused")
+	WorkflowActionWorkflowUnpaused        = workflowAction("update-workflow-unpaused")
+	WorkflowActionWorkflowCronSkipUpdated = workflowAction("update-workflow-cron-skip")
+	WorkflowActionUpdateResultRecorded    = workflowAction("add-update-result")
+
 	// decision
 	WorkflowActionDecisionTaskScheduled = workflowAction("add-decisiontask-scheduled-event")
 	WorkflowActionDecisionTaskStarted   = workflowAction("add-decisiontask-started-event")
@@ -123,6 +129,8 @@ var (
 	ComponentESVisibilityManager      = component("es-visibility-manager")
 	ComponentArchiver                 = component("archiver")
 	ComponentBatcher                  = component("batcher")
+	ComponentNamespaceDeleter         = component("namespace-deleter")
+	ComponentMigrator                 = component("migrator")
 	ComponentWorker                   = component("worker")
 	ComponentServiceResolver          = component("service-resolver")
 	ComponentMetadataInitializer      = component("metadata-initializer")
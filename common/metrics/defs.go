@@ -348,6 +348,24 @@ const (
 	HistoryClientMergeDLQMessagesScope
 	// HistoryClientRefreshWorkflowTasksScope tracks RPC calls to history service
 	HistoryClientRefreshWorkflowTasksScope
+	// HistoryClientPauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientPauseWorkflowExecutionScope
+	// HistoryClientUnpauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUnpauseWorkflowExecutionScope
+	// HistoryClientTriggerCronRunScope tracks RPC calls to history service
+	HistoryClientTriggerCronRunScope
+	// HistoryClientSkipCronRunsScope tracks RPC calls to history service
+	HistoryClientSkipCronRunsScope
+	// HistoryClientUpdateActivityOptionsScope tracks RPC calls to history service
+	HistoryClientUpdateActivityOptionsScope
+	// HistoryClientResetActivityScope tracks RPC calls to history service
+	HistoryClientResetActivityScope
+	// HistoryClientDeleteWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientDeleteWorkflowExecutionScope
+	// HistoryClientUpsertWorkflowAttributesScope tracks RPC calls to history service
+	HistoryClientUpsertWorkflowAttributesScope
+	// HistoryClientUpdateWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUpdateWorkflowExecutionScope
 	// MatchingClientPollForDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientPollForDecisionTaskScope
 	// MatchingClientPollForActivityTaskScope tracks RPC calls to matching service
@@ -366,6 +384,8 @@ const (
 	MatchingClientDescribeTaskListScope
 	// MatchingClientListTaskListPartitionsScope tracks RPC calls to matching service
 	MatchingClientListTaskListPartitionsScope
+	// MatchingClientUpdateTaskListRateLimitScope tracks RPC calls to matching service
+	MatchingClientUpdateTaskListRateLimitScope
 	// FrontendClientDeprecateNamespaceScope tracks RPC calls to frontend service
 	FrontendClientDeprecateNamespaceScope
 	// FrontendClientDescribeNamespaceScope tracks RPC calls to frontend service
@@ -476,6 +496,54 @@ const (
 	AdminClientMergeDLQMessagesScope
 	// AdminClientRefreshWorkflowTasksScope tracks RPC calls to admin service
 	AdminClientRefreshWorkflowTasksScope
+	// AdminClientPauseWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientPauseWorkflowExecutionScope
+	// AdminClientUnpauseWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientUnpauseWorkflowExecutionScope
+	// AdminClientTriggerCronRunScope tracks RPC calls to admin service
+	AdminClientTriggerCronRunScope
+	// AdminClientSkipCronRunsScope tracks RPC calls to admin service
+	AdminClientSkipCronRunsScope
+	// AdminClientUpdateActivityOptionsScope tracks RPC calls to admin service
+	AdminClientUpdateActivityOptionsScope
+	// AdminClientResetActivityScope tracks RPC calls to admin service
+	AdminClientResetActivityScope
+	// AdminClientStartBatchOperationScope tracks RPC calls to admin service
+	AdminClientStartBatchOperationScope
+	// AdminClientDescribeBatchOperationScope tracks RPC calls to admin service
+	AdminClientDescribeBatchOperationScope
+	// AdminClientListBatchOperationsScope tracks RPC calls to admin service
+	AdminClientListBatchOperationsScope
+	// AdminClientStopBatchOperationScope tracks RPC calls to admin service
+	AdminClientStopBatchOperationScope
+	// AdminClientDeleteWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientDeleteWorkflowExecutionScope
+	// AdminClientUpsertWorkflowAttributesScope tracks RPC calls to admin service
+	AdminClientUpsertWorkflowAttributesScope
+	// AdminClientUpdateWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientUpdateWorkflowExecutionScope
+	// AdminClientUpdateWorkerVersionSetsScope tracks RPC calls to admin service
+	AdminClientUpdateWorkerVersionSetsScope
+	// AdminClientDescribeTaskListVersionsScope tracks RPC calls to admin service
+	AdminClientDescribeTaskListVersionsScope
+	// AdminClientUpdateTaskListRateLimitScope tracks RPC calls to admin service
+	AdminClientUpdateTaskListRateLimitScope
+	// AdminClientDeleteNamespaceScope tracks RPC calls to admin service
+	AdminClientDeleteNamespaceScope
+	// AdminClientDescribeNamespaceDeletionScope tracks RPC calls to admin service
+	AdminClientDescribeNamespaceDeletionScope
+	// AdminClientRenameNamespaceScope tracks RPC calls to admin service
+	AdminClientRenameNamespaceScope
+	// AdminClientStartMigrationScope tracks RPC calls to admin service
+	AdminClientStartMigrationScope
+	// AdminClientDescribeMigrationScope tracks RPC calls to admin service
+	AdminClientDescribeMigrationScope
+	// AdminClientExportWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientExportWorkflowExecutionScope
+	// AdminClientImportWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientImportWorkflowExecutionScope
+	// AdminClientStreamWorkflowExecutionHistoryScope tracks RPC calls to admin service
+	AdminClientStreamWorkflowExecutionHistoryScope
 	// DCRedirectionDeprecateNamespaceScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateNamespaceScope
 	// DCRedirectionDescribeNamespaceScope tracks RPC calls for dc redirection
@@ -677,6 +745,54 @@ const (
 	AdminReapplyEventsScope
 	// AdminRefreshWorkflowTasksScope is the metric scope for admin.RefreshWorkflowTasks
 	AdminRefreshWorkflowTasksScope
+	// AdminPauseWorkflowExecutionScope is the metric scope for admin.PauseWorkflowExecution
+	AdminPauseWorkflowExecutionScope
+	// AdminUnpauseWorkflowExecutionScope is the metric scope for admin.UnpauseWorkflowExecution
+	AdminUnpauseWorkflowExecutionScope
+	// AdminTriggerCronRunScope is the metric scope for admin.TriggerCronRun
+	AdminTriggerCronRunScope
+	// AdminSkipCronRunsScope is the metric scope for admin.SkipCronRuns
+	AdminSkipCronRunsScope
+	// AdminUpdateActivityOptionsScope is the metric scope for admin.UpdateActivityOptions
+	AdminUpdateActivityOptionsScope
+	// AdminResetActivityScope is the metric scope for admin.ResetActivity
+	AdminResetActivityScope
+	// AdminStartBatchOperationScope is the metric scope for admin.StartBatchOperation
+	AdminStartBatchOperationScope
+	// AdminDescribeBatchOperationScope is the metric scope for admin.DescribeBatchOperation
+	AdminDescribeBatchOperationScope
+	// AdminListBatchOperationsScope is the metric scope for admin.ListBatchOperations
+	AdminListBatchOperationsScope
+	// AdminStopBatchOperationScope is the metric scope for admin.StopBatchOperation
+	AdminStopBatchOperationScope
+	// AdminDeleteWorkflowExecutionScope is the metric scope for admin.DeleteWorkflowExecution
+	AdminDeleteWorkflowExecutionScope
+	// AdminUpsertWorkflowAttributesScope is the metric scope for admin.UpsertWorkflowAttributes
+	AdminUpsertWorkflowAttributesScope
+	// AdminUpdateWorkflowExecutionScope is the metric scope for admin.UpdateWorkflowExecution
+	AdminUpdateWorkflowExecutionScope
+	// AdminUpdateWorkerVersionSetsScope is the metric scope for admin.UpdateWorkerVersionSets
+	AdminUpdateWorkerVersionSetsScope
+	// AdminDescribeTaskListVersionsScope is the metric scope for admin.DescribeTaskListVersions
+	AdminDescribeTaskListVersionsScope
+	// AdminUpdateTaskListRateLimitScope is the metric scope for admin.UpdateTaskListRateLimit
+	AdminUpdateTaskListRateLimitScope
+	// AdminDeleteNamespaceScope is the metric scope for admin.DeleteNamespace
+	AdminDeleteNamespaceScope
+	// AdminDescribeNamespaceDeletionScope is the metric scope for admin.DescribeNamespaceDeletion
+	AdminDescribeNamespaceDeletionScope
+	// AdminRenameNamespaceScope is the metric scope for admin.RenameNamespace
+	AdminRenameNamespaceScope
+	// AdminStartMigrationScope is the metric scope for admin.StartMigration
+	AdminStartMigrationScope
+	// AdminDescribeMigrationScope is the metric scope for admin.DescribeMigration
+	AdminDescribeMigrationScope
+	// AdminExportWorkflowExecutionScope is the metric scope for admin.ExportWorkflowExecution
+	AdminExportWorkflowExecutionScope
+	// AdminImportWorkflowExecutionScope is the metric scope for admin.ImportWorkflowExecution
+	AdminImportWorkflowExecutionScope
+	// AdminStreamWorkflowExecutionHistoryScope is the metric scope for admin.StreamWorkflowExecutionHistory
+	AdminStreamWorkflowExecutionHistoryScope
 	// AdminRemoveTaskScope is the metric scope for admin.AdminRemoveTaskScope
 	AdminRemoveTaskScope
 	//AdminCloseShardTaskScope is the metric scope for admin.AdminRemoveTaskScope
@@ -687,6 +803,8 @@ const (
 	AdminPurgeDLQMessagesScope
 	//AdminMergeDLQMessagesScope is the metric scope for admin.AdminMergeDLQMessagesScope
 	AdminMergeDLQMessagesScope
+	// AdminDescribeClusterScope is the metric scope for admin.DescribeCluster
+	AdminDescribeClusterScope
 
 	NumAdminScopes
 )
@@ -771,6 +889,8 @@ const (
 	FrontendResetWorkflowExecutionScope
 	// FrontendGetSearchAttributesScope is the metric scope for frontend.GetSearchAttributes
 	FrontendGetSearchAttributesScope
+	// FrontendGetClusterInfoScope is the metric scope for frontend.GetClusterInfo
+	FrontendGetClusterInfoScope
 
 	NumFrontendScopes
 )
@@ -845,6 +965,24 @@ const (
 	HistoryReapplyEventsScope
 	// HistoryRefreshWorkflowTasksScope is the scope used by refresh workflow tasks API
 	HistoryRefreshWorkflowTasksScope
+	// HistoryPauseWorkflowExecutionScope is the scope used by pause workflow execution API
+	HistoryPauseWorkflowExecutionScope
+	// HistoryUnpauseWorkflowExecutionScope is the scope used by unpause workflow execution API
+	HistoryUnpauseWorkflowExecutionScope
+	// HistoryTriggerCronRunScope is the scope used by trigger cron run API
+	HistoryTriggerCronRunScope
+	// HistorySkipCronRunsScope is the scope used by skip cron runs API
+	HistorySkipCronRunsScope
+	// HistoryUpdateActivityOptionsScope is the scope used by update activity options API
+	HistoryUpdateActivityOptionsScope
+	// HistoryResetActivityScope is the scope used by reset activity API
+	HistoryResetActivityScope
+	// HistoryDeleteWorkflowExecutionScope is the scope used by delete workflow execution API
+	HistoryDeleteWorkflowExecutionScope
+	// HistoryUpsertWorkflowAttributesScope is the scope used by upsert workflow attributes API
+	HistoryUpsertWorkflowAttributesScope
+	// HistoryUpdateWorkflowExecutionScope is the scope used by update workflow execution API API
+	HistoryUpdateWorkflowExecutionScope
 	// TaskPriorityAssignerScope is the scope used by all metric emitted by task priority assigner
 	TaskPriorityAssignerScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
@@ -871,6 +1009,8 @@ const (
 	TransferActiveTaskResetWorkflowScope
 	// TransferActiveTaskUpsertWorkflowSearchAttributesScope is the scope used for upsert search attributes processing by transfer queue processor
 	TransferActiveTaskUpsertWorkflowSearchAttributesScope
+	// TransferActiveTaskDeleteExecutionScope is the scope used for delete execution task processing by transfer queue processor
+	TransferActiveTaskDeleteExecutionScope
 	// TransferStandbyTaskResetWorkflowScope is the scope used for record workflow started task processing by transfer queue processor
 	TransferStandbyTaskResetWorkflowScope
 	// TransferStandbyTaskActivityScope is the scope used for activity task processing by transfer queue processor
@@ -889,6 +1029,8 @@ const (
 	TransferStandbyTaskRecordWorkflowStartedScope
 	// TransferStandbyTaskUpsertWorkflowSearchAttributesScope is the scope used for upsert search attributes processing by transfer queue processor
 	TransferStandbyTaskUpsertWorkflowSearchAttributesScope
+	// TransferStandbyTaskDeleteExecutionScope is the scope used for delete execution task processing by transfer queue processor
+	TransferStandbyTaskDeleteExecutionScope
 	// TimerQueueProcessorScope is the scope used by all metric emitted by timer queue processor
 	TimerQueueProcessorScope
 	// TimerActiveQueueProcessorScope is the scope used by all metric emitted by timer queue processor
@@ -931,6 +1073,10 @@ const (
 	ReplicatorTaskHistoryScope
 	// ReplicatorTaskSyncActivityScope is the scope used for sync activity by replicator queue processor
 	ReplicatorTaskSyncActivityScope
+	// ReplicatorTaskDeleteExecutionScope is the scope used for delete execution by replicator queue processor
+	ReplicatorTaskDeleteExecutionScope
+	// ReplicatorTaskSyncWorkflowStateScope is the scope used for sync workflow state by replicator queue processor
+	ReplicatorTaskSyncWorkflowStateScope
 	// ReplicateHistoryEventsScope is the scope used by historyReplicator API for applying events
 	ReplicateHistoryEventsScope
 	// ShardInfoScope is the scope used when updating shard info
@@ -1003,6 +1149,8 @@ const (
 	MatchingDescribeTaskListScope
 	// MatchingListTaskListPartitionsScope tracks ListTaskListPartitions API calls received by service
 	MatchingListTaskListPartitionsScope
+	// MatchingUpdateTaskListRateLimitScope tracks UpdateTaskListRateLimit API calls received by service
+	MatchingUpdateTaskListRateLimitScope
 
 	NumMatchingScopes
 )
@@ -1023,6 +1171,10 @@ const (
 	SyncShardTaskScope
 	// SyncActivityTaskScope is the scope used by sync activity information processing
 	SyncActivityTaskScope
+	// DeleteExecutionReplicationTaskScope is the scope used by delete execution replication processing
+	DeleteExecutionReplicationTaskScope
+	// SyncWorkflowStateTaskScope is the scope used by sync workflow state processing
+	SyncWorkflowStateTaskScope
 	// ESProcessorScope is scope used by all metric emitted by esProcessor
 	ESProcessorScope
 	// IndexProcessorScope is scope used by all metric emitted by index processor
@@ -1177,6 +1329,15 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientPurgeDLQMessagesScope:                    {operation: "HistoryClientPurgeDLQMessagesScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientMergeDLQMessagesScope:                    {operation: "HistoryClientMergeDLQMessagesScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRefreshWorkflowTasksScope:                {operation: "HistoryClientRefreshWorkflowTasksScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientPauseWorkflowExecutionScope:              {operation: "HistoryClientPauseWorkflowExecutionScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUnpauseWorkflowExecutionScope:            {operation: "HistoryClientUnpauseWorkflowExecutionScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientTriggerCronRunScope:                      {operation: "HistoryClientTriggerCronRunScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientSkipCronRunsScope:                        {operation: "HistoryClientSkipCronRunsScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUpdateActivityOptionsScope:               {operation: "HistoryClientUpdateActivityOptionsScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientResetActivityScope:                       {operation: "HistoryClientResetActivityScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientDeleteWorkflowExecutionScope:             {operation: "HistoryClientDeleteWorkflowExecutionScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUpsertWorkflowAttributesScope:            {operation: "HistoryClientUpsertWorkflowAttributesScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUpdateWorkflowExecutionScope:             {operation: "HistoryClientUpdateWorkflowExecutionScope", tags: map[string]string{ServiceRoleTagName: HistoryRoleTagValue}},
 		MatchingClientPollForDecisionTaskScope:                {operation: "MatchingClientPollForDecisionTask", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientPollForActivityTaskScope:                {operation: "MatchingClientPollForActivityTask", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientAddActivityTaskScope:                    {operation: "MatchingClientAddActivityTask", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
@@ -1186,6 +1347,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingClientCancelOutstandingPollScope:              {operation: "MatchingClientCancelOutstandingPoll", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientDescribeTaskListScope:                   {operation: "MatchingClientDescribeTaskList", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		MatchingClientListTaskListPartitionsScope:             {operation: "MatchingClientListTaskListPartitions", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
+		MatchingClientUpdateTaskListRateLimitScope:            {operation: "MatchingClientUpdateTaskListRateLimit", tags: map[string]string{ServiceRoleTagName: MatchingRoleTagValue}},
 		FrontendClientDeprecateNamespaceScope:                 {operation: "FrontendClientDeprecateNamespace", tags: map[string]string{ServiceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientDescribeNamespaceScope:                  {operation: "FrontendClientDescribeNamespace", tags: map[string]string{ServiceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientDescribeTaskListScope:                   {operation: "FrontendClientDescribeTaskList", tags: map[string]string{ServiceRoleTagName: FrontendRoleTagValue}},
@@ -1237,6 +1399,30 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminClientGetWorkflowExecutionRawHistoryV2Scope:      {operation: "AdminClientGetWorkflowExecutionRawHistoryV2", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeClusterScope:                       {operation: "AdminClientDescribeCluster", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientRefreshWorkflowTasksScope:                  {operation: "AdminClientRefreshWorkflowTasks", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientPauseWorkflowExecutionScope:                {operation: "AdminClientPauseWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUnpauseWorkflowExecutionScope:              {operation: "AdminClientUnpauseWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientTriggerCronRunScope:                        {operation: "AdminClientTriggerCronRun", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientSkipCronRunsScope:                          {operation: "AdminClientSkipCronRuns", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateActivityOptionsScope:                 {operation: "AdminClientUpdateActivityOptions", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientResetActivityScope:                         {operation: "AdminClientResetActivity", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientStartBatchOperationScope:                   {operation: "AdminClientStartBatchOperation", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeBatchOperationScope:                {operation: "AdminClientDescribeBatchOperation", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientListBatchOperationsScope:                   {operation: "AdminClientListBatchOperations", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientStopBatchOperationScope:                    {operation: "AdminClientStopBatchOperation", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeleteWorkflowExecutionScope:               {operation: "AdminClientDeleteWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpsertWorkflowAttributesScope:              {operation: "AdminClientUpsertWorkflowAttributes", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateWorkflowExecutionScope:               {operation: "AdminClientUpdateWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateWorkerVersionSetsScope:               {operation: "AdminClientUpdateWorkerVersionSets", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeTaskListVersionsScope:              {operation: "AdminClientDescribeTaskListVersions", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientUpdateTaskListRateLimitScope: {operation: "AdminClientUpdateTaskListRateLimit", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeleteNamespaceScope:                       {operation: "AdminClientDeleteNamespace", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeNamespaceDeletionScope:             {operation: "AdminClientDescribeNamespaceDeletion", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientRenameNamespaceScope:                       {operation: "AdminClientRenameNamespace", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientStartMigrationScope:                        {operation: "AdminClientStartMigration", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeMigrationScope:                     {operation: "AdminClientDescribeMigration", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientExportWorkflowExecutionScope:               {operation: "AdminClientExportWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientImportWorkflowExecutionScope:               {operation: "AdminClientImportWorkflowExecution", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
+		AdminClientStreamWorkflowExecutionHistoryScope:        {operation: "AdminClientStreamWorkflowExecutionHistory", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientCloseShardScope:                            {operation: "AdminClientCloseShard", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientReadDLQMessagesScope:                       {operation: "AdminClientReadDLQMessages", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
 		AdminClientPurgeDLQMessagesScope:                      {operation: "AdminClientPurgeDLQMessages", tags: map[string]string{ServiceRoleTagName: AdminRoleTagValue}},
@@ -1328,6 +1514,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminReadDLQMessagesScope:                  {operation: "AdminReadDLQMessages"},
 		AdminPurgeDLQMessagesScope:                 {operation: "AdminPurgeDLQMessages"},
 		AdminMergeDLQMessagesScope:                 {operation: "AdminMergeDLQMessages"},
+		AdminDescribeClusterScope:                  {operation: "DescribeCluster"},
 		AdminDescribeHistoryHostScope:              {operation: "DescribeHistoryHost"},
 		AdminAddSearchAttributeScope:               {operation: "AddSearchAttribute"},
 		AdminDescribeWorkflowExecutionScope:        {operation: "DescribeWorkflowExecution"},
@@ -1338,6 +1525,30 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminGetDLQReplicationMessagesScope:        {operation: "AdminGetDLQReplicationMessages"},
 		AdminReapplyEventsScope:                    {operation: "ReapplyEvents"},
 		AdminRefreshWorkflowTasksScope:             {operation: "RefreshWorkflowTasks"},
+		AdminPauseWorkflowExecutionScope:           {operation: "PauseWorkflowExecution"},
+		AdminUnpauseWorkflowExecutionScope:         {operation: "UnpauseWorkflowExecution"},
+		AdminTriggerCronRunScope:                   {operation: "TriggerCronRun"},
+		AdminSkipCronRunsScope:                     {operation: "SkipCronRuns"},
+		AdminUpdateActivityOptionsScope:            {operation: "UpdateActivityOptions"},
+		AdminResetActivityScope:                    {operation: "ResetActivity"},
+		AdminStartBatchOperationScope:              {operation: "StartBatchOperation"},
+		AdminDescribeBatchOperationScope:           {operation: "DescribeBatchOperation"},
+		AdminListBatchOperationsScope:              {operation: "ListBatchOperations"},
+		AdminStopBatchOperationScope:               {operation: "StopBatchOperation"},
+		AdminDeleteWorkflowExecutionScope:          {operation: "DeleteWorkflowExecution"},
+		AdminUpsertWorkflowAttributesScope:         {operation: "UpsertWorkflowAttributes"},
+		AdminUpdateWorkflowExecutionScope:          {operation: "UpdateWorkflowExecution"},
+		AdminUpdateWorkerVersionSetsScope:          {operation: "UpdateWorkerVersionSets"},
+		AdminDescribeTaskListVersionsScope:         {operation: "DescribeTaskListVersions"},
+		AdminUpdateTaskListRateLimitScope: {operation: "UpdateTaskListRateLimit"},
+		AdminDeleteNamespaceScope:                  {operation: "DeleteNamespace"},
+		AdminDescribeNamespaceDeletionScope:        {operation: "DescribeNamespaceDeletion"},
+		AdminRenameNamespaceScope:                  {operation: "RenameNamespace"},
+		AdminStartMigrationScope:                   {operation: "StartMigration"},
+		AdminDescribeMigrationScope:                {operation: "DescribeMigration"},
+		AdminExportWorkflowExecutionScope:          {operation: "ExportWorkflowExecution"},
+		AdminImportWorkflowExecutionScope:          {operation: "ImportWorkflowExecution"},
+		AdminStreamWorkflowExecutionHistoryScope:   {operation: "StreamWorkflowExecutionHistory"},
 
 		FrontendStartWorkflowExecutionScope:             {operation: "StartWorkflowExecution"},
 		FrontendPollForDecisionTaskScope:                {operation: "PollForDecisionTask"},
@@ -1378,6 +1589,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendDescribeTaskListScope:                   {operation: "DescribeTaskList"},
 		FrontendResetStickyTaskListScope:                {operation: "ResetStickyTaskList"},
 		FrontendGetSearchAttributesScope:                {operation: "GetSearchAttributes"},
+		FrontendGetClusterInfoScope:                     {operation: "GetClusterInfo"},
 	},
 	// History Scope Names
 	History: {
@@ -1418,6 +1630,15 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryShardControllerScope:                            {operation: "ShardController"},
 		HistoryReapplyEventsScope:                              {operation: "EventReapplication"},
 		HistoryRefreshWorkflowTasksScope:                       {operation: "RefreshWorkflowTasks"},
+		HistoryPauseWorkflowExecutionScope:                     {operation: "PauseWorkflowExecution"},
+		HistoryUnpauseWorkflowExecutionScope:                   {operation: "UnpauseWorkflowExecution"},
+		HistoryTriggerCronRunScope:                             {operation: "TriggerCronRun"},
+		HistorySkipCronRunsScope:                               {operation: "SkipCronRuns"},
+		HistoryUpdateActivityOptionsScope:                      {operation: "UpdateActivityOptions"},
+		HistoryResetActivityScope:                              {operation: "ResetActivity"},
+		HistoryDeleteWorkflowExecutionScope:                    {operation: "DeleteWorkflowExecution"},
+		HistoryUpsertWorkflowAttributesScope:                   {operation: "UpsertWorkflowAttributes"},
+		HistoryUpdateWorkflowExecutionScope:                    {operation: "UpdateWorkflowExecution"},
 		TaskPriorityAssignerScope:                              {operation: "TaskPriorityAssigner"},
 		TransferQueueProcessorScope:                            {operation: "TransferQueueProcessor"},
 		TransferActiveQueueProcessorScope:                      {operation: "TransferActiveQueueProcessor"},
@@ -1431,6 +1652,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TransferActiveTaskRecordWorkflowStartedScope:           {operation: "TransferActiveTaskRecordWorkflowStarted"},
 		TransferActiveTaskResetWorkflowScope:                   {operation: "TransferActiveTaskResetWorkflow"},
 		TransferActiveTaskUpsertWorkflowSearchAttributesScope:  {operation: "TransferActiveTaskUpsertWorkflowSearchAttributes"},
+		TransferActiveTaskDeleteExecutionScope:                 {operation: "TransferActiveTaskDeleteExecution"},
 		TransferStandbyTaskActivityScope:                       {operation: "TransferStandbyTaskActivity"},
 		TransferStandbyTaskDecisionScope:                       {operation: "TransferStandbyTaskDecision"},
 		TransferStandbyTaskCloseExecutionScope:                 {operation: "TransferStandbyTaskCloseExecution"},
@@ -1440,6 +1662,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TransferStandbyTaskRecordWorkflowStartedScope:          {operation: "TransferStandbyTaskRecordWorkflowStarted"},
 		TransferStandbyTaskResetWorkflowScope:                  {operation: "TransferStandbyTaskResetWorkflow"},
 		TransferStandbyTaskUpsertWorkflowSearchAttributesScope: {operation: "TransferStandbyTaskUpsertWorkflowSearchAttributes"},
+		TransferStandbyTaskDeleteExecutionScope:                {operation: "TransferStandbyTaskDeleteExecution"},
 		TimerQueueProcessorScope:                               {operation: "TimerQueueProcessor"},
 		TimerActiveQueueProcessorScope:                         {operation: "TimerActiveQueueProcessor"},
 		TimerStandbyQueueProcessorScope:                        {operation: "TimerStandbyQueueProcessor"},
@@ -1461,6 +1684,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ReplicatorQueueProcessorScope:                          {operation: "ReplicatorQueueProcessor"},
 		ReplicatorTaskHistoryScope:                             {operation: "ReplicatorTaskHistory"},
 		ReplicatorTaskSyncActivityScope:                        {operation: "ReplicatorTaskSyncActivity"},
+		ReplicatorTaskDeleteExecutionScope:                     {operation: "ReplicatorTaskDeleteExecution"},
+		ReplicatorTaskSyncWorkflowStateScope:                   {operation: "ReplicatorTaskSyncWorkflowState"},
 		ReplicateHistoryEventsScope:                            {operation: "ReplicateHistoryEvents"},
 		ShardInfoScope:                                         {operation: "ShardInfo"},
 		WorkflowContextScope:                                   {operation: "WorkflowContext"},
@@ -1494,6 +1719,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingCancelOutstandingPollScope:     {operation: "CancelOutstandingPoll"},
 		MatchingDescribeTaskListScope:          {operation: "DescribeTaskList"},
 		MatchingListTaskListPartitionsScope:    {operation: "ListTaskListPartitions"},
+		MatchingUpdateTaskListRateLimitScope:   {operation: "UpdateTaskListRateLimit"},
 	},
 	// Worker Scope Names
 	Worker: {
@@ -1504,6 +1730,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryReplicationV2TaskScope:          {operation: "HistoryReplicationV2Task"},
 		SyncShardTaskScope:                     {operation: "SyncShardTask"},
 		SyncActivityTaskScope:                  {operation: "SyncActivityTask"},
+		DeleteExecutionReplicationTaskScope:    {operation: "DeleteExecutionReplicationTask"},
+		SyncWorkflowStateTaskScope:             {operation: "SyncWorkflowStateTask"},
 		ESProcessorScope:                       {operation: "ESProcessor"},
 		IndexProcessorScope:                    {operation: "IndexProcessor"},
 		ArchiverDeleteHistoryActivityScope:     {operation: "ArchiverDeleteHistoryActivity"},
@@ -1572,6 +1800,8 @@ const (
 
 	HistorySize
 	HistoryCount
+	HistoryCompressedSize
+	HistoryCompressionRatio
 	EventBlobSize
 
 	ArchivalConfigFailures
@@ -1766,6 +1996,7 @@ const (
 	DeleteRequestCancelInfoCount
 	WorkflowRetryBackoffTimerCount
 	WorkflowCronBackoffTimerCount
+	WorkflowCronOverlapTimerCount
 	WorkflowCleanupDeleteCount
 	WorkflowCleanupArchiveCount
 	WorkflowCleanupNopCount
@@ -1809,6 +2040,9 @@ const (
 	QueryBeforeFirstDecisionCount
 	QueryBufferExceededCount
 	QueryRegistryInvalidStateCount
+	UpdateTimeoutCount
+	UpdateBufferExceededCount
+	UpdateRegistryInvalidStateCount
 	WorkerNotSupportsConsistentQueryCount
 	DecisionStartToCloseTimeoutOverrideCount
 	WorkflowExecutionStartToCloseTimeoutOverrideCount
@@ -1848,6 +2082,7 @@ const (
 	LocalToRemoteMatchPerTaskListCounter
 	RemoteToLocalMatchPerTaskListCounter
 	RemoteToRemoteMatchPerTaskListCounter
+	BufferedBacklogPerTaskListGauge
 
 	NumMatchingMetrics
 )
@@ -1962,6 +2197,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		NamespaceCacheCallbacksLatency:                      {metricName: "namespace_cache_callbacks_latency", metricType: Timer},
 		HistorySize:                                         {metricName: "history_size", metricType: Timer},
 		HistoryCount:                                        {metricName: "history_count", metricType: Timer},
+		HistoryCompressedSize:                               {metricName: "history_compressed_size", metricType: Timer},
+		HistoryCompressionRatio:                             {metricName: "history_compression_ratio", metricType: Gauge},
 		EventBlobSize:                                       {metricName: "event_blob_size", metricType: Timer},
 		ArchivalConfigFailures:                              {metricName: "archivalconfig_failures", metricType: Counter},
 		ElasticsearchRequests:                               {metricName: "elasticsearch_requests", metricType: Counter},
@@ -2179,6 +2416,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DeleteRequestCancelInfoCount:                      {metricName: "delete_request_cancel_info", metricType: Timer},
 		WorkflowRetryBackoffTimerCount:                    {metricName: "workflow_retry_backoff_timer", metricType: Counter},
 		WorkflowCronBackoffTimerCount:                     {metricName: "workflow_cron_backoff_timer", metricType: Counter},
+		WorkflowCronOverlapTimerCount:                     {metricName: "workflow_cron_overlap_timer", metricType: Counter},
 		WorkflowCleanupDeleteCount:                        {metricName: "workflow_cleanup_delete", metricType: Counter},
 		WorkflowCleanupArchiveCount:                       {metricName: "workflow_cleanup_archive", metricType: Counter},
 		WorkflowCleanupNopCount:                           {metricName: "workflow_cleanup_nop", metricType: Counter},
@@ -2222,6 +2460,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		QueryBeforeFirstDecisionCount:                     {metricName: "query_before_first_decision", metricType: Counter},
 		QueryBufferExceededCount:                          {metricName: "query_buffer_exceeded", metricType: Counter},
 		QueryRegistryInvalidStateCount:                    {metricName: "query_registry_invalid_state", metricType: Counter},
+		UpdateTimeoutCount:                                {metricName: "update_timeout", metricType: Counter},
+		UpdateBufferExceededCount:                         {metricName: "update_buffer_exceeded", metricType: Counter},
+		UpdateRegistryInvalidStateCount:                   {metricName: "update_registry_invalid_state", metricType: Counter},
 		WorkerNotSupportsConsistentQueryCount:             {metricName: "worker_not_supports_consistent_query", metricType: Counter},
 		DecisionStartToCloseTimeoutOverrideCount:          {metricName: "decision_start_to_close_timeout_overrides", metricType: Counter},
 		WorkflowExecutionStartToCloseTimeoutOverrideCount: {metricName: "workflow_execution_start_to_close_timeout_overrides", metricType: Counter},
@@ -2257,6 +2498,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		LocalToRemoteMatchPerTaskListCounter:     {metricName: "local_to_remote_matches_per_tl", metricRollupName: "local_to_remote_matches"},
 		RemoteToLocalMatchPerTaskListCounter:     {metricName: "remote_to_local_matches_per_tl", metricRollupName: "remote_to_local_matches"},
 		RemoteToRemoteMatchPerTaskListCounter:    {metricName: "remote_to_remote_matches_per_tl", metricRollupName: "remote_to_remote_matches"},
+		BufferedBacklogPerTaskListGauge:          {metricName: "buffered_backlog_per_tl", metricType: Gauge},
 	},
 	Worker: {
 		ReplicatorMessages:                            {metricName: "replicator_messages"},
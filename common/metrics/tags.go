@@ -38,6 +38,7 @@ const (
 	workflowType  = "workflowType"
 	activityType  = "activityType"
 	decisionType  = "decisionType"
+	taskPriority  = "taskPriority"
 
 	namespaceAllValue = "all"
 	unknownValue      = "_unknown_"
@@ -81,6 +82,10 @@ type (
 	decisionTypeTag struct {
 		value string
 	}
+
+	taskPriorityTag struct {
+		value string
+	}
 )
 
 // NamespaceTag returns a new namespace tag. For timers, this also ensures that we
@@ -237,3 +242,21 @@ func (d decisionTypeTag) Key() string {
 func (d decisionTypeTag) Value() string {
 	return d.value
 }
+
+// TaskPriorityTag returns a new task priority tag.
+func TaskPriorityTag(value string) Tag {
+	if len(value) == 0 {
+		value = unknownValue
+	}
+	return taskPriorityTag{value}
+}
+
+// Key returns the key of the task priority tag
+func (d taskPriorityTag) Key() string {
+	return taskPriority
+}
+
+// Value returns the value of the task priority tag
+func (d taskPriorityTag) Value() string {
+	return d.value
+}
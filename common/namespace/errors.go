@@ -36,4 +36,10 @@ var (
 	errCannotDoNamespaceFailoverAndUpdate = serviceerror.NewInvalidArgument("Cannot set active cluster to current cluster when other parameters are set.")
 	errInvalidRetentionPeriod             = serviceerror.NewInvalidArgument("A valid retention period is not set on request.")
 	errInvalidArchivalConfig              = serviceerror.NewInvalidArgument("Invalid to enable archival without specifying a uri.")
+	errNewNamespaceNameNotSet             = serviceerror.NewInvalidArgument("New namespace name is not set on request.")
+	errNamespaceNameNotChanged            = serviceerror.NewInvalidArgument("New namespace name is the same as the current one.")
+	errInvalidAliasGracePeriod            = serviceerror.NewInvalidArgument("Alias grace period cannot be negative.")
+	errCannotRenameDeletedNamespace       = serviceerror.NewInvalidArgument("Namespace is being deleted, cannot rename it.")
+	errEmptyWorkerVersionSet              = serviceerror.NewInvalidArgument("Worker version set has no build ID.")
+	errEmptyWorkerBuildID                 = serviceerror.NewInvalidArgument("Worker build ID cannot be empty.")
 )
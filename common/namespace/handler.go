@@ -27,8 +27,10 @@
 package namespace
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/types"
@@ -38,6 +40,8 @@ import (
 	"go.temporal.io/temporal-proto/serviceerror"
 	"go.temporal.io/temporal-proto/workflowservice"
 
+	"github.com/temporalio/temporal/.gen/proto/adminservice"
+	namespacegenpb "github.com/temporalio/temporal/.gen/proto/namespace"
 	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
 	replicationgenpb "github.com/temporalio/temporal/.gen/proto/replication"
 	"github.com/temporalio/temporal/common"
@@ -70,10 +74,18 @@ type (
 			ctx context.Context,
 			registerRequest *workflowservice.RegisterNamespaceRequest,
 		) (*workflowservice.RegisterNamespaceResponse, error)
+		RenameNamespace(
+			ctx context.Context,
+			renameRequest *adminservice.RenameNamespaceRequest,
+		) (*adminservice.RenameNamespaceResponse, error)
 		UpdateNamespace(
 			ctx context.Context,
 			updateRequest *workflowservice.UpdateNamespaceRequest,
 		) (*workflowservice.UpdateNamespaceResponse, error)
+		UpdateWorkerVersionSets(
+			ctx context.Context,
+			updateRequest *adminservice.UpdateWorkerVersionSetsRequest,
+		) (*adminservice.UpdateWorkerVersionSetsResponse, error)
 	}
 
 	// HandlerImpl is the namespace operation handler implementation
@@ -145,6 +157,9 @@ func (d *HandlerImpl) RegisterNamespace(
 		// other err
 		return nil, err
 	}
+	if err := d.validateNameNotAliased(registerRequest.GetName(), nil); err != nil {
+		return nil, err
+	}
 
 	var activeClusterName string
 	// input validation on cluster names
@@ -581,6 +596,174 @@ func (d *HandlerImpl) UpdateNamespace(
 	return response, nil
 }
 
+// RenameNamespace renames the namespace, its ID and therefore all its data are kept
+func (d *HandlerImpl) RenameNamespace(
+	_ context.Context,
+	renameRequest *adminservice.RenameNamespaceRequest,
+) (*adminservice.RenameNamespaceResponse, error) {
+
+	name := renameRequest.GetNamespace()
+	newName := renameRequest.GetNewName()
+	if newName == "" {
+		return nil, errNewNamespaceNameNotSet
+	}
+	if newName == name {
+		return nil, errNamespaceNameNotChanged
+	}
+	if renameRequest.GetAliasGracePeriodSeconds() < 0 {
+		return nil, errInvalidAliasGracePeriod
+	}
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 namespace table
+	metadata, err := d.metadataMgr.GetMetadata()
+	if err != nil {
+		return nil, err
+	}
+	notificationVersion := metadata.NotificationVersion
+	getResponse, err := d.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	info := getResponse.Namespace.Info
+	if info.Status == namespacepb.NamespaceStatus_Deleted {
+		return nil, errCannotRenameDeletedNamespace
+	}
+	isGlobalNamespace := getResponse.IsGlobalNamespace
+	if isGlobalNamespace && !d.clusterMetadata.IsMasterCluster() {
+		return nil, errNotMasterCluster
+	}
+
+	_, err = d.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: newName})
+	switch err.(type) {
+	case nil:
+		return nil, serviceerror.NewNamespaceAlreadyExists("Namespace already exists.")
+	case *serviceerror.NotFound:
+		// new name is not taken, proceeds
+	default:
+		return nil, err
+	}
+	if err := d.validateNameNotAliased(newName, info.Id); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var aliases []*namespacegenpb.NamespaceAlias
+	for _, alias := range info.Aliases {
+		if alias.GetName() != newName && alias.GetExpireTimeNanos() > now.UnixNano() {
+			aliases = append(aliases, alias)
+		}
+	}
+	if renameRequest.GetAliasGracePeriodSeconds() > 0 {
+		aliases = append(aliases, &namespacegenpb.NamespaceAlias{
+			Name:            name,
+			ExpireTimeNanos: now.Add(time.Duration(renameRequest.GetAliasGracePeriodSeconds()) * time.Second).UnixNano(),
+		})
+	}
+	info.Name = newName
+	info.Aliases = aliases
+	configVersion := getResponse.Namespace.ConfigVersion + 1
+
+	err = d.metadataMgr.UpdateNamespace(&persistence.UpdateNamespaceRequest{
+		Namespace: &persistenceblobs.NamespaceDetail{
+			Info:                        info,
+			Config:                      getResponse.Namespace.Config,
+			ReplicationConfig:           getResponse.Namespace.ReplicationConfig,
+			ConfigVersion:               configVersion,
+			FailoverVersion:             getResponse.Namespace.FailoverVersion,
+			FailoverNotificationVersion: getResponse.Namespace.FailoverNotificationVersion,
+		},
+		NotificationVersion: notificationVersion,
+		PreviousName:        name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if isGlobalNamespace {
+		err = d.namespaceReplicator.HandleTransmissionTask(replicationgenpb.NamespaceOperation_Update,
+			info, getResponse.Namespace.Config, getResponse.Namespace.ReplicationConfig, configVersion,
+			getResponse.Namespace.FailoverVersion, isGlobalNamespace)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	d.logger.Info("Rename namespace succeeded",
+		tag.WorkflowNamespace(newName),
+		tag.WorkflowNamespaceIDBytes(info.Id),
+	)
+	return &adminservice.RenameNamespaceResponse{}, nil
+}
+
+// UpdateWorkerVersionSets replaces the sets of compatible worker build IDs of the namespace
+func (d *HandlerImpl) UpdateWorkerVersionSets(
+	_ context.Context,
+	updateRequest *adminservice.UpdateWorkerVersionSetsRequest,
+) (*adminservice.UpdateWorkerVersionSetsResponse, error) {
+
+	if err := validateWorkerVersionSets(updateRequest.GetVersionSets()); err != nil {
+		return nil, err
+	}
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 namespace table
+	metadata, err := d.metadataMgr.GetMetadata()
+	if err != nil {
+		return nil, err
+	}
+	notificationVersion := metadata.NotificationVersion
+	getResponse, err := d.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: updateRequest.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+
+	info := getResponse.Namespace.Info
+	config := getResponse.Namespace.Config
+	isGlobalNamespace := getResponse.IsGlobalNamespace
+	if isGlobalNamespace && !d.clusterMetadata.IsMasterCluster() {
+		return nil, errNotMasterCluster
+	}
+
+	versionSets, err := assignWorkerVersionSetIDs(config.GetWorkerVersionSets(), updateRequest.GetVersionSets())
+	if err != nil {
+		return nil, err
+	}
+	config.WorkerVersionSets = versionSets
+	configVersion := getResponse.Namespace.ConfigVersion + 1
+
+	err = d.metadataMgr.UpdateNamespace(&persistence.UpdateNamespaceRequest{
+		Namespace: &persistenceblobs.NamespaceDetail{
+			Info:                        info,
+			Config:                      config,
+			ReplicationConfig:           getResponse.Namespace.ReplicationConfig,
+			ConfigVersion:               configVersion,
+			FailoverVersion:             getResponse.Namespace.FailoverVersion,
+			FailoverNotificationVersion: getResponse.Namespace.FailoverNotificationVersion,
+		},
+		NotificationVersion: notificationVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if isGlobalNamespace {
+		err = d.namespaceReplicator.HandleTransmissionTask(replicationgenpb.NamespaceOperation_Update,
+			info, config, getResponse.Namespace.ReplicationConfig, configVersion,
+			getResponse.Namespace.FailoverVersion, isGlobalNamespace)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	d.logger.Info("Update worker version sets succeeded",
+		tag.WorkflowNamespace(info.Name),
+		tag.WorkflowNamespaceIDBytes(info.Id),
+	)
+	return &adminservice.UpdateWorkerVersionSetsResponse{}, nil
+}
+
 // DeprecateNamespace deprecates a namespace
 func (d *HandlerImpl) DeprecateNamespace(
 	ctx context.Context,
@@ -685,6 +868,121 @@ func (d *HandlerImpl) mergeBadBinaries(
 	}
 }
 
+// validateNameNotAliased checks that the name is not an alias of a namespace other than the given one
+func (d *HandlerImpl) validateNameNotAliased(
+	name string,
+	id primitives.UUID,
+) error {
+
+	now := time.Now().UnixNano()
+	request := &persistence.ListNamespacesRequest{PageSize: 100}
+	for {
+		resp, err := d.metadataMgr.ListNamespaces(request)
+		if err != nil {
+			return err
+		}
+		for _, namespace := range resp.Namespaces {
+			info := namespace.Namespace.Info
+			if bytes.Equal(info.Id, id) {
+				continue
+			}
+			for _, alias := range info.Aliases {
+				if alias.GetName() == name && alias.GetExpireTimeNanos() > now {
+					return serviceerror.NewNamespaceAlreadyExists(fmt.Sprintf("Namespace name is an alias of namespace %v.", info.Name))
+				}
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			return nil
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+}
+
+// validateWorkerVersionSets checks that the sets are not empty and that every build ID belongs to a single set.
+// Build IDs must not take the prefix of the build IDs outside of the sets, the key of their task lists would
+// collide with the id of the set.
+func validateWorkerVersionSets(
+	versionSets []*namespacegenpb.WorkerVersionSet,
+) error {
+
+	seen := make(map[string]struct{})
+	for _, versionSet := range versionSets {
+		if len(versionSet.GetBuildIds()) == 0 {
+			return errEmptyWorkerVersionSet
+		}
+		for _, buildID := range versionSet.GetBuildIds() {
+			if buildID == "" {
+				return errEmptyWorkerBuildID
+			}
+			if strings.HasPrefix(buildID, common.WorkerBuildIDVersionSetPrefix) {
+				return serviceerror.NewInvalidArgument(fmt.Sprintf("Build ID %v cannot start with %v.", buildID, common.WorkerBuildIDVersionSetPrefix))
+			}
+			if _, ok := seen[buildID]; ok {
+				return serviceerror.NewInvalidArgument(fmt.Sprintf("Build ID %v is in more than one worker version set.", buildID))
+			}
+			seen[buildID] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// assignWorkerVersionSetIDs returns the new sets with the ids matching uses to key their task lists. A set
+// keeps the id of the old set it shares build IDs with, so that the backlog of the set is not orphaned when
+// build IDs are added to or removed from it. Sets of old sets merged together are rejected, the tasks of
+// all but one of them would be stuck.
+func assignWorkerVersionSetIDs(
+	oldVersionSets []*namespacegenpb.WorkerVersionSet,
+	newVersionSets []*namespacegenpb.WorkerVersionSet,
+) ([]*namespacegenpb.WorkerVersionSet, error) {
+
+	oldIDs := make(map[string]string)
+	for _, versionSet := range oldVersionSets {
+		for _, buildID := range versionSet.GetBuildIds() {
+			oldIDs[buildID] = common.GetWorkerVersionSetID(versionSet)
+		}
+	}
+
+	result := make([]*namespacegenpb.WorkerVersionSet, len(newVersionSets))
+	assigned := make(map[string]struct{})
+	for i, versionSet := range newVersionSets {
+		result[i] = &namespacegenpb.WorkerVersionSet{BuildIds: versionSet.GetBuildIds()}
+		inherited := ""
+		for _, buildID := range versionSet.GetBuildIds() {
+			id, ok := oldIDs[buildID]
+			if !ok {
+				continue
+			}
+			if inherited != "" && inherited != id {
+				return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Worker version set with build ID %v merges existing sets.", buildID))
+			}
+			inherited = id
+		}
+		// the first set split from an old set inherits its id
+		if _, ok := assigned[inherited]; inherited != "" && !ok {
+			result[i].Id = inherited
+			assigned[inherited] = struct{}{}
+		}
+	}
+
+	usedIDs := make(map[string]struct{})
+	for _, id := range oldIDs {
+		usedIDs[id] = struct{}{}
+	}
+	for _, versionSet := range result {
+		if versionSet.Id != "" {
+			continue
+		}
+		// ids of old sets are never reused, their task lists may still hold tasks
+		versionSet.Id = versionSet.BuildIds[0]
+		if _, ok := usedIDs[versionSet.Id]; ok {
+			versionSet.Id = uuid.New()
+		}
+		usedIDs[versionSet.Id] = struct{}{}
+	}
+	return result, nil
+}
+
 func (d *HandlerImpl) mergeNamespaceData(
 	old map[string]string,
 	new map[string]string,
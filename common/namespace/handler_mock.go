@@ -34,6 +34,8 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	workflowservice "go.temporal.io/temporal-proto/workflowservice"
+
+	adminservice "github.com/temporalio/temporal/.gen/proto/adminservice"
 )
 
 // MockHandler is a mock of Handler interface.
@@ -119,6 +121,21 @@ func (mr *MockHandlerMockRecorder) RegisterNamespace(ctx, registerRequest interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterNamespace", reflect.TypeOf((*MockHandler)(nil).RegisterNamespace), ctx, registerRequest)
 }
 
+// RenameNamespace mocks base method.
+func (m *MockHandler) RenameNamespace(ctx context.Context, renameRequest *adminservice.RenameNamespaceRequest) (*adminservice.RenameNamespaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameNamespace", ctx, renameRequest)
+	ret0, _ := ret[0].(*adminservice.RenameNamespaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameNamespace indicates an expected call of RenameNamespace.
+func (mr *MockHandlerMockRecorder) RenameNamespace(ctx, renameRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameNamespace", reflect.TypeOf((*MockHandler)(nil).RenameNamespace), ctx, renameRequest)
+}
+
 // UpdateNamespace mocks base method.
 func (m *MockHandler) UpdateNamespace(ctx context.Context, updateRequest *workflowservice.UpdateNamespaceRequest) (*workflowservice.UpdateNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,3 +150,18 @@ func (mr *MockHandlerMockRecorder) UpdateNamespace(ctx, updateRequest interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNamespace", reflect.TypeOf((*MockHandler)(nil).UpdateNamespace), ctx, updateRequest)
 }
+
+// UpdateWorkerVersionSets mocks base method.
+func (m *MockHandler) UpdateWorkerVersionSets(ctx context.Context, updateRequest *adminservice.UpdateWorkerVersionSetsRequest) (*adminservice.UpdateWorkerVersionSetsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkerVersionSets", ctx, updateRequest)
+	ret0, _ := ret[0].(*adminservice.UpdateWorkerVersionSetsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkerVersionSets indicates an expected call of UpdateWorkerVersionSets.
+func (mr *MockHandlerMockRecorder) UpdateWorkerVersionSets(ctx, updateRequest interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkerVersionSets", reflect.TypeOf((*MockHandler)(nil).UpdateWorkerVersionSets), ctx, updateRequest)
+}
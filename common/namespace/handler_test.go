@@ -39,8 +39,11 @@ import (
 	"github.com/stretchr/testify/suite"
 	namespacepb "go.temporal.io/temporal-proto/namespace"
 	replicationpb "go.temporal.io/temporal-proto/replication"
+	"go.temporal.io/temporal-proto/serviceerror"
 	"go.temporal.io/temporal-proto/workflowservice"
 
+	"github.com/temporalio/temporal/.gen/proto/adminservice"
+	namespacegenpb "github.com/temporalio/temporal/.gen/proto/namespace"
 	"github.com/temporalio/temporal/common"
 	"github.com/temporalio/temporal/common/archiver"
 	"github.com/temporalio/temporal/common/archiver/provider"
@@ -49,6 +52,7 @@ import (
 	"github.com/temporalio/temporal/common/mocks"
 	"github.com/temporalio/temporal/common/persistence"
 	persistencetests "github.com/temporalio/temporal/common/persistence/persistence-tests"
+	"github.com/temporalio/temporal/common/primitives"
 	"github.com/temporalio/temporal/common/service/config"
 	dc "github.com/temporalio/temporal/common/service/dynamicconfig"
 )
@@ -428,6 +432,220 @@ func (s *namespaceHandlerCommonSuite) TestUpdateNamespace_InvalidRetentionPeriod
 	s.Nil(resp)
 }
 
+func (s *namespaceHandlerCommonSuite) TestRenameNamespace() {
+	namespace := s.getRandomNamespace()
+	newNamespace := s.getRandomNamespace()
+	registerRequest := &workflowservice.RegisterNamespaceRequest{
+		Name:                                   namespace,
+		Description:                            namespace,
+		WorkflowExecutionRetentionPeriodInDays: int32(10),
+		IsGlobalNamespace:                      false,
+	}
+	registerResp, err := s.handler.RegisterNamespace(context.Background(), registerRequest)
+	s.NoError(err)
+	s.Nil(registerResp)
+	describeResp, err := s.handler.DescribeNamespace(context.Background(), &workflowservice.DescribeNamespaceRequest{Name: namespace})
+	s.NoError(err)
+
+	renameResp, err := s.handler.RenameNamespace(context.Background(), &adminservice.RenameNamespaceRequest{
+		Namespace:               namespace,
+		NewName:                 newNamespace,
+		AliasGracePeriodSeconds: 3600,
+	})
+	s.NoError(err)
+	s.NotNil(renameResp)
+
+	_, err = s.handler.DescribeNamespace(context.Background(), &workflowservice.DescribeNamespaceRequest{Name: namespace})
+	s.IsType(&serviceerror.NotFound{}, err)
+	getResp, err := s.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: newNamespace})
+	s.NoError(err)
+	s.Equal(describeResp.NamespaceInfo.GetId(), primitives.UUIDString(getResp.Namespace.Info.Id))
+	s.Equal(newNamespace, getResp.Namespace.Info.Name)
+	s.Equal(int64(1), getResp.Namespace.ConfigVersion)
+	s.Len(getResp.Namespace.Info.Aliases, 1)
+	s.Equal(namespace, getResp.Namespace.Info.Aliases[0].GetName())
+	s.True(getResp.Namespace.Info.Aliases[0].GetExpireTimeNanos() > time.Now().UnixNano())
+
+	// the old name is reserved for the grace period
+	_, err = s.handler.RegisterNamespace(context.Background(), registerRequest)
+	s.IsType(&serviceerror.NamespaceAlreadyExists{}, err)
+
+	// renaming back drops the alias
+	_, err = s.handler.RenameNamespace(context.Background(), &adminservice.RenameNamespaceRequest{
+		Namespace: newNamespace,
+		NewName:   namespace,
+	})
+	s.NoError(err)
+	getResp, err = s.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: namespace})
+	s.NoError(err)
+	s.Empty(getResp.Namespace.Info.Aliases)
+}
+
+func (s *namespaceHandlerCommonSuite) TestRenameNamespace_AlreadyExists() {
+	namespace := s.getRandomNamespace()
+	otherNamespace := s.getRandomNamespace()
+	for _, name := range []string{namespace, otherNamespace} {
+		_, err := s.handler.RegisterNamespace(context.Background(), &workflowservice.RegisterNamespaceRequest{
+			Name:                                   name,
+			WorkflowExecutionRetentionPeriodInDays: int32(10),
+		})
+		s.NoError(err)
+	}
+
+	resp, err := s.handler.RenameNamespace(context.Background(), &adminservice.RenameNamespaceRequest{
+		Namespace: namespace,
+		NewName:   otherNamespace,
+	})
+	s.IsType(&serviceerror.NamespaceAlreadyExists{}, err)
+	s.Nil(resp)
+
+	resp, err = s.handler.RenameNamespace(context.Background(), &adminservice.RenameNamespaceRequest{
+		Namespace: namespace,
+		NewName:   namespace,
+	})
+	s.Equal(errNamespaceNameNotChanged, err)
+	s.Nil(resp)
+}
+
+func (s *namespaceHandlerCommonSuite) TestUpdateWorkerVersionSets() {
+	namespace := s.getRandomNamespace()
+	_, err := s.handler.RegisterNamespace(context.Background(), &workflowservice.RegisterNamespaceRequest{
+		Name:                                   namespace,
+		WorkflowExecutionRetentionPeriodInDays: int32(10),
+	})
+	s.NoError(err)
+
+	versionSets := []*namespacegenpb.WorkerVersionSet{
+		{BuildIds: []string{"1.0", "1.1"}},
+		{BuildIds: []string{"2.0"}},
+	}
+	resp, err := s.handler.UpdateWorkerVersionSets(context.Background(), &adminservice.UpdateWorkerVersionSetsRequest{
+		Namespace:   namespace,
+		VersionSets: versionSets,
+	})
+	s.NoError(err)
+	s.NotNil(resp)
+
+	getResp, err := s.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: namespace})
+	s.NoError(err)
+	s.Equal([]*namespacegenpb.WorkerVersionSet{
+		{BuildIds: []string{"1.0", "1.1"}, Id: "1.0"},
+		{BuildIds: []string{"2.0"}, Id: "2.0"},
+	}, getResp.Namespace.Config.WorkerVersionSets)
+	s.Equal(int64(1), getResp.Namespace.ConfigVersion)
+	s.Equal(int32(10), getResp.Namespace.Config.RetentionDays)
+
+	// an empty list turns build ID routing off
+	_, err = s.handler.UpdateWorkerVersionSets(context.Background(), &adminservice.UpdateWorkerVersionSetsRequest{
+		Namespace: namespace,
+	})
+	s.NoError(err)
+	getResp, err = s.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: namespace})
+	s.NoError(err)
+	s.Empty(getResp.Namespace.Config.WorkerVersionSets)
+}
+
+func (s *namespaceHandlerCommonSuite) TestUpdateWorkerVersionSets_KeepIDs() {
+	namespace := s.getRandomNamespace()
+	_, err := s.handler.RegisterNamespace(context.Background(), &workflowservice.RegisterNamespaceRequest{
+		Name:                                   namespace,
+		WorkflowExecutionRetentionPeriodInDays: int32(10),
+	})
+	s.NoError(err)
+
+	updateVersionSets := func(versionSets []*namespacegenpb.WorkerVersionSet) ([]*namespacegenpb.WorkerVersionSet, error) {
+		_, err := s.handler.UpdateWorkerVersionSets(context.Background(), &adminservice.UpdateWorkerVersionSetsRequest{
+			Namespace:   namespace,
+			VersionSets: versionSets,
+		})
+		if err != nil {
+			return nil, err
+		}
+		getResp, err := s.metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: namespace})
+		s.NoError(err)
+		return getResp.Namespace.Config.WorkerVersionSets, nil
+	}
+
+	versionSets, err := updateVersionSets([]*namespacegenpb.WorkerVersionSet{
+		{BuildIds: []string{"1.0", "1.1"}},
+		{BuildIds: []string{"2.0"}},
+	})
+	s.NoError(err)
+	s.Equal("1.0", versionSets[0].GetId())
+	s.Equal("2.0", versionSets[1].GetId())
+
+	// ids given by the client are ignored, sets keep their ids when their first build ID is removed
+	versionSets, err = updateVersionSets([]*namespacegenpb.WorkerVersionSet{
+		{BuildIds: []string{"1.1", "1.2"}, Id: "other"},
+		{BuildIds: []string{"2.0", "2.1"}},
+		{BuildIds: []string{"3.0"}},
+	})
+	s.NoError(err)
+	s.Equal("1.0", versionSets[0].GetId())
+	s.Equal("2.0", versionSets[1].GetId())
+	s.Equal("3.0", versionSets[2].GetId())
+
+	// a split set gets a new id, the id of a removed set is not reused
+	versionSets, err = updateVersionSets([]*namespacegenpb.WorkerVersionSet{
+		{BuildIds: []string{"1.1"}},
+		{BuildIds: []string{"1.2"}},
+		{BuildIds: []string{"2.0", "2.1"}},
+		{BuildIds: []string{"1.0"}},
+	})
+	s.NoError(err)
+	s.Equal("1.0", versionSets[0].GetId())
+	s.Equal("1.2", versionSets[1].GetId())
+	s.Equal("2.0", versionSets[2].GetId())
+	s.NotContains([]string{"", "1.0", "1.2", "2.0", "3.0"}, versionSets[3].GetId())
+
+	// merging sets would strand the backlog of all but one of them
+	_, err = updateVersionSets([]*namespacegenpb.WorkerVersionSet{
+		{BuildIds: []string{"1.1", "2.0"}},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
+func (s *namespaceHandlerCommonSuite) TestUpdateWorkerVersionSets_Invalid() {
+	namespace := s.getRandomNamespace()
+	_, err := s.handler.RegisterNamespace(context.Background(), &workflowservice.RegisterNamespaceRequest{
+		Name:                                   namespace,
+		WorkflowExecutionRetentionPeriodInDays: int32(10),
+	})
+	s.NoError(err)
+
+	resp, err := s.handler.UpdateWorkerVersionSets(context.Background(), &adminservice.UpdateWorkerVersionSetsRequest{
+		Namespace:   namespace,
+		VersionSets: []*namespacegenpb.WorkerVersionSet{{}},
+	})
+	s.Equal(errEmptyWorkerVersionSet, err)
+	s.Nil(resp)
+
+	resp, err = s.handler.UpdateWorkerVersionSets(context.Background(), &adminservice.UpdateWorkerVersionSetsRequest{
+		Namespace:   namespace,
+		VersionSets: []*namespacegenpb.WorkerVersionSet{{BuildIds: []string{""}}},
+	})
+	s.Equal(errEmptyWorkerBuildID, err)
+	s.Nil(resp)
+
+	// the prefix is reserved for the build IDs outside of the sets
+	resp, err = s.handler.UpdateWorkerVersionSets(context.Background(), &adminservice.UpdateWorkerVersionSetsRequest{
+		Namespace:   namespace,
+		VersionSets: []*namespacegenpb.WorkerVersionSet{{BuildIds: []string{common.WorkerBuildIDVersionSetPrefix + "1.0"}}},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Nil(resp)
+
+	resp, err = s.handler.UpdateWorkerVersionSets(context.Background(), &adminservice.UpdateWorkerVersionSetsRequest{
+		Namespace: namespace,
+		VersionSets: []*namespacegenpb.WorkerVersionSet{
+			{BuildIds: []string{"1.0", "1.1"}},
+			{BuildIds: []string{"1.1", "2.0"}},
+		},
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+	s.Nil(resp)
+}
+
 func (s *namespaceHandlerCommonSuite) getRandomNamespace() string {
 	return "namespace" + uuid.New()
 }
@@ -120,6 +120,7 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceCreationReplicatio
 				Description: task.Info.GetDescription(),
 				Owner:       task.Info.GetOwnerEmail(),
 				Data:        task.Info.Data,
+				Aliases:     task.Aliases,
 			},
 			Config: &persistenceblobs.NamespaceConfig{
 				RetentionDays:            task.Config.GetWorkflowExecutionRetentionPeriodInDays(),
@@ -128,6 +129,7 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceCreationReplicatio
 				HistoryArchivalURI:       task.Config.GetHistoryArchivalURI(),
 				VisibilityArchivalStatus: task.Config.GetVisibilityArchivalStatus(),
 				VisibilityArchivalURI:    task.Config.GetVisibilityArchivalURI(),
+				WorkerVersionSets:        task.WorkerVersionSets,
 			},
 			ReplicationConfig: &persistenceblobs.NamespaceReplicationConfig{
 				ActiveClusterName: task.ReplicationConfig.GetActiveClusterName(),
@@ -205,8 +207,9 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 
 	// plus, we need to check whether the config version is <= the config version set in the input
 	// plus, we need to check whether the failover version is <= the failover version set in the input
+	// the namespace is looked up by ID since the update can rename it
 	resp, err := h.metadataManagerV2.GetNamespace(&persistence.GetNamespaceRequest{
-		Name: task.Info.GetName(),
+		ID: primitives.MustParseUUID(task.GetId()),
 	})
 	if err != nil {
 		if _, ok := err.(*serviceerror.NotFound); ok {
@@ -225,6 +228,9 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 
 	if resp.Namespace.ConfigVersion < task.GetConfigVersion() {
 		recordUpdated = true
+		if resp.Namespace.Info.Name != task.Info.GetName() {
+			request.PreviousName = resp.Namespace.Info.Name
+		}
 		request.Namespace.Info = &persistenceblobs.NamespaceInfo{
 			Id:          primitives.MustParseUUID(task.GetId()),
 			Name:        task.Info.GetName(),
@@ -232,6 +238,7 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 			Description: task.Info.GetDescription(),
 			Owner:       task.Info.GetOwnerEmail(),
 			Data:        task.Info.Data,
+			Aliases:     task.Aliases,
 		}
 		request.Namespace.Config = &persistenceblobs.NamespaceConfig{
 			RetentionDays:            task.Config.GetWorkflowExecutionRetentionPeriodInDays(),
@@ -240,6 +247,7 @@ func (h *namespaceReplicationTaskExecutorImpl) handleNamespaceUpdateReplicationT
 			HistoryArchivalURI:       task.Config.GetHistoryArchivalURI(),
 			VisibilityArchivalStatus: task.Config.GetVisibilityArchivalStatus(),
 			VisibilityArchivalURI:    task.Config.GetVisibilityArchivalURI(),
+			WorkerVersionSets:        task.WorkerVersionSets,
 		}
 		if task.Config.GetBadBinaries() != nil {
 			request.Namespace.Config.BadBinaries = task.Config.GetBadBinaries()
@@ -26,6 +26,7 @@ package namespace
 
 import (
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/pborman/uuid"
@@ -35,6 +36,7 @@ import (
 	"go.temporal.io/temporal-proto/serviceerror"
 	"go.uber.org/zap"
 
+	namespacegenpb "github.com/temporalio/temporal/.gen/proto/namespace"
 	replicationgenpb "github.com/temporalio/temporal/.gen/proto/replication"
 	"github.com/temporalio/temporal/common/log/loggerimpl"
 	"github.com/temporalio/temporal/common/persistence"
@@ -837,3 +839,72 @@ func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_
 	s.Equal(int64(0), resp.Namespace.FailoverNotificationVersion)
 	s.Equal(notificationVersion, resp.NotificationVersion)
 }
+
+func (s *namespaceReplicationTaskExecutorSuite) TestExecute_UpdateNamespaceTask_Rename() {
+	id := uuid.New()
+	name := "some random namespace test name"
+	newName := "other random namespace test name"
+	clusterActive := "some random active cluster name"
+	clusterStandby := "some random standby cluster name"
+	configVersion := int64(0)
+	failoverVersion := int64(59)
+
+	createTask := &replicationgenpb.NamespaceTaskAttributes{
+		NamespaceOperation: replicationgenpb.NamespaceOperation_Create,
+		Id:                 id,
+		Info: &namespacepb.NamespaceInfo{
+			Name:        name,
+			Status:      namespacepb.NamespaceStatus_Registered,
+			Description: "some random test description",
+			OwnerEmail:  "some random test owner",
+			Data:        map[string]string{"k": "v"},
+		},
+		Config: &namespacepb.NamespaceConfiguration{
+			WorkflowExecutionRetentionPeriodInDays: 10,
+			EmitMetric:                             &types.BoolValue{Value: true},
+			HistoryArchivalStatus:                  namespacepb.ArchivalStatus_Disabled,
+			VisibilityArchivalStatus:               namespacepb.ArchivalStatus_Disabled,
+		},
+		ReplicationConfig: &replicationpb.NamespaceReplicationConfiguration{
+			ActiveClusterName: clusterActive,
+			Clusters: []*replicationpb.ClusterReplicationConfiguration{
+				{ClusterName: clusterActive},
+				{ClusterName: clusterStandby},
+			},
+		},
+		ConfigVersion:   configVersion,
+		FailoverVersion: failoverVersion,
+	}
+	err := s.namespaceReplicator.Execute(createTask)
+	s.Nil(err)
+
+	aliases := []*namespacegenpb.NamespaceAlias{{Name: name, ExpireTimeNanos: time.Now().Add(time.Hour).UnixNano()}}
+	updateTask := &replicationgenpb.NamespaceTaskAttributes{
+		NamespaceOperation: replicationgenpb.NamespaceOperation_Update,
+		Id:                 id,
+		Info: &namespacepb.NamespaceInfo{
+			Name:        newName,
+			Status:      createTask.Info.Status,
+			Description: createTask.Info.Description,
+			OwnerEmail:  createTask.Info.OwnerEmail,
+			Data:        createTask.Info.Data,
+		},
+		Config:            createTask.Config,
+		ReplicationConfig: createTask.ReplicationConfig,
+		ConfigVersion:     configVersion + 1,
+		FailoverVersion:   failoverVersion,
+		Aliases:           aliases,
+	}
+	err = s.namespaceReplicator.Execute(updateTask)
+	s.Nil(err)
+
+	_, err = s.MetadataManager.GetNamespace(&persistence.GetNamespaceRequest{Name: name})
+	s.IsType(&serviceerror.NotFound{}, err)
+	resp, err := s.MetadataManager.GetNamespace(&persistence.GetNamespaceRequest{Name: newName})
+	s.Nil(err)
+	s.EqualValues(primitives.MustParseUUID(id), resp.Namespace.Info.Id)
+	s.Equal(newName, resp.Namespace.Info.Name)
+	s.Equal(aliases, resp.Namespace.Info.Aliases)
+	s.Equal(configVersion+1, resp.Namespace.ConfigVersion)
+	s.True(resp.IsGlobalNamespace)
+}
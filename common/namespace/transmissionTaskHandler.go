@@ -97,8 +97,10 @@ func (namespaceReplicator *namespaceReplicatorImpl) HandleTransmissionTask(names
 				ActiveClusterName: replicationConfig.ActiveClusterName,
 				Clusters:          namespaceReplicator.convertClusterReplicationConfigToProto(replicationConfig.Clusters),
 			},
-			ConfigVersion:   configVersion,
-			FailoverVersion: failoverVersion,
+			ConfigVersion:     configVersion,
+			FailoverVersion:   failoverVersion,
+			Aliases:           info.Aliases,
+			WorkerVersionSets: config.WorkerVersionSets,
 		},
 	}
 
@@ -56,6 +56,10 @@ const (
 	templateDeleteNamespaceQuery = `DELETE FROM namespaces ` +
 		`WHERE id = ?`
 
+	templateUpdateNamespaceNameQuery = `UPDATE namespaces ` +
+		`SET name = ? ` +
+		`WHERE id = ?`
+
 	templateNamespaceColumns = `id, name, detail, detail_encoding, notification_version, is_global_namespace`
 
 	templateCreateNamespaceByNameQueryWithinBatchV2 = `INSERT INTO namespaces_by_name_v2 ` +
@@ -192,6 +196,10 @@ func (m *cassandraMetadataPersistenceV2) CreateNamespaceInV2Table(request *p.Int
 }
 
 func (m *cassandraMetadataPersistenceV2) UpdateNamespace(request *p.InternalUpdateNamespaceRequest) error {
+	if request.PreviousName != "" && request.PreviousName != request.Name {
+		return m.renameNamespace(request)
+	}
+
 	batch := m.session.NewBatch(gocql.LoggedBatch)
 	batch.Query(templateUpdateNamespaceByNameQueryWithinBatchV2,
 		request.Namespace.Data,
@@ -220,6 +228,64 @@ func (m *cassandraMetadataPersistenceV2) UpdateNamespace(request *p.InternalUpda
 	return nil
 }
 
+// renameNamespace moves the namespace to a new row of namespaces_by_name_v2 table.
+// Cassandra does not support conditional updates across multiple tables.  For this reason we have to first update the
+// name in 'Namespaces' table and then do a conditional insert into namespaces_by_name table.  If the conditional write
+// fails we restore the previous name in namespaces table.
+func (m *cassandraMetadataPersistenceV2) renameNamespace(request *p.InternalUpdateNamespaceRequest) error {
+	previous, err := m.GetNamespace(&p.GetNamespaceRequest{Name: request.PreviousName})
+	if err != nil {
+		return err
+	}
+
+	query := m.session.Query(templateUpdateNamespaceNameQuery, request.Name, request.Id.Downcast())
+	if err := query.Exec(); err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("UpdateNamespace operation failed. Updating namespaces table. Error: %v", err))
+	}
+
+	batch := m.session.NewBatch(gocql.LoggedBatch)
+	batch.Query(templateCreateNamespaceByNameQueryWithinBatchV2,
+		constNamespacePartition,
+		request.Id.Downcast(),
+		request.Name,
+		request.Namespace.Data,
+		request.Namespace.Encoding.String(),
+		request.NotificationVersion,
+		previous.IsGlobal,
+	)
+	batch.Query(templateDeleteNamespaceByNameQueryV2,
+		constNamespacePartition,
+		request.PreviousName,
+	)
+	m.updateMetadataBatch(batch, request.NotificationVersion)
+
+	conflict := make(map[string]interface{})
+	applied, iter, err := m.session.MapExecuteBatchCAS(batch, conflict)
+	defer func() {
+		if iter != nil {
+			iter.Close()
+		}
+	}()
+
+	if err == nil && applied {
+		return nil
+	}
+
+	// Rename failed.  Restore the previous name before returning back to user
+	query = m.session.Query(templateUpdateNamespaceNameQuery, request.PreviousName, request.Id.Downcast())
+	if errRestore := query.Exec(); errRestore != nil {
+		m.logger.Warn("Unable to restore namespace name. Error", tag.Error(errRestore))
+	}
+
+	if err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("UpdateNamespace operation failed. Inserting into namespaces_by_name_v2 table. Error: %v", err))
+	}
+	if id, ok := conflict["id"].([]byte); ok && primitives.UUIDString(id) != request.Id.String() {
+		return serviceerror.NewNamespaceAlreadyExists(fmt.Sprintf("Namespace already exists.  NamespaceId: %v", primitives.UUIDString(id)))
+	}
+	return serviceerror.NewInternal(fmt.Sprintf("UpdateNamespace operation failed because of conditional failure."))
+}
+
 func (m *cassandraMetadataPersistenceV2) GetNamespace(request *p.GetNamespaceRequest) (*p.InternalGetNamespaceResponse, error) {
 	var query *gocql.Query
 	var err error
@@ -169,6 +169,7 @@ func applyWorkflowMutationBatch(
 		namespaceID,
 		workflowID,
 		runID,
+		workflowMutation.ExecutionInfo.Priority,
 		workflowMutation.TransferTasks,
 		workflowMutation.ReplicationTasks,
 		workflowMutation.TimerTasks,
@@ -294,6 +295,7 @@ func applyWorkflowSnapshotBatchAsReset(
 		namespaceID,
 		workflowID,
 		runID,
+		workflowSnapshot.ExecutionInfo.Priority,
 		workflowSnapshot.TransferTasks,
 		workflowSnapshot.ReplicationTasks,
 		workflowSnapshot.TimerTasks,
@@ -415,6 +417,7 @@ func applyWorkflowSnapshotBatchAsNew(
 		namespaceID,
 		workflowID,
 		runID,
+		workflowSnapshot.ExecutionInfo.Priority,
 		workflowSnapshot.TransferTasks,
 		workflowSnapshot.ReplicationTasks,
 		workflowSnapshot.TimerTasks,
@@ -655,6 +658,7 @@ func applyTasks(
 	namespaceID string,
 	workflowID string,
 	runID string,
+	priority int32,
 	transferTasks []p.Task,
 	replicationTasks []p.Task,
 	timerTasks []p.Task,
@@ -667,6 +671,7 @@ func applyTasks(
 		namespaceID,
 		workflowID,
 		runID,
+		priority,
 	); err != nil {
 		return err
 	}
@@ -689,6 +694,7 @@ func applyTasks(
 		namespaceID,
 		workflowID,
 		runID,
+		priority,
 	)
 }
 
@@ -699,6 +705,7 @@ func createTransferTasks(
 	namespaceID string,
 	workflowID string,
 	runID string,
+	priority int32,
 ) error {
 
 	targetNamespaceID := namespaceID
@@ -709,12 +716,16 @@ func createTransferTasks(
 		targetRunID := ""
 		targetChildWorkflowOnly := false
 		recordVisibility := false
+		taskPriority := priority
 
 		switch task.GetType() {
 		case p.TransferTaskTypeActivityTask:
 			targetNamespaceID = task.(*p.ActivityTask).NamespaceID
 			taskList = task.(*p.ActivityTask).TaskList
 			scheduleID = task.(*p.ActivityTask).ScheduleID
+			if task.(*p.ActivityTask).Priority != common.TaskPriorityUnspecified {
+				taskPriority = task.(*p.ActivityTask).Priority
+			}
 
 		case p.TransferTaskTypeDecisionTask:
 			targetNamespaceID = task.(*p.DecisionTask).NamespaceID
@@ -746,7 +757,8 @@ func createTransferTasks(
 		case p.TransferTaskTypeCloseExecution,
 			p.TransferTaskTypeRecordWorkflowStarted,
 			p.TransferTaskTypeResetWorkflow,
-			p.TransferTaskTypeUpsertWorkflowSearchAttributes:
+			p.TransferTaskTypeUpsertWorkflowSearchAttributes,
+			p.TransferTaskTypeDeleteExecution:
 			// No explicit property needs to be set
 
 		default:
@@ -775,6 +787,7 @@ func createTransferTasks(
 			TaskId:                  task.GetTaskID(),
 			VisibilityTimestamp:     taskVisTs,
 			RecordVisibility:        recordVisibility,
+			Priority:                taskPriority,
 		}
 
 		datablob, err := serialization.TransferTaskInfoToBlob(p)
@@ -835,6 +848,12 @@ func createReplicationTasks(
 			// cassandra does not like null
 			lastReplicationInfo = make(map[string]*replicationgenpb.ReplicationInfo)
 
+		case p.ReplicationTaskTypeDeleteExecution,
+			p.ReplicationTaskTypeSyncWorkflowState:
+			version = task.GetVersion()
+			// cassandra does not like null
+			lastReplicationInfo = make(map[string]*replicationgenpb.ReplicationInfo)
+
 		default:
 			return serviceerror.NewInternal(fmt.Sprintf("Unknow replication type: %v", task.GetType()))
 		}
@@ -883,11 +902,13 @@ func createTimerTasks(
 	namespaceID string,
 	workflowID string,
 	runID string,
+	priority int32,
 ) error {
 
 	for _, task := range timerTasks {
 		var eventID int64
 		var attempt int64
+		var activityScheduledTime int64
 
 		timeoutType := 0
 
@@ -908,6 +929,9 @@ func createTimerTasks(
 		case *p.ActivityRetryTimerTask:
 			eventID = t.EventID
 			attempt = int64(t.Attempt)
+			if !t.ScheduledTime.IsZero() {
+				activityScheduledTime = t.ScheduledTime.UnixNano()
+			}
 
 		case *p.WorkflowBackoffTimerTask:
 			eventID = t.EventID
@@ -933,16 +957,18 @@ func createTimerTasks(
 		}
 
 		datablob, err := serialization.TimerTaskInfoToBlob(&persistenceblobs.TimerTaskInfo{
-			NamespaceId:         primitives.MustParseUUID(namespaceID),
-			WorkflowId:          workflowID,
-			RunId:               primitives.MustParseUUID(runID),
-			TaskType:            int32(task.GetType()),
-			TimeoutType:         int32(timeoutType),
-			Version:             task.GetVersion(),
-			ScheduleAttempt:     attempt,
-			EventId:             eventID,
-			TaskId:              task.GetTaskID(),
-			VisibilityTimestamp: protoTs,
+			NamespaceId:                primitives.MustParseUUID(namespaceID),
+			WorkflowId:                 workflowID,
+			RunId:                      primitives.MustParseUUID(runID),
+			TaskType:                   int32(task.GetType()),
+			TimeoutType:                int32(timeoutType),
+			Version:                    task.GetVersion(),
+			ScheduleAttempt:            attempt,
+			EventId:                    eventID,
+			TaskId:                     task.GetTaskID(),
+			VisibilityTimestamp:        protoTs,
+			ActivityScheduledTimeNanos: activityScheduledTime,
+			Priority:                   priority,
 		})
 
 		if err != nil {
@@ -28,6 +28,7 @@ import (
 	"sync"
 
 	"github.com/temporalio/temporal/common/log"
+	"github.com/temporalio/temporal/common/log/tag"
 	"github.com/temporalio/temporal/common/metrics"
 	p "github.com/temporalio/temporal/common/persistence"
 	"github.com/temporalio/temporal/common/persistence/cassandra"
@@ -100,6 +101,7 @@ type (
 		metricsClient            metrics.Client
 		logger                   log.Logger
 		datastores               map[storeType]Datastore
+		serializer               p.PayloadSerializer
 		clusterName              string
 	}
 
@@ -194,7 +196,7 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewHistoryV2ManagerImpl(store, f.logger, f.config.TransactionSizeLimit)
+	result := p.NewHistoryV2ManagerImpl(store, f.serializer, f.logger, f.config.TransactionSizeLimit)
 	if ds.ratelimit != nil {
 		result = p.NewHistoryV2PersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -251,7 +253,7 @@ func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, erro
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewExecutionManagerImpl(store, f.logger)
+	result := p.NewExecutionManagerImpl(store, f.serializer, f.logger)
 	if ds.ratelimit != nil {
 		result = p.NewWorkflowExecutionPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -273,7 +275,7 @@ func (f *factoryImpl) NewVisibilityManager() (p.VisibilityManager, error) {
 		store, err = cassandra.NewVisibilityPersistenceV2(store, f.getCassandraConfig(), f.logger)
 	}
 
-	result := p.NewVisibilityManagerImpl(store, f.logger)
+	result := p.NewVisibilityManagerImpl(store, f.serializer, f.logger)
 	if ds.ratelimit != nil {
 		result = p.NewVisibilityPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -352,6 +354,12 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 	}
 
 	f.datastores[storeTypeVisibility] = visibilityDataStore
+
+	serializer, err := p.NewPayloadSerializerWithEncryption(f.config.Encryption)
+	if err != nil {
+		f.logger.Fatal("invalid encryption config", tag.Error(err))
+	}
+	f.serializer = serializer
 }
 
 func buildRatelimiters(cfg *config.Persistence, maxQPS dynamicconfig.IntPropertyFn) map[string]quotas.Limiter {
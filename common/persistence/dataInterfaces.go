@@ -36,6 +36,7 @@ import (
 	eventpb "go.temporal.io/temporal-proto/event"
 	executionpb "go.temporal.io/temporal-proto/execution"
 
+	eventgenpb "github.com/temporalio/temporal/.gen/proto/event"
 	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
 	replicationgenpb "github.com/temporalio/temporal/.gen/proto/replication"
 	"github.com/temporalio/temporal/common"
@@ -147,12 +148,15 @@ const (
 	TransferTaskTypeRecordWorkflowStarted
 	TransferTaskTypeResetWorkflow
 	TransferTaskTypeUpsertWorkflowSearchAttributes
+	TransferTaskTypeDeleteExecution
 )
 
 // Types of replication tasks
 const (
 	ReplicationTaskTypeHistory = iota
 	ReplicationTaskTypeSyncActivity
+	ReplicationTaskTypeDeleteExecution
+	ReplicationTaskTypeSyncWorkflowState
 )
 
 // Types of timers
@@ -173,6 +177,7 @@ const UnknownNumRowsAffected = -1
 const (
 	WorkflowBackoffTimeoutTypeRetry = iota
 	WorkflowBackoffTimeoutTypeCron
+	WorkflowBackoffTimeoutTypeCronOverlap
 )
 
 const (
@@ -319,6 +324,24 @@ type (
 		// Cron
 		CronSchedule      string
 		ExpirationSeconds int32
+		CronSkipUntil     time.Time
+		// Pause
+		Paused        bool
+		PauseReason   string
+		PauseIdentity string
+		// Search attributes and memo set by UpdateWorkflowAttributes instead of history events
+		UpdatedSearchAttributes map[string][]byte
+		UpdatedMemo             map[string][]byte
+		// Results of the recent updates by update id, which are returned to the retries of the updates
+		UpdateResults map[string]*eventgenpb.WorkflowUpdateResult
+		// Failover version of the last change of the state which is replicated by SyncWorkflowStateTask
+		SyncStateVersion int64
+		// Signal deduplication, request id to the time the signal was accepted
+		SignalRequestIDTimestamps map[string]time.Time
+		// Priority of the tasks of the workflow
+		Priority int32
+		// Build ID of the worker that completed the last decision task
+		WorkerBuildID string
 	}
 
 	// ExecutionStats is the statistics about workflow execution
@@ -367,6 +390,8 @@ type (
 		TaskList            string
 		ScheduleID          int64
 		Version             int64
+		// Priority overrides the priority of the workflow for the activity task
+		Priority int32
 	}
 
 	// DecisionTask identifies a transfer task for decision
@@ -458,6 +483,13 @@ type (
 		Version int64
 	}
 
+	// DeleteExecutionTask identifies a transfer task for deleting a workflow execution and all its data
+	DeleteExecutionTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+	}
+
 	// StartChildExecutionTask identifies a transfer task for starting child execution
 	StartChildExecutionTask struct {
 		VisibilityTimestamp time.Time
@@ -493,6 +525,8 @@ type (
 		EventID             int64
 		Version             int64
 		Attempt             int32
+		// ScheduledTime is the scheduled time of the activity retry, the visibility timestamp can be shifted by the shard
+		ScheduledTime time.Time
 	}
 
 	// WorkflowBackoffTimerTask to schedule first decision task for retried workflow
@@ -527,6 +561,21 @@ type (
 		ScheduledID         int64
 	}
 
+	// DeleteExecutionReplicationTask is the replication task created for deleting a workflow execution in other clusters
+	DeleteExecutionReplicationTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+	}
+
+	// SyncWorkflowStateTask is the replication task created for shipping the state of a workflow execution
+	// which is not recorded in history events, such as the pause, to other clusters
+	SyncWorkflowStateTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+	}
+
 	// VersionHistoryItem contains the event id and the associated version
 	VersionHistoryItem struct {
 		EventID int64
@@ -597,6 +646,10 @@ type (
 		LastFailureReason  string
 		LastWorkerIdentity string
 		LastFailureDetails []byte
+		Priority           int32
+		// For the last update of the activity options by an operator
+		OptionsUpdateIdentity string
+		OptionsUpdateTime     time.Time
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds int64
 	}
@@ -1051,6 +1104,8 @@ type (
 	UpdateNamespaceRequest struct {
 		Namespace           *persistenceblobs.NamespaceDetail
 		NotificationVersion int64
+		// PreviousName is only set when the update renames the namespace
+		PreviousName string
 	}
 
 	// DeleteNamespaceRequest is used to delete namespace entry from namespaces table
@@ -1150,12 +1205,18 @@ type (
 		Encoding common.EncodingType
 		// The shard to get history node data
 		ShardID *int
+		// The namespace the events belong to, it selects the key the events are encrypted with
+		NamespaceID string
+		// optional compression of the events
+		Compression CompressionType
 	}
 
 	// AppendHistoryNodesResponse is a response to AppendHistoryNodesRequest
 	AppendHistoryNodesResponse struct {
 		// the size of the event data that has been appended
 		Size int
+		// the size of the event data after compression, it equals Size when the data is not compressed
+		CompressedSize int
 	}
 
 	// ReadHistoryBranchRequest is used to read a history branch
@@ -1593,6 +1654,11 @@ func (d ReplicationTaskInfoWrapper) GetVisibilityTimestamp() *types.Timestamp {
 	return &types.Timestamp{}
 }
 
+// GetPriority returns the priority of the replication task, replication tasks are not prioritized
+func (d ReplicationTaskInfoWrapper) GetPriority() int32 {
+	return common.TaskPriorityUnspecified
+}
+
 // GetVisibilityTimestamp get the visibility timestamp
 func (d *DecisionTask) GetVisibilityTimestamp() time.Time {
 	return d.VisibilityTimestamp
@@ -2058,6 +2124,41 @@ func (u *UpsertWorkflowSearchAttributesTask) SetVisibilityTimestamp(timestamp ti
 	u.VisibilityTimestamp = timestamp
 }
 
+// GetType returns the type of the delete execution transfer task
+func (d *DeleteExecutionTask) GetType() int {
+	return TransferTaskTypeDeleteExecution
+}
+
+// GetVersion returns the version of the delete execution transfer task
+func (d *DeleteExecutionTask) GetVersion() int64 {
+	return d.Version
+}
+
+// SetVersion returns the version of the delete execution transfer task
+func (d *DeleteExecutionTask) SetVersion(version int64) {
+	d.Version = version
+}
+
+// GetTaskID returns the sequence ID of the delete execution transfer task
+func (d *DeleteExecutionTask) GetTaskID() int64 {
+	return d.TaskID
+}
+
+// SetTaskID sets the sequence ID of the delete execution transfer task
+func (d *DeleteExecutionTask) SetTaskID(id int64) {
+	d.TaskID = id
+}
+
+// GetVisibilityTimestamp get the visibility timestamp
+func (d *DeleteExecutionTask) GetVisibilityTimestamp() time.Time {
+	return d.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp set the visibility timestamp
+func (d *DeleteExecutionTask) SetVisibilityTimestamp(timestamp time.Time) {
+	d.VisibilityTimestamp = timestamp
+}
+
 // GetType returns the type of the start child transfer task
 func (u *StartChildExecutionTask) GetType() int {
 	return TransferTaskTypeStartChildExecution
@@ -2163,6 +2264,76 @@ func (a *SyncActivityTask) SetVisibilityTimestamp(timestamp time.Time) {
 	a.VisibilityTimestamp = timestamp
 }
 
+// GetType returns the type of the delete execution replication task
+func (d *DeleteExecutionReplicationTask) GetType() int {
+	return ReplicationTaskTypeDeleteExecution
+}
+
+// GetVersion returns the version of the delete execution replication task
+func (d *DeleteExecutionReplicationTask) GetVersion() int64 {
+	return d.Version
+}
+
+// SetVersion returns the version of the delete execution replication task
+func (d *DeleteExecutionReplicationTask) SetVersion(version int64) {
+	d.Version = version
+}
+
+// GetTaskID returns the sequence ID of the delete execution replication task
+func (d *DeleteExecutionReplicationTask) GetTaskID() int64 {
+	return d.TaskID
+}
+
+// SetTaskID sets the sequence ID of the delete execution replication task
+func (d *DeleteExecutionReplicationTask) SetTaskID(id int64) {
+	d.TaskID = id
+}
+
+// GetVisibilityTimestamp get the visibility timestamp
+func (d *DeleteExecutionReplicationTask) GetVisibilityTimestamp() time.Time {
+	return d.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp set the visibility timestamp
+func (d *DeleteExecutionReplicationTask) SetVisibilityTimestamp(timestamp time.Time) {
+	d.VisibilityTimestamp = timestamp
+}
+
+// GetType returns the type of the sync workflow state replication task
+func (s *SyncWorkflowStateTask) GetType() int {
+	return ReplicationTaskTypeSyncWorkflowState
+}
+
+// GetVersion returns the version of the sync workflow state replication task
+func (s *SyncWorkflowStateTask) GetVersion() int64 {
+	return s.Version
+}
+
+// SetVersion returns the version of the sync workflow state replication task
+func (s *SyncWorkflowStateTask) SetVersion(version int64) {
+	s.Version = version
+}
+
+// GetTaskID returns the sequence ID of the sync workflow state replication task
+func (s *SyncWorkflowStateTask) GetTaskID() int64 {
+	return s.TaskID
+}
+
+// SetTaskID sets the sequence ID of the sync workflow state replication task
+func (s *SyncWorkflowStateTask) SetTaskID(id int64) {
+	s.TaskID = id
+}
+
+// GetVisibilityTimestamp get the visibility timestamp
+func (s *SyncWorkflowStateTask) GetVisibilityTimestamp() time.Time {
+	return s.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp set the visibility timestamp
+func (s *SyncWorkflowStateTask) SetVisibilityTimestamp(timestamp time.Time) {
+	s.VisibilityTimestamp = timestamp
+}
+
 // DBTimestampToUnixNano converts CQL timestamp to UnixNano
 func DBTimestampToUnixNano(milliseconds int64) int64 {
 	return milliseconds * 1000 * 1000 // Milliseconds are 10⁻³, nanoseconds are 10⁻⁹, (-3) - (-9) = 6, so multiply by 10⁶
@@ -36,12 +36,14 @@ import (
 
 // NewESVisibilityManager create a visibility manager for ElasticSearch
 // In history, it only needs kafka producer for writing data;
-// In frontend, it only needs ES client and related config for reading data
+// In frontend, it only needs ES client and related config for reading data.
+// The serializer must be the one used by the other persistence managers so that
+// memo and search attributes are encrypted the same way.
 func NewESVisibilityManager(indexName string, esClient es.Client, config *config.VisibilityConfig,
-	producer messaging.Producer, metricsClient metrics.Client, log log.Logger) p.VisibilityManager {
+	producer messaging.Producer, serializer p.PayloadSerializer, metricsClient metrics.Client, log log.Logger) p.VisibilityManager {
 
 	visibilityFromESStore := NewElasticSearchVisibilityStore(esClient, indexName, producer, config, log)
-	visibilityFromES := p.NewVisibilityManagerImpl(visibilityFromESStore, log)
+	visibilityFromES := p.NewVisibilityManagerImpl(visibilityFromESStore, serializer, log)
 
 	if config != nil {
 		// wrap with rate limiter
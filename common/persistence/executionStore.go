@@ -49,11 +49,12 @@ var _ ExecutionManager = (*executionManagerImpl)(nil)
 // NewExecutionManagerImpl returns new ExecutionManager
 func NewExecutionManagerImpl(
 	persistence ExecutionStore,
+	serializer PayloadSerializer,
 	logger log.Logger,
 ) ExecutionManager {
 
 	return &executionManagerImpl{
-		serializer:    NewPayloadSerializer(),
+		serializer:    serializer,
 		persistence:   persistence,
 		statsComputer: statsComputer{},
 		logger:        logger,
@@ -184,6 +185,17 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		AutoResetPoints:                    autoResetPoints,
 		SearchAttributes:                   info.SearchAttributes,
 		Memo:                               info.Memo,
+		Paused:                             info.Paused,
+		PauseReason:                        info.PauseReason,
+		PauseIdentity:                      info.PauseIdentity,
+		CronSkipUntil:                      info.CronSkipUntil,
+		SyncStateVersion:                   info.SyncStateVersion,
+		UpdatedSearchAttributes:            info.UpdatedSearchAttributes,
+		UpdatedMemo:                        info.UpdatedMemo,
+		UpdateResults:                      info.UpdateResults,
+		SignalRequestIDTimestamps:          info.SignalRequestIDTimestamps,
+		Priority:                           info.Priority,
+		WorkerBuildID:                      info.WorkerBuildID,
 	}
 	newStats := &ExecutionStats{
 		HistorySize: info.HistorySize,
@@ -267,6 +279,14 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 		if err != nil {
 			return nil, err
 		}
+		details, err := m.serializer.DecryptData(v.Details)
+		if err != nil {
+			return nil, err
+		}
+		lastFailureDetails, err := m.serializer.DecryptData(v.LastFailureDetails)
+		if err != nil {
+			return nil, err
+		}
 		a := &ActivityInfo{
 			ScheduledEvent: scheduledEvent,
 			StartedEvent:   startedEvent,
@@ -279,7 +299,7 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			StartedTime:                             v.StartedTime,
 			ActivityID:                              v.ActivityID,
 			RequestID:                               v.RequestID,
-			Details:                                 v.Details,
+			Details:                                 details,
 			ScheduleToStartTimeout:                  v.ScheduleToStartTimeout,
 			ScheduleToCloseTimeout:                  v.ScheduleToCloseTimeout,
 			StartToCloseTimeout:                     v.StartToCloseTimeout,
@@ -301,7 +321,10 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			NonRetriableErrors:                      v.NonRetriableErrors,
 			LastFailureReason:                       v.LastFailureReason,
 			LastWorkerIdentity:                      v.LastWorkerIdentity,
-			LastFailureDetails:                      v.LastFailureDetails,
+			LastFailureDetails:                      lastFailureDetails,
+			Priority:                                v.Priority,
+			OptionsUpdateIdentity:                   v.OptionsUpdateIdentity,
+			OptionsUpdateTime:                       v.OptionsUpdateTime,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
 		}
 		newInfos[k] = a
@@ -340,17 +363,18 @@ func (m *executionManagerImpl) UpdateWorkflowExecution(
 }
 
 func (m *executionManagerImpl) SerializeUpsertChildExecutionInfos(
+	namespaceID string,
 	infos []*ChildExecutionInfo,
 	encoding common.EncodingType,
 ) ([]*InternalChildExecutionInfo, error) {
 
 	newInfos := make([]*InternalChildExecutionInfo, 0)
 	for _, v := range infos {
-		initiatedEvent, err := m.serializer.SerializeEvent(v.InitiatedEvent, encoding)
+		initiatedEvent, err := m.serializeEvent(namespaceID, v.InitiatedEvent, encoding)
 		if err != nil {
 			return nil, err
 		}
-		startedEvent, err := m.serializer.SerializeEvent(v.StartedEvent, encoding)
+		startedEvent, err := m.serializeEvent(namespaceID, v.StartedEvent, encoding)
 		if err != nil {
 			return nil, err
 		}
@@ -375,17 +399,26 @@ func (m *executionManagerImpl) SerializeUpsertChildExecutionInfos(
 }
 
 func (m *executionManagerImpl) SerializeUpsertActivityInfos(
+	namespaceID string,
 	infos []*ActivityInfo,
 	encoding common.EncodingType,
 ) ([]*InternalActivityInfo, error) {
 
 	newInfos := make([]*InternalActivityInfo, 0)
 	for _, v := range infos {
-		scheduledEvent, err := m.serializer.SerializeEvent(v.ScheduledEvent, encoding)
+		scheduledEvent, err := m.serializeEvent(namespaceID, v.ScheduledEvent, encoding)
 		if err != nil {
 			return nil, err
 		}
-		startedEvent, err := m.serializer.SerializeEvent(v.StartedEvent, encoding)
+		startedEvent, err := m.serializeEvent(namespaceID, v.StartedEvent, encoding)
+		if err != nil {
+			return nil, err
+		}
+		details, err := m.serializer.EncryptData(namespaceID, v.Details)
+		if err != nil {
+			return nil, err
+		}
+		lastFailureDetails, err := m.serializer.EncryptData(namespaceID, v.LastFailureDetails)
 		if err != nil {
 			return nil, err
 		}
@@ -400,7 +433,7 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			StartedTime:                             v.StartedTime,
 			ActivityID:                              v.ActivityID,
 			RequestID:                               v.RequestID,
-			Details:                                 v.Details,
+			Details:                                 details,
 			ScheduleToStartTimeout:                  v.ScheduleToStartTimeout,
 			ScheduleToCloseTimeout:                  v.ScheduleToCloseTimeout,
 			StartToCloseTimeout:                     v.StartToCloseTimeout,
@@ -422,7 +455,10 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			NonRetriableErrors:                      v.NonRetriableErrors,
 			LastFailureReason:                       v.LastFailureReason,
 			LastWorkerIdentity:                      v.LastWorkerIdentity,
-			LastFailureDetails:                      v.LastFailureDetails,
+			LastFailureDetails:                      lastFailureDetails,
+			Priority:                                v.Priority,
+			OptionsUpdateIdentity:                   v.OptionsUpdateIdentity,
+			OptionsUpdateTime:                       v.OptionsUpdateTime,
 			LastHeartbeatTimeoutVisibilityInSeconds: v.LastHeartbeatTimeoutVisibilityInSeconds,
 		}
 		newInfos = append(newInfos, i)
@@ -439,7 +475,7 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 	if info == nil {
 		return &InternalWorkflowExecutionInfo{}, nil
 	}
-	completionEvent, err := m.serializer.SerializeEvent(info.CompletionEvent, encoding)
+	completionEvent, err := m.serializeEvent(info.NamespaceID, info.CompletionEvent, encoding)
 	if err != nil {
 		return nil, err
 	}
@@ -504,6 +540,17 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		ExpirationSeconds:                  info.ExpirationSeconds,
 		Memo:                               info.Memo,
 		SearchAttributes:                   info.SearchAttributes,
+		Paused:                             info.Paused,
+		PauseReason:                        info.PauseReason,
+		PauseIdentity:                      info.PauseIdentity,
+		CronSkipUntil:                      info.CronSkipUntil,
+		SyncStateVersion:                   info.SyncStateVersion,
+		UpdatedSearchAttributes:            info.UpdatedSearchAttributes,
+		UpdatedMemo:                        info.UpdatedMemo,
+		UpdateResults:                      info.UpdateResults,
+		SignalRequestIDTimestamps:          info.SignalRequestIDTimestamps,
+		Priority:                           info.Priority,
+		WorkerBuildID:                      info.WorkerBuildID,
 
 		// attributes which are not related to mutable state
 		HistorySize: stats.HistorySize,
@@ -629,11 +676,12 @@ func (m *executionManagerImpl) SerializeWorkflowMutation(
 	if err != nil {
 		return nil, err
 	}
-	serializedUpsertActivityInfos, err := m.SerializeUpsertActivityInfos(input.UpsertActivityInfos, encoding)
+	namespaceID := getNamespaceID(input.ExecutionInfo)
+	serializedUpsertActivityInfos, err := m.SerializeUpsertActivityInfos(namespaceID, input.UpsertActivityInfos, encoding)
 	if err != nil {
 		return nil, err
 	}
-	serializedUpsertChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(input.UpsertChildExecutionInfos, encoding)
+	serializedUpsertChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(namespaceID, input.UpsertChildExecutionInfos, encoding)
 	if err != nil {
 		return nil, err
 	}
@@ -643,6 +691,10 @@ func (m *executionManagerImpl) SerializeWorkflowMutation(
 		if err != nil {
 			return nil, err
 		}
+		serializedNewBufferedEvents, err = m.serializer.EncryptBlob(namespaceID, serializedNewBufferedEvents)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	startVersion, err := getStartVersion(input.VersionHistories, input.ReplicationState)
@@ -702,11 +754,12 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot(
 	if err != nil {
 		return nil, err
 	}
-	serializedActivityInfos, err := m.SerializeUpsertActivityInfos(input.ActivityInfos, encoding)
+	namespaceID := getNamespaceID(input.ExecutionInfo)
+	serializedActivityInfos, err := m.SerializeUpsertActivityInfos(namespaceID, input.ActivityInfos, encoding)
 	if err != nil {
 		return nil, err
 	}
-	serializedChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(input.ChildExecutionInfos, encoding)
+	serializedChildExecutionInfos, err := m.SerializeUpsertChildExecutionInfos(namespaceID, input.ChildExecutionInfos, encoding)
 	if err != nil {
 		return nil, err
 	}
@@ -743,6 +796,19 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot(
 	}, nil
 }
 
+func (m *executionManagerImpl) serializeEvent(
+	namespaceID string,
+	event *eventpb.HistoryEvent,
+	encoding common.EncodingType,
+) (*serialization.DataBlob, error) {
+
+	blob, err := m.serializer.SerializeEvent(event, encoding)
+	if err != nil {
+		return nil, err
+	}
+	return m.serializer.EncryptBlob(namespaceID, blob)
+}
+
 func (m *executionManagerImpl) SerializeVersionHistories(
 	versionHistories *VersionHistories,
 	encoding common.EncodingType,
@@ -891,6 +957,16 @@ func (m *executionManagerImpl) Close() {
 	m.persistence.Close()
 }
 
+func getNamespaceID(
+	info *WorkflowExecutionInfo,
+) string {
+
+	if info == nil {
+		return ""
+	}
+	return info.NamespaceID
+}
+
 func getStartVersion(
 	versionHistories *VersionHistories,
 	replicationState *ReplicationState,
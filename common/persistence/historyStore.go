@@ -61,12 +61,13 @@ var _ HistoryManager = (*historyV2ManagerImpl)(nil)
 // NewHistoryV2ManagerImpl returns new HistoryManager
 func NewHistoryV2ManagerImpl(
 	persistence HistoryStore,
+	historySerializer PayloadSerializer,
 	logger log.Logger,
 	transactionSizeLimit dynamicconfig.IntPropertyFn,
 ) HistoryManager {
 
 	return &historyV2ManagerImpl{
-		historySerializer:     NewPayloadSerializer(),
+		historySerializer:     historySerializer,
 		persistence:           persistence,
 		logger:                logger,
 		pagingTokenSerializer: newJSONHistoryTokenSerializer(),
@@ -208,6 +209,15 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(
 			Msg: fmt.Sprintf("transaction size of %v bytes exceeds limit of %v bytes", size, sizeLimit),
 		}
 	}
+	blob, err = m.historySerializer.CompressBlob(blob, request.Compression)
+	if err != nil {
+		return nil, err
+	}
+	storedSize := len(blob.Data)
+	blob, err = m.historySerializer.EncryptBlob(request.NamespaceID, blob)
+	if err != nil {
+		return nil, err
+	}
 	shardID, err := getShardID(request.ShardID)
 	if err != nil {
 		m.logger.Error("shardID is not set in append history nodes operation", tag.Error(err))
@@ -226,7 +236,8 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(
 	err = m.persistence.AppendHistoryNodes(req)
 
 	return &AppendHistoryNodesResponse{
-		Size: size,
+		Size:           size,
+		CompressedSize: storedSize,
 	}, err
 }
 
@@ -272,6 +283,17 @@ func (m *historyV2ManagerImpl) ReadRawHistoryBranch(
 		return nil, err
 	}
 
+	// raw history leaves persistence, so it is returned decrypted and decompressed
+	// for consumers such as replication which only understand the plain encodings
+	for i, dataBlob := range dataBlobs {
+		if dataBlob, err = m.historySerializer.DecryptBlob(dataBlob); err != nil {
+			return nil, err
+		}
+		if dataBlobs[i], err = m.historySerializer.DecompressBlob(dataBlob); err != nil {
+			return nil, err
+		}
+	}
+
 	nextPageToken, err := m.serializeToken(token)
 	if err != nil {
 		return nil, err
@@ -95,6 +95,7 @@ func (m *metadataManagerImpl) UpdateNamespace(request *UpdateNamespaceRequest) e
 		Name:                request.Namespace.Info.Name,
 		Namespace:           &datablob,
 		NotificationVersion: request.NotificationVersion,
+		PreviousName:        request.PreviousName,
 	})
 }
 
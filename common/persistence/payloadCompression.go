@@ -0,0 +1,181 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/golang/snappy"
+
+	"github.com/temporalio/temporal/common"
+	"github.com/temporalio/temporal/common/persistence/serialization"
+)
+
+type (
+	// CompressionType is the algorithm used to compress serialized payloads
+	CompressionType string
+)
+
+const (
+	// CompressionTypeNone leaves payloads uncompressed
+	CompressionTypeNone CompressionType = ""
+	// CompressionTypeSnappy compresses payloads with snappy
+	CompressionTypeSnappy CompressionType = "snappy"
+	// CompressionTypeGzip compresses payloads with gzip
+	CompressionTypeGzip CompressionType = "gzip"
+
+	compressionFormatV1 = byte(1)
+)
+
+// compressionMagic prefixes every compressed payload, it starts with a zero byte so that it can
+// not be mistaken for a JSON or thrift payload
+var compressionMagic = []byte{0x00, 't', 'c', 'm', 'p'}
+
+func (c CompressionType) String() string {
+	return string(c)
+}
+
+// ParseCompressionType validates a compression type read from config, "none" and the
+// empty string both disable compression
+func ParseCompressionType(value string) (CompressionType, error) {
+	switch CompressionType(strings.ToLower(strings.TrimSpace(value))) {
+	case CompressionTypeNone, "none":
+		return CompressionTypeNone, nil
+	case CompressionTypeSnappy:
+		return CompressionTypeSnappy, nil
+	case CompressionTypeGzip:
+		return CompressionTypeGzip, nil
+	default:
+		return CompressionTypeNone, fmt.Errorf("unknown compression type: %v", value)
+	}
+}
+
+func (t *serializerImpl) CompressBlob(data *serialization.DataBlob, compression CompressionType) (*serialization.DataBlob, error) {
+	if data == nil || len(data.Data) == 0 || compression == CompressionTypeNone {
+		return data, nil
+	}
+	if data.Encoding == common.EncodingTypeCompressed || data.Encoding == common.EncodingTypeEncrypted {
+		return data, nil
+	}
+	if len(data.Encoding) > maxHeaderFieldLen {
+		return nil, NewSerializationError(fmt.Sprintf("encoding type %v is too long to be compressed", data.Encoding))
+	}
+
+	var header bytes.Buffer
+	header.Write(compressionMagic)
+	header.WriteByte(compressionFormatV1)
+	header.WriteByte(byte(len(compression)))
+	header.WriteString(string(compression))
+	header.WriteByte(byte(len(data.Encoding)))
+	header.WriteString(string(data.Encoding))
+
+	var compressed []byte
+	switch compression {
+	case CompressionTypeSnappy:
+		compressed = snappy.Encode(nil, data.Data)
+	case CompressionTypeGzip:
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		if _, err := writer.Write(data.Data); err != nil {
+			return nil, NewSerializationError(err.Error())
+		}
+		if err := writer.Close(); err != nil {
+			return nil, NewSerializationError(err.Error())
+		}
+		compressed = buf.Bytes()
+	default:
+		return nil, NewSerializationError(fmt.Sprintf("unknown compression type: %v", compression))
+	}
+
+	// small payloads may not compress, they are stored as is
+	if header.Len()+len(compressed) >= len(data.Data) {
+		return data, nil
+	}
+	return &serialization.DataBlob{
+		Data:     append(header.Bytes(), compressed...),
+		Encoding: common.EncodingTypeCompressed,
+	}, nil
+}
+
+func (t *serializerImpl) DecompressBlob(data *serialization.DataBlob) (*serialization.DataBlob, error) {
+	if data == nil || data.Encoding != common.EncodingTypeCompressed {
+		return data, nil
+	}
+	payload := data.Data
+	if !bytes.HasPrefix(payload, compressionMagic) {
+		return nil, NewDeserializationError("compressed payload is missing the compression header")
+	}
+	pos := len(compressionMagic)
+	readField := func() (string, bool) {
+		if pos >= len(payload) {
+			return "", false
+		}
+		n := int(payload[pos])
+		if pos+1+n > len(payload) {
+			return "", false
+		}
+		field := string(payload[pos+1 : pos+1+n])
+		pos += 1 + n
+		return field, true
+	}
+
+	if pos >= len(payload) || payload[pos] != compressionFormatV1 {
+		return nil, NewDeserializationError("unsupported compressed payload version")
+	}
+	pos++
+	compression, ok := readField()
+	if !ok {
+		return nil, NewDeserializationError("compressed payload has a malformed compression type")
+	}
+	encoding, ok := readField()
+	if !ok {
+		return nil, NewDeserializationError("compressed payload has a malformed encoding type")
+	}
+
+	var decompressed []byte
+	var err error
+	switch CompressionType(compression) {
+	case CompressionTypeSnappy:
+		decompressed, err = snappy.Decode(nil, payload[pos:])
+	case CompressionTypeGzip:
+		var reader *gzip.Reader
+		if reader, err = gzip.NewReader(bytes.NewReader(payload[pos:])); err == nil {
+			decompressed, err = ioutil.ReadAll(reader)
+		}
+	default:
+		return nil, NewDeserializationError(fmt.Sprintf("unknown compression type: %v", compression))
+	}
+	if err != nil {
+		return nil, NewDeserializationError(fmt.Sprintf("unable to decompress payload with %v: %v", compression, err))
+	}
+	return &serialization.DataBlob{
+		Data:     decompressed,
+		Encoding: common.EncodingType(encoding),
+	}, nil
+}
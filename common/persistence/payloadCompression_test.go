@@ -0,0 +1,144 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/temporalio/temporal/common"
+	"github.com/temporalio/temporal/common/persistence/serialization"
+)
+
+type (
+	payloadCompressionSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestPayloadCompressionSuite(t *testing.T) {
+	s := new(payloadCompressionSuite)
+	suite.Run(t, s)
+}
+
+func (s *payloadCompressionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *payloadCompressionSuite) TestCompressBlob() {
+	serializer := NewPayloadSerializer()
+	blob := &serialization.DataBlob{Data: []byte(strings.Repeat("payload", 100)), Encoding: common.EncodingTypeProto3}
+
+	for _, compression := range []CompressionType{CompressionTypeSnappy, CompressionTypeGzip} {
+		compressed, err := serializer.CompressBlob(blob, compression)
+		s.NoError(err)
+		s.Equal(common.EncodingTypeCompressed, compressed.Encoding)
+		s.Equal(common.EncodingTypeCompressed, compressed.GetEncoding())
+		s.True(len(compressed.Data) < len(blob.Data))
+
+		decompressed, err := serializer.DecompressBlob(compressed)
+		s.NoError(err)
+		s.Equal(blob, decompressed)
+	}
+}
+
+func (s *payloadCompressionSuite) TestCompressBlob_Skipped() {
+	serializer := NewPayloadSerializer()
+	blob := &serialization.DataBlob{Data: []byte("payload"), Encoding: common.EncodingTypeJSON}
+
+	uncompressed, err := serializer.CompressBlob(blob, CompressionTypeNone)
+	s.NoError(err)
+	s.Equal(blob, uncompressed)
+
+	// too small to get any smaller
+	uncompressed, err = serializer.CompressBlob(blob, CompressionTypeSnappy)
+	s.NoError(err)
+	s.Equal(blob, uncompressed)
+
+	decompressed, err := serializer.DecompressBlob(blob)
+	s.NoError(err)
+	s.Equal(blob, decompressed)
+
+	_, err = serializer.CompressBlob(blob, CompressionType("lz4"))
+	s.IsType(&SerializationError{}, err)
+}
+
+func (s *payloadCompressionSuite) TestParseCompressionType() {
+	for value, expected := range map[string]CompressionType{
+		"":       CompressionTypeNone,
+		"none":   CompressionTypeNone,
+		"snappy": CompressionTypeSnappy,
+		" GZIP ": CompressionTypeGzip,
+		"gzip":   CompressionTypeGzip,
+	} {
+		compression, err := ParseCompressionType(value)
+		s.NoError(err)
+		s.Equal(expected, compression)
+	}
+
+	compression, err := ParseCompressionType("zstd")
+	s.Error(err)
+	s.Equal(CompressionTypeNone, compression)
+}
+
+func (s *payloadCompressionSuite) TestDecompressBlob_Invalid() {
+	serializer := NewPayloadSerializer()
+	blob := &serialization.DataBlob{Data: []byte(strings.Repeat("payload", 100)), Encoding: common.EncodingTypeProto3}
+	compressed, err := serializer.CompressBlob(blob, CompressionTypeSnappy)
+	s.NoError(err)
+
+	_, err = serializer.DecompressBlob(&serialization.DataBlob{Data: compressed.Data[:len(compressed.Data)-5], Encoding: common.EncodingTypeCompressed})
+	s.IsType(&DeserializationError{}, err)
+
+	_, err = serializer.DecompressBlob(&serialization.DataBlob{Data: compressed.Data[:8], Encoding: common.EncodingTypeCompressed})
+	s.IsType(&DeserializationError{}, err)
+
+	_, err = serializer.DecompressBlob(&serialization.DataBlob{Data: blob.Data, Encoding: common.EncodingTypeCompressed})
+	s.IsType(&DeserializationError{}, err)
+}
+
+func (s *payloadCompressionSuite) TestCompressAndEncryptBlob() {
+	serializer := &serializerImpl{keyProvider: &fileKeyProvider{
+		defaultKeyID: "key1",
+		keys:         map[string][]byte{"key1": make([]byte, encryptionKeySize)},
+	}}
+	blob := &serialization.DataBlob{Data: []byte(strings.Repeat("payload", 100)), Encoding: common.EncodingTypeProto3}
+
+	compressed, err := serializer.CompressBlob(blob, CompressionTypeGzip)
+	s.NoError(err)
+	encrypted, err := serializer.EncryptBlob("namespace1", compressed)
+	s.NoError(err)
+	s.Equal(common.EncodingTypeEncrypted, encrypted.Encoding)
+
+	decoded, err := serializer.decodeBlob(encrypted)
+	s.NoError(err)
+	s.Equal(blob, decoded)
+}
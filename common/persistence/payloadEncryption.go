@@ -0,0 +1,327 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/temporalio/temporal/common"
+	"github.com/temporalio/temporal/common/persistence/serialization"
+	"github.com/temporalio/temporal/common/service/config"
+)
+
+type (
+	// EncryptionKeyProvider selects and loads the keys used to encrypt payloads at rest.
+	// Keys are identified by a key ID which is stored next to every encrypted payload, so
+	// rotating a key is done by adding a new key ID and switching the namespaces to it while
+	// keeping the old keys around until the payloads written with them are gone
+	EncryptionKeyProvider interface {
+		// GetKeyID returns the ID of the key new payloads of the namespace are encrypted with,
+		// an empty key ID leaves the payloads of the namespace unencrypted
+		GetKeyID(namespaceID string) string
+		// GetKey returns the AES-256 key for the key ID
+		GetKey(keyID string) ([]byte, error)
+	}
+
+	fileKeyProvider struct {
+		defaultKeyID    string
+		namespaceKeyIDs map[string]string
+		keys            map[string][]byte
+	}
+
+	// encryptionKeyFile is the content of a local keyfile, keys are base64 encoded
+	encryptionKeyFile struct {
+		Keys map[string]string `yaml:"keys"`
+	}
+)
+
+const (
+	// EncryptionKeyProviderFile is the key provider which reads keys from a local keyfile
+	EncryptionKeyProviderFile = "file"
+
+	encryptionKeySize   = 32
+	encryptionNonceSize = 12
+	encryptionFormatV1  = byte(1)
+	maxHeaderFieldLen   = 255
+)
+
+// encryptionMagic prefixes every encrypted payload, it starts with a zero byte so that it can
+// not be mistaken for a JSON or thrift payload
+var encryptionMagic = []byte{0x00, 't', 'e', 'n', 'c'}
+
+// NewEncryptionKeyProvider returns the key provider for the encryption config,
+// nil is returned when no key provider is configured
+func NewEncryptionKeyProvider(cfg *config.Encryption) (EncryptionKeyProvider, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	switch cfg.Provider {
+	case "", EncryptionKeyProviderFile:
+		if cfg.KeyFile == "" {
+			return nil, fmt.Errorf("encryption key provider %q requires a keyFile", EncryptionKeyProviderFile)
+		}
+		return NewFileEncryptionKeyProvider(cfg.KeyFile, cfg.DefaultKeyID, cfg.NamespaceKeyIDs)
+	default:
+		return nil, fmt.Errorf("unknown encryption key provider: %v", cfg.Provider)
+	}
+}
+
+// NewPayloadSerializerWithEncryption returns the PayloadSerializer for the encryption config,
+// every component reading or writing payloads of a cluster must use the same serializer
+func NewPayloadSerializerWithEncryption(cfg *config.Encryption) (PayloadSerializer, error) {
+	keyProvider, err := NewEncryptionKeyProvider(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return NewEncryptingPayloadSerializer(keyProvider), nil
+}
+
+// NewFileEncryptionKeyProvider returns a key provider which reads the keys from a local
+// yaml keyfile. An empty defaultKeyID leaves namespaces without an entry in namespaceKeyIDs
+// unencrypted, the keys of the keyfile can still be used to read their payloads
+func NewFileEncryptionKeyProvider(
+	keyFile string,
+	defaultKeyID string,
+	namespaceKeyIDs map[string]string,
+) (EncryptionKeyProvider, error) {
+
+	content, err := ioutil.ReadFile(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read encryption keyfile: %v", err)
+	}
+	var file encryptionKeyFile
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("unable to parse encryption keyfile: %v", err)
+	}
+
+	provider := &fileKeyProvider{
+		defaultKeyID:    defaultKeyID,
+		namespaceKeyIDs: namespaceKeyIDs,
+		keys:            make(map[string][]byte, len(file.Keys)),
+	}
+	for keyID, encoded := range file.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("encryption key %v is not base64 encoded: %v", keyID, err)
+		}
+		if len(key) != encryptionKeySize {
+			return nil, fmt.Errorf("encryption key %v must be %v bytes long, got %v", keyID, encryptionKeySize, len(key))
+		}
+		if len(keyID) > maxHeaderFieldLen {
+			return nil, fmt.Errorf("encryption key ID %v is longer than %v bytes", keyID, maxHeaderFieldLen)
+		}
+		provider.keys[keyID] = key
+	}
+
+	keyIDs := []string{defaultKeyID}
+	for _, keyID := range namespaceKeyIDs {
+		keyIDs = append(keyIDs, keyID)
+	}
+	for _, keyID := range keyIDs {
+		if _, ok := provider.keys[keyID]; keyID != "" && !ok {
+			return nil, fmt.Errorf("encryption key %v is not in keyfile %v", keyID, keyFile)
+		}
+	}
+	return provider, nil
+}
+
+func (p *fileKeyProvider) GetKeyID(namespaceID string) string {
+	if keyID, ok := p.namespaceKeyIDs[namespaceID]; ok {
+		return keyID
+	}
+	return p.defaultKeyID
+}
+
+func (p *fileKeyProvider) GetKey(keyID string) ([]byte, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown encryption key: %v", keyID)
+	}
+	return key, nil
+}
+
+func (t *serializerImpl) EncryptBlob(namespaceID string, data *serialization.DataBlob) (*serialization.DataBlob, error) {
+	if data == nil || len(data.Data) == 0 || data.Encoding == common.EncodingTypeEncrypted {
+		return data, nil
+	}
+	keyID := t.getKeyID(namespaceID)
+	if keyID == "" {
+		return data, nil
+	}
+	encrypted, err := t.encrypt(keyID, data.Encoding, data.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &serialization.DataBlob{
+		Data:     encrypted,
+		Encoding: common.EncodingTypeEncrypted,
+	}, nil
+}
+
+func (t *serializerImpl) DecryptBlob(data *serialization.DataBlob) (*serialization.DataBlob, error) {
+	if data == nil || data.Encoding != common.EncodingTypeEncrypted {
+		return data, nil
+	}
+	encoding, decrypted, err := t.decrypt(data.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &serialization.DataBlob{
+		Data:     decrypted,
+		Encoding: encoding,
+	}, nil
+}
+
+func (t *serializerImpl) EncryptData(namespaceID string, data []byte) ([]byte, error) {
+	if len(data) == 0 || isEncrypted(data) {
+		return data, nil
+	}
+	keyID := t.getKeyID(namespaceID)
+	if keyID == "" {
+		return data, nil
+	}
+	return t.encrypt(keyID, common.EncodingTypeEmpty, data)
+}
+
+func (t *serializerImpl) DecryptData(data []byte) ([]byte, error) {
+	if !isEncrypted(data) {
+		return data, nil
+	}
+	_, decrypted, err := t.decrypt(data)
+	return decrypted, err
+}
+
+func (t *serializerImpl) getKeyID(namespaceID string) string {
+	if t.keyProvider == nil {
+		return ""
+	}
+	return t.keyProvider.GetKeyID(namespaceID)
+}
+
+// encrypt seals the payload with AES-256-GCM, the result is laid out as
+// magic | version | len(keyID) | keyID | len(encoding) | encoding | nonce | ciphertext
+// and the header up to the nonce is authenticated along with the payload
+func (t *serializerImpl) encrypt(keyID string, encoding common.EncodingType, data []byte) ([]byte, error) {
+	if len(encoding) > maxHeaderFieldLen {
+		return nil, NewSerializationError(fmt.Sprintf("encoding type %v is too long to be encrypted", encoding))
+	}
+	aead, err := t.getCipher(keyID)
+	if err != nil {
+		return nil, NewSerializationError(err.Error())
+	}
+
+	var header bytes.Buffer
+	header.Write(encryptionMagic)
+	header.WriteByte(encryptionFormatV1)
+	header.WriteByte(byte(len(keyID)))
+	header.WriteString(keyID)
+	header.WriteByte(byte(len(encoding)))
+	header.WriteString(string(encoding))
+
+	nonce := make([]byte, encryptionNonceSize)
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, NewSerializationError(err.Error())
+	}
+
+	result := make([]byte, 0, header.Len()+len(nonce)+len(data)+aead.Overhead())
+	result = append(result, header.Bytes()...)
+	result = append(result, nonce...)
+	return aead.Seal(result, nonce, data, header.Bytes()), nil
+}
+
+func (t *serializerImpl) decrypt(data []byte) (common.EncodingType, []byte, error) {
+	if !isEncrypted(data) {
+		return "", nil, NewDeserializationError("encrypted payload is missing the encryption header")
+	}
+	pos := len(encryptionMagic)
+	readField := func() (string, bool) {
+		if pos >= len(data) {
+			return "", false
+		}
+		n := int(data[pos])
+		if pos+1+n > len(data) {
+			return "", false
+		}
+		field := string(data[pos+1 : pos+1+n])
+		pos += 1 + n
+		return field, true
+	}
+
+	if pos >= len(data) || data[pos] != encryptionFormatV1 {
+		return "", nil, NewDeserializationError("unsupported encrypted payload version")
+	}
+	pos++
+	keyID, ok := readField()
+	if !ok {
+		return "", nil, NewDeserializationError("encrypted payload has a malformed key ID")
+	}
+	encoding, ok := readField()
+	if !ok {
+		return "", nil, NewDeserializationError("encrypted payload has a malformed encoding type")
+	}
+	if pos+encryptionNonceSize > len(data) {
+		return "", nil, NewDeserializationError("encrypted payload is truncated")
+	}
+
+	aead, err := t.getCipher(keyID)
+	if err != nil {
+		return "", nil, NewDeserializationError(err.Error())
+	}
+	header := data[:pos]
+	nonce := data[pos : pos+encryptionNonceSize]
+	decrypted, err := aead.Open(nil, nonce, data[pos+encryptionNonceSize:], header)
+	if err != nil {
+		return "", nil, NewDeserializationError(fmt.Sprintf("unable to decrypt payload with key %v: %v", keyID, err))
+	}
+	return common.EncodingType(encoding), decrypted, nil
+}
+
+func (t *serializerImpl) getCipher(keyID string) (cipher.AEAD, error) {
+	if t.keyProvider == nil {
+		return nil, fmt.Errorf("no encryption key provider is configured to use key %v", keyID)
+	}
+	key, err := t.keyProvider.GetKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func isEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, encryptionMagic)
+}
@@ -0,0 +1,227 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/temporalio/temporal/common"
+	"github.com/temporalio/temporal/common/persistence/serialization"
+	"github.com/temporalio/temporal/common/service/config"
+)
+
+type (
+	payloadEncryptionSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+		keyDir  string
+		keyFile string
+	}
+)
+
+func TestPayloadEncryptionSuite(t *testing.T) {
+	s := new(payloadEncryptionSuite)
+	suite.Run(t, s)
+}
+
+func (s *payloadEncryptionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	var err error
+	s.keyDir, err = ioutil.TempDir("", "payloadEncryptionSuite")
+	s.NoError(err)
+	s.keyFile = s.writeKeyFile("key1", "key2")
+}
+
+func (s *payloadEncryptionSuite) TearDownTest() {
+	s.NoError(os.RemoveAll(s.keyDir))
+}
+
+func (s *payloadEncryptionSuite) TestEncryptBlob() {
+	serializer := s.newSerializer("key1", map[string]string{"namespace2": "key2", "namespace3": ""})
+	blob := &serialization.DataBlob{Data: []byte("payload"), Encoding: common.EncodingTypeProto3}
+
+	encrypted, err := serializer.EncryptBlob("namespace1", blob)
+	s.NoError(err)
+	s.Equal(common.EncodingTypeEncrypted, encrypted.Encoding)
+	s.Equal(common.EncodingTypeEncrypted, encrypted.GetEncoding())
+	s.NotContains(string(encrypted.Data), "payload")
+	s.Contains(string(encrypted.Data), "key1")
+
+	decrypted, err := serializer.DecryptBlob(encrypted)
+	s.NoError(err)
+	s.Equal(blob, decrypted)
+
+	encrypted, err = serializer.EncryptBlob("namespace2", blob)
+	s.NoError(err)
+	s.Contains(string(encrypted.Data), "key2")
+	decrypted, err = serializer.DecryptBlob(encrypted)
+	s.NoError(err)
+	s.Equal(blob, decrypted)
+
+	unencrypted, err := serializer.EncryptBlob("namespace3", blob)
+	s.NoError(err)
+	s.Equal(blob, unencrypted)
+	decrypted, err = serializer.DecryptBlob(unencrypted)
+	s.NoError(err)
+	s.Equal(blob, decrypted)
+}
+
+func (s *payloadEncryptionSuite) TestEncryptData() {
+	serializer := s.newSerializer("key1", nil)
+
+	encrypted, err := serializer.EncryptData("namespace1", []byte("details"))
+	s.NoError(err)
+	s.NotContains(string(encrypted), "details")
+
+	decrypted, err := serializer.DecryptData(encrypted)
+	s.NoError(err)
+	s.Equal([]byte("details"), decrypted)
+
+	decrypted, err = serializer.DecryptData([]byte("details"))
+	s.NoError(err)
+	s.Equal([]byte("details"), decrypted)
+
+	empty, err := serializer.EncryptData("namespace1", nil)
+	s.NoError(err)
+	s.Nil(empty)
+}
+
+func (s *payloadEncryptionSuite) TestKeyRotation() {
+	blob := &serialization.DataBlob{Data: []byte("payload"), Encoding: common.EncodingTypeJSON}
+	encrypted, err := s.newSerializer("key1", nil).EncryptBlob("namespace1", blob)
+	s.NoError(err)
+
+	rotated := s.newSerializer("key2", nil)
+	decrypted, err := rotated.DecryptBlob(encrypted)
+	s.NoError(err)
+	s.Equal(blob, decrypted)
+
+	reencrypted, err := rotated.EncryptBlob("namespace1", blob)
+	s.NoError(err)
+	s.Contains(string(reencrypted.Data), "key2")
+
+	// payloads written with a key which was removed from the keyfile can not be read anymore
+	s.keyFile = s.writeKeyFile("key2")
+	_, err = s.newSerializer("key2", nil).DecryptBlob(encrypted)
+	s.Error(err)
+}
+
+func (s *payloadEncryptionSuite) TestDecryptBlob_Invalid() {
+	serializer := s.newSerializer("key1", nil)
+	blob := &serialization.DataBlob{Data: []byte("payload"), Encoding: common.EncodingTypeProto3}
+	encrypted, err := serializer.EncryptBlob("namespace1", blob)
+	s.NoError(err)
+
+	tampered := append([]byte{}, encrypted.Data...)
+	tampered[len(tampered)-1] ^= 0xff
+	_, err = serializer.DecryptBlob(&serialization.DataBlob{Data: tampered, Encoding: common.EncodingTypeEncrypted})
+	s.IsType(&DeserializationError{}, err)
+
+	_, err = serializer.DecryptBlob(&serialization.DataBlob{Data: encrypted.Data[:10], Encoding: common.EncodingTypeEncrypted})
+	s.IsType(&DeserializationError{}, err)
+
+	_, err = serializer.DecryptBlob(&serialization.DataBlob{Data: []byte("payload"), Encoding: common.EncodingTypeEncrypted})
+	s.IsType(&DeserializationError{}, err)
+
+	_, err = NewPayloadSerializer().DecryptBlob(encrypted)
+	s.IsType(&DeserializationError{}, err)
+}
+
+func (s *payloadEncryptionSuite) TestNewEncryptionKeyProvider() {
+	provider, err := NewEncryptionKeyProvider(nil)
+	s.NoError(err)
+	s.Nil(provider)
+
+	provider, err = NewEncryptionKeyProvider(&config.Encryption{KeyFile: s.keyFile, DefaultKeyID: "key1"})
+	s.NoError(err)
+	s.Equal("key1", provider.GetKeyID("namespace1"))
+
+	_, err = NewEncryptionKeyProvider(&config.Encryption{})
+	s.Error(err)
+
+	_, err = NewEncryptionKeyProvider(&config.Encryption{Provider: "vault", KeyFile: s.keyFile})
+	s.Error(err)
+
+	_, err = NewEncryptionKeyProvider(&config.Encryption{KeyFile: s.keyFile, DefaultKeyID: "key3"})
+	s.Error(err)
+
+	_, err = NewEncryptionKeyProvider(&config.Encryption{KeyFile: s.keyFile, NamespaceKeyIDs: map[string]string{"namespace1": "key3"}})
+	s.Error(err)
+
+	invalidKeyFile := filepath.Join(s.keyDir, "invalid.yaml")
+	s.NoError(ioutil.WriteFile(invalidKeyFile, []byte("keys:\n  key1: c2hvcnQ=\n"), 0600))
+	_, err = NewEncryptionKeyProvider(&config.Encryption{KeyFile: invalidKeyFile})
+	s.Error(err)
+}
+
+func (s *payloadEncryptionSuite) TestNewPayloadSerializerWithEncryption() {
+	blob := &serialization.DataBlob{Data: []byte("payload"), Encoding: common.EncodingTypeJSON}
+	serializer, err := NewPayloadSerializerWithEncryption(&config.Encryption{KeyFile: s.keyFile, DefaultKeyID: "key1"})
+	s.NoError(err)
+	encrypted, err := serializer.EncryptBlob("namespace1", blob)
+	s.NoError(err)
+	s.Equal(common.EncodingTypeEncrypted, encrypted.Encoding)
+
+	// a serializer built from the same config reads what the other one wrote
+	other, err := NewPayloadSerializerWithEncryption(&config.Encryption{KeyFile: s.keyFile, DefaultKeyID: "key1"})
+	s.NoError(err)
+	decrypted, err := other.DecryptBlob(encrypted)
+	s.NoError(err)
+	s.Equal(blob, decrypted)
+
+	_, err = NewPayloadSerializerWithEncryption(&config.Encryption{})
+	s.Error(err)
+}
+
+func (s *payloadEncryptionSuite) newSerializer(defaultKeyID string, namespaceKeyIDs map[string]string) PayloadSerializer {
+	provider, err := NewFileEncryptionKeyProvider(s.keyFile, defaultKeyID, namespaceKeyIDs)
+	s.NoError(err)
+	return NewEncryptingPayloadSerializer(provider)
+}
+
+func (s *payloadEncryptionSuite) writeKeyFile(keyIDs ...string) string {
+	content := "keys:\n"
+	for _, keyID := range keyIDs {
+		key := make([]byte, encryptionKeySize)
+		_, err := rand.Read(key)
+		s.NoError(err)
+		content += fmt.Sprintf("  %v: %v\n", keyID, base64.StdEncoding.EncodeToString(key))
+	}
+	keyFile := filepath.Join(s.keyDir, "keys.yaml")
+	s.NoError(ioutil.WriteFile(keyFile, []byte(content), 0600))
+	return keyFile
+}
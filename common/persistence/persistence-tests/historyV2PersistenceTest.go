@@ -28,6 +28,7 @@ import (
 	"math/rand"
 	"os"
 	"reflect"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -176,6 +177,62 @@ func (s *HistoryV2PersistenceSuite) TestScanAllTrees() {
 	s.Equal(0, len(trees))
 }
 
+// TestCompressedHistory test
+func (s *HistoryV2PersistenceSuite) TestCompressedHistory() {
+	treeID := uuid.NewRandom()
+	bi, err := s.newHistoryBranch(treeID)
+	s.Nil(err)
+
+	compressions := []p.CompressionType{p.CompressionTypeNone, p.CompressionTypeSnappy, p.CompressionTypeGzip}
+	var events []*eventpb.HistoryEvent
+	for i, compression := range compressions {
+		eventID := int64(i + 1)
+		batch := s.genRandomEvents([]int64{eventID}, 1)
+		batch[0].Attributes = &eventpb.HistoryEvent_WorkflowExecutionSignaledEventAttributes{WorkflowExecutionSignaledEventAttributes: &eventpb.WorkflowExecutionSignaledEventAttributes{
+			SignalName: strings.Repeat("signal", 100),
+		}}
+		resp, err := s.HistoryV2Mgr.AppendHistoryNodes(&p.AppendHistoryNodesRequest{
+			IsNewBranch:   i == 0,
+			Info:          "branchInfo",
+			BranchToken:   bi,
+			Events:        batch,
+			TransactionID: eventID,
+			Encoding:      common.EncodingTypeProto3,
+			Compression:   compression,
+			ShardID:       convert.IntPtr(int(s.ShardInfo.GetShardId())),
+		})
+		s.Nil(err)
+		if compression == p.CompressionTypeNone {
+			s.Equal(resp.Size, resp.CompressedSize)
+		} else {
+			s.True(resp.CompressedSize < resp.Size)
+		}
+		events = append(events, batch...)
+	}
+
+	s.Equal(events, s.read(bi, 1, int64(len(compressions)+1)))
+
+	rawResp, err := s.HistoryV2Mgr.ReadRawHistoryBranch(&p.ReadHistoryBranchRequest{
+		BranchToken: bi,
+		MinEventID:  1,
+		MaxEventID:  int64(len(compressions) + 1),
+		PageSize:    len(compressions),
+		ShardID:     convert.IntPtr(int(s.ShardInfo.GetShardId())),
+	})
+	s.Nil(err)
+	s.Equal(len(compressions), len(rawResp.HistoryEventBlobs))
+	serializer := p.NewPayloadSerializer()
+	for i, blob := range rawResp.HistoryEventBlobs {
+		s.Equal(common.EncodingTypeProto3, blob.Encoding)
+		batch, err := serializer.DeserializeBatchEvents(blob)
+		s.Nil(err)
+		s.Equal(events[i:i+1], batch)
+	}
+
+	err = s.deleteHistoryBranch(bi)
+	s.Nil(err)
+}
+
 // TestReadBranchByPagination test
 func (s *HistoryV2PersistenceSuite) TestReadBranchByPagination() {
 	treeID := uuid.NewRandom()
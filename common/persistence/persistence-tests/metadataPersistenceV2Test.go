@@ -41,6 +41,7 @@ import (
 	namespacepb "go.temporal.io/temporal-proto/namespace"
 	"go.temporal.io/temporal-proto/serviceerror"
 
+	namespacegenpb "github.com/temporalio/temporal/.gen/proto/namespace"
 	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
 	"github.com/temporalio/temporal/common/cluster"
 	p "github.com/temporalio/temporal/common/persistence"
@@ -913,6 +914,103 @@ func (m *MetadataPersistenceSuiteV2) TestDeleteNamespace() {
 }
 
 // TestListNamespaces test
+func (m *MetadataPersistenceSuiteV2) TestRenameNamespace() {
+	id := primitives.UUID(uuid.NewRandom())
+	name := "rename-namespace-test-name"
+	newName := "rename-namespace-test-new-name"
+	otherName := "rename-namespace-test-other-name"
+	isGlobalNamespace := true
+
+	info := &persistenceblobs.NamespaceInfo{
+		Id:          id,
+		Name:        name,
+		Status:      namespacepb.NamespaceStatus_Registered,
+		Description: "rename-namespace-test-description",
+		Owner:       "rename-namespace-test-owner",
+		Data:        map[string]string{"k1": "v1"},
+	}
+	config := &persistenceblobs.NamespaceConfig{
+		RetentionDays:            10,
+		EmitMetric:               true,
+		HistoryArchivalStatus:    namespacepb.ArchivalStatus_Disabled,
+		VisibilityArchivalStatus: namespacepb.ArchivalStatus_Disabled,
+	}
+	replicationConfig := &persistenceblobs.NamespaceReplicationConfig{
+		ActiveClusterName: cluster.TestCurrentClusterName,
+		Clusters:          []string{cluster.TestCurrentClusterName},
+	}
+
+	_, err := m.CreateNamespace(info, config, replicationConfig, isGlobalNamespace, 0, 0)
+	m.NoError(err)
+	_, err = m.CreateNamespace(
+		&persistenceblobs.NamespaceInfo{
+			Id:     primitives.UUID(uuid.NewRandom()),
+			Name:   otherName,
+			Status: namespacepb.NamespaceStatus_Registered,
+		},
+		config,
+		replicationConfig,
+		isGlobalNamespace,
+		0,
+		0,
+	)
+	m.NoError(err)
+
+	metadata, err := m.MetadataManager.GetMetadata()
+	m.NoError(err)
+	info.Name = newName
+	info.Aliases = []*namespacegenpb.NamespaceAlias{{Name: name, ExpireTimeNanos: time.Now().Add(time.Hour).UnixNano()}}
+	err = m.MetadataManager.UpdateNamespace(&p.UpdateNamespaceRequest{
+		Namespace: &persistenceblobs.NamespaceDetail{
+			Info:              info,
+			Config:            config,
+			ReplicationConfig: replicationConfig,
+			ConfigVersion:     1,
+		},
+		NotificationVersion: metadata.NotificationVersion,
+		PreviousName:        name,
+	})
+	m.NoError(err)
+
+	resp, err := m.GetNamespace(nil, name)
+	m.Error(err)
+	m.IsType(&serviceerror.NotFound{}, err)
+	m.Nil(resp)
+
+	resp, err = m.GetNamespace(nil, newName)
+	m.NoError(err)
+	m.EqualValues(id, resp.Namespace.Info.Id)
+	m.Equal(newName, resp.Namespace.Info.Name)
+	m.Equal(info.Aliases, resp.Namespace.Info.Aliases)
+	m.Equal(isGlobalNamespace, resp.IsGlobalNamespace)
+	m.Equal(int64(1), resp.Namespace.ConfigVersion)
+	m.Equal(metadata.NotificationVersion, resp.NotificationVersion)
+
+	resp, err = m.GetNamespace(id, "")
+	m.NoError(err)
+	m.Equal(newName, resp.Namespace.Info.Name)
+
+	metadata, err = m.MetadataManager.GetMetadata()
+	m.NoError(err)
+	info.Name = otherName
+	err = m.MetadataManager.UpdateNamespace(&p.UpdateNamespaceRequest{
+		Namespace: &persistenceblobs.NamespaceDetail{
+			Info:              info,
+			Config:            config,
+			ReplicationConfig: replicationConfig,
+			ConfigVersion:     2,
+		},
+		NotificationVersion: metadata.NotificationVersion,
+		PreviousName:        newName,
+	})
+	m.Error(err)
+	m.IsType(&serviceerror.NamespaceAlreadyExists{}, err)
+
+	resp, err = m.GetNamespace(id, "")
+	m.NoError(err)
+	m.Equal(newName, resp.Namespace.Info.Name)
+}
+
 func (m *MetadataPersistenceSuiteV2) TestListNamespaces() {
 	clusterActive1 := "some random active cluster name"
 	clusterStandby1 := "some random standby cluster name"
@@ -26,6 +26,7 @@ package persistencetests
 
 import (
 	"os"
+	"strconv"
 	"testing"
 	"time"
 
@@ -674,8 +675,89 @@ func (s *VisibilityPersistenceSuite) TestDelete() {
 	}
 }
 
+// TestListWorkflowExecutionsByQuery test
+func (s *VisibilityPersistenceSuite) TestListWorkflowExecutionsByQuery() {
+	if s.VisibilityMgr.GetName() == "cassandra" {
+		s.T().Skip("this test is not applicable for cassandra")
+	}
+	testNamespaceUUID := uuid.New()
+	startTime := time.Now().Add(time.Second * -5).UnixNano()
+	nRows := 4
+	for i := 0; i < nRows; i++ {
+		startReq := &p.RecordWorkflowExecutionStartedRequest{
+			NamespaceID:      testNamespaceUUID,
+			Execution:        executionpb.WorkflowExecution{WorkflowId: uuid.New(), RunId: uuid.New()},
+			WorkflowTypeName: "visibility-workflow",
+			StartTimestamp:   startTime + int64(i),
+			TaskList:         "visibility-tasklist",
+			SearchAttributes: map[string][]byte{
+				definition.CustomIntField:     []byte(strconv.Itoa(i)),
+				definition.CustomKeywordField: []byte(`["all","` + strconv.Itoa(i%2) + `"]`),
+			},
+		}
+		s.Nil(s.VisibilityMgr.RecordWorkflowExecutionStarted(startReq))
+		if i%2 == 0 {
+			s.Nil(s.VisibilityMgr.RecordWorkflowExecutionClosed(&p.RecordWorkflowExecutionClosedRequest{
+				NamespaceID:      testNamespaceUUID,
+				Execution:        startReq.Execution,
+				WorkflowTypeName: startReq.WorkflowTypeName,
+				StartTimestamp:   startReq.StartTimestamp,
+				TaskList:         startReq.TaskList,
+				SearchAttributes: startReq.SearchAttributes,
+				Status:           executionpb.WorkflowExecutionStatus_Completed,
+				CloseTimestamp:   time.Now().UnixNano(),
+				HistoryLength:    3,
+			}))
+		}
+	}
+
+	resp, err := s.VisibilityMgr.ListWorkflowExecutions(&p.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceUUID,
+		PageSize:    10,
+		Query:       "ExecutionStatus = 'Running' and CustomKeywordField = 'all' order by CustomIntField desc",
+	})
+	s.Nil(err)
+	s.Equal(2, len(resp.Executions))
+	s.Equal([]byte("3"), resp.Executions[0].GetSearchAttributes().GetIndexedFields()[definition.CustomIntField])
+	s.Equal([]byte("1"), resp.Executions[1].GetSearchAttributes().GetIndexedFields()[definition.CustomIntField])
+	s.Equal("visibility-tasklist", resp.Executions[0].GetTaskList())
+
+	var pages int
+	var nextPageToken []byte
+	for pages == 0 || len(nextPageToken) > 0 {
+		resp, err = s.VisibilityMgr.ListWorkflowExecutions(&p.ListWorkflowExecutionsRequestV2{
+			NamespaceID:   testNamespaceUUID,
+			PageSize:      1,
+			NextPageToken: nextPageToken,
+			Query:         "CustomIntField >= 1 and CustomIntField < 3",
+		})
+		s.Nil(err)
+		nextPageToken = resp.NextPageToken
+		pages++
+	}
+	s.Equal(3, pages) // the last page is empty since the page size is reached on the second page
+
+	countResp, err := s.VisibilityMgr.CountWorkflowExecutions(&p.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceUUID,
+		Query:       "CloseTime != missing and WorkflowType = 'visibility-workflow'",
+	})
+	s.Nil(err)
+	s.Equal(int64(2), countResp.Count)
+
+	_, err = s.VisibilityMgr.CountWorkflowExecutions(&p.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceUUID,
+		Query:       "CustomIntField like '1%'",
+	})
+	s.IsType(&serviceerror.InvalidArgument{}, err)
+}
+
 // TestUpsertWorkflowExecution test
 func (s *VisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
+	// SQL visibility stores search attributes, upserts are only a no-op on Cassandra
+	var notSupportedErr error
+	if s.VisibilityMgr.GetName() == "cassandra" {
+		notSupportedErr = p.NewOperationNotSupportErrorForVis()
+	}
 	tests := []struct {
 		request  *p.UpsertWorkflowExecutionRequest
 		expected error
@@ -692,7 +774,7 @@ func (s *VisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 				TaskID:             0,
 				Memo:               nil,
 				SearchAttributes: map[string][]byte{
-					definition.TemporalChangeVersion: []byte("dummy"),
+					definition.TemporalChangeVersion: []byte(`"dummy"`),
 				},
 			},
 			expected: nil,
@@ -710,7 +792,7 @@ func (s *VisibilityPersistenceSuite) TestUpsertWorkflowExecution() {
 				Memo:               nil,
 				SearchAttributes:   nil,
 			},
-			expected: p.NewOperationNotSupportErrorForVis(),
+			expected: notSupportedErr,
 		},
 	}
 
@@ -33,6 +33,7 @@ import (
 	"github.com/temporalio/temporal/common/primitives"
 	"github.com/temporalio/temporal/common/primitives/timestamp"
 
+	eventgenpb "github.com/temporalio/temporal/.gen/proto/event"
 	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
 	"github.com/temporalio/temporal/common/persistence/serialization"
 
@@ -261,6 +262,20 @@ type (
 		ExpirationSeconds  int32
 		Memo               map[string][]byte
 		SearchAttributes   map[string][]byte
+		Paused             bool
+		PauseReason        string
+		PauseIdentity      string
+		CronSkipUntil      time.Time
+		SyncStateVersion   int64
+		// UpdatedSearchAttributes and UpdatedMemo are set by UpdateWorkflowAttributes instead of history events
+		UpdatedSearchAttributes map[string][]byte
+		UpdatedMemo             map[string][]byte
+		// UpdateResults maps the ids of recent updates to their results
+		UpdateResults map[string]*eventgenpb.WorkflowUpdateResult
+		// SignalRequestIDTimestamps maps request ids of recent signals to the time they were accepted
+		SignalRequestIDTimestamps map[string]time.Time
+		Priority                  int32
+		WorkerBuildID             string
 
 		// attributes which are not related to mutable state at all
 		HistorySize int64
@@ -319,6 +334,10 @@ type (
 		LastFailureReason  string
 		LastWorkerIdentity string
 		LastFailureDetails []byte
+		Priority           int32
+		// For the last update of the activity options by an operator
+		OptionsUpdateIdentity string
+		OptionsUpdateTime     time.Time
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibilityInSeconds int64
 	}
@@ -655,6 +674,7 @@ type (
 		Name                string
 		Namespace           *serialization.DataBlob
 		NotificationVersion int64
+		PreviousName        string
 	}
 
 	// InternalListNamespacesResponse is the response for GetNamespace
@@ -784,12 +804,32 @@ func InternalWorkflowExecutionInfoToProto(executionInfo *InternalWorkflowExecuti
 		AutoResetPointsEncoding:                 executionInfo.AutoResetPoints.GetEncoding().String(),
 		SearchAttributes:                        executionInfo.SearchAttributes,
 		Memo:                                    executionInfo.Memo,
+		Paused:                                  executionInfo.Paused,
+		PauseReason:                             executionInfo.PauseReason,
+		PauseIdentity:                           executionInfo.PauseIdentity,
+		SyncStateVersion:                        executionInfo.SyncStateVersion,
+		UpdatedSearchAttributes:                 executionInfo.UpdatedSearchAttributes,
+		UpdatedMemo:                             executionInfo.UpdatedMemo,
+		UpdateResults:                           executionInfo.UpdateResults,
+		Priority:                                executionInfo.Priority,
+		WorkerBuildId:                           executionInfo.WorkerBuildID,
 	}
 
 	if !executionInfo.ExpirationTime.IsZero() {
 		info.RetryExpirationTimeNanos = executionInfo.ExpirationTime.UnixNano()
 	}
 
+	if !executionInfo.CronSkipUntil.IsZero() {
+		info.CronSkipUntilNanos = executionInfo.CronSkipUntil.UnixNano()
+	}
+
+	if len(executionInfo.SignalRequestIDTimestamps) > 0 {
+		info.SignalRequestIdTimestamps = make(map[string]int64, len(executionInfo.SignalRequestIDTimestamps))
+		for requestID, timestamp := range executionInfo.SignalRequestIDTimestamps {
+			info.SignalRequestIdTimestamps[requestID] = timestamp.UnixNano()
+		}
+	}
+
 	completionEvent := executionInfo.CompletionEvent
 	if completionEvent != nil {
 		info.CompletionEvent = completionEvent.Data
@@ -872,12 +912,32 @@ func ProtoWorkflowExecutionToPartialInternalExecution(info *persistenceblobs.Wor
 		NonRetriableErrors:                 info.GetRetryNonRetryableErrors(),
 		SearchAttributes:                   info.GetSearchAttributes(),
 		Memo:                               info.GetMemo(),
+		Paused:                             info.GetPaused(),
+		PauseReason:                        info.GetPauseReason(),
+		PauseIdentity:                      info.GetPauseIdentity(),
+		SyncStateVersion:                   info.GetSyncStateVersion(),
+		UpdatedSearchAttributes:            info.GetUpdatedSearchAttributes(),
+		UpdatedMemo:                        info.GetUpdatedMemo(),
+		UpdateResults:                      info.GetUpdateResults(),
+		Priority:                           info.GetPriority(),
+		WorkerBuildID:                      info.GetWorkerBuildId(),
 	}
 
 	if info.GetRetryExpirationTimeNanos() != 0 {
 		executionInfo.ExpirationTime = time.Unix(0, info.GetRetryExpirationTimeNanos())
 	}
 
+	if info.GetCronSkipUntilNanos() != 0 {
+		executionInfo.CronSkipUntil = time.Unix(0, info.GetCronSkipUntilNanos())
+	}
+
+	if len(info.GetSignalRequestIdTimestamps()) > 0 {
+		executionInfo.SignalRequestIDTimestamps = make(map[string]time.Time, len(info.GetSignalRequestIdTimestamps()))
+		for requestID, timestampNanos := range info.GetSignalRequestIdTimestamps() {
+			executionInfo.SignalRequestIDTimestamps[requestID] = time.Unix(0, timestampNanos)
+		}
+	}
+
 	if info.ParentNamespaceId != nil {
 		executionInfo.ParentNamespaceID = primitives.UUID(info.ParentNamespaceId).String()
 		executionInfo.ParentWorkflowID = info.GetParentWorkflowId()
@@ -940,10 +1000,15 @@ func ProtoActivityInfoToInternalActivityInfo(decoded *persistenceblobs.ActivityI
 		LastFailureReason:        decoded.GetRetryLastFailureReason(),
 		LastWorkerIdentity:       decoded.GetRetryLastWorkerIdentity(),
 		LastFailureDetails:       decoded.GetRetryLastFailureDetails(),
+		Priority:                 decoded.GetPriority(),
+		OptionsUpdateIdentity:    decoded.GetOptionsUpdateIdentity(),
 	}
 	if decoded.GetRetryExpirationTimeNanos() != 0 {
 		info.ExpirationTime = time.Unix(0, decoded.GetRetryExpirationTimeNanos())
 	}
+	if decoded.GetOptionsUpdateTimeNanos() != 0 {
+		info.OptionsUpdateTime = time.Unix(0, decoded.GetOptionsUpdateTimeNanos())
+	}
 	if decoded.StartedEvent != nil {
 		info.StartedEvent = NewDataBlob(decoded.StartedEvent, common.EncodingType(decoded.GetStartedEventEncoding()))
 	}
@@ -989,10 +1054,15 @@ func (v *InternalActivityInfo) ToProto() *persistenceblobs.ActivityInfo {
 		RetryLastFailureReason:        v.LastFailureReason,
 		RetryLastWorkerIdentity:       v.LastWorkerIdentity,
 		RetryLastFailureDetails:       v.LastFailureDetails,
+		Priority:                      v.Priority,
+		OptionsUpdateIdentity:         v.OptionsUpdateIdentity,
 	}
 	if !v.ExpirationTime.IsZero() {
 		info.RetryExpirationTimeNanos = v.ExpirationTime.UnixNano()
 	}
+	if !v.OptionsUpdateTime.IsZero() {
+		info.OptionsUpdateTimeNanos = v.OptionsUpdateTime.UnixNano()
+	}
 	return info
 }
 
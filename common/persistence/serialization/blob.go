@@ -298,6 +298,10 @@ func (d *DataBlob) GetEncoding() common.EncodingType {
 		return common.EncodingTypeJSON
 	case common.EncodingTypeEmpty:
 		return common.EncodingTypeEmpty
+	case common.EncodingTypeEncrypted:
+		return common.EncodingTypeEncrypted
+	case common.EncodingTypeCompressed:
+		return common.EncodingTypeCompressed
 	default:
 		return common.EncodingTypeUnknown
 	}
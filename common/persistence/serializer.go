@@ -73,6 +73,19 @@ type (
 		// serialize/deserialize immutable cluster metadata
 		SerializeImmutableClusterMetadata(icm *persistenceblobs.ImmutableClusterMetadata, encodingType common.EncodingType) (*serialization.DataBlob, error)
 		DeserializeImmutableClusterMetadata(data *serialization.DataBlob) (*persistenceblobs.ImmutableClusterMetadata, error)
+
+		// encrypt/decrypt serialized payloads, the Deserialize methods above decrypt transparently
+		EncryptBlob(namespaceID string, data *serialization.DataBlob) (*serialization.DataBlob, error)
+		DecryptBlob(data *serialization.DataBlob) (*serialization.DataBlob, error)
+
+		// compress/decompress serialized payloads, the Deserialize methods above decompress transparently.
+		// Compressed payloads can still be encrypted, they are compressed first
+		CompressBlob(data *serialization.DataBlob, compression CompressionType) (*serialization.DataBlob, error)
+		DecompressBlob(data *serialization.DataBlob) (*serialization.DataBlob, error)
+
+		// encrypt/decrypt opaque payload bytes which are stored without an encoding, e.g. heartbeat details
+		EncryptData(namespaceID string, data []byte) ([]byte, error)
+		DecryptData(data []byte) ([]byte, error)
 	}
 
 	// SerializationError is an error type for serialization
@@ -90,14 +103,22 @@ type (
 		encodingType common.EncodingType
 	}
 
-	serializerImpl struct{}
+	serializerImpl struct {
+		keyProvider EncryptionKeyProvider
+	}
 )
 
-// NewPayloadSerializer returns a PayloadSerializer
+// NewPayloadSerializer returns a PayloadSerializer which does not encrypt payloads
 func NewPayloadSerializer() PayloadSerializer {
 	return &serializerImpl{}
 }
 
+// NewEncryptingPayloadSerializer returns a PayloadSerializer which encrypts payloads with
+// the keys of the given provider, a nil provider disables encryption
+func NewEncryptingPayloadSerializer(keyProvider EncryptionKeyProvider) PayloadSerializer {
+	return &serializerImpl{keyProvider: keyProvider}
+}
+
 func (t *serializerImpl) SerializeBatchEvents(events []*eventpb.HistoryEvent, encodingType common.EncodingType) (*serialization.DataBlob, error) {
 	return t.serialize(&eventpb.History{Events: events}, encodingType)
 }
@@ -109,9 +130,12 @@ func (t *serializerImpl) DeserializeBatchEvents(data *serialization.DataBlob) ([
 	if len(data.Data) == 0 {
 		return nil, nil
 	}
+	data, err := t.decodeBlob(data)
+	if err != nil {
+		return nil, err
+	}
 
 	events := &eventpb.History{}
-	var err error
 	switch data.Encoding {
 	case common.EncodingTypeJSON:
 		err = codec.NewJSONPBEncoder().Decode(data.Data, events)
@@ -142,9 +166,12 @@ func (t *serializerImpl) DeserializeEvent(data *serialization.DataBlob) (*eventp
 	if len(data.Data) == 0 {
 		return nil, nil
 	}
+	data, err := t.decodeBlob(data)
+	if err != nil {
+		return nil, err
+	}
 
 	event := &eventpb.HistoryEvent{}
-	var err error
 	switch data.Encoding {
 	case common.EncodingTypeJSON:
 		err = codec.NewJSONPBEncoder().Decode(data.Data, event)
@@ -177,9 +204,12 @@ func (t *serializerImpl) DeserializeResetPoints(data *serialization.DataBlob) (*
 	if len(data.Data) == 0 {
 		return &executionpb.ResetPoints{}, nil
 	}
+	data, err := t.decodeBlob(data)
+	if err != nil {
+		return nil, err
+	}
 
 	memo := &executionpb.ResetPoints{}
-	var err error
 	switch data.Encoding {
 	case common.EncodingTypeJSON:
 		err = codec.NewJSONPBEncoder().Decode(data.Data, memo)
@@ -212,9 +242,12 @@ func (t *serializerImpl) DeserializeBadBinaries(data *serialization.DataBlob) (*
 	if len(data.Data) == 0 {
 		return &namespacepb.BadBinaries{}, nil
 	}
+	data, err := t.decodeBlob(data)
+	if err != nil {
+		return nil, err
+	}
 
 	memo := &namespacepb.BadBinaries{}
-	var err error
 	switch data.Encoding {
 	case common.EncodingTypeJSON:
 		err = codec.NewJSONPBEncoder().Decode(data.Data, memo)
@@ -249,9 +282,12 @@ func (t *serializerImpl) DeserializeVisibilityMemo(data *serialization.DataBlob)
 	if len(data.Data) == 0 {
 		return &commonpb.Memo{}, nil
 	}
+	data, err := t.decodeBlob(data)
+	if err != nil {
+		return nil, err
+	}
 
 	memo := &commonpb.Memo{}
-	var err error
 	switch data.Encoding {
 	case common.EncodingTypeJSON:
 		err = codec.NewJSONPBEncoder().Decode(data.Data, memo)
@@ -284,9 +320,12 @@ func (t *serializerImpl) DeserializeVersionHistories(data *serialization.DataBlo
 	if len(data.Data) == 0 {
 		return &eventgenpb.VersionHistories{}, nil
 	}
+	data, err := t.decodeBlob(data)
+	if err != nil {
+		return nil, err
+	}
 
 	memo := &eventgenpb.VersionHistories{}
-	var err error
 	switch data.Encoding {
 	case common.EncodingTypeJSON:
 		err = codec.NewJSONPBEncoder().Decode(data.Data, memo)
@@ -319,9 +358,12 @@ func (t *serializerImpl) DeserializeImmutableClusterMetadata(data *serialization
 	if len(data.Data) == 0 {
 		return nil, nil
 	}
+	data, err := t.decodeBlob(data)
+	if err != nil {
+		return nil, err
+	}
 
 	event := &persistenceblobs.ImmutableClusterMetadata{}
-	var err error
 	switch data.Encoding {
 	case common.EncodingTypeJSON:
 		err = codec.NewJSONPBEncoder().Decode(data.Data, event)
@@ -340,6 +382,15 @@ func (t *serializerImpl) DeserializeImmutableClusterMetadata(data *serialization
 	return event, err
 }
 
+// decodeBlob undoes the encryption and compression of a stored payload
+func (t *serializerImpl) decodeBlob(data *serialization.DataBlob) (*serialization.DataBlob, error) {
+	data, err := t.DecryptBlob(data)
+	if err != nil {
+		return nil, err
+	}
+	return t.DecompressBlob(data)
+}
+
 func (t *serializerImpl) serializeProto(p proto.Marshaler, encodingType common.EncodingType) (*serialization.DataBlob, error) {
 	if p == nil {
 		return nil, nil
@@ -96,6 +96,7 @@ func applyWorkflowMutationTx(
 		namespaceID,
 		workflowID,
 		runID,
+		workflowMutation.ExecutionInfo.Priority,
 		workflowMutation.TransferTasks,
 		workflowMutation.ReplicationTasks,
 		workflowMutation.TimerTasks); err != nil {
@@ -236,6 +237,7 @@ func applyWorkflowSnapshotTxAsReset(
 		namespaceID,
 		workflowID,
 		runID,
+		workflowSnapshot.ExecutionInfo.Priority,
 		workflowSnapshot.TransferTasks,
 		workflowSnapshot.ReplicationTasks,
 		workflowSnapshot.TimerTasks); err != nil {
@@ -398,6 +400,7 @@ func (m *sqlExecutionManager) applyWorkflowSnapshotTxAsNew(
 		namespaceID,
 		workflowID,
 		runID,
+		workflowSnapshot.ExecutionInfo.Priority,
 		workflowSnapshot.TransferTasks,
 		workflowSnapshot.ReplicationTasks,
 		workflowSnapshot.TimerTasks); err != nil {
@@ -473,6 +476,7 @@ func applyTasks(
 	namespaceID primitives.UUID,
 	workflowID string,
 	runID primitives.UUID,
+	priority int32,
 	transferTasks []p.Task,
 	replicationTasks []p.Task,
 	timerTasks []p.Task,
@@ -483,7 +487,8 @@ func applyTasks(
 		shardID,
 		namespaceID,
 		workflowID,
-		runID); err != nil {
+		runID,
+		priority); err != nil {
 		return serviceerror.NewInternal(fmt.Sprintf("applyTasks failed. Failed to create transfer tasks. Error: %v", err))
 	}
 
@@ -501,7 +506,8 @@ func applyTasks(
 		shardID,
 		namespaceID,
 		workflowID,
-		runID); err != nil {
+		runID,
+		priority); err != nil {
 		return serviceerror.NewInternal(fmt.Sprintf("applyTasks failed. Failed to create timer tasks. Error: %v", err))
 	}
 
@@ -656,6 +662,7 @@ func createTransferTasks(
 	namespaceID primitives.UUID,
 	workflowID string,
 	runID primitives.UUID,
+	priority int32,
 ) error {
 
 	if len(transferTasks) == 0 {
@@ -672,6 +679,7 @@ func createTransferTasks(
 			TargetWorkflowId:  p.TransferTaskTransferTargetWorkflowID,
 			ScheduleId:        0,
 			TaskId:            task.GetTaskID(),
+			Priority:          priority,
 		}
 
 		transferTasksRows[i].ShardID = shardID
@@ -682,6 +690,9 @@ func createTransferTasks(
 			info.TargetNamespaceId = primitives.MustParseUUID(task.(*p.ActivityTask).NamespaceID)
 			info.TaskList = task.(*p.ActivityTask).TaskList
 			info.ScheduleId = task.(*p.ActivityTask).ScheduleID
+			if task.(*p.ActivityTask).Priority != common.TaskPriorityUnspecified {
+				info.Priority = task.(*p.ActivityTask).Priority
+			}
 
 		case p.TransferTaskTypeDecisionTask:
 			info.TargetNamespaceId = primitives.MustParseUUID(task.(*p.DecisionTask).NamespaceID)
@@ -714,7 +725,8 @@ func createTransferTasks(
 		case p.TransferTaskTypeCloseExecution,
 			p.TransferTaskTypeRecordWorkflowStarted,
 			p.TransferTaskTypeResetWorkflow,
-			p.TransferTaskTypeUpsertWorkflowSearchAttributes:
+			p.TransferTaskTypeUpsertWorkflowSearchAttributes,
+			p.TransferTaskTypeDeleteExecution:
 			// No explicit property needs to be set
 
 		default:
@@ -803,6 +815,11 @@ func createReplicationTasks(
 			activityScheduleID = task.(*p.SyncActivityTask).ScheduledID
 			lastReplicationInfo = map[string]*replicationgenpb.ReplicationInfo{}
 
+		case p.ReplicationTaskTypeDeleteExecution,
+			p.ReplicationTaskTypeSyncWorkflowState:
+			version = task.GetVersion()
+			lastReplicationInfo = map[string]*replicationgenpb.ReplicationInfo{}
+
 		default:
 			return serviceerror.NewInternal(fmt.Sprintf("Unknown replication task: %v", task.GetType()))
 		}
@@ -857,6 +874,7 @@ func createTimerTasks(
 	namespaceID primitives.UUID,
 	workflowID string,
 	runID primitives.UUID,
+	priority int32,
 ) error {
 
 	if len(timerTasks) > 0 {
@@ -881,6 +899,9 @@ func createTimerTasks(
 			case *p.ActivityRetryTimerTask:
 				info.EventId = t.EventID
 				info.ScheduleAttempt = int64(t.Attempt)
+				if !t.ScheduledTime.IsZero() {
+					info.ActivityScheduledTimeNanos = t.ScheduledTime.UnixNano()
+				}
 
 			case *p.WorkflowBackoffTimerTask:
 				info.EventId = t.EventID
@@ -902,6 +923,7 @@ func createTimerTasks(
 			info.Version = task.GetVersion()
 			info.TaskType = int32(task.GetType())
 			info.TaskId = task.GetTaskID()
+			info.Priority = priority
 
 			goVisTs := task.GetVisibilityTimestamp()
 			protoVisTs, err := types.TimestampProto(goVisTs)
@@ -166,6 +166,9 @@ func (m *sqlMetadataManagerV2) UpdateNamespace(request *persistence.InternalUpda
 			NotificationVersion: request.NotificationVersion,
 		})
 		if err != nil {
+			if m.db.IsDupEntryError(err) {
+				return serviceerror.NewNamespaceAlreadyExists(fmt.Sprintf("name: %v", request.Name))
+			}
 			return err
 		}
 		noRowsAffected, err := result.RowsAffected()
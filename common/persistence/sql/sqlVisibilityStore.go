@@ -33,6 +33,7 @@ import (
 	"github.com/temporalio/temporal/common"
 	"github.com/temporalio/temporal/common/convert"
 	"github.com/temporalio/temporal/common/log"
+	"github.com/temporalio/temporal/common/log/tag"
 	p "github.com/temporalio/temporal/common/persistence"
 	"github.com/temporalio/temporal/common/persistence/sql/sqlplugin"
 	"github.com/temporalio/temporal/common/service/config"
@@ -49,8 +50,17 @@ type (
 		Time  time.Time
 		RunID string
 	}
+
+	// visibilityQueryPageToken is the page token of the query based list APIs, whose
+	// results can be ordered by any field so that pages are read by offset
+	visibilityQueryPageToken struct {
+		Offset int
+	}
 )
 
+// defaultQueryPageSize is the page size of ScanWorkflowExecutions and of list requests that don't set one
+const defaultQueryPageSize = 1000
+
 // NewSQLVisibilityStore creates an instance of ExecutionStore
 func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore, error) {
 	db, err := NewSQLDB(&cfg)
@@ -66,7 +76,11 @@ func NewSQLVisibilityStore(cfg config.SQL, logger log.Logger) (p.VisibilityStore
 }
 
 func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(request *p.InternalRecordWorkflowExecutionStartedRequest) error {
-	_, err := s.db.InsertIntoVisibility(&sqlplugin.VisibilityRow{
+	searchAttributes, err := serializeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
+	_, err = s.db.InsertIntoVisibility(&sqlplugin.VisibilityRow{
 		NamespaceID:      request.NamespaceID,
 		WorkflowID:       request.WorkflowID,
 		RunID:            request.RunID,
@@ -75,12 +89,18 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionStarted(request *p.InternalR
 		WorkflowTypeName: request.WorkflowTypeName,
 		Memo:             request.Memo.Data,
 		Encoding:         string(request.Memo.GetEncoding()),
+		TaskList:         request.TaskList,
+		SearchAttributes: searchAttributes,
 	})
 
 	return err
 }
 
 func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRecordWorkflowExecutionClosedRequest) error {
+	searchAttributes, err := serializeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
+	}
 	closeTime := time.Unix(0, request.CloseTimestamp)
 	result, err := s.db.ReplaceIntoVisibility(&sqlplugin.VisibilityRow{
 		NamespaceID:      request.NamespaceID,
@@ -94,6 +114,8 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRe
 		HistoryLength:    &request.HistoryLength,
 		Memo:             request.Memo.Data,
 		Encoding:         string(request.Memo.GetEncoding()),
+		TaskList:         request.TaskList,
+		SearchAttributes: searchAttributes,
 	})
 	if err != nil {
 		return err
@@ -109,10 +131,26 @@ func (s *sqlVisibilityStore) RecordWorkflowExecutionClosed(request *p.InternalRe
 }
 
 func (s *sqlVisibilityStore) UpsertWorkflowExecution(request *p.InternalUpsertWorkflowExecutionRequest) error {
-	if p.IsNopUpsertWorkflowRequest(request) {
-		return nil
+	searchAttributes, err := serializeSearchAttributes(request.SearchAttributes)
+	if err != nil {
+		return err
 	}
-	return p.NewOperationNotSupportErrorForVis()
+	_, err = s.db.UpsertIntoVisibility(&sqlplugin.VisibilityRow{
+		NamespaceID:      request.NamespaceID,
+		WorkflowID:       request.WorkflowID,
+		RunID:            request.RunID,
+		StartTime:        time.Unix(0, request.StartTimestamp),
+		ExecutionTime:    time.Unix(0, request.ExecutionTimestamp),
+		WorkflowTypeName: request.WorkflowTypeName,
+		Memo:             request.Memo.Data,
+		Encoding:         string(request.Memo.GetEncoding()),
+		TaskList:         request.TaskList,
+		SearchAttributes: searchAttributes,
+	})
+	if err != nil {
+		return serviceerror.NewInternal(fmt.Sprintf("UpsertWorkflowExecution operation failed. Error: %v", err))
+	}
+	return nil
 }
 
 func (s *sqlVisibilityStore) ListOpenWorkflowExecutions(request *p.ListWorkflowExecutionsRequest) (*p.InternalListWorkflowExecutionsResponse, error) {
@@ -254,15 +292,73 @@ func (s *sqlVisibilityStore) DeleteWorkflowExecution(request *p.VisibilityDelete
 }
 
 func (s *sqlVisibilityStore) ListWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	return s.queryWorkflowExecutions("ListWorkflowExecutions", request, request.PageSize)
 }
 
 func (s *sqlVisibilityStore) ScanWorkflowExecutions(request *p.ListWorkflowExecutionsRequestV2) (*p.InternalListWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	// rows are ordered by the query, or by start time, so that scanning is listing with a larger page
+	pageSize := request.PageSize
+	if pageSize < defaultQueryPageSize {
+		pageSize = defaultQueryPageSize
+	}
+	return s.queryWorkflowExecutions("ScanWorkflowExecutions", request, pageSize)
 }
 
 func (s *sqlVisibilityStore) CountWorkflowExecutions(request *p.CountWorkflowExecutionsRequest) (*p.CountWorkflowExecutionsResponse, error) {
-	return nil, p.NewOperationNotSupportErrorForVis()
+	query, err := sqlplugin.ParseVisibilityQuery(request.Query)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Error when parse query: %v", err))
+	}
+	count, err := s.db.CountFromVisibility(&sqlplugin.VisibilityQueryFilter{
+		NamespaceID: request.NamespaceID,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("CountWorkflowExecutions operation failed. Query failed: %v", err))
+	}
+	return &p.CountWorkflowExecutionsResponse{Count: count}, nil
+}
+
+func (s *sqlVisibilityStore) queryWorkflowExecutions(opName string, request *p.ListWorkflowExecutionsRequestV2, pageSize int) (*p.InternalListWorkflowExecutionsResponse, error) {
+	query, err := sqlplugin.ParseVisibilityQuery(request.Query)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("Error when parse query: %v", err))
+	}
+	if pageSize <= 0 {
+		pageSize = defaultQueryPageSize
+	}
+	token := &visibilityQueryPageToken{}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, token); err != nil {
+			return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("unable to deserialize page token. err: %v", err))
+		}
+	}
+
+	rows, err := s.db.SelectFromVisibilityByQuery(&sqlplugin.VisibilityQueryFilter{
+		NamespaceID: request.NamespaceID,
+		Query:       query,
+		PageSize:    pageSize,
+		Offset:      token.Offset,
+	})
+	if err != nil {
+		return nil, serviceerror.NewInternal(fmt.Sprintf("%v operation failed. Select failed: %v", opName, err))
+	}
+
+	infos := make([]*p.VisibilityWorkflowExecutionInfo, len(rows))
+	for i := range rows {
+		infos[i] = s.rowToInfo(&rows[i])
+	}
+	var nextPageToken []byte
+	if len(rows) == pageSize {
+		nextPageToken, err = json.Marshal(&visibilityQueryPageToken{Offset: token.Offset + len(rows)})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &p.InternalListWorkflowExecutionsResponse{
+		Executions:    infos,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.VisibilityWorkflowExecutionInfo {
@@ -276,6 +372,12 @@ func (s *sqlVisibilityStore) rowToInfo(row *sqlplugin.VisibilityRow) *p.Visibili
 		StartTime:     row.StartTime,
 		ExecutionTime: row.ExecutionTime,
 		Memo:          p.NewDataBlob(row.Memo, common.EncodingType(row.Encoding)),
+		TaskList:      row.TaskList,
+	}
+	if len(row.SearchAttributes) > 0 {
+		if err := json.Unmarshal(row.SearchAttributes, &info.SearchAttributes); err != nil {
+			s.logger.Error("unable to decode search attributes", tag.Error(err), tag.WorkflowRunID(row.RunID))
+		}
 	}
 	if row.Status != nil {
 		status := executionpb.WorkflowExecutionStatus(*row.Status)
@@ -327,6 +429,23 @@ func (s *sqlVisibilityStore) listWorkflowExecutions(opName string, pageToken []b
 	}, nil
 }
 
+// serializeSearchAttributes encodes search attributes as a JSON object, the values
+// of the map are already JSON encoded
+func serializeSearchAttributes(attributes map[string][]byte) ([]byte, error) {
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+	fields := make(map[string]json.RawMessage, len(attributes))
+	for key, value := range attributes {
+		fields[key] = value
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(fmt.Sprintf("unable to encode search attributes: %v", err))
+	}
+	return data, nil
+}
+
 func (s *sqlVisibilityStore) deserializePageToken(data []byte) (*visibilityPageToken, error) {
 	var token visibilityPageToken
 	err := json.Unmarshal(data, &token)
@@ -509,6 +509,8 @@ type (
 		HistoryLength    *int64
 		Memo             []byte
 		Encoding         string
+		TaskList         string
+		SearchAttributes []byte
 	}
 
 	// VisibilityFilter contains the column names within executions_visibility table that
@@ -525,6 +527,15 @@ type (
 		PageSize         *int
 	}
 
+	// VisibilityQueryFilter contains the parameters of a visibility query, i.e. the namespace,
+	// the parsed query and the page to read, pages are read by offset
+	VisibilityQueryFilter struct {
+		NamespaceID string
+		Query       *VisibilityQuery
+		PageSize    int
+		Offset      int
+	}
+
 	// QueueRow represents a row in queue table
 	QueueRow struct {
 		QueueType      persistence.QueueType
@@ -739,6 +750,13 @@ type (
 		//     - workflowID, workflowTypeName, status (along with closed=true)
 		SelectFromVisibility(filter *VisibilityFilter) ([]VisibilityRow, error)
 		DeleteFromVisibility(filter *VisibilityFilter) (sql.Result, error)
+		// UpsertIntoVisibility inserts a row for an open workflow into visibility table or updates
+		// the memo, task list and search attributes of an existing row, close fields are left as such
+		UpsertIntoVisibility(row *VisibilityRow) (sql.Result, error)
+		// SelectFromVisibilityByQuery returns a page of rows from visibility table that match the query
+		SelectFromVisibilityByQuery(filter *VisibilityQueryFilter) ([]VisibilityRow, error)
+		// CountFromVisibility returns the number of rows in visibility table that match the query
+		CountFromVisibility(filter *VisibilityQueryFilter) (int64, error)
 
 		InsertIntoQueue(row *QueueRow) (sql.Result, error)
 		GetLastEnqueuedMessageIDForUpdate(queueType persistence.QueueType) (int64, error)
@@ -0,0 +1,124 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// CreateSchemaVersionTables sets up the schema version tables, the schema version
+// is a field of the in-memory database so there is nothing to create
+func (mdb *db) CreateSchemaVersionTables() error {
+	return nil
+}
+
+// ReadSchemaVersion returns the current schema version for the keyspace
+func (mdb *db) ReadSchemaVersion(database string) (string, error) {
+	d, ok := databases.lookup(database)
+	if !ok {
+		return "", sql.ErrNoRows
+	}
+	d.Lock()
+	defer d.Unlock()
+	if d.schemaVersion == "" {
+		return "", sql.ErrNoRows
+	}
+	return d.schemaVersion, nil
+}
+
+// UpdateSchemaVersion updates the schema version for the keyspace
+func (mdb *db) UpdateSchemaVersion(database string, newVersion string, minCompatibleVersion string) error {
+	d := databases.open(database)
+	d.Lock()
+	defer d.Unlock()
+	d.schemaVersion = newVersion
+	return nil
+}
+
+// WriteSchemaUpdateLog adds an entry to the schema update history table, the
+// history is not kept by this plugin
+func (mdb *db) WriteSchemaUpdateLog(oldVersion string, newVersion string, manifestMD5 string, desc string) error {
+	return nil
+}
+
+// Exec executes a sql statement, statements are ignored since the tables of
+// the in-memory database are fixed
+func (mdb *db) Exec(stmt string, args ...interface{}) error {
+	return nil
+}
+
+// ListTables returns a list of tables in this database
+func (mdb *db) ListTables(database string) ([]string, error) {
+	d, ok := databases.lookup(database)
+	if !ok {
+		return nil, nil
+	}
+	d.Lock()
+	defer d.Unlock()
+	var names []string
+	for _, t := range d.tables() {
+		names = append(names, t.name)
+	}
+	return names, nil
+}
+
+// DropTable drops a given table from the database, the table is left empty
+func (mdb *db) DropTable(name string) error {
+	mdb.lock()
+	defer mdb.unlock()
+	for _, t := range mdb.store.tables() {
+		if t.name == name {
+			t.truncate()
+			return nil
+		}
+	}
+	return fmt.Errorf("table %v does not exist", name)
+}
+
+// DropAllTables drops all tables from this database
+func (mdb *db) DropAllTables(database string) error {
+	d, ok := databases.lookup(database)
+	if !ok {
+		return nil
+	}
+	d.Lock()
+	defer d.Unlock()
+	d.reset()
+	d.schemaVersion = ""
+	return nil
+}
+
+// CreateDatabase creates a database if it doesn't exist
+func (mdb *db) CreateDatabase(name string) error {
+	databases.open(name)
+	return nil
+}
+
+// DropDatabase drops a database
+func (mdb *db) DropDatabase(name string) error {
+	databases.drop(name)
+	return nil
+}
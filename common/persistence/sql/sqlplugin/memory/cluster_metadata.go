@@ -0,0 +1,124 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"bytes"
+	"database/sql"
+	"sort"
+
+	p "github.com/temporalio/temporal/common/persistence"
+
+	"github.com/temporalio/temporal/common/persistence/sql/sqlplugin"
+)
+
+type (
+	clusterMetadataKey struct {
+		metadataPartition int
+	}
+
+	clusterMembershipKey struct {
+		hostID string
+	}
+)
+
+const constMetadataPartition = 0
+
+// Does not follow traditional lock, select, read, insert as we only expect a single row.
+func (mdb *db) InsertIfNotExistsIntoClusterMetadata(row *sqlplugin.ClusterMetadataRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	key := clusterMetadataKey{metadataPartition: constMetadataPartition}
+	if _, ok := mdb.store.clusterMetadata.rows[key]; ok {
+		return result(0), nil
+	}
+	mdb.set(mdb.store.clusterMetadata, key, *row)
+	return result(1), nil
+}
+
+func (mdb *db) GetClusterMetadata() (*sqlplugin.ClusterMetadataRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	row, ok := mdb.store.clusterMetadata.rows[clusterMetadataKey{metadataPartition: constMetadataPartition}]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	result := row.(sqlplugin.ClusterMetadataRow)
+	return &result, nil
+}
+
+func (mdb *db) UpsertClusterMembership(row *sqlplugin.ClusterMembershipRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	// like the auto increment column of the SQL schema, every upsert gets a new insertion order
+	mdb.store.nextInsertionOrder++
+	upserted := *row
+	upserted.SessionStart = row.SessionStart.UTC()
+	upserted.LastHeartbeat = row.LastHeartbeat.UTC()
+	upserted.RecordExpiry = row.RecordExpiry.UTC()
+	upserted.InsertionOrder = mdb.store.nextInsertionOrder
+	key := clusterMembershipKey{hostID: string(row.HostID)}
+	return result(mdb.replace(mdb.store.clusterMembership, key, upserted)), nil
+}
+
+func (mdb *db) GetClusterMembers(filter *sqlplugin.ClusterMembershipFilter) ([]sqlplugin.ClusterMembershipRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	var rows []sqlplugin.ClusterMembershipRow
+	for _, r := range mdb.store.clusterMembership.rows {
+		row := r.(sqlplugin.ClusterMembershipRow)
+		switch {
+		case filter.HostIDEquals != nil && !bytes.Equal(row.HostID, filter.HostIDEquals),
+			filter.RPCAddressEquals != "" && row.RPCAddress != filter.RPCAddressEquals,
+			filter.RoleEquals != p.All && row.Role != filter.RoleEquals,
+			!filter.LastHeartbeatAfter.IsZero() && !row.LastHeartbeat.After(filter.LastHeartbeatAfter),
+			!filter.RecordExpiryAfter.IsZero() && !row.RecordExpiry.After(filter.RecordExpiryAfter),
+			!filter.SessionStartedAfter.IsZero() && !row.SessionStart.After(filter.SessionStartedAfter),
+			filter.InsertionOrderGreaterThan > 0 && row.InsertionOrder <= filter.InsertionOrderGreaterThan:
+			continue
+		}
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].InsertionOrder < rows[j].InsertionOrder
+	})
+	if filter.MaxRecordCount > 0 && len(rows) > filter.MaxRecordCount {
+		rows = rows[:filter.MaxRecordCount]
+	}
+	return rows, nil
+}
+
+func (mdb *db) PruneClusterMembership(filter *sqlplugin.PruneClusterMembershipFilter) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	var n int64
+	return mdb.deleteWhere(mdb.store.clusterMembership, func(_ interface{}, r interface{}) bool {
+		if n >= int64(filter.MaxRecordsAffected) || !r.(sqlplugin.ClusterMembershipRow).RecordExpiry.Before(filter.PruneRecordsBefore) {
+			return false
+		}
+		n++
+		return true
+	}), nil
+}
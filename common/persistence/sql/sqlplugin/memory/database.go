@@ -0,0 +1,206 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"sort"
+	"sync"
+)
+
+type (
+	// table holds the rows of a single table keyed by their primary key. Keys are
+	// comparable structs built from the primary key columns and rows are stored as
+	// struct values. Range queries scan the whole table, this plugin is meant for
+	// development and tests rather than large data sets.
+	table struct {
+		name string
+		rows map[interface{}]interface{}
+	}
+
+	// database is the in-memory counterpart of a SQL database. All access is serialized
+	// through the embedded mutex: a statement outside of a transaction holds it for the
+	// duration of the statement while a transaction holds it from BeginTx until Commit
+	// or Rollback, which is what SELECT ... FOR UPDATE achieves on MySQL and Postgres.
+	database struct {
+		sync.Mutex
+
+		schemaVersion string
+
+		clusterMetadata        *table
+		clusterMembership      *table
+		namespaces             *table
+		namespaceMetadata      *table
+		shards                 *table
+		transferTasks          *table
+		executions             *table
+		currentExecutions      *table
+		bufferedEvents         *table
+		tasks                  *table
+		taskLists              *table
+		replicationTasks       *table
+		replicationTasksDLQ    *table
+		timerTasks             *table
+		activityInfoMaps       *table
+		timerInfoMaps          *table
+		childExecutionInfoMaps *table
+		requestCancelInfoMaps  *table
+		signalInfoMaps         *table
+		signalsRequestedSets   *table
+		historyNode            *table
+		historyTree            *table
+		visibility             *table
+		queue                  *table
+		queueMetadata          *table
+
+		// auto increment counters, like their SQL counterparts they are not rolled back
+		nextInsertionOrder  uint64
+		nextBufferedEventID int64
+	}
+
+	// registry holds every named database of this process
+	registry struct {
+		sync.Mutex
+		databases map[string]*database
+	}
+)
+
+// databases lives as long as the process, data is lost on restart
+var databases = &registry{databases: make(map[string]*database)}
+
+// open returns the database with the given name, creating it if it does not exist.
+// An empty name returns a new database that is private to the caller.
+func (r *registry) open(name string) *database {
+	if name == "" {
+		return newDatabase()
+	}
+	r.Lock()
+	defer r.Unlock()
+	d, ok := r.databases[name]
+	if !ok {
+		d = newDatabase()
+		r.databases[name] = d
+	}
+	return d
+}
+
+// lookup returns the database with the given name if it exists
+func (r *registry) lookup(name string) (*database, bool) {
+	r.Lock()
+	defer r.Unlock()
+	d, ok := r.databases[name]
+	return d, ok
+}
+
+// drop removes the database with the given name, connections that are still open
+// keep working against the dropped data
+func (r *registry) drop(name string) {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.databases, name)
+}
+
+func newDatabase() *database {
+	d := &database{
+		clusterMetadata:        newTable("cluster_metadata"),
+		clusterMembership:      newTable("cluster_membership"),
+		namespaces:             newTable("namespaces"),
+		namespaceMetadata:      newTable("namespace_metadata"),
+		shards:                 newTable("shards"),
+		transferTasks:          newTable("transfer_tasks"),
+		executions:             newTable("executions"),
+		currentExecutions:      newTable("current_executions"),
+		bufferedEvents:         newTable("buffered_events"),
+		tasks:                  newTable("tasks"),
+		taskLists:              newTable("task_lists"),
+		replicationTasks:       newTable("replication_tasks"),
+		replicationTasksDLQ:    newTable("replication_tasks_dlq"),
+		timerTasks:             newTable("timer_tasks"),
+		activityInfoMaps:       newTable("activity_info_maps"),
+		timerInfoMaps:          newTable("timer_info_maps"),
+		childExecutionInfoMaps: newTable("child_execution_info_maps"),
+		requestCancelInfoMaps:  newTable("request_cancel_info_maps"),
+		signalInfoMaps:         newTable("signal_info_maps"),
+		signalsRequestedSets:   newTable("signals_requested_sets"),
+		historyNode:            newTable("history_node"),
+		historyTree:            newTable("history_tree"),
+		visibility:             newTable("executions_visibility"),
+		queue:                  newTable("queue"),
+		queueMetadata:          newTable("queue_metadata"),
+	}
+	d.reset()
+	return d
+}
+
+// reset drops the content of every table and restores the rows that schema.sql inserts
+func (d *database) reset() {
+	for _, t := range d.tables() {
+		t.truncate()
+	}
+	d.namespaceMetadata.rows[namespaceMetadataKey{}] = int64(1)
+}
+
+// tables returns all tables of the database ordered by name
+func (d *database) tables() []*table {
+	tables := []*table{
+		d.clusterMetadata,
+		d.clusterMembership,
+		d.namespaces,
+		d.namespaceMetadata,
+		d.shards,
+		d.transferTasks,
+		d.executions,
+		d.currentExecutions,
+		d.bufferedEvents,
+		d.tasks,
+		d.taskLists,
+		d.replicationTasks,
+		d.replicationTasksDLQ,
+		d.timerTasks,
+		d.activityInfoMaps,
+		d.timerInfoMaps,
+		d.childExecutionInfoMaps,
+		d.requestCancelInfoMaps,
+		d.signalInfoMaps,
+		d.signalsRequestedSets,
+		d.historyNode,
+		d.historyTree,
+		d.visibility,
+		d.queue,
+		d.queueMetadata,
+	}
+	sort.Slice(tables, func(i, j int) bool {
+		return tables[i].name < tables[j].name
+	})
+	return tables
+}
+
+func newTable(name string) *table {
+	return &table{name: name, rows: make(map[interface{}]interface{})}
+}
+
+// truncate removes all rows of the table
+func (t *table) truncate() {
+	t.rows = make(map[interface{}]interface{})
+}
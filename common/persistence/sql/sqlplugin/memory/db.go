@@ -0,0 +1,247 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/temporalio/temporal/common/persistence/sql/sqlplugin"
+)
+
+type (
+	// db represents a logical connection to an in-memory database
+	db struct {
+		store *database
+		tx    *transaction
+	}
+
+	// transaction records how to undo every change made through it
+	transaction struct {
+		undo []func()
+		done bool
+	}
+
+	// result implements sql.Result for statements executed by this plugin
+	result int64
+
+	// dupEntryError is returned when an insert violates a primary or unique key
+	dupEntryError struct {
+		table string
+	}
+)
+
+var _ sqlplugin.AdminDB = (*db)(nil)
+var _ sqlplugin.DB = (*db)(nil)
+var _ sqlplugin.Tx = (*db)(nil)
+
+var (
+	errNotInTransaction         = errors.New("not in a transaction")
+	errLastInsertIDNotSupported = errors.New("LastInsertId is not supported by the memory plugin")
+)
+
+func (e *dupEntryError) Error() string {
+	return fmt.Sprintf("duplicate entry for key in table %v", e.table)
+}
+
+// LastInsertId is not supported, none of the tables rely on it
+func (r result) LastInsertId() (int64, error) {
+	return 0, errLastInsertIDNotSupported
+}
+
+// RowsAffected returns the number of rows inserted, updated or deleted by the statement
+func (r result) RowsAffected() (int64, error) {
+	return int64(r), nil
+}
+
+func (mdb *db) IsDupEntryError(err error) bool {
+	_, ok := err.(*dupEntryError)
+	return ok
+}
+
+// newDB returns an instance of DB, which is a logical
+// connection to the given in-memory database
+func newDB(store *database, tx *transaction) *db {
+	return &db{store: store, tx: tx}
+}
+
+// BeginTx starts a new transaction and returns a reference to the Tx object. The
+// database stays locked until the transaction is committed or rolled back.
+func (mdb *db) BeginTx() (sqlplugin.Tx, error) {
+	mdb.store.Lock()
+	return newDB(mdb.store, &transaction{}), nil
+}
+
+// Commit commits a previously started transaction
+func (mdb *db) Commit() error {
+	if mdb.tx == nil {
+		return errNotInTransaction
+	}
+	if mdb.tx.done {
+		return sql.ErrTxDone
+	}
+	mdb.tx.done = true
+	mdb.tx.undo = nil
+	mdb.store.Unlock()
+	return nil
+}
+
+// Rollback triggers rollback of a previously started transaction
+func (mdb *db) Rollback() error {
+	if mdb.tx == nil {
+		return errNotInTransaction
+	}
+	if mdb.tx.done {
+		return sql.ErrTxDone
+	}
+	for i := len(mdb.tx.undo) - 1; i >= 0; i-- {
+		mdb.tx.undo[i]()
+	}
+	mdb.tx.done = true
+	mdb.tx.undo = nil
+	mdb.store.Unlock()
+	return nil
+}
+
+// Close closes the connection to the in-memory database, the data is kept
+// until the database is dropped
+func (mdb *db) Close() error {
+	return nil
+}
+
+// PluginName returns the name of the memory plugin
+func (mdb *db) PluginName() string {
+	return PluginName
+}
+
+// lock acquires the database for a single statement, a transaction already holds it
+func (mdb *db) lock() {
+	if mdb.tx == nil {
+		mdb.store.Lock()
+	}
+}
+
+func (mdb *db) unlock() {
+	if mdb.tx == nil {
+		mdb.store.Unlock()
+	}
+}
+
+// set stores row under key and returns whether an existing row was replaced
+func (mdb *db) set(t *table, key interface{}, row interface{}) bool {
+	old, ok := t.rows[key]
+	t.rows[key] = row
+	if mdb.tx != nil {
+		mdb.tx.undo = append(mdb.tx.undo, func() {
+			if ok {
+				t.rows[key] = old
+			} else {
+				delete(t.rows, key)
+			}
+		})
+	}
+	return ok
+}
+
+// remove deletes the row stored under key and returns whether it existed
+func (mdb *db) remove(t *table, key interface{}) bool {
+	old, ok := t.rows[key]
+	if !ok {
+		return false
+	}
+	delete(t.rows, key)
+	if mdb.tx != nil {
+		mdb.tx.undo = append(mdb.tx.undo, func() {
+			t.rows[key] = old
+		})
+	}
+	return true
+}
+
+// insert stores row under key unless a row already exists, in which case a
+// duplicate entry error is returned like a primary key violation would
+func (mdb *db) insert(t *table, key interface{}, row interface{}) (sql.Result, error) {
+	if _, ok := t.rows[key]; ok {
+		return nil, &dupEntryError{table: t.name}
+	}
+	mdb.set(t, key, row)
+	return result(1), nil
+}
+
+// insertAll stores all rows or none of them if any key is already taken
+func (mdb *db) insertAll(t *table, keys []interface{}, rows []interface{}) (sql.Result, error) {
+	seen := make(map[interface{}]struct{}, len(keys))
+	for _, key := range keys {
+		if _, ok := t.rows[key]; ok {
+			return nil, &dupEntryError{table: t.name}
+		}
+		if _, ok := seen[key]; ok {
+			return nil, &dupEntryError{table: t.name}
+		}
+		seen[key] = struct{}{}
+	}
+	for i, key := range keys {
+		mdb.set(t, key, rows[i])
+	}
+	return result(len(keys)), nil
+}
+
+// replace stores row under key, the number of affected rows follows MySQL REPLACE,
+// i.e. 1 for a new row and 2 when an existing row got replaced
+func (mdb *db) replace(t *table, key interface{}, row interface{}) int64 {
+	if mdb.set(t, key, row) {
+		return 2
+	}
+	return 1
+}
+
+// update stores row under key only if a row already exists
+func (mdb *db) update(t *table, key interface{}, row interface{}) sql.Result {
+	if _, ok := t.rows[key]; !ok {
+		return result(0)
+	}
+	mdb.set(t, key, row)
+	return result(1)
+}
+
+// delete removes the row stored under key if any
+func (mdb *db) delete(t *table, key interface{}) sql.Result {
+	if mdb.remove(t, key) {
+		return result(1)
+	}
+	return result(0)
+}
+
+// deleteWhere removes all rows accepted by match
+func (mdb *db) deleteWhere(t *table, match func(key interface{}, row interface{}) bool) sql.Result {
+	var n int64
+	for key, row := range t.rows {
+		if match(key, row) && mdb.remove(t, key) {
+			n++
+		}
+	}
+	return result(n)
+}
@@ -0,0 +1,143 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"bytes"
+	"database/sql"
+	"sort"
+
+	"github.com/temporalio/temporal/common/persistence/sql/sqlplugin"
+)
+
+type (
+	historyNodeKey struct {
+		shardID  int
+		treeID   string
+		branchID string
+		nodeID   int64
+		txnID    int64
+	}
+
+	historyTreeKey struct {
+		shardID  int
+		treeID   string
+		branchID string
+	}
+)
+
+// For history_node table:
+
+// InsertIntoHistoryNode inserts a row into history_node table
+func (mdb *db) InsertIntoHistoryNode(row *sqlplugin.HistoryNodeRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	// the row keeps its own copy of txn_id, callers are free to reuse the pointer
+	txnID := *row.TxnID
+	stored := *row
+	stored.TxnID = &txnID
+	key := historyNodeKey{
+		shardID:  row.ShardID,
+		treeID:   string(row.TreeID),
+		branchID: string(row.BranchID),
+		nodeID:   row.NodeID,
+		txnID:    txnID,
+	}
+	return mdb.insert(mdb.store.historyNode, key, stored)
+}
+
+// SelectFromHistoryNode reads one or more rows from history_node table, rows of the
+// same node are ordered by txn_id descending
+func (mdb *db) SelectFromHistoryNode(filter *sqlplugin.HistoryNodeFilter) ([]sqlplugin.HistoryNodeRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	var rows []sqlplugin.HistoryNodeRow
+	for _, r := range mdb.store.historyNode.rows {
+		row := r.(sqlplugin.HistoryNodeRow)
+		if isHistoryNodeOf(row, filter) && row.NodeID >= *filter.MinNodeID && row.NodeID < *filter.MaxNodeID {
+			txnID := *row.TxnID
+			row.TxnID = &txnID
+			rows = append(rows, row)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].NodeID != rows[j].NodeID {
+			return rows[i].NodeID < rows[j].NodeID
+		}
+		return *rows[i].TxnID > *rows[j].TxnID
+	})
+	if len(rows) > *filter.PageSize {
+		rows = rows[:*filter.PageSize]
+	}
+	return rows, nil
+}
+
+// DeleteFromHistoryNode deletes one or more rows from history_node table
+func (mdb *db) DeleteFromHistoryNode(filter *sqlplugin.HistoryNodeFilter) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	return mdb.deleteWhere(mdb.store.historyNode, func(_ interface{}, r interface{}) bool {
+		row := r.(sqlplugin.HistoryNodeRow)
+		return isHistoryNodeOf(row, filter) && row.NodeID >= *filter.MinNodeID
+	}), nil
+}
+
+func isHistoryNodeOf(row sqlplugin.HistoryNodeRow, filter *sqlplugin.HistoryNodeFilter) bool {
+	return row.ShardID == filter.ShardID &&
+		bytes.Equal(row.TreeID, filter.TreeID) &&
+		bytes.Equal(row.BranchID, filter.BranchID)
+}
+
+// For history_tree table:
+
+// InsertIntoHistoryTree inserts a row into history_tree table
+func (mdb *db) InsertIntoHistoryTree(row *sqlplugin.HistoryTreeRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	key := historyTreeKey{shardID: row.ShardID, treeID: string(row.TreeID), branchID: string(row.BranchID)}
+	return mdb.insert(mdb.store.historyTree, key, *row)
+}
+
+// SelectFromHistoryTree reads one or more rows from history_tree table
+func (mdb *db) SelectFromHistoryTree(filter *sqlplugin.HistoryTreeFilter) ([]sqlplugin.HistoryTreeRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	var rows []sqlplugin.HistoryTreeRow
+	for _, r := range mdb.store.historyTree.rows {
+		row := r.(sqlplugin.HistoryTreeRow)
+		if row.ShardID == filter.ShardID && bytes.Equal(row.TreeID, filter.TreeID) {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+// DeleteFromHistoryTree deletes one or more rows from history_tree table
+func (mdb *db) DeleteFromHistoryTree(filter *sqlplugin.HistoryTreeFilter) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	key := historyTreeKey{shardID: filter.ShardID, treeID: string(filter.TreeID), branchID: string(*filter.BranchID)}
+	return mdb.delete(mdb.store.historyTree, key), nil
+}
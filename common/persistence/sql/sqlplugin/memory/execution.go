@@ -0,0 +1,485 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/temporalio/temporal/common/persistence/sql/sqlplugin"
+	"github.com/temporalio/temporal/common/primitives"
+)
+
+type (
+	// executionKey identifies a run, it is the key of executions table and the prefix
+	// of the keys of every table that holds per run state
+	executionKey struct {
+		shardID     int64
+		namespaceID string
+		workflowID  string
+		runID       string
+	}
+
+	currentExecutionsKey struct {
+		shardID     int64
+		namespaceID string
+		workflowID  string
+	}
+
+	transferTasksKey struct {
+		shardID int
+		taskID  int64
+	}
+
+	timerTasksKey struct {
+		shardID             int
+		visibilityTimestamp timeKey
+		taskID              int64
+	}
+
+	bufferedEventsKey struct {
+		execution executionKey
+		id        int64
+	}
+
+	replicationTasksKey struct {
+		shardID int
+		taskID  int64
+	}
+
+	replicationTasksDLQKey struct {
+		sourceClusterName string
+		shardID           int
+		taskID            int64
+	}
+
+	// timeKey is a comparable representation of a point in time, time.Time
+	// values must not be compared with ==
+	timeKey struct {
+		sec  int64
+		nsec int
+	}
+)
+
+func newExecutionKey(shardID int64, namespaceID primitives.UUID, workflowID string, runID primitives.UUID) executionKey {
+	return executionKey{
+		shardID:     shardID,
+		namespaceID: string(namespaceID),
+		workflowID:  workflowID,
+		runID:       string(runID),
+	}
+}
+
+func newTimeKey(t time.Time) timeKey {
+	return timeKey{sec: t.Unix(), nsec: t.Nanosecond()}
+}
+
+// InsertIntoExecutions inserts a row into executions table
+func (mdb *db) InsertIntoExecutions(row *sqlplugin.ExecutionsRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	key := newExecutionKey(int64(row.ShardID), row.NamespaceID, row.WorkflowID, row.RunID)
+	return mdb.insert(mdb.store.executions, key, *row)
+}
+
+// UpdateExecutions updates a single row in executions table
+func (mdb *db) UpdateExecutions(row *sqlplugin.ExecutionsRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	key := newExecutionKey(int64(row.ShardID), row.NamespaceID, row.WorkflowID, row.RunID)
+	return mdb.update(mdb.store.executions, key, *row), nil
+}
+
+// SelectFromExecutions reads a single row from executions table
+func (mdb *db) SelectFromExecutions(filter *sqlplugin.ExecutionsFilter) (*sqlplugin.ExecutionsRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	key := newExecutionKey(int64(filter.ShardID), filter.NamespaceID, filter.WorkflowID, filter.RunID)
+	row, ok := mdb.store.executions.rows[key]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	result := row.(sqlplugin.ExecutionsRow)
+	return &result, nil
+}
+
+// DeleteFromExecutions deletes a single row from executions table
+func (mdb *db) DeleteFromExecutions(filter *sqlplugin.ExecutionsFilter) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	key := newExecutionKey(int64(filter.ShardID), filter.NamespaceID, filter.WorkflowID, filter.RunID)
+	return mdb.delete(mdb.store.executions, key), nil
+}
+
+// ReadLockExecutions acquires a write lock on a single row in executions table
+func (mdb *db) ReadLockExecutions(filter *sqlplugin.ExecutionsFilter) (int, error) {
+	return mdb.lockExecutions(filter)
+}
+
+// WriteLockExecutions acquires a write lock on a single row in executions table
+func (mdb *db) WriteLockExecutions(filter *sqlplugin.ExecutionsFilter) (int, error) {
+	return mdb.lockExecutions(filter)
+}
+
+// lockExecutions returns the next_event_id of the run, the row is locked along
+// with the rest of the database by the enclosing transaction
+func (mdb *db) lockExecutions(filter *sqlplugin.ExecutionsFilter) (int, error) {
+	row, err := mdb.SelectFromExecutions(filter)
+	if err != nil {
+		return 0, err
+	}
+	return int(row.NextEventID), nil
+}
+
+// InsertIntoCurrentExecutions inserts a single row into current_executions table
+func (mdb *db) InsertIntoCurrentExecutions(row *sqlplugin.CurrentExecutionsRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	key := currentExecutionsKey{shardID: row.ShardID, namespaceID: string(row.NamespaceID), workflowID: row.WorkflowID}
+	return mdb.insert(mdb.store.currentExecutions, key, *row)
+}
+
+// UpdateCurrentExecutions updates a single row in current_executions table
+func (mdb *db) UpdateCurrentExecutions(row *sqlplugin.CurrentExecutionsRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	key := currentExecutionsKey{shardID: row.ShardID, namespaceID: string(row.NamespaceID), workflowID: row.WorkflowID}
+	return mdb.update(mdb.store.currentExecutions, key, *row), nil
+}
+
+// SelectFromCurrentExecutions reads one or more rows from current_executions table
+func (mdb *db) SelectFromCurrentExecutions(filter *sqlplugin.CurrentExecutionsFilter) (*sqlplugin.CurrentExecutionsRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	row, ok := mdb.currentExecution(filter)
+	if !ok {
+		return &row, sql.ErrNoRows
+	}
+	return &row, nil
+}
+
+// DeleteFromCurrentExecutions deletes a single row in current_executions table
+func (mdb *db) DeleteFromCurrentExecutions(filter *sqlplugin.CurrentExecutionsFilter) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	row, ok := mdb.currentExecution(filter)
+	if !ok || row.RunID.String() != filter.RunID.String() {
+		return result(0), nil
+	}
+	key := currentExecutionsKey{shardID: filter.ShardID, namespaceID: string(filter.NamespaceID), workflowID: filter.WorkflowID}
+	return mdb.delete(mdb.store.currentExecutions, key), nil
+}
+
+// LockCurrentExecutions acquires a write lock on a single row in current_executions table
+func (mdb *db) LockCurrentExecutions(filter *sqlplugin.CurrentExecutionsFilter) (*sqlplugin.CurrentExecutionsRow, error) {
+	return mdb.SelectFromCurrentExecutions(filter)
+}
+
+// LockCurrentExecutionsJoinExecutions joins a row in current_executions with executions table and acquires a
+// write lock on the result
+func (mdb *db) LockCurrentExecutionsJoinExecutions(filter *sqlplugin.CurrentExecutionsFilter) ([]sqlplugin.CurrentExecutionsRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	row, ok := mdb.currentExecution(filter)
+	if !ok {
+		return nil, nil
+	}
+	execution, ok := mdb.store.executions.rows[newExecutionKey(row.ShardID, row.NamespaceID, row.WorkflowID, row.RunID)]
+	if !ok {
+		return nil, nil
+	}
+	row.LastWriteVersion = execution.(sqlplugin.ExecutionsRow).LastWriteVersion
+	return []sqlplugin.CurrentExecutionsRow{row}, nil
+}
+
+func (mdb *db) currentExecution(filter *sqlplugin.CurrentExecutionsFilter) (sqlplugin.CurrentExecutionsRow, bool) {
+	key := currentExecutionsKey{shardID: filter.ShardID, namespaceID: string(filter.NamespaceID), workflowID: filter.WorkflowID}
+	row, ok := mdb.store.currentExecutions.rows[key]
+	if !ok {
+		return sqlplugin.CurrentExecutionsRow{}, false
+	}
+	return row.(sqlplugin.CurrentExecutionsRow), true
+}
+
+// InsertIntoTransferTasks inserts one or more rows into transfer_tasks table
+func (mdb *db) InsertIntoTransferTasks(rows []sqlplugin.TransferTasksRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	keys := make([]interface{}, len(rows))
+	values := make([]interface{}, len(rows))
+	for i, row := range rows {
+		keys[i] = transferTasksKey{shardID: row.ShardID, taskID: row.TaskID}
+		values[i] = row
+	}
+	return mdb.insertAll(mdb.store.transferTasks, keys, values)
+}
+
+// SelectFromTransferTasks reads one or more rows from transfer_tasks table
+func (mdb *db) SelectFromTransferTasks(filter *sqlplugin.TransferTasksFilter) ([]sqlplugin.TransferTasksRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	var rows []sqlplugin.TransferTasksRow
+	for _, r := range mdb.store.transferTasks.rows {
+		row := r.(sqlplugin.TransferTasksRow)
+		if row.ShardID == filter.ShardID && row.TaskID > *filter.MinTaskID && row.TaskID <= *filter.MaxTaskID {
+			rows = append(rows, row)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].TaskID < rows[j].TaskID
+	})
+	return rows, nil
+}
+
+// DeleteFromTransferTasks deletes one or more rows from transfer_tasks table
+func (mdb *db) DeleteFromTransferTasks(filter *sqlplugin.TransferTasksFilter) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	if filter.MinTaskID != nil {
+		return mdb.deleteWhere(mdb.store.transferTasks, func(_ interface{}, r interface{}) bool {
+			row := r.(sqlplugin.TransferTasksRow)
+			return row.ShardID == filter.ShardID && row.TaskID > *filter.MinTaskID && row.TaskID <= *filter.MaxTaskID
+		}), nil
+	}
+	return mdb.delete(mdb.store.transferTasks, transferTasksKey{shardID: filter.ShardID, taskID: *filter.TaskID}), nil
+}
+
+// InsertIntoTimerTasks inserts one or more rows into timer_tasks table
+func (mdb *db) InsertIntoTimerTasks(rows []sqlplugin.TimerTasksRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	keys := make([]interface{}, len(rows))
+	values := make([]interface{}, len(rows))
+	for i, row := range rows {
+		row.VisibilityTimestamp = row.VisibilityTimestamp.UTC()
+		keys[i] = timerTasksKey{shardID: row.ShardID, visibilityTimestamp: newTimeKey(row.VisibilityTimestamp), taskID: row.TaskID}
+		values[i] = row
+	}
+	return mdb.insertAll(mdb.store.timerTasks, keys, values)
+}
+
+// SelectFromTimerTasks reads one or more rows from timer_tasks table
+func (mdb *db) SelectFromTimerTasks(filter *sqlplugin.TimerTasksFilter) ([]sqlplugin.TimerTasksRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	minTimestamp := *filter.MinVisibilityTimestamp
+	maxTimestamp := *filter.MaxVisibilityTimestamp
+	var rows []sqlplugin.TimerTasksRow
+	for _, r := range mdb.store.timerTasks.rows {
+		row := r.(sqlplugin.TimerTasksRow)
+		if row.ShardID != filter.ShardID || !row.VisibilityTimestamp.Before(maxTimestamp) {
+			continue
+		}
+		if row.VisibilityTimestamp.After(minTimestamp) ||
+			(row.VisibilityTimestamp.Equal(minTimestamp) && row.TaskID >= filter.TaskID) {
+			rows = append(rows, row)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if !rows[i].VisibilityTimestamp.Equal(rows[j].VisibilityTimestamp) {
+			return rows[i].VisibilityTimestamp.Before(rows[j].VisibilityTimestamp)
+		}
+		return rows[i].TaskID < rows[j].TaskID
+	})
+	if len(rows) > *filter.PageSize {
+		rows = rows[:*filter.PageSize]
+	}
+	return rows, nil
+}
+
+// DeleteFromTimerTasks deletes one or more rows from timer_tasks table
+func (mdb *db) DeleteFromTimerTasks(filter *sqlplugin.TimerTasksFilter) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	if filter.MinVisibilityTimestamp != nil {
+		minTimestamp := *filter.MinVisibilityTimestamp
+		maxTimestamp := *filter.MaxVisibilityTimestamp
+		return mdb.deleteWhere(mdb.store.timerTasks, func(_ interface{}, r interface{}) bool {
+			row := r.(sqlplugin.TimerTasksRow)
+			return row.ShardID == filter.ShardID &&
+				!row.VisibilityTimestamp.Before(minTimestamp) &&
+				row.VisibilityTimestamp.Before(maxTimestamp)
+		}), nil
+	}
+	key := timerTasksKey{shardID: filter.ShardID, visibilityTimestamp: newTimeKey(*filter.VisibilityTimestamp), taskID: filter.TaskID}
+	return mdb.delete(mdb.store.timerTasks, key), nil
+}
+
+// InsertIntoBufferedEvents inserts one or more rows into buffered_events table
+func (mdb *db) InsertIntoBufferedEvents(rows []sqlplugin.BufferedEventsRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	keys := make([]interface{}, len(rows))
+	values := make([]interface{}, len(rows))
+	for i, row := range rows {
+		mdb.store.nextBufferedEventID++
+		keys[i] = bufferedEventsKey{
+			execution: newExecutionKey(int64(row.ShardID), row.NamespaceID, row.WorkflowID, row.RunID),
+			id:        mdb.store.nextBufferedEventID,
+		}
+		values[i] = row
+	}
+	return mdb.insertAll(mdb.store.bufferedEvents, keys, values)
+}
+
+// SelectFromBufferedEvents reads one or more rows from buffered_events table
+func (mdb *db) SelectFromBufferedEvents(filter *sqlplugin.BufferedEventsFilter) ([]sqlplugin.BufferedEventsRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	execution := newExecutionKey(int64(filter.ShardID), filter.NamespaceID, filter.WorkflowID, filter.RunID)
+	var keys []bufferedEventsKey
+	for k := range mdb.store.bufferedEvents.rows {
+		key := k.(bufferedEventsKey)
+		if key.execution == execution {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].id < keys[j].id
+	})
+	var rows []sqlplugin.BufferedEventsRow
+	for _, key := range keys {
+		rows = append(rows, mdb.store.bufferedEvents.rows[key].(sqlplugin.BufferedEventsRow))
+	}
+	return rows, nil
+}
+
+// DeleteFromBufferedEvents deletes one or more rows from buffered_events table
+func (mdb *db) DeleteFromBufferedEvents(filter *sqlplugin.BufferedEventsFilter) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	execution := newExecutionKey(int64(filter.ShardID), filter.NamespaceID, filter.WorkflowID, filter.RunID)
+	return mdb.deleteWhere(mdb.store.bufferedEvents, func(k interface{}, _ interface{}) bool {
+		return k.(bufferedEventsKey).execution == execution
+	}), nil
+}
+
+// InsertIntoReplicationTasks inserts one or more rows into replication_tasks table
+func (mdb *db) InsertIntoReplicationTasks(rows []sqlplugin.ReplicationTasksRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	keys := make([]interface{}, len(rows))
+	values := make([]interface{}, len(rows))
+	for i, row := range rows {
+		keys[i] = replicationTasksKey{shardID: row.ShardID, taskID: row.TaskID}
+		values[i] = row
+	}
+	return mdb.insertAll(mdb.store.replicationTasks, keys, values)
+}
+
+// SelectFromReplicationTasks reads one or more rows from replication_tasks table
+func (mdb *db) SelectFromReplicationTasks(filter *sqlplugin.ReplicationTasksFilter) ([]sqlplugin.ReplicationTasksRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	var rows []sqlplugin.ReplicationTasksRow
+	for _, r := range mdb.store.replicationTasks.rows {
+		row := r.(sqlplugin.ReplicationTasksRow)
+		if row.ShardID == filter.ShardID && row.TaskID > filter.MinTaskID && row.TaskID <= filter.MaxTaskID {
+			rows = append(rows, row)
+		}
+	}
+	return sortAndLimitReplicationTasks(rows, filter.PageSize), nil
+}
+
+// DeleteFromReplicationTasks deletes one row from replication_tasks table
+func (mdb *db) DeleteFromReplicationTasks(filter *sqlplugin.ReplicationTasksFilter) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	return mdb.delete(mdb.store.replicationTasks, replicationTasksKey{shardID: filter.ShardID, taskID: filter.TaskID}), nil
+}
+
+// RangeDeleteFromReplicationTasks deletes multi rows from replication_tasks table
+func (mdb *db) RangeDeleteFromReplicationTasks(filter *sqlplugin.ReplicationTasksFilter) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	return mdb.deleteWhere(mdb.store.replicationTasks, func(_ interface{}, r interface{}) bool {
+		row := r.(sqlplugin.ReplicationTasksRow)
+		return row.ShardID == filter.ShardID && row.TaskID <= filter.InclusiveEndTaskID
+	}), nil
+}
+
+// InsertIntoReplicationTasksDLQ inserts one or more rows into replication_tasks_dlq table
+func (mdb *db) InsertIntoReplicationTasksDLQ(row *sqlplugin.ReplicationTaskDLQRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	key := replicationTasksDLQKey{sourceClusterName: row.SourceClusterName, shardID: row.ShardID, taskID: row.TaskID}
+	return mdb.insert(mdb.store.replicationTasksDLQ, key, *row)
+}
+
+// SelectFromReplicationTasksDLQ reads one or more rows from replication_tasks_dlq table
+func (mdb *db) SelectFromReplicationTasksDLQ(filter *sqlplugin.ReplicationTasksDLQFilter) ([]sqlplugin.ReplicationTasksRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	var rows []sqlplugin.ReplicationTasksRow
+	for _, r := range mdb.store.replicationTasksDLQ.rows {
+		row := r.(sqlplugin.ReplicationTaskDLQRow)
+		if row.SourceClusterName == filter.SourceClusterName && row.ShardID == filter.ShardID &&
+			row.TaskID > filter.MinTaskID && row.TaskID <= filter.MaxTaskID {
+			rows = append(rows, sqlplugin.ReplicationTasksRow{
+				ShardID:      row.ShardID,
+				TaskID:       row.TaskID,
+				Data:         row.Data,
+				DataEncoding: row.DataEncoding,
+			})
+		}
+	}
+	return sortAndLimitReplicationTasks(rows, filter.PageSize), nil
+}
+
+// DeleteMessageFromReplicationTasksDLQ deletes one row from replication_tasks_dlq table
+func (mdb *db) DeleteMessageFromReplicationTasksDLQ(
+	filter *sqlplugin.ReplicationTasksDLQFilter,
+) (sql.Result, error) {
+
+	mdb.lock()
+	defer mdb.unlock()
+	key := replicationTasksDLQKey{sourceClusterName: filter.SourceClusterName, shardID: filter.ShardID, taskID: filter.TaskID}
+	return mdb.delete(mdb.store.replicationTasksDLQ, key), nil
+}
+
+// RangeDeleteMessageFromReplicationTasksDLQ deletes one or more rows from replication_tasks_dlq table
+func (mdb *db) RangeDeleteMessageFromReplicationTasksDLQ(
+	filter *sqlplugin.ReplicationTasksDLQFilter,
+) (sql.Result, error) {
+
+	mdb.lock()
+	defer mdb.unlock()
+	return mdb.deleteWhere(mdb.store.replicationTasksDLQ, func(_ interface{}, r interface{}) bool {
+		row := r.(sqlplugin.ReplicationTaskDLQRow)
+		return row.SourceClusterName == filter.SourceClusterName && row.ShardID == filter.ShardID &&
+			row.TaskID > filter.TaskID && row.TaskID <= filter.InclusiveEndTaskID
+	}), nil
+}
+
+func sortAndLimitReplicationTasks(rows []sqlplugin.ReplicationTasksRow, pageSize int) []sqlplugin.ReplicationTasksRow {
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].TaskID < rows[j].TaskID
+	})
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+	}
+	return rows
+}
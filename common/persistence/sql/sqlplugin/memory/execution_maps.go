@@ -0,0 +1,302 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"database/sql"
+
+	"github.com/temporalio/temporal/common/persistence/sql/sqlplugin"
+)
+
+// executionMapKey is the key of the tables that hold a map per run, e.g. activity_info_maps
+// where key is the schedule_id of the activity
+type executionMapKey struct {
+	execution executionKey
+	key       interface{}
+}
+
+// replaceIntoMap replaces one row per key, rows[i] is stored under keys[i]
+func (mdb *db) replaceIntoMap(t *table, keys []executionMapKey, rows []interface{}) sql.Result {
+	var n int64
+	for i, key := range keys {
+		n += mdb.replace(t, key, rows[i])
+	}
+	return result(n)
+}
+
+// selectFromMap returns all the rows stored for the given run
+func (mdb *db) selectFromMap(t *table, execution executionKey) []interface{} {
+	var rows []interface{}
+	for k, row := range t.rows {
+		if k.(executionMapKey).execution == execution {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// deleteMap removes all the rows stored for the given run
+func (mdb *db) deleteMap(t *table, execution executionKey) sql.Result {
+	return mdb.deleteWhere(t, func(k interface{}, _ interface{}) bool {
+		return k.(executionMapKey).execution == execution
+	})
+}
+
+// ReplaceIntoActivityInfoMaps replaces one or more rows in activity_info_maps table
+func (mdb *db) ReplaceIntoActivityInfoMaps(rows []sqlplugin.ActivityInfoMapsRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	keys := make([]executionMapKey, len(rows))
+	values := make([]interface{}, len(rows))
+	for i, row := range rows {
+		keys[i] = executionMapKey{
+			execution: newExecutionKey(row.ShardID, row.NamespaceID, row.WorkflowID, row.RunID),
+			key:       row.ScheduleID,
+		}
+		values[i] = row
+	}
+	return mdb.replaceIntoMap(mdb.store.activityInfoMaps, keys, values), nil
+}
+
+// SelectFromActivityInfoMaps reads one or more rows from activity_info_maps table
+func (mdb *db) SelectFromActivityInfoMaps(filter *sqlplugin.ActivityInfoMapsFilter) ([]sqlplugin.ActivityInfoMapsRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	var rows []sqlplugin.ActivityInfoMapsRow
+	execution := newExecutionKey(filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
+	for _, row := range mdb.selectFromMap(mdb.store.activityInfoMaps, execution) {
+		rows = append(rows, row.(sqlplugin.ActivityInfoMapsRow))
+	}
+	return rows, nil
+}
+
+// DeleteFromActivityInfoMaps deletes one or more rows from activity_info_maps table
+func (mdb *db) DeleteFromActivityInfoMaps(filter *sqlplugin.ActivityInfoMapsFilter) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	execution := newExecutionKey(filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
+	if filter.ScheduleID != nil {
+		return mdb.delete(mdb.store.activityInfoMaps, executionMapKey{execution: execution, key: *filter.ScheduleID}), nil
+	}
+	return mdb.deleteMap(mdb.store.activityInfoMaps, execution), nil
+}
+
+// ReplaceIntoTimerInfoMaps replaces one or more rows in timer_info_maps table
+func (mdb *db) ReplaceIntoTimerInfoMaps(rows []sqlplugin.TimerInfoMapsRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	keys := make([]executionMapKey, len(rows))
+	values := make([]interface{}, len(rows))
+	for i, row := range rows {
+		keys[i] = executionMapKey{
+			execution: newExecutionKey(row.ShardID, row.NamespaceID, row.WorkflowID, row.RunID),
+			key:       row.TimerID,
+		}
+		values[i] = row
+	}
+	return mdb.replaceIntoMap(mdb.store.timerInfoMaps, keys, values), nil
+}
+
+// SelectFromTimerInfoMaps reads one or more rows from timer_info_maps table
+func (mdb *db) SelectFromTimerInfoMaps(filter *sqlplugin.TimerInfoMapsFilter) ([]sqlplugin.TimerInfoMapsRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	var rows []sqlplugin.TimerInfoMapsRow
+	execution := newExecutionKey(filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
+	for _, row := range mdb.selectFromMap(mdb.store.timerInfoMaps, execution) {
+		rows = append(rows, row.(sqlplugin.TimerInfoMapsRow))
+	}
+	return rows, nil
+}
+
+// DeleteFromTimerInfoMaps deletes one or more rows from timer_info_maps table
+func (mdb *db) DeleteFromTimerInfoMaps(filter *sqlplugin.TimerInfoMapsFilter) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	execution := newExecutionKey(filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
+	if filter.TimerID != nil {
+		return mdb.delete(mdb.store.timerInfoMaps, executionMapKey{execution: execution, key: *filter.TimerID}), nil
+	}
+	return mdb.deleteMap(mdb.store.timerInfoMaps, execution), nil
+}
+
+// ReplaceIntoChildExecutionInfoMaps replaces one or more rows in child_execution_info_maps table
+func (mdb *db) ReplaceIntoChildExecutionInfoMaps(rows []sqlplugin.ChildExecutionInfoMapsRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	keys := make([]executionMapKey, len(rows))
+	values := make([]interface{}, len(rows))
+	for i, row := range rows {
+		keys[i] = executionMapKey{
+			execution: newExecutionKey(row.ShardID, row.NamespaceID, row.WorkflowID, row.RunID),
+			key:       row.InitiatedID,
+		}
+		values[i] = row
+	}
+	return mdb.replaceIntoMap(mdb.store.childExecutionInfoMaps, keys, values), nil
+}
+
+// SelectFromChildExecutionInfoMaps reads one or more rows from child_execution_info_maps table
+func (mdb *db) SelectFromChildExecutionInfoMaps(filter *sqlplugin.ChildExecutionInfoMapsFilter) ([]sqlplugin.ChildExecutionInfoMapsRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	var rows []sqlplugin.ChildExecutionInfoMapsRow
+	execution := newExecutionKey(filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
+	for _, row := range mdb.selectFromMap(mdb.store.childExecutionInfoMaps, execution) {
+		rows = append(rows, row.(sqlplugin.ChildExecutionInfoMapsRow))
+	}
+	return rows, nil
+}
+
+// DeleteFromChildExecutionInfoMaps deletes one or more rows from child_execution_info_maps table
+func (mdb *db) DeleteFromChildExecutionInfoMaps(filter *sqlplugin.ChildExecutionInfoMapsFilter) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	execution := newExecutionKey(filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
+	if filter.InitiatedID != nil {
+		return mdb.delete(mdb.store.childExecutionInfoMaps, executionMapKey{execution: execution, key: *filter.InitiatedID}), nil
+	}
+	return mdb.deleteMap(mdb.store.childExecutionInfoMaps, execution), nil
+}
+
+// ReplaceIntoRequestCancelInfoMaps replaces one or more rows in request_cancel_info_maps table
+func (mdb *db) ReplaceIntoRequestCancelInfoMaps(rows []sqlplugin.RequestCancelInfoMapsRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	keys := make([]executionMapKey, len(rows))
+	values := make([]interface{}, len(rows))
+	for i, row := range rows {
+		keys[i] = executionMapKey{
+			execution: newExecutionKey(row.ShardID, row.NamespaceID, row.WorkflowID, row.RunID),
+			key:       row.InitiatedID,
+		}
+		values[i] = row
+	}
+	return mdb.replaceIntoMap(mdb.store.requestCancelInfoMaps, keys, values), nil
+}
+
+// SelectFromRequestCancelInfoMaps reads one or more rows from request_cancel_info_maps table
+func (mdb *db) SelectFromRequestCancelInfoMaps(filter *sqlplugin.RequestCancelInfoMapsFilter) ([]sqlplugin.RequestCancelInfoMapsRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	var rows []sqlplugin.RequestCancelInfoMapsRow
+	execution := newExecutionKey(filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
+	for _, row := range mdb.selectFromMap(mdb.store.requestCancelInfoMaps, execution) {
+		rows = append(rows, row.(sqlplugin.RequestCancelInfoMapsRow))
+	}
+	return rows, nil
+}
+
+// DeleteFromRequestCancelInfoMaps deletes one or more rows from request_cancel_info_maps table
+func (mdb *db) DeleteFromRequestCancelInfoMaps(filter *sqlplugin.RequestCancelInfoMapsFilter) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	execution := newExecutionKey(filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
+	if filter.InitiatedID != nil {
+		return mdb.delete(mdb.store.requestCancelInfoMaps, executionMapKey{execution: execution, key: *filter.InitiatedID}), nil
+	}
+	return mdb.deleteMap(mdb.store.requestCancelInfoMaps, execution), nil
+}
+
+// ReplaceIntoSignalInfoMaps replaces one or more rows in signal_info_maps table
+func (mdb *db) ReplaceIntoSignalInfoMaps(rows []sqlplugin.SignalInfoMapsRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	keys := make([]executionMapKey, len(rows))
+	values := make([]interface{}, len(rows))
+	for i, row := range rows {
+		keys[i] = executionMapKey{
+			execution: newExecutionKey(row.ShardID, row.NamespaceID, row.WorkflowID, row.RunID),
+			key:       row.InitiatedID,
+		}
+		values[i] = row
+	}
+	return mdb.replaceIntoMap(mdb.store.signalInfoMaps, keys, values), nil
+}
+
+// SelectFromSignalInfoMaps reads one or more rows from signal_info_maps table
+func (mdb *db) SelectFromSignalInfoMaps(filter *sqlplugin.SignalInfoMapsFilter) ([]sqlplugin.SignalInfoMapsRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	var rows []sqlplugin.SignalInfoMapsRow
+	execution := newExecutionKey(filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
+	for _, row := range mdb.selectFromMap(mdb.store.signalInfoMaps, execution) {
+		rows = append(rows, row.(sqlplugin.SignalInfoMapsRow))
+	}
+	return rows, nil
+}
+
+// DeleteFromSignalInfoMaps deletes one or more rows from signal_info_maps table
+func (mdb *db) DeleteFromSignalInfoMaps(filter *sqlplugin.SignalInfoMapsFilter) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	execution := newExecutionKey(filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
+	if filter.InitiatedID != nil {
+		return mdb.delete(mdb.store.signalInfoMaps, executionMapKey{execution: execution, key: *filter.InitiatedID}), nil
+	}
+	return mdb.deleteMap(mdb.store.signalInfoMaps, execution), nil
+}
+
+// InsertIntoSignalsRequestedSets inserts one or more rows into signals_requested_sets table,
+// rows that already exist are ignored
+func (mdb *db) InsertIntoSignalsRequestedSets(rows []sqlplugin.SignalsRequestedSetsRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	var n int64
+	for _, row := range rows {
+		key := executionMapKey{
+			execution: newExecutionKey(row.ShardID, row.NamespaceID, row.WorkflowID, row.RunID),
+			key:       row.SignalID,
+		}
+		if _, ok := mdb.store.signalsRequestedSets.rows[key]; !ok {
+			mdb.set(mdb.store.signalsRequestedSets, key, row)
+			n++
+		}
+	}
+	return result(n), nil
+}
+
+// SelectFromSignalsRequestedSets reads one or more rows from signals_requested_sets table
+func (mdb *db) SelectFromSignalsRequestedSets(filter *sqlplugin.SignalsRequestedSetsFilter) ([]sqlplugin.SignalsRequestedSetsRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	var rows []sqlplugin.SignalsRequestedSetsRow
+	execution := newExecutionKey(filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
+	for _, row := range mdb.selectFromMap(mdb.store.signalsRequestedSets, execution) {
+		rows = append(rows, row.(sqlplugin.SignalsRequestedSetsRow))
+	}
+	return rows, nil
+}
+
+// DeleteFromSignalsRequestedSets deletes one or more rows from signals_requested_sets table
+func (mdb *db) DeleteFromSignalsRequestedSets(filter *sqlplugin.SignalsRequestedSetsFilter) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	execution := newExecutionKey(filter.ShardID, filter.NamespaceID, filter.WorkflowID, filter.RunID)
+	if filter.SignalID != nil {
+		return mdb.delete(mdb.store.signalsRequestedSets, executionMapKey{execution: execution, key: *filter.SignalID}), nil
+	}
+	return mdb.deleteMap(mdb.store.signalsRequestedSets, execution), nil
+}
@@ -0,0 +1,184 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"sort"
+
+	"github.com/temporalio/temporal/common/persistence/sql/sqlplugin"
+)
+
+type (
+	namespacesKey struct {
+		id string
+	}
+
+	// namespaceMetadataKey is the key of the single row in namespace_metadata table,
+	// the row holds the notification version
+	namespaceMetadataKey struct{}
+)
+
+var errMissingArgs = errors.New("missing one or more args for API")
+
+// InsertIntoNamespace inserts a single row into namespaces table
+func (mdb *db) InsertIntoNamespace(row *sqlplugin.NamespaceRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	if _, ok := mdb.namespaceByName(row.Name); ok {
+		return nil, &dupEntryError{table: mdb.store.namespaces.name}
+	}
+	return mdb.insert(mdb.store.namespaces, namespacesKey{id: string(row.ID)}, *row)
+}
+
+// UpdateNamespace updates a single row in namespaces table
+func (mdb *db) UpdateNamespace(row *sqlplugin.NamespaceRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	key := namespacesKey{id: string(row.ID)}
+	old, ok := mdb.store.namespaces.rows[key]
+	if !ok {
+		return result(0), nil
+	}
+	if other, ok := mdb.namespaceByName(row.Name); ok && !bytes.Equal(other.ID, row.ID) {
+		return nil, &dupEntryError{table: mdb.store.namespaces.name}
+	}
+	updated := old.(sqlplugin.NamespaceRow)
+	updated.Name = row.Name
+	updated.Data = row.Data
+	updated.DataEncoding = row.DataEncoding
+	updated.NotificationVersion = row.NotificationVersion
+	return mdb.update(mdb.store.namespaces, key, updated), nil
+}
+
+// SelectFromNamespace reads one or more rows from namespaces table
+func (mdb *db) SelectFromNamespace(filter *sqlplugin.NamespaceFilter) ([]sqlplugin.NamespaceRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	switch {
+	case filter.ID != nil || filter.Name != nil:
+		return mdb.selectFromNamespace(filter)
+	case filter.PageSize != nil && *filter.PageSize > 0:
+		return mdb.selectAllFromNamespace(filter)
+	default:
+		return nil, errMissingArgs
+	}
+}
+
+func (mdb *db) selectFromNamespace(filter *sqlplugin.NamespaceFilter) ([]sqlplugin.NamespaceRow, error) {
+	var row sqlplugin.NamespaceRow
+	var ok bool
+	switch {
+	case filter.ID != nil:
+		var found interface{}
+		found, ok = mdb.store.namespaces.rows[namespacesKey{id: string(*filter.ID)}]
+		if ok {
+			row = found.(sqlplugin.NamespaceRow)
+		}
+	case filter.Name != nil:
+		row, ok = mdb.namespaceByName(*filter.Name)
+	}
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return []sqlplugin.NamespaceRow{row}, nil
+}
+
+func (mdb *db) selectAllFromNamespace(filter *sqlplugin.NamespaceFilter) ([]sqlplugin.NamespaceRow, error) {
+	var rows []sqlplugin.NamespaceRow
+	for _, r := range mdb.store.namespaces.rows {
+		row := r.(sqlplugin.NamespaceRow)
+		if filter.GreaterThanID != nil && bytes.Compare(row.ID, *filter.GreaterThanID) <= 0 {
+			continue
+		}
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return bytes.Compare(rows[i].ID, rows[j].ID) < 0
+	})
+	if len(rows) > *filter.PageSize {
+		rows = rows[:*filter.PageSize]
+	}
+	return rows, nil
+}
+
+// DeleteFromNamespace deletes a single row in namespaces table
+func (mdb *db) DeleteFromNamespace(filter *sqlplugin.NamespaceFilter) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	switch {
+	case filter.ID != nil:
+		return mdb.delete(mdb.store.namespaces, namespacesKey{id: string(*filter.ID)}), nil
+	case filter.Name != nil:
+		row, ok := mdb.namespaceByName(*filter.Name)
+		if !ok {
+			return result(0), nil
+		}
+		return mdb.delete(mdb.store.namespaces, namespacesKey{id: string(row.ID)}), nil
+	default:
+		return nil, errMissingArgs
+	}
+}
+
+// LockNamespaceMetadata acquires a write lock on a single row in namespace_metadata table
+func (mdb *db) LockNamespaceMetadata() error {
+	_, err := mdb.SelectFromNamespaceMetadata()
+	return err
+}
+
+// SelectFromNamespaceMetadata reads a single row in namespace_metadata table
+func (mdb *db) SelectFromNamespaceMetadata() (*sqlplugin.NamespaceMetadataRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	version, ok := mdb.store.namespaceMetadata.rows[namespaceMetadataKey{}]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &sqlplugin.NamespaceMetadataRow{NotificationVersion: version.(int64)}, nil
+}
+
+// UpdateNamespaceMetadata updates a single row in namespace_metadata table
+func (mdb *db) UpdateNamespaceMetadata(row *sqlplugin.NamespaceMetadataRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	version, ok := mdb.store.namespaceMetadata.rows[namespaceMetadataKey{}]
+	if !ok || version.(int64) != row.NotificationVersion {
+		return result(0), nil
+	}
+	return mdb.update(mdb.store.namespaceMetadata, namespaceMetadataKey{}, row.NotificationVersion+1), nil
+}
+
+// namespaceByName looks up a namespace through its unique name
+func (mdb *db) namespaceByName(name string) (sqlplugin.NamespaceRow, bool) {
+	for _, r := range mdb.store.namespaces.rows {
+		row := r.(sqlplugin.NamespaceRow)
+		if row.Name == name {
+			return row, true
+		}
+	}
+	return sqlplugin.NamespaceRow{}, false
+}
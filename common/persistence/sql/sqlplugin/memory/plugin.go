@@ -0,0 +1,85 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"errors"
+
+	pt "github.com/temporalio/temporal/common/persistence/persistence-tests"
+	"github.com/temporalio/temporal/common/persistence/sql"
+	"github.com/temporalio/temporal/common/persistence/sql/sqlplugin"
+	"github.com/temporalio/temporal/common/service/config"
+)
+
+const (
+	// PluginName is the name of the plugin
+	PluginName = "memory"
+)
+
+var errTLSNotSupported = errors.New("tls is not supported by the memory plugin since data never leaves the process")
+
+type plugin struct{}
+
+var _ sqlplugin.Plugin = (*plugin)(nil)
+
+func init() {
+	sql.RegisterPlugin(PluginName, &plugin{})
+}
+
+// CreateDB initialize the db object. Connections opened with the same database name
+// share their data, the database is created on first use so that a server can be
+// started without running the schema tool.
+func (p *plugin) CreateDB(cfg *config.SQL) (sqlplugin.DB, error) {
+	if cfg.TLS != nil && cfg.TLS.Enabled {
+		return nil, errTLSNotSupported
+	}
+	db := newDB(databases.open(cfg.DatabaseName), nil)
+	return db, nil
+}
+
+// CreateAdminDB initialize the adminDB object
+func (p *plugin) CreateAdminDB(cfg *config.SQL) (sqlplugin.AdminDB, error) {
+	if cfg.TLS != nil && cfg.TLS.Enabled {
+		return nil, errTLSNotSupported
+	}
+	db := newDB(databases.open(cfg.DatabaseName), nil)
+	return db, nil
+}
+
+const (
+	// testSchemaDir is only read by the test cluster, statements executed through
+	// the admin connection are ignored by this plugin
+	testSchemaDir = "schema/mysql/v57"
+)
+
+// GetTestClusterOption return test options
+func GetTestClusterOption() *pt.TestBaseOptions {
+	return &pt.TestBaseOptions{
+		SQLDBPluginName: PluginName,
+		DBHost:          "127.0.0.1",
+		SchemaDir:       testSchemaDir,
+		StoreType:       config.StoreTypeSQL,
+	}
+}
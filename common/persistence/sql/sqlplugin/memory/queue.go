@@ -0,0 +1,185 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"database/sql"
+	"encoding/json"
+	"sort"
+
+	"github.com/temporalio/temporal/common/persistence"
+	"github.com/temporalio/temporal/common/persistence/sql/sqlplugin"
+)
+
+type (
+	queueKey struct {
+		queueType persistence.QueueType
+		messageID int64
+	}
+
+	queueMetadataKey struct {
+		queueType persistence.QueueType
+	}
+)
+
+// InsertIntoQueue inserts a new row into queue table
+func (mdb *db) InsertIntoQueue(row *sqlplugin.QueueRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	return mdb.insert(mdb.store.queue, queueKey{queueType: row.QueueType, messageID: row.MessageID}, *row)
+}
+
+// GetLastEnqueuedMessageIDForUpdate returns the last enqueued message ID
+func (mdb *db) GetLastEnqueuedMessageIDForUpdate(queueType persistence.QueueType) (int64, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	var lastMessageID int64
+	found := false
+	for k := range mdb.store.queue.rows {
+		key := k.(queueKey)
+		if key.queueType == queueType && (!found || key.messageID > lastMessageID) {
+			lastMessageID = key.messageID
+			found = true
+		}
+	}
+	if !found {
+		return 0, sql.ErrNoRows
+	}
+	return lastMessageID, nil
+}
+
+// GetMessagesFromQueue retrieves messages from the queue
+func (mdb *db) GetMessagesFromQueue(queueType persistence.QueueType, lastMessageID int64, maxRows int) ([]sqlplugin.QueueRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	return mdb.selectFromQueue(queueType, func(messageID int64) bool {
+		return messageID > lastMessageID
+	}, maxRows), nil
+}
+
+// GetMessagesBetween retrieves messages from the queue
+func (mdb *db) GetMessagesBetween(queueType persistence.QueueType, firstMessageID int64, lastMessageID int64, maxRows int) ([]sqlplugin.QueueRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	return mdb.selectFromQueue(queueType, func(messageID int64) bool {
+		return messageID > firstMessageID && messageID <= lastMessageID
+	}, maxRows), nil
+}
+
+// DeleteMessagesBefore deletes messages before messageID from the queue
+func (mdb *db) DeleteMessagesBefore(queueType persistence.QueueType, messageID int64) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	return mdb.deleteFromQueue(queueType, func(id int64) bool {
+		return id < messageID
+	}), nil
+}
+
+// RangeDeleteMessages deletes messages before messageID from the queue
+func (mdb *db) RangeDeleteMessages(queueType persistence.QueueType, exclusiveBeginMessageID int64, inclusiveEndMessageID int64) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	return mdb.deleteFromQueue(queueType, func(id int64) bool {
+		return id > exclusiveBeginMessageID && id <= inclusiveEndMessageID
+	}), nil
+}
+
+// DeleteMessage deletes message with a messageID from the queue
+func (mdb *db) DeleteMessage(queueType persistence.QueueType, messageID int64) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	return mdb.delete(mdb.store.queue, queueKey{queueType: queueType, messageID: messageID}), nil
+}
+
+func (mdb *db) selectFromQueue(queueType persistence.QueueType, match func(messageID int64) bool, maxRows int) []sqlplugin.QueueRow {
+	var rows []sqlplugin.QueueRow
+	for _, r := range mdb.store.queue.rows {
+		row := r.(sqlplugin.QueueRow)
+		if row.QueueType == queueType && match(row.MessageID) {
+			rows = append(rows, row)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].MessageID < rows[j].MessageID
+	})
+	if len(rows) > maxRows {
+		rows = rows[:maxRows]
+	}
+	return rows
+}
+
+func (mdb *db) deleteFromQueue(queueType persistence.QueueType, match func(messageID int64) bool) sql.Result {
+	return mdb.deleteWhere(mdb.store.queue, func(k interface{}, _ interface{}) bool {
+		key := k.(queueKey)
+		return key.queueType == queueType && match(key.messageID)
+	})
+}
+
+// InsertAckLevel inserts ack level
+func (mdb *db) InsertAckLevel(queueType persistence.QueueType, messageID int64, clusterName string) error {
+	clusterAckLevels := map[string]int64{clusterName: messageID}
+	data, err := json.Marshal(clusterAckLevels)
+	if err != nil {
+		return err
+	}
+
+	mdb.lock()
+	defer mdb.unlock()
+	row := sqlplugin.QueueMetadataRow{QueueType: queueType, Data: data}
+	_, err = mdb.insert(mdb.store.queueMetadata, queueMetadataKey{queueType: queueType}, row)
+	return err
+}
+
+// UpdateAckLevels updates cluster ack levels
+func (mdb *db) UpdateAckLevels(queueType persistence.QueueType, clusterAckLevels map[string]int64) error {
+	data, err := json.Marshal(clusterAckLevels)
+	if err != nil {
+		return err
+	}
+
+	mdb.lock()
+	defer mdb.unlock()
+	row := sqlplugin.QueueMetadataRow{QueueType: queueType, Data: data}
+	mdb.update(mdb.store.queueMetadata, queueMetadataKey{queueType: queueType}, row)
+	return nil
+}
+
+// GetAckLevels returns ack levels for pulling clusters, the database is already
+// locked for the enclosing transaction when forUpdate is set
+func (mdb *db) GetAckLevels(queueType persistence.QueueType, forUpdate bool) (map[string]int64, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	row, ok := mdb.store.queueMetadata.rows[queueMetadataKey{queueType: queueType}]
+	if !ok {
+		return nil, nil
+	}
+
+	var clusterAckLevels map[string]int64
+	if err := json.Unmarshal(row.(sqlplugin.QueueMetadataRow).Data, &clusterAckLevels); err != nil {
+		return nil, err
+	}
+
+	return clusterAckLevels, nil
+}
@@ -0,0 +1,81 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"database/sql"
+
+	"github.com/temporalio/temporal/common/persistence/sql/sqlplugin"
+)
+
+type shardsKey struct {
+	shardID int64
+}
+
+// InsertIntoShards inserts one or more rows into shards table
+func (mdb *db) InsertIntoShards(row *sqlplugin.ShardsRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	return mdb.insert(mdb.store.shards, shardsKey{shardID: row.ShardID}, *row)
+}
+
+// UpdateShards updates one or more rows into shards table
+func (mdb *db) UpdateShards(row *sqlplugin.ShardsRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	return mdb.update(mdb.store.shards, shardsKey{shardID: row.ShardID}, *row), nil
+}
+
+// SelectFromShards reads one or more rows from shards table
+func (mdb *db) SelectFromShards(filter *sqlplugin.ShardsFilter) (*sqlplugin.ShardsRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	row, ok := mdb.store.shards.rows[shardsKey{shardID: filter.ShardID}]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	result := row.(sqlplugin.ShardsRow)
+	return &result, nil
+}
+
+// ReadLockShards acquires a read lock on a single row in shards table
+func (mdb *db) ReadLockShards(filter *sqlplugin.ShardsFilter) (int, error) {
+	return mdb.lockShards(filter)
+}
+
+// WriteLockShards acquires a write lock on a single row in shards table
+func (mdb *db) WriteLockShards(filter *sqlplugin.ShardsFilter) (int, error) {
+	return mdb.lockShards(filter)
+}
+
+// lockShards returns the range_id of the shard, the row is locked along with
+// the rest of the database by the enclosing transaction
+func (mdb *db) lockShards(filter *sqlplugin.ShardsFilter) (int, error) {
+	row, err := mdb.SelectFromShards(filter)
+	if err != nil {
+		return 0, err
+	}
+	return int(row.RangeID), nil
+}
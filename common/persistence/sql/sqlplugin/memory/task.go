@@ -0,0 +1,243 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"github.com/temporalio/temporal/common/persistence/sql/sqlplugin"
+)
+
+type (
+	tasksKey struct {
+		namespaceID  string
+		taskListName string
+		taskType     int64
+		taskID       int64
+	}
+
+	taskListsKey struct {
+		shardID     int
+		namespaceID string
+		name        string
+		taskType    int64
+	}
+)
+
+// InsertIntoTasks inserts one or more rows into tasks table
+func (mdb *db) InsertIntoTasks(rows []sqlplugin.TasksRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	keys := make([]interface{}, len(rows))
+	values := make([]interface{}, len(rows))
+	for i, row := range rows {
+		keys[i] = tasksKey{
+			namespaceID:  string(row.NamespaceID),
+			taskListName: row.TaskListName,
+			taskType:     row.TaskType,
+			taskID:       row.TaskID,
+		}
+		values[i] = row
+	}
+	return mdb.insertAll(mdb.store.tasks, keys, values)
+}
+
+// SelectFromTasks reads one or more rows from tasks table
+func (mdb *db) SelectFromTasks(filter *sqlplugin.TasksFilter) ([]sqlplugin.TasksRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	var rows []sqlplugin.TasksRow
+	for _, r := range mdb.store.tasks.rows {
+		row := r.(sqlplugin.TasksRow)
+		if !isTaskOf(row, filter) || row.TaskID <= *filter.MinTaskID {
+			continue
+		}
+		if filter.MaxTaskID != nil && row.TaskID > *filter.MaxTaskID {
+			continue
+		}
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i].TaskID < rows[j].TaskID
+	})
+	if len(rows) > *filter.PageSize {
+		rows = rows[:*filter.PageSize]
+	}
+	return rows, nil
+}
+
+// DeleteFromTasks deletes one or more rows from tasks table
+func (mdb *db) DeleteFromTasks(filter *sqlplugin.TasksFilter) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	if filter.TaskIDLessThanEquals != nil {
+		if filter.Limit == nil || *filter.Limit == 0 {
+			return nil, fmt.Errorf("missing limit parameter")
+		}
+		var keys []tasksKey
+		for k, r := range mdb.store.tasks.rows {
+			row := r.(sqlplugin.TasksRow)
+			if isTaskOf(row, filter) && row.TaskID <= *filter.TaskIDLessThanEquals {
+				keys = append(keys, k.(tasksKey))
+			}
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return keys[i].taskID < keys[j].taskID
+		})
+		if len(keys) > *filter.Limit {
+			keys = keys[:*filter.Limit]
+		}
+		for _, key := range keys {
+			mdb.remove(mdb.store.tasks, key)
+		}
+		return result(len(keys)), nil
+	}
+	key := tasksKey{
+		namespaceID:  string(filter.NamespaceID),
+		taskListName: filter.TaskListName,
+		taskType:     filter.TaskType,
+		taskID:       *filter.TaskID,
+	}
+	return mdb.delete(mdb.store.tasks, key), nil
+}
+
+func isTaskOf(row sqlplugin.TasksRow, filter *sqlplugin.TasksFilter) bool {
+	return bytes.Equal(row.NamespaceID, filter.NamespaceID) &&
+		row.TaskListName == filter.TaskListName &&
+		row.TaskType == filter.TaskType
+}
+
+// InsertIntoTaskLists inserts one or more rows into task_lists table
+func (mdb *db) InsertIntoTaskLists(row *sqlplugin.TaskListsRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	return mdb.insert(mdb.store.taskLists, newTaskListsKey(row), *row)
+}
+
+// ReplaceIntoTaskLists replaces one or more rows in task_lists table
+func (mdb *db) ReplaceIntoTaskLists(row *sqlplugin.TaskListsRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	return result(mdb.replace(mdb.store.taskLists, newTaskListsKey(row), *row)), nil
+}
+
+// UpdateTaskLists updates a row in task_lists table
+func (mdb *db) UpdateTaskLists(row *sqlplugin.TaskListsRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	return mdb.update(mdb.store.taskLists, newTaskListsKey(row), *row), nil
+}
+
+// SelectFromTaskLists reads one or more rows from task_lists table
+func (mdb *db) SelectFromTaskLists(filter *sqlplugin.TaskListsFilter) ([]sqlplugin.TaskListsRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	switch {
+	case filter.NamespaceID != nil && filter.Name != nil && filter.TaskType != nil:
+		return mdb.selectFromTaskLists(filter)
+	case filter.NamespaceIDGreaterThan != nil && filter.NameGreaterThan != nil && filter.TaskTypeGreaterThan != nil && filter.PageSize != nil:
+		return mdb.rangeSelectFromTaskLists(filter)
+	default:
+		return nil, fmt.Errorf("invalid set of query filter params")
+	}
+}
+
+func (mdb *db) selectFromTaskLists(filter *sqlplugin.TaskListsFilter) ([]sqlplugin.TaskListsRow, error) {
+	row, ok := mdb.store.taskLists.rows[taskListsKeyOf(filter)]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return []sqlplugin.TaskListsRow{row.(sqlplugin.TaskListsRow)}, nil
+}
+
+func (mdb *db) rangeSelectFromTaskLists(filter *sqlplugin.TaskListsFilter) ([]sqlplugin.TaskListsRow, error) {
+	var rows []sqlplugin.TaskListsRow
+	for _, r := range mdb.store.taskLists.rows {
+		row := r.(sqlplugin.TaskListsRow)
+		if row.ShardID == filter.ShardID &&
+			bytes.Compare(row.NamespaceID, *filter.NamespaceIDGreaterThan) > 0 &&
+			row.Name > *filter.NameGreaterThan &&
+			row.TaskType > *filter.TaskTypeGreaterThan {
+			rows = append(rows, row)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if c := bytes.Compare(rows[i].NamespaceID, rows[j].NamespaceID); c != 0 {
+			return c < 0
+		}
+		if rows[i].Name != rows[j].Name {
+			return rows[i].Name < rows[j].Name
+		}
+		return rows[i].TaskType < rows[j].TaskType
+	})
+	if len(rows) > *filter.PageSize {
+		rows = rows[:*filter.PageSize]
+	}
+	return rows, nil
+}
+
+// DeleteFromTaskLists deletes a row from task_lists table
+func (mdb *db) DeleteFromTaskLists(filter *sqlplugin.TaskListsFilter) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	key := taskListsKeyOf(filter)
+	row, ok := mdb.store.taskLists.rows[key]
+	if !ok || row.(sqlplugin.TaskListsRow).RangeID != *filter.RangeID {
+		return result(0), nil
+	}
+	return mdb.delete(mdb.store.taskLists, key), nil
+}
+
+// LockTaskLists locks a row in task_lists table
+func (mdb *db) LockTaskLists(filter *sqlplugin.TaskListsFilter) (int64, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	row, ok := mdb.store.taskLists.rows[taskListsKeyOf(filter)]
+	if !ok {
+		return 0, sql.ErrNoRows
+	}
+	return row.(sqlplugin.TaskListsRow).RangeID, nil
+}
+
+func newTaskListsKey(row *sqlplugin.TaskListsRow) taskListsKey {
+	return taskListsKey{
+		shardID:     row.ShardID,
+		namespaceID: string(row.NamespaceID),
+		name:        row.Name,
+		taskType:    row.TaskType,
+	}
+}
+
+func taskListsKeyOf(filter *sqlplugin.TaskListsFilter) taskListsKey {
+	return taskListsKey{
+		shardID:     filter.ShardID,
+		namespaceID: string(*filter.NamespaceID),
+		name:        *filter.Name,
+		taskType:    *filter.TaskType,
+	}
+}
@@ -0,0 +1,211 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package memory
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/temporalio/temporal/common/persistence/sql/sqlplugin"
+)
+
+type visibilityKey struct {
+	namespaceID string
+	runID       string
+}
+
+var errCloseParams = errors.New("missing one of {status, closeTime, historyLength} params")
+
+// InsertIntoVisibility inserts a row into visibility table. If an row already exist,
+// its left as such and no update will be made
+func (mdb *db) InsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	key := visibilityKey{namespaceID: row.NamespaceID, runID: row.RunID}
+	if _, ok := mdb.store.visibility.rows[key]; ok {
+		return result(0), nil
+	}
+	mdb.set(mdb.store.visibility, key, sqlplugin.VisibilityRow{
+		NamespaceID:      row.NamespaceID,
+		RunID:            row.RunID,
+		WorkflowTypeName: row.WorkflowTypeName,
+		WorkflowID:       row.WorkflowID,
+		StartTime:        row.StartTime.UTC(),
+		ExecutionTime:    row.ExecutionTime.UTC(),
+		Memo:             row.Memo,
+		Encoding:         row.Encoding,
+		TaskList:         row.TaskList,
+		SearchAttributes: row.SearchAttributes,
+	})
+	return result(1), nil
+}
+
+// ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
+func (mdb *db) ReplaceIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	if row.Status == nil || row.CloseTime == nil || row.HistoryLength == nil {
+		return nil, errCloseParams
+	}
+	mdb.lock()
+	defer mdb.unlock()
+	status := *row.Status
+	closeTime := row.CloseTime.UTC()
+	historyLength := *row.HistoryLength
+	key := visibilityKey{namespaceID: row.NamespaceID, runID: row.RunID}
+	return result(mdb.replace(mdb.store.visibility, key, sqlplugin.VisibilityRow{
+		NamespaceID:      row.NamespaceID,
+		RunID:            row.RunID,
+		WorkflowTypeName: row.WorkflowTypeName,
+		WorkflowID:       row.WorkflowID,
+		StartTime:        row.StartTime.UTC(),
+		ExecutionTime:    row.ExecutionTime.UTC(),
+		Status:           &status,
+		CloseTime:        &closeTime,
+		HistoryLength:    &historyLength,
+		Memo:             row.Memo,
+		Encoding:         row.Encoding,
+		TaskList:         row.TaskList,
+		SearchAttributes: row.SearchAttributes,
+	})), nil
+}
+
+// DeleteFromVisibility deletes a row from visibility table if it exist
+func (mdb *db) DeleteFromVisibility(filter *sqlplugin.VisibilityFilter) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	return mdb.delete(mdb.store.visibility, visibilityKey{namespaceID: filter.NamespaceID, runID: *filter.RunID}), nil
+}
+
+// SelectFromVisibility reads one or more rows from visibility table
+func (mdb *db) SelectFromVisibility(filter *sqlplugin.VisibilityFilter) ([]sqlplugin.VisibilityRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	switch {
+	case filter.MinStartTime == nil && filter.RunID != nil && filter.Closed:
+		row, ok := mdb.store.visibility.rows[visibilityKey{namespaceID: filter.NamespaceID, runID: *filter.RunID}]
+		if !ok || row.(sqlplugin.VisibilityRow).Status == nil {
+			return nil, sql.ErrNoRows
+		}
+		return []sqlplugin.VisibilityRow{row.(sqlplugin.VisibilityRow)}, nil
+	case filter.MinStartTime != nil:
+		return mdb.rangeSelectFromVisibility(filter), nil
+	default:
+		return nil, fmt.Errorf("invalid query filter")
+	}
+}
+
+// rangeSelectFromVisibility returns a page of open or closed runs started within
+// [MinStartTime, MaxStartTime], RunID and MaxStartTime identify the last row of
+// the previous page
+func (mdb *db) rangeSelectFromVisibility(filter *sqlplugin.VisibilityFilter) []sqlplugin.VisibilityRow {
+	closed := filter.Closed || filter.Status != nil
+	var rows []sqlplugin.VisibilityRow
+	for _, r := range mdb.store.visibility.rows {
+		row := r.(sqlplugin.VisibilityRow)
+		switch {
+		case row.NamespaceID != filter.NamespaceID,
+			(row.Status != nil) != closed,
+			filter.WorkflowID != nil && row.WorkflowID != *filter.WorkflowID,
+			filter.WorkflowTypeName != nil && row.WorkflowTypeName != *filter.WorkflowTypeName,
+			filter.Status != nil && *row.Status != *filter.Status,
+			row.StartTime.Before(*filter.MinStartTime),
+			row.StartTime.After(*filter.MaxStartTime),
+			row.RunID <= *filter.RunID && !row.StartTime.Before(*filter.MaxStartTime):
+			continue
+		}
+		rows = append(rows, row)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if !rows[i].StartTime.Equal(rows[j].StartTime) {
+			return rows[i].StartTime.After(rows[j].StartTime)
+		}
+		return rows[i].RunID < rows[j].RunID
+	})
+	if len(rows) > *filter.PageSize {
+		rows = rows[:*filter.PageSize]
+	}
+	return rows
+}
+
+// UpsertIntoVisibility inserts a row for an open workflow or updates the memo, task list
+// and search attributes of an existing row
+func (mdb *db) UpsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	key := visibilityKey{namespaceID: row.NamespaceID, runID: row.RunID}
+	upserted := sqlplugin.VisibilityRow{
+		NamespaceID:      row.NamespaceID,
+		RunID:            row.RunID,
+		WorkflowTypeName: row.WorkflowTypeName,
+		WorkflowID:       row.WorkflowID,
+		StartTime:        row.StartTime.UTC(),
+		ExecutionTime:    row.ExecutionTime.UTC(),
+	}
+	if r, ok := mdb.store.visibility.rows[key]; ok {
+		upserted = r.(sqlplugin.VisibilityRow)
+	}
+	upserted.Memo = row.Memo
+	upserted.Encoding = row.Encoding
+	upserted.TaskList = row.TaskList
+	upserted.SearchAttributes = row.SearchAttributes
+	return result(mdb.replace(mdb.store.visibility, key, upserted)), nil
+}
+
+// SelectFromVisibilityByQuery reads a page of rows that match a visibility query
+func (mdb *db) SelectFromVisibilityByQuery(filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	rows := mdb.queryVisibility(filter)
+	sort.Slice(rows, func(i, j int) bool {
+		return filter.Query.Less(&rows[i], &rows[j])
+	})
+	if filter.Offset >= len(rows) {
+		return nil, nil
+	}
+	rows = rows[filter.Offset:]
+	if len(rows) > filter.PageSize {
+		rows = rows[:filter.PageSize]
+	}
+	return rows, nil
+}
+
+// CountFromVisibility counts the rows that match a visibility query
+func (mdb *db) CountFromVisibility(filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	mdb.lock()
+	defer mdb.unlock()
+	return int64(len(mdb.queryVisibility(filter))), nil
+}
+
+func (mdb *db) queryVisibility(filter *sqlplugin.VisibilityQueryFilter) []sqlplugin.VisibilityRow {
+	var rows []sqlplugin.VisibilityRow
+	for _, r := range mdb.store.visibility.rows {
+		row := r.(sqlplugin.VisibilityRow)
+		if row.NamespaceID == filter.NamespaceID && filter.Query.Match(&row) {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
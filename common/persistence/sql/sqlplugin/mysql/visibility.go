@@ -28,18 +28,27 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/temporalio/temporal/common/persistence/sql/sqlplugin"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT IGNORE INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
 
 	templateCreateWorkflowExecutionClosed = `REPLACE INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding, task_list, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE memo = VALUES(memo),
+		  encoding = VALUES(encoding),
+		  task_list = VALUES(task_list),
+		  search_attributes = VALUES(search_attributes)`
 
 	// RunID condition is needed for correct pagination
 	templateConditions = ` AND namespace_id = ?
@@ -49,7 +58,7 @@ const (
          ORDER BY start_time DESC, run_id
          LIMIT ?`
 
-	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding`
+	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, search_attributes`
 	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE status IS NULL `
 
 	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, status, history_length
@@ -69,14 +78,23 @@ const (
 
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND status = ?` + templateConditions
 
-	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length 
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length, task_list, search_attributes 
 		 FROM executions_visibility
 		 WHERE namespace_id = ? AND status IS NOT NULL
 		 AND run_id = ?`
 
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE namespace_id=? AND run_id=?"
+
+	// the where and order by clauses are built from a sqlplugin.VisibilityQuery
+	templateGetWorkflowExecutionsByQuery = `SELECT ` + templateOpenFieldNames + `, close_time, status, history_length
+		 FROM executions_visibility WHERE %v ORDER BY %v LIMIT ? OFFSET ?`
+
+	templateCountWorkflowExecutionsByQuery = `SELECT COUNT(*) FROM executions_visibility WHERE %v`
 )
 
+// queryDialect renders visibility queries for MySQL, search attributes are read from the JSON column
+type queryDialect struct{}
+
 var errCloseParams = errors.New("missing one of {status, closeTime, historyLength} params")
 
 // InsertIntoVisibility inserts a row into visibility table. If an row already exist,
@@ -91,7 +109,9 @@ func (mdb *db) InsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, e
 		row.ExecutionTime,
 		row.WorkflowTypeName,
 		row.Memo,
-		row.Encoding)
+		row.Encoding,
+		row.TaskList,
+		searchAttributesArg(row.SearchAttributes))
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -111,7 +131,9 @@ func (mdb *db) ReplaceIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result,
 			*row.Status,
 			*row.HistoryLength,
 			row.Memo,
-			row.Encoding)
+			row.Encoding,
+			row.TaskList,
+			searchAttributesArg(row.SearchAttributes))
 	default:
 		return nil, errCloseParams
 	}
@@ -196,6 +218,56 @@ func (mdb *db) SelectFromVisibility(filter *sqlplugin.VisibilityFilter) ([]sqlpl
 	if err != nil {
 		return nil, err
 	}
+	mdb.fromMySQLVisibilityRows(rows)
+	return rows, err
+}
+
+// UpsertIntoVisibility inserts a row for an open workflow or updates the memo, task list
+// and search attributes of an existing row
+func (mdb *db) UpsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	return mdb.conn.Exec(templateUpsertWorkflowExecution,
+		row.NamespaceID,
+		row.WorkflowID,
+		row.RunID,
+		mdb.converter.ToMySQLDateTime(row.StartTime),
+		mdb.converter.ToMySQLDateTime(row.ExecutionTime),
+		row.WorkflowTypeName,
+		row.Memo,
+		row.Encoding,
+		row.TaskList,
+		searchAttributesArg(row.SearchAttributes))
+}
+
+// SelectFromVisibilityByQuery reads a page of rows that match a visibility query
+func (mdb *db) SelectFromVisibilityByQuery(filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	where, orderBy, args := filter.Query.Build(filter.NamespaceID, queryDialect{})
+	args = append(mdb.toMySQLQueryArgs(args), filter.PageSize, filter.Offset)
+	var rows []sqlplugin.VisibilityRow
+	if err := mdb.conn.Select(&rows, fmt.Sprintf(templateGetWorkflowExecutionsByQuery, where, orderBy), args...); err != nil {
+		return nil, err
+	}
+	mdb.fromMySQLVisibilityRows(rows)
+	return rows, nil
+}
+
+// CountFromVisibility counts the rows that match a visibility query
+func (mdb *db) CountFromVisibility(filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	where, _, args := filter.Query.Build(filter.NamespaceID, queryDialect{})
+	var count int64
+	err := mdb.conn.Get(&count, fmt.Sprintf(templateCountWorkflowExecutionsByQuery, where), mdb.toMySQLQueryArgs(args)...)
+	return count, err
+}
+
+func (mdb *db) toMySQLQueryArgs(args []interface{}) []interface{} {
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			args[i] = mdb.converter.ToMySQLDateTime(t)
+		}
+	}
+	return args
+}
+
+func (mdb *db) fromMySQLVisibilityRows(rows []sqlplugin.VisibilityRow) {
 	for i := range rows {
 		rows[i].StartTime = mdb.converter.FromMySQLDateTime(rows[i].StartTime)
 		rows[i].ExecutionTime = mdb.converter.FromMySQLDateTime(rows[i].ExecutionTime)
@@ -204,5 +276,33 @@ func (mdb *db) SelectFromVisibility(filter *sqlplugin.VisibilityFilter) ([]sqlpl
 			rows[i].CloseTime = &closeTime
 		}
 	}
-	return rows, err
+}
+
+// searchAttributesArg binds search attributes as text, MySQL refuses to build JSON from binary strings
+func searchAttributesArg(data []byte) interface{} {
+	if data == nil {
+		return nil
+	}
+	return string(data)
+}
+
+// Placeholder returns the bind variable of the n-th argument
+func (queryDialect) Placeholder(n int) string {
+	return "?"
+}
+
+// SearchAttribute extracts a search attribute from the search_attributes column
+func (queryDialect) SearchAttribute(name string, valueType sqlplugin.SearchAttributeType) string {
+	value := fmt.Sprintf("JSON_EXTRACT(search_attributes, '$.%v')", name)
+	switch valueType {
+	case sqlplugin.SearchAttributeString, sqlplugin.SearchAttributeBool:
+		return "JSON_UNQUOTE(" + value + ")"
+	default:
+		return value
+	}
+}
+
+// SearchAttributeContains matches a keyword search attribute or any value of a keyword list
+func (queryDialect) SearchAttributeContains(name string, placeholder string) string {
+	return fmt.Sprintf("JSON_CONTAINS(search_attributes, JSON_QUOTE(%v), '$.%v')", placeholder, name)
 }
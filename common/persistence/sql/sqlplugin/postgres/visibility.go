@@ -29,19 +29,20 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/temporalio/temporal/common/persistence/sql/sqlplugin"
 )
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
          ON CONFLICT (namespace_id, run_id) DO NOTHING`
 
 	templateCreateWorkflowExecutionClosed = `INSERT INTO executions_visibility (` +
-		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding) ` +
-		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding, task_list, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 		ON CONFLICT (namespace_id, run_id) DO UPDATE 
 		  SET workflow_id = excluded.workflow_id,
 		      start_time = excluded.start_time,
@@ -51,7 +52,18 @@ const (
 			  status = excluded.status,
 			  history_length = excluded.history_length,
 			  memo = excluded.memo,
-			  encoding = excluded.encoding`
+			  encoding = excluded.encoding,
+			  task_list = excluded.task_list,
+			  search_attributes = excluded.search_attributes`
+
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, search_attributes) ` +
+		`VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (namespace_id, run_id) DO UPDATE
+		  SET memo = excluded.memo,
+			  encoding = excluded.encoding,
+			  task_list = excluded.task_list,
+			  search_attributes = excluded.search_attributes`
 
 	// RunID condition is needed for correct pagination
 	templateConditions1 = ` AND namespace_id = $1
@@ -68,7 +80,7 @@ const (
          ORDER BY start_time DESC, run_id
          LIMIT $7`
 
-	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding`
+	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, search_attributes`
 	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE status IS NULL `
 
 	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, status, history_length
@@ -88,14 +100,23 @@ const (
 
 	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND status = $1` + templateConditions2
 
-	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length 
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length, task_list, search_attributes 
 		 FROM executions_visibility
 		 WHERE namespace_id = $1 AND status IS NOT NULL
 		 AND run_id = $2`
 
 	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE namespace_id=$1 AND run_id=$2"
+
+	// the where and order by clauses are built from a sqlplugin.VisibilityQuery
+	templateGetWorkflowExecutionsByQuery = `SELECT ` + templateOpenFieldNames + `, close_time, status, history_length
+		 FROM executions_visibility WHERE %v ORDER BY %v LIMIT $%v OFFSET $%v`
+
+	templateCountWorkflowExecutionsByQuery = `SELECT COUNT(*) FROM executions_visibility WHERE %v`
 )
 
+// queryDialect renders visibility queries for Postgres, search attributes are read from the JSONB column
+type queryDialect struct{}
+
 var errCloseParams = errors.New("missing one of {status, closeTime, historyLength} params")
 
 // InsertIntoVisibility inserts a row into visibility table. If an row already exist,
@@ -110,7 +131,9 @@ func (pdb *db) InsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, e
 		row.ExecutionTime,
 		row.WorkflowTypeName,
 		row.Memo,
-		row.Encoding)
+		row.Encoding,
+		row.TaskList,
+		searchAttributesArg(row.SearchAttributes))
 }
 
 // ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
@@ -130,7 +153,9 @@ func (pdb *db) ReplaceIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result,
 			*row.Status,
 			*row.HistoryLength,
 			row.Memo,
-			row.Encoding)
+			row.Encoding,
+			row.TaskList,
+			searchAttributesArg(row.SearchAttributes))
 	default:
 		return nil, errCloseParams
 	}
@@ -217,6 +242,57 @@ func (pdb *db) SelectFromVisibility(filter *sqlplugin.VisibilityFilter) ([]sqlpl
 	if err != nil {
 		return nil, err
 	}
+	pdb.fromPostgresVisibilityRows(rows)
+	return rows, err
+}
+
+// UpsertIntoVisibility inserts a row for an open workflow or updates the memo, task list
+// and search attributes of an existing row
+func (pdb *db) UpsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	return pdb.conn.Exec(templateUpsertWorkflowExecution,
+		row.NamespaceID,
+		row.WorkflowID,
+		row.RunID,
+		pdb.converter.ToPostgresDateTime(row.StartTime),
+		pdb.converter.ToPostgresDateTime(row.ExecutionTime),
+		row.WorkflowTypeName,
+		row.Memo,
+		row.Encoding,
+		row.TaskList,
+		searchAttributesArg(row.SearchAttributes))
+}
+
+// SelectFromVisibilityByQuery reads a page of rows that match a visibility query
+func (pdb *db) SelectFromVisibilityByQuery(filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	where, orderBy, args := filter.Query.Build(filter.NamespaceID, queryDialect{})
+	args = append(pdb.toPostgresQueryArgs(args), filter.PageSize, filter.Offset)
+	qry := fmt.Sprintf(templateGetWorkflowExecutionsByQuery, where, orderBy, len(args)-1, len(args))
+	var rows []sqlplugin.VisibilityRow
+	if err := pdb.conn.Select(&rows, qry, args...); err != nil {
+		return nil, err
+	}
+	pdb.fromPostgresVisibilityRows(rows)
+	return rows, nil
+}
+
+// CountFromVisibility counts the rows that match a visibility query
+func (pdb *db) CountFromVisibility(filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	where, _, args := filter.Query.Build(filter.NamespaceID, queryDialect{})
+	var count int64
+	err := pdb.conn.Get(&count, fmt.Sprintf(templateCountWorkflowExecutionsByQuery, where), pdb.toPostgresQueryArgs(args)...)
+	return count, err
+}
+
+func (pdb *db) toPostgresQueryArgs(args []interface{}) []interface{} {
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			args[i] = pdb.converter.ToPostgresDateTime(t)
+		}
+	}
+	return args
+}
+
+func (pdb *db) fromPostgresVisibilityRows(rows []sqlplugin.VisibilityRow) {
 	for i := range rows {
 		rows[i].StartTime = pdb.converter.FromPostgresDateTime(rows[i].StartTime)
 		rows[i].ExecutionTime = pdb.converter.FromPostgresDateTime(rows[i].ExecutionTime)
@@ -227,5 +303,34 @@ func (pdb *db) SelectFromVisibility(filter *sqlplugin.VisibilityFilter) ([]sqlpl
 		rows[i].RunID = strings.TrimSpace(rows[i].RunID)
 		rows[i].WorkflowID = strings.TrimSpace(rows[i].WorkflowID)
 	}
-	return rows, err
+}
+
+// searchAttributesArg binds search attributes as text, []byte would be sent as BYTEA
+func searchAttributesArg(data []byte) interface{} {
+	if data == nil {
+		return nil
+	}
+	return string(data)
+}
+
+// Placeholder returns the bind variable of the n-th argument
+func (queryDialect) Placeholder(n int) string {
+	return fmt.Sprintf("$%v", n)
+}
+
+// SearchAttribute extracts a search attribute from the search_attributes column
+func (queryDialect) SearchAttribute(name string, valueType sqlplugin.SearchAttributeType) string {
+	switch valueType {
+	case sqlplugin.SearchAttributeString, sqlplugin.SearchAttributeBool:
+		return fmt.Sprintf("search_attributes->>'%v'", name)
+	case sqlplugin.SearchAttributeNumber:
+		return fmt.Sprintf("(search_attributes->>'%v')::numeric", name)
+	default:
+		return fmt.Sprintf("search_attributes->'%v'", name)
+	}
+}
+
+// SearchAttributeContains matches a keyword search attribute or any value of a keyword list
+func (queryDialect) SearchAttributeContains(name string, placeholder string) string {
+	return fmt.Sprintf("search_attributes->'%v' @> to_jsonb(%v::text)", name, placeholder)
 }
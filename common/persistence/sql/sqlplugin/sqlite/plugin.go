@@ -0,0 +1,177 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"sort"
+
+	"github.com/iancoleman/strcase"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/mattn/go-sqlite3" // needed to register the sqlite3 driver
+
+	"github.com/temporalio/temporal/common/persistence/sql"
+	"github.com/temporalio/temporal/common/persistence/sql/sqlplugin"
+	"github.com/temporalio/temporal/common/service/config"
+)
+
+const (
+	// PluginName is the name of the plugin
+	PluginName = "sqlite"
+	driverName = "sqlite3"
+	dsnFmt     = "file:%s"
+	// memoryDatabase is used for connections that are not bound to a database file, e.g.
+	// the admin connection used by the schema tool to create and drop databases
+	memoryDatabase = ":memory:"
+	// defaultFileExtension is appended to database names that have no extension
+	defaultFileExtension = ".db"
+)
+
+// dsnAttrOverrides are always applied, the sql persistence layer relies on every
+// transaction taking the write lock upfront, this is what SELECT ... FOR UPDATE
+// achieves on MySQL and Postgres
+var dsnAttrOverrides = map[string]string{
+	"_txlock": "immediate",
+}
+
+// dsnAttrDefaults can be overridden through the connect attributes
+var dsnAttrDefaults = map[string]string{
+	"_busy_timeout": "10000",
+	"_journal_mode": "WAL",
+}
+
+var errTLSNotSupported = errors.New("tls is not supported by sqlite since it is an embedded database")
+
+type plugin struct{}
+
+var _ sqlplugin.Plugin = (*plugin)(nil)
+
+func init() {
+	sql.RegisterPlugin(PluginName, &plugin{})
+}
+
+// CreateDB initialize the db object
+func (p *plugin) CreateDB(cfg *config.SQL) (sqlplugin.DB, error) {
+	conn, err := p.createDBConnection(cfg)
+	if err != nil {
+		return nil, err
+	}
+	db := newDB(conn, nil)
+	return db, nil
+}
+
+// CreateAdminDB initialize the adminDB object
+func (p *plugin) CreateAdminDB(cfg *config.SQL) (sqlplugin.AdminDB, error) {
+	conn, err := p.createDBConnection(cfg)
+	if err != nil {
+		return nil, err
+	}
+	db := newDB(conn, nil)
+	return db, nil
+}
+
+// createDBConnection opens the database file named by cfg.DatabaseName, the file is
+// created if it does not exist yet. ConnectAddr, User and Password are ignored since
+// sqlite is an embedded database. When no database name is given, a private in-memory
+// database is used.
+func (p *plugin) createDBConnection(cfg *config.SQL) (*sqlx.DB, error) {
+	if cfg.TLS != nil && cfg.TLS.Enabled {
+		return nil, errTLSNotSupported
+	}
+
+	db, err := sqlx.Connect(driverName, buildDSN(cfg))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.DatabaseName == "" {
+		// every connection to :memory: opens a new, empty database
+		db.SetMaxOpenConns(1)
+	} else if cfg.MaxConns > 0 {
+		db.SetMaxOpenConns(cfg.MaxConns)
+	}
+	if cfg.MaxIdleConns > 0 {
+		db.SetMaxIdleConns(cfg.MaxIdleConns)
+	}
+	if cfg.MaxConnLifetime > 0 {
+		db.SetConnMaxLifetime(cfg.MaxConnLifetime)
+	}
+
+	// Maps struct names in CamelCase to snake without need for db struct tags.
+	db.MapperFunc(strcase.ToSnake)
+	return db, nil
+}
+
+func buildDSN(cfg *config.SQL) string {
+	if cfg.DatabaseName == "" {
+		return memoryDatabase
+	}
+	dsn := fmt.Sprintf(dsnFmt, databasePath(cfg.DatabaseName))
+	if attrs := buildDSNAttrs(cfg); attrs != "" {
+		dsn = dsn + "?" + attrs
+	}
+	return dsn
+}
+
+func buildDSNAttrs(cfg *config.SQL) string {
+	attrs := make(map[string]string, len(dsnAttrDefaults)+len(dsnAttrOverrides)+len(cfg.ConnectAttributes))
+	for k, v := range dsnAttrDefaults {
+		attrs[k] = v
+	}
+	for k, v := range cfg.ConnectAttributes {
+		attrs[k] = v
+	}
+	for k, v := range dsnAttrOverrides {
+		attrs[k] = v
+	}
+
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	for i, k := range keys {
+		if i > 0 {
+			buf.WriteString("&")
+		}
+		buf.WriteString(url.QueryEscape(k))
+		buf.WriteString("=")
+		buf.WriteString(url.QueryEscape(attrs[k]))
+	}
+	return buf.String()
+}
+
+// databasePath returns the path of the file backing the given database
+func databasePath(name string) string {
+	if filepath.Ext(name) == "" {
+		return name + defaultFileExtension
+	}
+	return name
+}
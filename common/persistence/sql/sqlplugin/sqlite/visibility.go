@@ -0,0 +1,308 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/temporalio/temporal/common/persistence/sql/sqlplugin"
+)
+
+const (
+	templateCreateWorkflowExecutionStarted = `INSERT OR IGNORE INTO executions_visibility (` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateCreateWorkflowExecutionClosed = `REPLACE INTO executions_visibility (` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, close_time, status, history_length, memo, encoding, task_list, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	templateUpsertWorkflowExecution = `INSERT INTO executions_visibility (` +
+		`namespace_id, workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT (namespace_id, run_id) DO UPDATE
+		  SET memo = excluded.memo,
+		      encoding = excluded.encoding,
+		      task_list = excluded.task_list,
+		      search_attributes = excluded.search_attributes`
+
+	// RunID condition is needed for correct pagination
+	templateConditions = ` AND namespace_id = ?
+		 AND start_time >= ?
+		 AND start_time <= ?
+ 		 AND (run_id > ? OR start_time < ?)
+         ORDER BY start_time DESC, run_id
+         LIMIT ?`
+
+	templateOpenFieldNames = `workflow_id, run_id, start_time, execution_time, workflow_type_name, memo, encoding, task_list, search_attributes`
+	templateOpenSelect     = `SELECT ` + templateOpenFieldNames + ` FROM executions_visibility WHERE status IS NULL `
+
+	templateClosedSelect = `SELECT ` + templateOpenFieldNames + `, close_time, status, history_length
+		 FROM executions_visibility WHERE status IS NOT NULL `
+
+	templateGetOpenWorkflowExecutions = templateOpenSelect + templateConditions
+
+	templateGetClosedWorkflowExecutions = templateClosedSelect + templateConditions
+
+	templateGetOpenWorkflowExecutionsByType = templateOpenSelect + `AND workflow_type_name = ?` + templateConditions
+
+	templateGetClosedWorkflowExecutionsByType = templateClosedSelect + `AND workflow_type_name = ?` + templateConditions
+
+	templateGetOpenWorkflowExecutionsByID = templateOpenSelect + `AND workflow_id = ?` + templateConditions
+
+	templateGetClosedWorkflowExecutionsByID = templateClosedSelect + `AND workflow_id = ?` + templateConditions
+
+	templateGetClosedWorkflowExecutionsByStatus = templateClosedSelect + `AND status = ?` + templateConditions
+
+	templateGetClosedWorkflowExecution = `SELECT workflow_id, run_id, start_time, execution_time, memo, encoding, close_time, workflow_type_name, status, history_length, task_list, search_attributes 
+		 FROM executions_visibility
+		 WHERE namespace_id = ? AND status IS NOT NULL
+		 AND run_id = ?`
+
+	templateDeleteWorkflowExecution = "DELETE FROM executions_visibility WHERE namespace_id=? AND run_id=?"
+
+	// the where and order by clauses are built from a sqlplugin.VisibilityQuery
+	templateGetWorkflowExecutionsByQuery = `SELECT ` + templateOpenFieldNames + `, close_time, status, history_length
+		 FROM executions_visibility WHERE %v ORDER BY %v LIMIT ? OFFSET ?`
+
+	templateCountWorkflowExecutionsByQuery = `SELECT COUNT(*) FROM executions_visibility WHERE %v`
+)
+
+// queryDialect renders visibility queries for SQLite, search attributes are read with the json1 functions
+type queryDialect struct{}
+
+var errCloseParams = errors.New("missing one of {status, closeTime, historyLength} params")
+
+// InsertIntoVisibility inserts a row into visibility table. If an row already exist,
+// its left as such and no update will be made
+func (sdb *db) InsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	return sdb.conn.Exec(templateCreateWorkflowExecutionStarted,
+		row.NamespaceID,
+		row.WorkflowID,
+		row.RunID,
+		sdb.converter.ToSQLiteDateTime(row.StartTime),
+		sdb.converter.ToSQLiteDateTime(row.ExecutionTime),
+		row.WorkflowTypeName,
+		row.Memo,
+		row.Encoding,
+		row.TaskList,
+		searchAttributesArg(row.SearchAttributes))
+}
+
+// ReplaceIntoVisibility replaces an existing row if it exist or creates a new row in visibility table
+func (sdb *db) ReplaceIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	switch {
+	case row.Status != nil && row.CloseTime != nil && row.HistoryLength != nil:
+		return sdb.conn.Exec(templateCreateWorkflowExecutionClosed,
+			row.NamespaceID,
+			row.WorkflowID,
+			row.RunID,
+			sdb.converter.ToSQLiteDateTime(row.StartTime),
+			sdb.converter.ToSQLiteDateTime(row.ExecutionTime),
+			row.WorkflowTypeName,
+			sdb.converter.ToSQLiteDateTime(*row.CloseTime),
+			*row.Status,
+			*row.HistoryLength,
+			row.Memo,
+			row.Encoding,
+			row.TaskList,
+			searchAttributesArg(row.SearchAttributes))
+	default:
+		return nil, errCloseParams
+	}
+}
+
+// DeleteFromVisibility deletes a row from visibility table if it exist
+func (sdb *db) DeleteFromVisibility(filter *sqlplugin.VisibilityFilter) (sql.Result, error) {
+	return sdb.conn.Exec(templateDeleteWorkflowExecution, filter.NamespaceID, filter.RunID)
+}
+
+// SelectFromVisibility reads one or more rows from visibility table
+func (sdb *db) SelectFromVisibility(filter *sqlplugin.VisibilityFilter) ([]sqlplugin.VisibilityRow, error) {
+	var err error
+	var rows []sqlplugin.VisibilityRow
+	var minStartTime, maxStartTime string
+	if filter.MinStartTime != nil {
+		minStartTime = sdb.converter.ToSQLiteDateTime(*filter.MinStartTime)
+	}
+	if filter.MaxStartTime != nil {
+		maxStartTime = sdb.converter.ToSQLiteDateTime(*filter.MaxStartTime)
+	}
+	switch {
+	case filter.MinStartTime == nil && filter.RunID != nil && filter.Closed:
+		var row sqlplugin.VisibilityRow
+		err = sdb.conn.Get(&row, templateGetClosedWorkflowExecution, filter.NamespaceID, *filter.RunID)
+		if err == nil {
+			rows = append(rows, row)
+		}
+	case filter.MinStartTime != nil && filter.WorkflowID != nil:
+		qry := templateGetOpenWorkflowExecutionsByID
+		if filter.Closed {
+			qry = templateGetClosedWorkflowExecutionsByID
+		}
+		err = sdb.conn.Select(&rows,
+			qry,
+			*filter.WorkflowID,
+			filter.NamespaceID,
+			minStartTime,
+			maxStartTime,
+			*filter.RunID,
+			maxStartTime,
+			*filter.PageSize)
+	case filter.MinStartTime != nil && filter.WorkflowTypeName != nil:
+		qry := templateGetOpenWorkflowExecutionsByType
+		if filter.Closed {
+			qry = templateGetClosedWorkflowExecutionsByType
+		}
+		err = sdb.conn.Select(&rows,
+			qry,
+			*filter.WorkflowTypeName,
+			filter.NamespaceID,
+			minStartTime,
+			maxStartTime,
+			*filter.RunID,
+			maxStartTime,
+			*filter.PageSize)
+	case filter.MinStartTime != nil && filter.Status != nil:
+		err = sdb.conn.Select(&rows,
+			templateGetClosedWorkflowExecutionsByStatus,
+			*filter.Status,
+			filter.NamespaceID,
+			minStartTime,
+			maxStartTime,
+			*filter.RunID,
+			maxStartTime,
+			*filter.PageSize)
+	case filter.MinStartTime != nil:
+		qry := templateGetOpenWorkflowExecutions
+		if filter.Closed {
+			qry = templateGetClosedWorkflowExecutions
+		}
+		err = sdb.conn.Select(&rows,
+			qry,
+			filter.NamespaceID,
+			minStartTime,
+			maxStartTime,
+			*filter.RunID,
+			maxStartTime,
+			*filter.PageSize)
+	default:
+		return nil, fmt.Errorf("invalid query filter")
+	}
+	if err != nil {
+		return nil, err
+	}
+	sdb.fromSQLiteVisibilityRows(rows)
+	return rows, err
+}
+
+// UpsertIntoVisibility inserts a row for an open workflow or updates the memo, task list
+// and search attributes of an existing row
+func (sdb *db) UpsertIntoVisibility(row *sqlplugin.VisibilityRow) (sql.Result, error) {
+	return sdb.conn.Exec(templateUpsertWorkflowExecution,
+		row.NamespaceID,
+		row.WorkflowID,
+		row.RunID,
+		sdb.converter.ToSQLiteDateTime(row.StartTime),
+		sdb.converter.ToSQLiteDateTime(row.ExecutionTime),
+		row.WorkflowTypeName,
+		row.Memo,
+		row.Encoding,
+		row.TaskList,
+		searchAttributesArg(row.SearchAttributes))
+}
+
+// SelectFromVisibilityByQuery reads a page of rows that match a visibility query
+func (sdb *db) SelectFromVisibilityByQuery(filter *sqlplugin.VisibilityQueryFilter) ([]sqlplugin.VisibilityRow, error) {
+	where, orderBy, args := filter.Query.Build(filter.NamespaceID, queryDialect{})
+	args = append(sdb.toSQLiteQueryArgs(args), filter.PageSize, filter.Offset)
+	var rows []sqlplugin.VisibilityRow
+	if err := sdb.conn.Select(&rows, fmt.Sprintf(templateGetWorkflowExecutionsByQuery, where, orderBy), args...); err != nil {
+		return nil, err
+	}
+	sdb.fromSQLiteVisibilityRows(rows)
+	return rows, nil
+}
+
+// CountFromVisibility counts the rows that match a visibility query
+func (sdb *db) CountFromVisibility(filter *sqlplugin.VisibilityQueryFilter) (int64, error) {
+	where, _, args := filter.Query.Build(filter.NamespaceID, queryDialect{})
+	var count int64
+	err := sdb.conn.Get(&count, fmt.Sprintf(templateCountWorkflowExecutionsByQuery, where), sdb.toSQLiteQueryArgs(args)...)
+	return count, err
+}
+
+// toSQLiteQueryArgs converts times to the text they are stored as, so that they compare as stored
+func (sdb *db) toSQLiteQueryArgs(args []interface{}) []interface{} {
+	for i, arg := range args {
+		if t, ok := arg.(time.Time); ok {
+			args[i] = sdb.converter.ToSQLiteDateTime(t)
+		}
+	}
+	return args
+}
+
+func (sdb *db) fromSQLiteVisibilityRows(rows []sqlplugin.VisibilityRow) {
+	for i := range rows {
+		rows[i].StartTime = sdb.converter.FromSQLiteDateTime(rows[i].StartTime)
+		rows[i].ExecutionTime = sdb.converter.FromSQLiteDateTime(rows[i].ExecutionTime)
+		if rows[i].CloseTime != nil {
+			closeTime := sdb.converter.FromSQLiteDateTime(*rows[i].CloseTime)
+			rows[i].CloseTime = &closeTime
+		}
+	}
+}
+
+// searchAttributesArg binds search attributes as text, the json1 functions reject blobs
+func searchAttributesArg(data []byte) interface{} {
+	if data == nil {
+		return nil
+	}
+	return string(data)
+}
+
+// Placeholder returns the bind variable of the n-th argument
+func (queryDialect) Placeholder(n int) string {
+	return "?"
+}
+
+// SearchAttribute extracts a search attribute from the search_attributes column
+func (queryDialect) SearchAttribute(name string, valueType sqlplugin.SearchAttributeType) string {
+	if valueType == sqlplugin.SearchAttributeBool {
+		// json_extract returns booleans as 1 and 0
+		return fmt.Sprintf("json_type(search_attributes, '$.%v')", name)
+	}
+	return fmt.Sprintf("json_extract(search_attributes, '$.%v')", name)
+}
+
+// SearchAttributeContains matches a keyword search attribute or any value of a keyword list,
+// the condition is NULL when the search attribute is missing as it is for other comparisons
+func (queryDialect) SearchAttributeContains(name string, placeholder string) string {
+	return fmt.Sprintf("CASE WHEN json_type(search_attributes, '$.%[1]v') IS NULL THEN NULL "+
+		"ELSE EXISTS (SELECT 1 FROM json_each(search_attributes, '$.%[1]v') WHERE value = %[2]v) END", name, placeholder)
+}
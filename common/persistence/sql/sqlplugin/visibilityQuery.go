@@ -0,0 +1,749 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xwb1989/sqlparser"
+	executionpb "go.temporal.io/temporal-proto/execution"
+
+	"github.com/temporalio/temporal/common"
+	"github.com/temporalio/temporal/common/definition"
+)
+
+type (
+	// VisibilityQuery is a parsed visibility query, i.e. the where and order by clauses accepted by
+	// ListWorkflowExecutions, ScanWorkflowExecutions and CountWorkflowExecutions. The query language
+	// is the one of the Elasticsearch visibility store. Plugins render the query into their own SQL
+	// dialect with Build, every value of the query is bound to a placeholder.
+	VisibilityQuery struct {
+		condition queryCondition
+		orderBy   []queryOrder
+	}
+
+	// VisibilityQueryDialect renders the parts of a visibility query that differ between databases
+	VisibilityQueryDialect interface {
+		// Placeholder returns the bind variable of the n-th argument, n starts at 1
+		Placeholder(n int) string
+		// SearchAttribute returns an expression that extracts the named search attribute
+		// from the search_attributes column as a value of the given type
+		SearchAttribute(name string, valueType SearchAttributeType) string
+		// SearchAttributeContains returns a condition that holds when the named search attribute
+		// equals the string bound to placeholder, or is an array that contains it
+		SearchAttributeContains(name string, placeholder string) string
+	}
+
+	// SearchAttributeType is the type a search attribute is extracted as
+	SearchAttributeType int
+
+	queryCondition interface {
+		build(b *queryBuilder) string
+		match(r *queryRecord) bool
+	}
+
+	andCondition struct {
+		left, right queryCondition
+	}
+
+	orCondition struct {
+		left, right queryCondition
+	}
+
+	// comparisonCondition compares a field with a value of the type of the field, i.e.
+	// time.Time for time fields, int64 for int fields and string for string fields.
+	// Search attributes are compared with a string, int64, float64 or bool value.
+	comparisonCondition struct {
+		field    queryField
+		operator string
+		value    interface{}
+	}
+
+	// nullCondition checks a nullable column, it is what CloseTime = missing and
+	// ExecutionStatus = 'Running' translate to since open workflows have no close status
+	nullCondition struct {
+		field  queryField
+		isNull bool
+	}
+
+	queryField struct {
+		name     string
+		column   string
+		kind     fieldKind
+		nullable bool
+	}
+
+	fieldKind int
+
+	queryOrder struct {
+		field queryField
+		desc  bool
+	}
+
+	queryBuilder struct {
+		dialect VisibilityQueryDialect
+		args    []interface{}
+	}
+
+	// queryRecord is a visibility row with its search attributes decoded, it is used to
+	// evaluate a query in memory
+	queryRecord struct {
+		row        *VisibilityRow
+		attributes map[string]interface{}
+	}
+)
+
+// SearchAttributeType values
+const (
+	// SearchAttributeJSON extracts the search attribute as JSON, used for ordering
+	SearchAttributeJSON SearchAttributeType = iota
+	// SearchAttributeString extracts the search attribute as text
+	SearchAttributeString
+	// SearchAttributeNumber extracts the search attribute as a number
+	SearchAttributeNumber
+	// SearchAttributeBool extracts the search attribute as text, i.e. 'true' or 'false',
+	// boolean values are bound as text so that databases without a boolean type compare them the same way
+	SearchAttributeBool
+)
+
+const (
+	fieldKindString fieldKind = iota
+	fieldKindTime
+	fieldKindInt
+	fieldKindSearchAttribute
+)
+
+const (
+	visibilityQueryTemplate        = "select * from dummy where %s"
+	visibilityOrderByQueryTemplate = "select * from dummy %s"
+
+	// missingValue is the value of CloseTime for open workflows, e.g. CloseTime = missing
+	missingValue = "missing"
+
+	defaultDateTimeFormat = time.RFC3339
+)
+
+var (
+	// systemFields maps the system search attributes to their column in executions_visibility table
+	systemFields = map[string]queryField{
+		definition.WorkflowID:      {name: definition.WorkflowID, column: "workflow_id", kind: fieldKindString},
+		definition.RunID:           {name: definition.RunID, column: "run_id", kind: fieldKindString},
+		definition.WorkflowType:    {name: definition.WorkflowType, column: "workflow_type_name", kind: fieldKindString},
+		definition.TaskList:        {name: definition.TaskList, column: "task_list", kind: fieldKindString},
+		definition.StartTime:       {name: definition.StartTime, column: "start_time", kind: fieldKindTime},
+		definition.ExecutionTime:   {name: definition.ExecutionTime, column: "execution_time", kind: fieldKindTime},
+		definition.CloseTime:       {name: definition.CloseTime, column: "close_time", kind: fieldKindTime, nullable: true},
+		definition.ExecutionStatus: {name: definition.ExecutionStatus, column: "status", kind: fieldKindInt, nullable: true},
+		definition.HistoryLength:   {name: definition.HistoryLength, column: "history_length", kind: fieldKindInt, nullable: true},
+	}
+
+	defaultOrderBy = []queryOrder{
+		{field: systemFields[definition.StartTime], desc: true},
+		{field: systemFields[definition.RunID]},
+	}
+
+	// search attribute names end up in JSON paths, so they are restricted to identifiers
+	searchAttributeNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+	errInvalidQueryExpression = errors.New("only and, or, comparison, in and between expressions are supported")
+)
+
+// ParseVisibilityQuery parses the where and order by clauses of a visibility query. Custom
+// search attributes may be prefixed with Attr, which is what the frontend query validator
+// does, e.g. Attr.CustomKeywordField = 'value'.
+func ParseVisibilityQuery(query string) (*VisibilityQuery, error) {
+	query = strings.TrimSpace(query)
+	parsed := &VisibilityQuery{orderBy: defaultOrderBy}
+	if len(query) == 0 {
+		return parsed, nil
+	}
+
+	template := visibilityQueryTemplate
+	if common.IsJustOrderByClause(query) {
+		template = visibilityOrderByQueryTemplate
+	}
+	stmt, err := sqlparser.Parse(fmt.Sprintf(template, query))
+	if err != nil {
+		return nil, fmt.Errorf("invalid query: %v", err)
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, errors.New("invalid select query")
+	}
+	if sel.Limit != nil || sel.GroupBy != nil || sel.Having != nil {
+		return nil, errors.New("only where and order by clauses are supported")
+	}
+
+	if sel.Where != nil {
+		parsed.condition, err = convertWhereExpr(sel.Where.Expr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(sel.OrderBy) > 0 {
+		parsed.orderBy, err = convertOrderBy(sel.OrderBy)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return parsed, nil
+}
+
+// Build renders the query as a where condition restricted to the given namespace and an order by
+// clause, args holds the values bound to the placeholders of both in order. The order by clause
+// always ends with run_id so that the order is stable across pages.
+func (q *VisibilityQuery) Build(namespaceID string, dialect VisibilityQueryDialect) (where string, orderBy string, args []interface{}) {
+	b := &queryBuilder{dialect: dialect}
+	where = "namespace_id = " + b.bind(namespaceID)
+	if q.condition != nil {
+		where += " AND (" + q.condition.build(b) + ")"
+	}
+
+	var columns []string
+	for _, order := range q.orderBy {
+		direction := "ASC"
+		if order.desc {
+			direction = "DESC"
+		}
+		columns = append(columns, b.expression(order.field, SearchAttributeJSON)+" "+direction)
+	}
+	return where, strings.Join(columns, ", "), b.args
+}
+
+// Match evaluates the where clause of the query against a row, the namespace is not checked
+func (q *VisibilityQuery) Match(row *VisibilityRow) bool {
+	if q.condition == nil {
+		return true
+	}
+	return q.condition.match(newQueryRecord(row))
+}
+
+// Less reports whether row a sorts before row b according to the order by clause of the query
+func (q *VisibilityQuery) Less(a *VisibilityRow, b *VisibilityRow) bool {
+	recordA := newQueryRecord(a)
+	recordB := newQueryRecord(b)
+	for _, order := range q.orderBy {
+		valueA, okA := recordA.value(order.field)
+		valueB, okB := recordB.value(order.field)
+		cmp := 0
+		switch {
+		case !okA && !okB:
+		case !okA:
+			cmp = -1 // NULL sorts first in ascending order
+		case !okB:
+			cmp = 1
+		default:
+			cmp, _ = compareValues(valueA, valueB)
+		}
+		if cmp != 0 {
+			return (cmp < 0) != order.desc
+		}
+	}
+	return a.RunID < b.RunID
+}
+
+func convertWhereExpr(expr sqlparser.Expr) (queryCondition, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		left, right, err := convertBinaryExpr(expr.Left, expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &andCondition{left: left, right: right}, nil
+	case *sqlparser.OrExpr:
+		left, right, err := convertBinaryExpr(expr.Left, expr.Right)
+		if err != nil {
+			return nil, err
+		}
+		return &orCondition{left: left, right: right}, nil
+	case *sqlparser.ParenExpr:
+		return convertWhereExpr(expr.Expr)
+	case *sqlparser.ComparisonExpr:
+		return convertComparisonExpr(expr)
+	case *sqlparser.RangeCond:
+		return convertRangeCond(expr)
+	default:
+		return nil, errInvalidQueryExpression
+	}
+}
+
+func convertBinaryExpr(leftExpr sqlparser.Expr, rightExpr sqlparser.Expr) (queryCondition, queryCondition, error) {
+	left, err := convertWhereExpr(leftExpr)
+	if err != nil {
+		return nil, nil, err
+	}
+	right, err := convertWhereExpr(rightExpr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return left, right, nil
+}
+
+func convertComparisonExpr(expr *sqlparser.ComparisonExpr) (queryCondition, error) {
+	field, err := convertField(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+
+	switch expr.Operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		// x IN (a, b) is x = a OR x = b, x NOT IN (a, b) is x != a AND x != b
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok || len(tuple) == 0 {
+			return nil, fmt.Errorf("invalid value list for %s", field.name)
+		}
+		operator := sqlparser.EqualStr
+		if expr.Operator == sqlparser.NotInStr {
+			operator = sqlparser.NotEqualStr
+		}
+		var result queryCondition
+		for _, valExpr := range tuple {
+			condition, err := newComparison(field, operator, valExpr)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case result == nil:
+				result = condition
+			case operator == sqlparser.EqualStr:
+				result = &orCondition{left: result, right: condition}
+			default:
+				result = &andCondition{left: result, right: condition}
+			}
+		}
+		return result, nil
+	case sqlparser.EqualStr, sqlparser.NotEqualStr, sqlparser.LessThanStr, sqlparser.LessEqualStr,
+		sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+		return newComparison(field, expr.Operator, expr.Right)
+	default:
+		return nil, fmt.Errorf("operator %s is not supported", expr.Operator)
+	}
+}
+
+func convertRangeCond(expr *sqlparser.RangeCond) (queryCondition, error) {
+	field, err := convertField(expr.Left)
+	if err != nil {
+		return nil, err
+	}
+	fromOperator, toOperator := sqlparser.GreaterEqualStr, sqlparser.LessEqualStr
+	if expr.Operator == sqlparser.NotBetweenStr {
+		fromOperator, toOperator = sqlparser.LessThanStr, sqlparser.GreaterThanStr
+	}
+	from, err := newComparison(field, fromOperator, expr.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := newComparison(field, toOperator, expr.To)
+	if err != nil {
+		return nil, err
+	}
+	if expr.Operator == sqlparser.NotBetweenStr {
+		return &orCondition{left: from, right: to}, nil
+	}
+	return &andCondition{left: from, right: to}, nil
+}
+
+func newComparison(field queryField, operator string, valExpr sqlparser.Expr) (queryCondition, error) {
+	// CloseTime = missing, the value is parsed as a column name
+	if colName, ok := valExpr.(*sqlparser.ColName); ok && colName.Name.EqualString(missingValue) {
+		return newNullCondition(field, operator)
+	}
+
+	switch field.kind {
+	case fieldKindString:
+		value, ok := valExpr.(*sqlparser.SQLVal)
+		if !ok || value.Type != sqlparser.StrVal {
+			return nil, fmt.Errorf("invalid value for %s: %s", field.name, sqlparser.String(valExpr))
+		}
+		return &comparisonCondition{field: field, operator: operator, value: string(value.Val)}, nil
+	case fieldKindTime:
+		value, err := convertTimeValue(valExpr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %s: %v", field.name, err)
+		}
+		return &comparisonCondition{field: field, operator: operator, value: value}, nil
+	case fieldKindInt:
+		if field.name == definition.ExecutionStatus {
+			return convertStatusComparison(field, operator, valExpr)
+		}
+		value, err := convertIntValue(valExpr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %s: %v", field.name, err)
+		}
+		return &comparisonCondition{field: field, operator: operator, value: value}, nil
+	default:
+		value, err := convertSearchAttributeValue(valExpr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for %s: %v", field.name, err)
+		}
+		if _, ok := value.(bool); ok && operator != sqlparser.EqualStr && operator != sqlparser.NotEqualStr {
+			return nil, fmt.Errorf("operator %s is not supported for bool value of %s", operator, field.name)
+		}
+		return &comparisonCondition{field: field, operator: operator, value: value}, nil
+	}
+}
+
+func newNullCondition(field queryField, operator string) (queryCondition, error) {
+	if !field.nullable {
+		return nil, fmt.Errorf("%s can't be compared with %s", field.name, missingValue)
+	}
+	switch operator {
+	case sqlparser.EqualStr:
+		return &nullCondition{field: field, isNull: true}, nil
+	case sqlparser.NotEqualStr:
+		return &nullCondition{field: field, isNull: false}, nil
+	default:
+		return nil, fmt.Errorf("operator %s is not supported for %s", operator, missingValue)
+	}
+}
+
+// convertStatusComparison handles ExecutionStatus, the status of open workflows is NULL
+// so that comparing with Running is the same as comparing with missing
+func convertStatusComparison(field queryField, operator string, valExpr sqlparser.Expr) (queryCondition, error) {
+	status, err := convertStatusValue(valExpr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for %s: %v", field.name, err)
+	}
+	if status == executionpb.WorkflowExecutionStatus_Running {
+		return newNullCondition(field, operator)
+	}
+	return &comparisonCondition{field: field, operator: operator, value: int64(status)}, nil
+}
+
+func convertField(expr sqlparser.Expr) (queryField, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return queryField{}, fmt.Errorf("invalid search attribute: %s", sqlparser.String(expr))
+	}
+	name := colName.Name.String()
+	if colName.Qualifier.Name.String() == definition.Attr {
+		name = definition.Attr + "." + name
+	}
+	if field, ok := systemFields[name]; ok {
+		return field, nil
+	}
+	name = strings.TrimPrefix(name, definition.Attr+".")
+	if name == definition.NamespaceID || !searchAttributeNameRegex.MatchString(name) {
+		return queryField{}, fmt.Errorf("invalid search attribute: %s", name)
+	}
+	return queryField{name: name, kind: fieldKindSearchAttribute}, nil
+}
+
+func convertOrderBy(orderBy sqlparser.OrderBy) ([]queryOrder, error) {
+	var result []queryOrder
+	hasRunID := false
+	for _, order := range orderBy {
+		field, err := convertField(order.Expr)
+		if err != nil {
+			return nil, err
+		}
+		hasRunID = hasRunID || field.column == "run_id"
+		result = append(result, queryOrder{field: field, desc: order.Direction == sqlparser.DescScr})
+	}
+	if !hasRunID {
+		result = append(result, queryOrder{field: systemFields[definition.RunID]})
+	}
+	return result, nil
+}
+
+// convertTimeValue accepts unix nanoseconds or a RFC3339 string, like the Elasticsearch visibility store
+func convertTimeValue(expr sqlparser.Expr) (time.Time, error) {
+	value, ok := expr.(*sqlparser.SQLVal)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%s is not a time", sqlparser.String(expr))
+	}
+	if nanos, err := strconv.ParseInt(string(value.Val), 10, 64); err == nil {
+		return time.Unix(0, nanos).UTC(), nil
+	}
+	t, err := time.Parse(defaultDateTimeFormat, string(value.Val))
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
+}
+
+func convertIntValue(expr sqlparser.Expr) (int64, error) {
+	value, ok := expr.(*sqlparser.SQLVal)
+	if !ok || value.Type != sqlparser.IntVal {
+		return 0, fmt.Errorf("%s is not an integer", sqlparser.String(expr))
+	}
+	return strconv.ParseInt(string(value.Val), 10, 64)
+}
+
+// convertStatusValue accepts the number or the name of a status, e.g. 2 or 'Completed'
+func convertStatusValue(expr sqlparser.Expr) (executionpb.WorkflowExecutionStatus, error) {
+	value, ok := expr.(*sqlparser.SQLVal)
+	if !ok {
+		return 0, fmt.Errorf("%s is not a workflow execution status", sqlparser.String(expr))
+	}
+	if value.Type == sqlparser.IntVal {
+		status, err := strconv.ParseInt(string(value.Val), 10, 32)
+		if err != nil {
+			return 0, err
+		}
+		if _, ok := executionpb.WorkflowExecutionStatus_name[int32(status)]; !ok {
+			return 0, fmt.Errorf("unknown workflow execution status: %v", status)
+		}
+		return executionpb.WorkflowExecutionStatus(status), nil
+	}
+	for name, status := range executionpb.WorkflowExecutionStatus_value {
+		if strings.EqualFold(name, string(value.Val)) {
+			return executionpb.WorkflowExecutionStatus(status), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown workflow execution status: %s", value.Val)
+}
+
+func convertSearchAttributeValue(expr sqlparser.Expr) (interface{}, error) {
+	switch value := expr.(type) {
+	case sqlparser.BoolVal:
+		return bool(value), nil
+	case *sqlparser.SQLVal:
+		switch value.Type {
+		case sqlparser.StrVal:
+			return string(value.Val), nil
+		case sqlparser.IntVal:
+			return strconv.ParseInt(string(value.Val), 10, 64)
+		case sqlparser.FloatVal:
+			return strconv.ParseFloat(string(value.Val), 64)
+		}
+	}
+	return nil, fmt.Errorf("unsupported value %s", sqlparser.String(expr))
+}
+
+func (c *andCondition) build(b *queryBuilder) string {
+	return "(" + c.left.build(b) + " AND " + c.right.build(b) + ")"
+}
+
+func (c *andCondition) match(r *queryRecord) bool {
+	return c.left.match(r) && c.right.match(r)
+}
+
+func (c *orCondition) build(b *queryBuilder) string {
+	return "(" + c.left.build(b) + " OR " + c.right.build(b) + ")"
+}
+
+func (c *orCondition) match(r *queryRecord) bool {
+	return c.left.match(r) || c.right.match(r)
+}
+
+func (c *comparisonCondition) build(b *queryBuilder) string {
+	if value, ok := c.value.(string); ok && c.field.kind == fieldKindSearchAttribute &&
+		(c.operator == sqlparser.EqualStr || c.operator == sqlparser.NotEqualStr) {
+		// keyword lists match when any of their values does, like they do on Elasticsearch
+		condition := b.dialect.SearchAttributeContains(c.field.name, b.bind(value))
+		if c.operator == sqlparser.NotEqualStr {
+			return "NOT " + condition
+		}
+		return condition
+	}
+	value := c.value
+	if boolValue, ok := value.(bool); ok {
+		value = strconv.FormatBool(boolValue)
+	}
+	return b.expression(c.field, searchAttributeTypeOf(c.value)) + " " + c.operator + " " + b.bind(value)
+}
+
+func (c *comparisonCondition) match(r *queryRecord) bool {
+	actual, ok := r.value(c.field)
+	if !ok {
+		return false // comparing NULL yields NULL
+	}
+	if list, ok := actual.([]interface{}); ok {
+		if c.operator != sqlparser.EqualStr && c.operator != sqlparser.NotEqualStr {
+			return false
+		}
+		contains := false
+		for _, item := range list {
+			if cmp, ok := compareValues(item, c.value); ok && cmp == 0 {
+				contains = true
+			}
+		}
+		return contains == (c.operator == sqlparser.EqualStr)
+	}
+	cmp, ok := compareValues(actual, c.value)
+	if !ok {
+		return false
+	}
+	switch c.operator {
+	case sqlparser.EqualStr:
+		return cmp == 0
+	case sqlparser.NotEqualStr:
+		return cmp != 0
+	case sqlparser.LessThanStr:
+		return cmp < 0
+	case sqlparser.LessEqualStr:
+		return cmp <= 0
+	case sqlparser.GreaterThanStr:
+		return cmp > 0
+	case sqlparser.GreaterEqualStr:
+		return cmp >= 0
+	default:
+		return false
+	}
+}
+
+func (c *nullCondition) build(b *queryBuilder) string {
+	if c.isNull {
+		return c.field.column + " IS NULL"
+	}
+	return c.field.column + " IS NOT NULL"
+}
+
+func (c *nullCondition) match(r *queryRecord) bool {
+	_, ok := r.value(c.field)
+	return ok != c.isNull
+}
+
+func (b *queryBuilder) bind(value interface{}) string {
+	b.args = append(b.args, value)
+	return b.dialect.Placeholder(len(b.args))
+}
+
+func (b *queryBuilder) expression(field queryField, valueType SearchAttributeType) string {
+	if field.kind == fieldKindSearchAttribute {
+		return b.dialect.SearchAttribute(field.name, valueType)
+	}
+	return field.column
+}
+
+func searchAttributeTypeOf(value interface{}) SearchAttributeType {
+	switch value.(type) {
+	case string:
+		return SearchAttributeString
+	case int64, float64:
+		return SearchAttributeNumber
+	case bool:
+		return SearchAttributeBool
+	default:
+		return SearchAttributeJSON
+	}
+}
+
+func newQueryRecord(row *VisibilityRow) *queryRecord {
+	record := &queryRecord{row: row}
+	if len(row.SearchAttributes) > 0 {
+		// a row that can't be decoded behaves as if it had no search attributes
+		_ = json.Unmarshal(row.SearchAttributes, &record.attributes)
+	}
+	return record
+}
+
+// value returns the value of a field, false is returned for NULL
+func (r *queryRecord) value(field queryField) (interface{}, bool) {
+	switch field.column {
+	case "workflow_id":
+		return r.row.WorkflowID, true
+	case "run_id":
+		return r.row.RunID, true
+	case "workflow_type_name":
+		return r.row.WorkflowTypeName, true
+	case "task_list":
+		return r.row.TaskList, true
+	case "start_time":
+		return r.row.StartTime, true
+	case "execution_time":
+		return r.row.ExecutionTime, true
+	case "close_time":
+		if r.row.CloseTime == nil {
+			return nil, false
+		}
+		return *r.row.CloseTime, true
+	case "status":
+		if r.row.Status == nil {
+			return nil, false
+		}
+		return int64(*r.row.Status), true
+	case "history_length":
+		if r.row.HistoryLength == nil {
+			return nil, false
+		}
+		return *r.row.HistoryLength, true
+	}
+	value, ok := r.attributes[field.name]
+	return value, ok && value != nil
+}
+
+// compareValues compares two values of compatible types, false is returned when they can't be compared
+func compareValues(a interface{}, b interface{}) (int, bool) {
+	switch a := a.(type) {
+	case string:
+		if b, ok := b.(string); ok {
+			return strings.Compare(a, b), true
+		}
+	case time.Time:
+		if b, ok := b.(time.Time); ok {
+			switch {
+			case a.Before(b):
+				return -1, true
+			case a.After(b):
+				return 1, true
+			default:
+				return 0, true
+			}
+		}
+	case bool:
+		if b, ok := b.(bool); ok {
+			switch {
+			case a == b:
+				return 0, true
+			case !a:
+				return -1, true
+			default:
+				return 1, true
+			}
+		}
+	case int64, float64:
+		x, _ := toFloat(a)
+		if y, ok := toFloat(b); ok {
+			switch {
+			case x < y:
+				return -1, true
+			case x > y:
+				return 1, true
+			default:
+				return 0, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch value := value.(type) {
+	case int64:
+		return float64(value), true
+	case float64:
+		return value, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,208 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package sqlplugin
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/temporalio/temporal/common/convert"
+)
+
+type (
+	visibilityQuerySuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+
+	testQueryDialect struct{}
+)
+
+func TestVisibilityQuerySuite(t *testing.T) {
+	suite.Run(t, new(visibilityQuerySuite))
+}
+
+func (s *visibilityQuerySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (testQueryDialect) Placeholder(n int) string {
+	return fmt.Sprintf("$%v", n)
+}
+
+func (testQueryDialect) SearchAttribute(name string, valueType SearchAttributeType) string {
+	return fmt.Sprintf("attr%v(%v)", valueType, name)
+}
+
+func (testQueryDialect) SearchAttributeContains(name string, placeholder string) string {
+	return fmt.Sprintf("contains(%v, %v)", name, placeholder)
+}
+
+func (s *visibilityQuerySuite) TestBuild() {
+	startTime := time.Unix(0, 1547596872371000000).UTC()
+	testCases := []struct {
+		query   string
+		where   string
+		orderBy string
+		args    []interface{}
+	}{
+		{
+			query:   "",
+			where:   "namespace_id = $1",
+			orderBy: "start_time DESC, run_id ASC",
+			args:    []interface{}{"ns"},
+		},
+		{
+			query:   "WorkflowType = 'wf' and ExecutionStatus = 'Completed'",
+			where:   "namespace_id = $1 AND ((workflow_type_name = $2 AND status = $3))",
+			orderBy: "start_time DESC, run_id ASC",
+			args:    []interface{}{"ns", "wf", int64(2)},
+		},
+		{
+			query:   "ExecutionStatus = 'Running' or CloseTime != missing",
+			where:   "namespace_id = $1 AND ((status IS NULL OR close_time IS NOT NULL))",
+			orderBy: "start_time DESC, run_id ASC",
+			args:    []interface{}{"ns"},
+		},
+		{
+			query:   "StartTime between 1547596872371000000 and '2019-01-15T23:59:59Z' order by HistoryLength",
+			where:   "namespace_id = $1 AND ((start_time >= $2 AND start_time <= $3))",
+			orderBy: "history_length ASC, run_id ASC",
+			args:    []interface{}{"ns", startTime, time.Date(2019, 1, 15, 23, 59, 59, 0, time.UTC)},
+		},
+		{
+			query:   "WorkflowId in ('a', 'b') and RunId not in ('c')",
+			where:   "namespace_id = $1 AND (((workflow_id = $2 OR workflow_id = $3) AND run_id != $4))",
+			orderBy: "start_time DESC, run_id ASC",
+			args:    []interface{}{"ns", "a", "b", "c"},
+		},
+		{
+			query:   "`Attr.CustomKeywordField` = 'key' and CustomIntField > 1 and CustomBoolField = true order by CustomIntField desc, RunId",
+			where:   "namespace_id = $1 AND (((contains(CustomKeywordField, $2) AND attr2(CustomIntField) > $3) AND attr3(CustomBoolField) = $4))",
+			orderBy: "attr0(CustomIntField) DESC, run_id ASC",
+			args:    []interface{}{"ns", "key", int64(1), "true"},
+		},
+		{
+			query:   "order by WorkflowId",
+			where:   "namespace_id = $1",
+			orderBy: "workflow_id ASC, run_id ASC",
+			args:    []interface{}{"ns"},
+		},
+	}
+
+	for _, tc := range testCases {
+		query, err := ParseVisibilityQuery(tc.query)
+		s.NoError(err, tc.query)
+		where, orderBy, args := query.Build("ns", testQueryDialect{})
+		s.Equal(tc.where, where, tc.query)
+		s.Equal(tc.orderBy, orderBy, tc.query)
+		s.Equal(tc.args, args, tc.query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestParseInvalidQuery() {
+	queries := []string{
+		"WorkflowId",
+		"WorkflowId = 'a' limit 10",
+		"WorkflowId like 'a%'",
+		"WorkflowId = 1",
+		"StartTime > 'yesterday'",
+		"ExecutionStatus = 'Unfinished'",
+		"HistoryLength = 'long'",
+		"StartTime = missing",
+		"NamespaceId = 'ns'",
+		"`Attr.Custom'Field` = 'a'",
+		"CustomBoolField > true",
+		"WorkflowId = 'a' group by WorkflowType",
+	}
+
+	for _, query := range queries {
+		_, err := ParseVisibilityQuery(query)
+		s.Error(err, query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestMatch() {
+	closeTime := time.Unix(2000, 0).UTC()
+	open := &VisibilityRow{
+		WorkflowID:       "wid",
+		RunID:            "rid1",
+		WorkflowTypeName: "wf",
+		StartTime:        time.Unix(1000, 0).UTC(),
+		SearchAttributes: []byte(`{"CustomKeywordField":["a","b"],"CustomIntField":5,"CustomBoolField":true}`),
+	}
+	closed := &VisibilityRow{
+		WorkflowID:       "wid",
+		RunID:            "rid2",
+		WorkflowTypeName: "wf",
+		StartTime:        time.Unix(1500, 0).UTC(),
+		CloseTime:        &closeTime,
+		Status:           convert.Int32Ptr(2),
+		HistoryLength:    convert.Int64Ptr(10),
+	}
+
+	testCases := []struct {
+		query  string
+		open   bool
+		closed bool
+	}{
+		{query: "", open: true, closed: true},
+		{query: "ExecutionStatus = 'Running'", open: true, closed: false},
+		{query: "ExecutionStatus = 2", open: false, closed: true},
+		{query: "CloseTime = missing", open: true, closed: false},
+		{query: "StartTime > '1970-01-01T00:20:00Z'", open: false, closed: true},
+		{query: "HistoryLength >= 10", open: false, closed: true},
+		{query: "CustomKeywordField = 'b'", open: true, closed: false},
+		{query: "CustomKeywordField != 'b'", open: false, closed: false},
+		{query: "CustomIntField between 1 and 5.5", open: true, closed: false},
+		{query: "CustomBoolField = true or WorkflowId = 'wid'", open: true, closed: true},
+		{query: "WorkflowType = 'wf' and RunId != 'rid1'", open: false, closed: true},
+	}
+
+	for _, tc := range testCases {
+		query, err := ParseVisibilityQuery(tc.query)
+		s.NoError(err, tc.query)
+		s.Equal(tc.open, query.Match(open), tc.query)
+		s.Equal(tc.closed, query.Match(closed), tc.query)
+	}
+}
+
+func (s *visibilityQuerySuite) TestLess() {
+	first := &VisibilityRow{RunID: "a", StartTime: time.Unix(2000, 0), SearchAttributes: []byte(`{"CustomIntField":1}`)}
+	second := &VisibilityRow{RunID: "b", StartTime: time.Unix(1000, 0), SearchAttributes: []byte(`{"CustomIntField":2}`)}
+
+	query, err := ParseVisibilityQuery("")
+	s.NoError(err)
+	s.True(query.Less(first, second))
+	s.False(query.Less(second, first))
+
+	query, err = ParseVisibilityQuery("order by CustomIntField desc")
+	s.NoError(err)
+	s.True(query.Less(second, first))
+	s.False(query.Less(first, second))
+}
@@ -52,9 +52,9 @@ const VisibilityEncoding = common.EncodingTypeProto3
 var _ VisibilityManager = (*visibilityManagerImpl)(nil)
 
 // NewVisibilityManagerImpl returns new VisibilityManager
-func NewVisibilityManagerImpl(persistence VisibilityStore, logger log.Logger) VisibilityManager {
+func NewVisibilityManagerImpl(persistence VisibilityStore, serializer PayloadSerializer, logger log.Logger) VisibilityManager {
 	return &visibilityManagerImpl{
-		serializer:  NewPayloadSerializer(),
+		serializer:  serializer,
 		persistence: persistence,
 		logger:      logger,
 	}
@@ -306,6 +306,9 @@ func (v *visibilityManagerImpl) convertVisibilityWorkflowExecutionInfo(execution
 
 func (v *visibilityManagerImpl) serializeMemo(visibilityMemo *commonpb.Memo, namespaceID, wID, rID string) *serialization.DataBlob {
 	memo, err := v.serializer.SerializeVisibilityMemo(visibilityMemo, VisibilityEncoding)
+	if err == nil {
+		memo, err = v.serializer.EncryptBlob(namespaceID, memo)
+	}
 	if err != nil {
 		v.logger.WithTags(
 			tag.WorkflowNamespaceID(namespaceID),
@@ -314,7 +317,7 @@ func (v *visibilityManagerImpl) serializeMemo(visibilityMemo *commonpb.Memo, nam
 			tag.Error(err)).
 			Error("Unable to encode visibility memo")
 	}
-	if memo == nil {
+	if err != nil || memo == nil {
 		return &serialization.DataBlob{}
 	}
 	return memo
@@ -46,6 +46,16 @@ func TestNewRateLimiter(t *testing.T) {
 	assert.Equal(t, _minBurst, limiter.Burst())
 }
 
+func TestSetMaxDispatch(t *testing.T) {
+	maxDispatch := 10.0
+	rl := NewRateLimiter(&maxDispatch, time.Minute, _minBurst)
+	higher := 20.0
+	assert.False(t, rl.UpdateMaxDispatch(&higher))
+	assert.Equal(t, maxDispatch, rl.Limit())
+	rl.SetMaxDispatch(&higher)
+	assert.Equal(t, higher, rl.Limit())
+}
+
 func TestMultiStageRateLimiterBlockedByNamespaceRps(t *testing.T) {
 	policy := newFixedRpsMultiStageRateLimiter(2, 1)
 	var result []bool
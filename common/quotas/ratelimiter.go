@@ -75,14 +75,24 @@ func NewRateLimiter(maxDispatchPerSecond *float64, ttl time.Duration, minBurst i
 	return rl
 }
 
-// UpdateMaxDispatch updates the max dispatch rate of the rate limiter
-func (rl *RateLimiter) UpdateMaxDispatch(maxDispatchPerSecond *float64) {
+// UpdateMaxDispatch updates the max dispatch rate of the rate limiter, it returns whether the rate was applied
+func (rl *RateLimiter) UpdateMaxDispatch(maxDispatchPerSecond *float64) bool {
 	if rl.shouldUpdate(maxDispatchPerSecond) {
 		rl.Lock()
 		rl.maxDispatchPerSecond = maxDispatchPerSecond
 		rl.storeLimiter(maxDispatchPerSecond)
 		rl.Unlock()
+		return true
 	}
+	return false
+}
+
+// SetMaxDispatch sets the max dispatch rate of the rate limiter, regardless of the TTL of the current rate
+func (rl *RateLimiter) SetMaxDispatch(maxDispatchPerSecond *float64) {
+	rl.Lock()
+	rl.maxDispatchPerSecond = maxDispatchPerSecond
+	rl.storeLimiter(maxDispatchPerSecond)
+	rl.Unlock()
 }
 
 // Wait waits up till deadline for a rate limit token
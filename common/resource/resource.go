@@ -28,6 +28,7 @@ import (
 	"net"
 
 	sdkclient "go.temporal.io/temporal/client"
+	"google.golang.org/grpc"
 
 	"github.com/temporalio/temporal/client"
 	"github.com/temporalio/temporal/client/admin"
@@ -109,5 +110,6 @@ type (
 
 		// for registering handlers
 		GetGRPCListener() net.Listener
+		GetGRPCServerOptions() []grpc.ServerOption
 	}
 )
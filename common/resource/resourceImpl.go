@@ -34,6 +34,7 @@ import (
 	"github.com/uber-go/tally"
 	"github.com/uber/tchannel-go"
 	sdkclient "go.temporal.io/temporal/client"
+	"google.golang.org/grpc"
 
 	"github.com/temporalio/temporal/client"
 	"github.com/temporalio/temporal/client/admin"
@@ -612,3 +613,8 @@ func (h *Impl) GetThrottledLogger() log.Logger {
 func (h *Impl) GetGRPCListener() net.Listener {
 	return h.grpcListener
 }
+
+// GetGRPCServerOptions return the options of the GRPC server handlers are registered with
+func (h *Impl) GetGRPCServerOptions() []grpc.ServerOption {
+	return h.rpcFactory.GetGRPCServerOptions()
+}
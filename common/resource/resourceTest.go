@@ -34,6 +34,7 @@ import (
 	sdkclient "go.temporal.io/temporal/client"
 	sdkmocks "go.temporal.io/temporal/mocks"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
 
 	"github.com/temporalio/temporal/.gen/proto/adminservicemock"
 	"github.com/temporalio/temporal/.gen/proto/historyservicemock"
@@ -436,6 +437,11 @@ func (s *Test) GetGRPCListener() net.Listener {
 	panic("user should implement this method for test")
 }
 
+// GetGRPCServerOptions for testing
+func (s *Test) GetGRPCServerOptions() []grpc.ServerOption {
+	return nil
+}
+
 // Finish checks whether expectations are met
 func (s *Test) Finish(
 	t mock.TestingT,
@@ -37,5 +37,7 @@ type (
 		GetGRPCListener() net.Listener
 		GetRingpopChannel() *tchannel.Channel
 		CreateGRPCConnection(hostName string) *grpc.ClientConn
+		CreateFrontendGRPCConnection(hostName string) *grpc.ClientConn
+		GetGRPCServerOptions() []grpc.ServerOption
 	}
 )
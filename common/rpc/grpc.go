@@ -29,7 +29,9 @@ import (
 
 	"github.com/gogo/status"
 	"go.temporal.io/temporal-proto/serviceerror"
+	sdkclient "go.temporal.io/temporal/client"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
 	"github.com/temporalio/temporal/common/headers"
 )
@@ -45,15 +47,46 @@ const (
 // The hostName syntax is defined in
 // https://github.com/grpc/grpc/blob/master/doc/naming.md.
 // e.g. to use dns resolver, a "dns:///" prefix should be applied to the target.
-func Dial(hostName string) (*grpc.ClientConn, error) {
-	return grpc.Dial(hostName,
-		grpc.WithInsecure(),
+// The connection is insecure when creds is nil, opts are appended to the default options.
+func Dial(hostName string, creds credentials.TransportCredentials, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	dialOptions := []grpc.DialOption{
+		transportOption(creds),
 		grpc.WithChainUnaryInterceptor(
 			versionHeadersInterceptor,
 			errorInterceptor),
 		grpc.WithDefaultServiceConfig(DefaultServiceConfig),
 		grpc.WithDisableServiceConfig(),
-	)
+	}
+	return grpc.Dial(hostName, append(dialOptions, opts...)...)
+}
+
+// NewSDKDialer returns a gRPC dialer for SDK clients which connects with the given
+// transport credentials, the connection is insecure when creds is nil
+func NewSDKDialer(creds credentials.TransportCredentials, opts ...grpc.DialOption) func(params sdkclient.GRPCDialerParams) (*grpc.ClientConn, error) {
+	return func(params sdkclient.GRPCDialerParams) (*grpc.ClientConn, error) {
+		dialOptions := []grpc.DialOption{
+			transportOption(creds),
+			grpc.WithChainUnaryInterceptor(params.RequiredInterceptors...),
+			grpc.WithDefaultServiceConfig(params.DefaultServiceConfig),
+		}
+		return grpc.Dial(params.HostPort, append(dialOptions, opts...)...)
+	}
+}
+
+// PerRPCCredentialsOptions returns the dial options which attach the given credentials to
+// every call, no options are returned when creds is nil
+func PerRPCCredentialsOptions(creds credentials.PerRPCCredentials) []grpc.DialOption {
+	if creds == nil {
+		return nil
+	}
+	return []grpc.DialOption{grpc.WithPerRPCCredentials(creds)}
+}
+
+func transportOption(creds credentials.TransportCredentials) grpc.DialOption {
+	if creds == nil {
+		return grpc.WithInsecure()
+	}
+	return grpc.WithTransportCredentials(creds)
 }
 
 func errorInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
@@ -0,0 +1,313 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+
+	"github.com/temporalio/temporal/common/auth"
+)
+
+const (
+	// DefaultTLSRefreshInterval is how often certificate files are checked for changes by default
+	DefaultTLSRefreshInterval = time.Minute
+)
+
+type (
+	// reloadingCredentials are gRPC transport credentials which build the TLS config
+	// of every handshake from certificate files that are reloaded when they change
+	reloadingCredentials struct {
+		getConfig  func() (*tls.Config, error)
+		serverName string
+	}
+
+	// watchedFiles holds the value loaded from a set of files and reloads it when the
+	// modification time of one of the files changes, the files are checked at most
+	// once per refresh interval
+	watchedFiles struct {
+		sync.Mutex
+		paths           []string
+		refreshInterval time.Duration
+		load            func(contents [][]byte) (interface{}, error)
+
+		value     interface{}
+		modTimes  []time.Time
+		nextCheck time.Time
+	}
+)
+
+var _ credentials.TransportCredentials = (*reloadingCredentials)(nil)
+
+// NewServerCredentials returns the transport credentials of a gRPC server, they are nil
+// when TLS is not configured
+func NewServerCredentials(cfg *auth.ServerTLS, refreshInterval time.Duration) (credentials.TransportCredentials, error) {
+	if !cfg.IsEnabled() {
+		return nil, nil
+	}
+	if cfg.RequireClientAuth && len(cfg.ClientCAFiles) == 0 {
+		return nil, errors.New("client authentication requires client CA files")
+	}
+
+	certificate := newWatchedFiles([]string{cfg.CertFile, cfg.KeyFile}, refreshInterval, loadKeyPair)
+	var clientCAs *watchedFiles
+	if len(cfg.ClientCAFiles) > 0 {
+		clientCAs = newWatchedFiles(cfg.ClientCAFiles, refreshInterval, loadCertPool)
+	}
+
+	return newReloadingCredentials(func() (*tls.Config, error) {
+		cert, err := certificate.get()
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig := &tls.Config{
+			MinVersion:   tls.VersionTLS12,
+			Certificates: []tls.Certificate{*cert.(*tls.Certificate)},
+		}
+		if clientCAs != nil {
+			pool, err := clientCAs.get()
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.ClientCAs = pool.(*x509.CertPool)
+			tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+		if cfg.RequireClientAuth {
+			tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+		return tlsConfig, nil
+	}, "")
+}
+
+// NewClientCredentials returns the transport credentials of a gRPC client, they are nil
+// when TLS is not enabled
+func NewClientCredentials(cfg *auth.ClientTLS, refreshInterval time.Duration) (credentials.TransportCredentials, error) {
+	if cfg == nil || !cfg.Enabled {
+		return nil, nil
+	}
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		return nil, errors.New("client certificate requires both cert and key file")
+	}
+
+	var certificate *watchedFiles
+	if cfg.CertFile != "" {
+		certificate = newWatchedFiles([]string{cfg.CertFile, cfg.KeyFile}, refreshInterval, loadKeyPair)
+	}
+	var rootCAs *watchedFiles
+	if len(cfg.RootCAFiles) > 0 {
+		rootCAs = newWatchedFiles(cfg.RootCAFiles, refreshInterval, loadCertPool)
+	}
+
+	return newReloadingCredentials(func() (*tls.Config, error) {
+		tlsConfig := &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			ServerName: cfg.ServerName,
+		}
+		if certificate != nil {
+			cert, err := certificate.get()
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.Certificates = []tls.Certificate{*cert.(*tls.Certificate)}
+		}
+		if rootCAs != nil {
+			pool, err := rootCAs.get()
+			if err != nil {
+				return nil, err
+			}
+			tlsConfig.RootCAs = pool.(*x509.CertPool)
+		}
+		if cfg.DisableHostVerification {
+			// the chain is verified without the host name by verifyChain instead
+			tlsConfig.InsecureSkipVerify = true
+			tlsConfig.VerifyPeerCertificate = verifyChain(tlsConfig.RootCAs)
+		}
+		return tlsConfig, nil
+	}, cfg.ServerName)
+}
+
+func newReloadingCredentials(getConfig func() (*tls.Config, error), serverName string) (credentials.TransportCredentials, error) {
+	// fail on start up instead of on the first handshake when the files are invalid
+	if _, err := getConfig(); err != nil {
+		return nil, err
+	}
+	return &reloadingCredentials{getConfig: getConfig, serverName: serverName}, nil
+}
+
+func (c *reloadingCredentials) ClientHandshake(ctx context.Context, authority string, rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	tlsConfig, err := c.getConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	if c.serverName != "" {
+		tlsConfig.ServerName = c.serverName
+	}
+	return credentials.NewTLS(tlsConfig).ClientHandshake(ctx, authority, rawConn)
+}
+
+func (c *reloadingCredentials) ServerHandshake(rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	tlsConfig, err := c.getConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	return credentials.NewTLS(tlsConfig).ServerHandshake(rawConn)
+}
+
+func (c *reloadingCredentials) Info() credentials.ProtocolInfo {
+	return credentials.NewTLS(&tls.Config{ServerName: c.serverName}).Info()
+}
+
+func (c *reloadingCredentials) Clone() credentials.TransportCredentials {
+	return &reloadingCredentials{getConfig: c.getConfig, serverName: c.serverName}
+}
+
+func (c *reloadingCredentials) OverrideServerName(serverName string) error {
+	c.serverName = serverName
+	return nil
+}
+
+func newWatchedFiles(
+	paths []string,
+	refreshInterval time.Duration,
+	load func(contents [][]byte) (interface{}, error),
+) *watchedFiles {
+	if refreshInterval <= 0 {
+		refreshInterval = DefaultTLSRefreshInterval
+	}
+	return &watchedFiles{
+		paths:           paths,
+		refreshInterval: refreshInterval,
+		load:            load,
+		modTimes:        make([]time.Time, len(paths)),
+	}
+}
+
+// get returns the value loaded from the files, a failed reload keeps the previous value
+func (w *watchedFiles) get() (interface{}, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	now := time.Now()
+	if w.value != nil && now.Before(w.nextCheck) {
+		return w.value, nil
+	}
+	w.nextCheck = now.Add(w.refreshInterval)
+
+	modTimes := make([]time.Time, len(w.paths))
+	changed := w.value == nil
+	for i, path := range w.paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			if w.value != nil {
+				return w.value, nil
+			}
+			return nil, err
+		}
+		modTimes[i] = info.ModTime()
+		changed = changed || !modTimes[i].Equal(w.modTimes[i])
+	}
+	if !changed {
+		return w.value, nil
+	}
+
+	contents := make([][]byte, len(w.paths))
+	for i, path := range w.paths {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			if w.value != nil {
+				return w.value, nil
+			}
+			return nil, err
+		}
+		contents[i] = content
+	}
+	value, err := w.load(contents)
+	if err != nil {
+		if w.value != nil {
+			// the files may be in the middle of being rotated, retry on the next check
+			return w.value, nil
+		}
+		return nil, err
+	}
+	w.value = value
+	w.modTimes = modTimes
+	return w.value, nil
+}
+
+func loadKeyPair(contents [][]byte) (interface{}, error) {
+	cert, err := tls.X509KeyPair(contents[0], contents[1])
+	if err != nil {
+		return nil, fmt.Errorf("unable to load certificate: %v", err)
+	}
+	return &cert, nil
+}
+
+func loadCertPool(contents [][]byte) (interface{}, error) {
+	pool := x509.NewCertPool()
+	for _, content := range contents {
+		if !pool.AppendCertsFromPEM(content) {
+			return nil, errors.New("unable to load CA certificates")
+		}
+	}
+	return pool, nil
+}
+
+// verifyChain returns a certificate verifier which checks the chain of the peer
+// certificate but not its host name
+func verifyChain(roots *x509.CertPool) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return errors.New("no peer certificate")
+		}
+		intermediates := x509.NewCertPool()
+		var leaf *x509.Certificate
+		for i, rawCert := range rawCerts {
+			cert, err := x509.ParseCertificate(rawCert)
+			if err != nil {
+				return err
+			}
+			if i == 0 {
+				leaf = cert
+			} else {
+				intermediates.AddCert(cert)
+			}
+		}
+		_, err := leaf.Verify(x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+		})
+		return err
+	}
+}
@@ -0,0 +1,246 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package rpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/temporalio/temporal/common/auth"
+)
+
+type (
+	tlsCredentialsSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		dir      string
+		server   *grpc.Server
+		hostPort string
+	}
+
+	testCA struct {
+		cert *x509.Certificate
+		key  *ecdsa.PrivateKey
+	}
+)
+
+const testRefreshInterval = 10 * time.Millisecond
+
+func TestTLSCredentialsSuite(t *testing.T) {
+	s := new(tlsCredentialsSuite)
+	suite.Run(t, s)
+}
+
+func (s *tlsCredentialsSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	var err error
+	s.dir, err = ioutil.TempDir("", "tlsCredentialsSuite")
+	s.NoError(err)
+}
+
+func (s *tlsCredentialsSuite) TearDownTest() {
+	if s.server != nil {
+		s.server.Stop()
+	}
+	os.RemoveAll(s.dir)
+}
+
+func (s *tlsCredentialsSuite) TestServerTLS() {
+	ca := s.newCA("ca")
+	s.writeCert("server", ca, "localhost", false)
+	s.writeCA("ca", ca)
+	s.startServer(&auth.ServerTLS{CertFile: s.path("server.crt"), KeyFile: s.path("server.key")})
+
+	s.NoError(s.check(&auth.ClientTLS{Enabled: true, RootCAFiles: []string{s.path("ca.crt")}}))
+	s.NoError(s.check(&auth.ClientTLS{Enabled: true, RootCAFiles: []string{s.path("ca.crt")}, ServerName: "localhost"}))
+	s.Error(s.check(&auth.ClientTLS{Enabled: true, RootCAFiles: []string{s.path("ca.crt")}, ServerName: "other-host"}))
+	s.NoError(s.check(&auth.ClientTLS{Enabled: true, RootCAFiles: []string{s.path("ca.crt")}, ServerName: "other-host", DisableHostVerification: true}))
+	s.Error(s.check(nil))
+
+	s.writeCA("other-ca", s.newCA("other-ca"))
+	s.Error(s.check(&auth.ClientTLS{Enabled: true, RootCAFiles: []string{s.path("other-ca.crt")}, DisableHostVerification: true}))
+}
+
+func (s *tlsCredentialsSuite) TestMutualTLS() {
+	ca := s.newCA("ca")
+	s.writeCA("ca", ca)
+	s.writeCert("server", ca, "localhost", false)
+	s.writeCert("client", ca, "client", true)
+	s.startServer(&auth.ServerTLS{
+		CertFile:          s.path("server.crt"),
+		KeyFile:           s.path("server.key"),
+		ClientCAFiles:     []string{s.path("ca.crt")},
+		RequireClientAuth: true,
+	})
+
+	s.NoError(s.check(&auth.ClientTLS{
+		Enabled:     true,
+		CertFile:    s.path("client.crt"),
+		KeyFile:     s.path("client.key"),
+		RootCAFiles: []string{s.path("ca.crt")},
+	}))
+	s.Error(s.check(&auth.ClientTLS{Enabled: true, RootCAFiles: []string{s.path("ca.crt")}}))
+}
+
+func (s *tlsCredentialsSuite) TestCertificateReload() {
+	ca := s.newCA("ca")
+	s.writeCA("ca", ca)
+	s.writeCert("server", ca, "localhost", false)
+	s.startServer(&auth.ServerTLS{CertFile: s.path("server.crt"), KeyFile: s.path("server.key")})
+
+	newCA := s.newCA("new-ca")
+	s.writeCA("new-ca", newCA)
+	s.Error(s.check(&auth.ClientTLS{Enabled: true, RootCAFiles: []string{s.path("new-ca.crt")}}))
+
+	// rotate the server certificate, the modification time must change for it to be picked up
+	time.Sleep(testRefreshInterval)
+	s.writeCert("server", newCA, "localhost", false)
+	future := time.Now().Add(time.Second)
+	s.NoError(os.Chtimes(s.path("server.crt"), future, future))
+	s.NoError(os.Chtimes(s.path("server.key"), future, future))
+	time.Sleep(2 * testRefreshInterval)
+
+	s.NoError(s.check(&auth.ClientTLS{Enabled: true, RootCAFiles: []string{s.path("new-ca.crt")}}))
+	s.Error(s.check(&auth.ClientTLS{Enabled: true, RootCAFiles: []string{s.path("ca.crt")}}))
+}
+
+func (s *tlsCredentialsSuite) TestInvalidConfig() {
+	_, err := NewServerCredentials(&auth.ServerTLS{CertFile: s.path("missing.crt"), KeyFile: s.path("missing.key")}, 0)
+	s.Error(err)
+
+	_, err = NewServerCredentials(&auth.ServerTLS{CertFile: s.path("missing.crt"), RequireClientAuth: true}, 0)
+	s.Error(err)
+
+	_, err = NewClientCredentials(&auth.ClientTLS{Enabled: true, CertFile: s.path("client.crt")}, 0)
+	s.Error(err)
+
+	creds, err := NewServerCredentials(&auth.ServerTLS{}, 0)
+	s.NoError(err)
+	s.Nil(creds)
+
+	creds, err = NewClientCredentials(&auth.ClientTLS{RootCAFiles: []string{s.path("missing.crt")}}, 0)
+	s.NoError(err)
+	s.Nil(creds)
+}
+
+func (s *tlsCredentialsSuite) startServer(cfg *auth.ServerTLS) {
+	creds, err := NewServerCredentials(cfg, testRefreshInterval)
+	s.NoError(err)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	s.NoError(err)
+	_, port, err := net.SplitHostPort(listener.Addr().String())
+	s.NoError(err)
+	s.hostPort = net.JoinHostPort("localhost", port)
+	s.server = grpc.NewServer(grpc.Creds(creds))
+	healthpb.RegisterHealthServer(s.server, health.NewServer())
+	go s.server.Serve(listener)
+}
+
+func (s *tlsCredentialsSuite) check(cfg *auth.ClientTLS) error {
+	creds, err := NewClientCredentials(cfg, testRefreshInterval)
+	s.NoError(err)
+	connection, err := Dial(s.hostPort, creds)
+	s.NoError(err)
+	defer connection.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err = healthpb.NewHealthClient(connection).Check(ctx, &healthpb.HealthCheckRequest{})
+	return err
+}
+
+func (s *tlsCredentialsSuite) newCA(name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	s.NoError(err)
+	cert, err := x509.ParseCertificate(der)
+	s.NoError(err)
+	return &testCA{cert: cert, key: key}
+}
+
+func (s *tlsCredentialsSuite) writeCA(name string, ca *testCA) {
+	s.writePEM(name+".crt", "CERTIFICATE", ca.cert.Raw)
+}
+
+func (s *tlsCredentialsSuite) writeCert(name string, ca *testCA, host string, client bool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	s.NoError(err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: host},
+		DNSNames:     []string{host},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	if client {
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	s.NoError(err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	s.NoError(err)
+	s.writePEM(name+".crt", "CERTIFICATE", der)
+	s.writePEM(name+".key", "EC PRIVATE KEY", keyDER)
+}
+
+func (s *tlsCredentialsSuite) writePEM(name string, blockType string, der []byte) {
+	content := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	s.NoError(ioutil.WriteFile(s.path(name), content, 0600))
+}
+
+func (s *tlsCredentialsSuite) path(name string) string {
+	return filepath.Join(s.dir, name)
+}
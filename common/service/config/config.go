@@ -70,6 +70,11 @@ type (
 		DynamicConfigClient dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
 		// NamespaceDefaults is the default config for every namespace
 		NamespaceDefaults NamespaceDefaults `yaml:"namespaceDefaults"`
+		// Authorization is the config for authorizing frontend API calls, every call is
+		// allowed when it is not set
+		Authorization *Authorization `yaml:"authorization"`
+		// TLS is the config for securing the gRPC endpoints of the services
+		TLS RootTLS `yaml:"tls"`
 	}
 
 	// Service contains the service specific config items
@@ -144,6 +149,22 @@ type (
 		VisibilityConfig *VisibilityConfig `yaml:"-" json:"-"`
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// Encryption is the config for encrypting payloads at rest, payloads are stored
+		// unencrypted when it is not set
+		Encryption *Encryption `yaml:"encryption"`
+	}
+
+	// Encryption contains the configuration for encrypting workflow payloads at rest
+	Encryption struct {
+		// Provider is the name of the key provider, only "file" is supported and it is the default
+		Provider string `yaml:"provider"`
+		// KeyFile is the path of the yaml file which maps key IDs to base64 encoded AES-256 keys
+		KeyFile string `yaml:"keyFile"`
+		// DefaultKeyID is the ID of the key payloads are encrypted with, keep the previous keys
+		// in the keyfile after changing it so that existing payloads can still be read
+		DefaultKeyID string `yaml:"defaultKeyID"`
+		// NamespaceKeyIDs overrides DefaultKeyID for the namespace IDs in the map
+		NamespaceKeyIDs map[string]string `yaml:"namespaceKeyIDs"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -421,6 +442,51 @@ type (
 		// URI is the namespace default URI for visibility archiver
 		URI string `yaml:"URI"`
 	}
+
+	// RootTLS contains the TLS config of the gRPC endpoints of all services
+	RootTLS struct {
+		// Internode secures the history and matching endpoints and the connections the
+		// services open to them, client CAs on the server enable mutual TLS
+		Internode GroupTLS `yaml:"internode"`
+		// Frontend secures the frontend endpoint and the connections the services open to it
+		Frontend GroupTLS `yaml:"frontend"`
+		// RefreshInterval is how often the certificate files are checked for changes,
+		// it defaults to one minute
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+	}
+
+	// GroupTLS contains the TLS config of the servers of a group of services and of the
+	// clients connecting to them, the clients use TLS when it is enabled in the client
+	// config or when the server config is set
+	GroupTLS struct {
+		// Server is the TLS config of the gRPC servers
+		Server auth.ServerTLS `yaml:"server"`
+		// Client is the TLS config of the connections to the servers
+		Client auth.ClientTLS `yaml:"client"`
+	}
+
+	// Authorization contains the config for authorizing API calls with JWT bearer tokens
+	Authorization struct {
+		// JWKSFiles are the paths of JSON Web Key Set files with the keys tokens are signed with
+		JWKSFiles []string `yaml:"jwksFiles"`
+		// StaticKeyFiles maps key IDs to the paths of PEM encoded public keys, files which
+		// are not PEM encoded are used as HMAC secrets
+		StaticKeyFiles map[string]string `yaml:"staticKeyFiles"`
+		// Issuer is the expected "iss" claim, it is not checked when empty
+		Issuer string `yaml:"issuer"`
+		// Audience is the expected "aud" claim, it is not checked when empty
+		Audience string `yaml:"audience"`
+		// PermissionsClaimName is the claim holding the list of "<namespace>:<role>" permissions
+		// of the caller, it defaults to "permissions"
+		PermissionsClaimName string `yaml:"permissionsClaimName"`
+		// SystemKeyFile is the path of the HMAC secret the server signs the tokens of its internal
+		// clients with, such as the system workflows of the worker and the replication of other
+		// clusters, which share the secret. Internal calls are denied when it is not set.
+		SystemKeyFile string `yaml:"systemKeyFile"`
+		// AllowTokensWithoutExpiration accepts tokens without an "exp" claim, which never expire
+		// when they leak. Tokens must carry an expiration time unless it is set.
+		AllowTokensWithoutExpiration bool `yaml:"allowTokensWithoutExpiration"`
+	}
 )
 
 // Validate validates this config
@@ -41,6 +41,14 @@ func (c *Persistence) DefaultStoreType() string {
 	return StoreTypeCassandra
 }
 
+// VisibilityStoreType returns the storeType for the visibility store
+func (c *Persistence) VisibilityStoreType() string {
+	if c.DataStores[c.VisibilityStore].SQL != nil {
+		return StoreTypeSQL
+	}
+	return StoreTypeCassandra
+}
+
 // Validate validates the persistence config
 func (c *Persistence) Validate() error {
 	stores := []string{c.DefaultStore, c.VisibilityStore}
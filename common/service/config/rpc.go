@@ -31,6 +31,7 @@ import (
 
 	"github.com/uber/tchannel-go"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
 	"github.com/temporalio/temporal/common/log"
 	"github.com/temporalio/temporal/common/log/tag"
@@ -43,22 +44,51 @@ type RPCFactory struct {
 	serviceName string
 	logger      log.Logger
 
+	serverCredentials    credentials.TransportCredentials
+	internodeCredentials credentials.TransportCredentials
+	frontendCredentials  credentials.TransportCredentials
+	// systemCredentials authorize the calls of this service to the frontend
+	systemCredentials credentials.PerRPCCredentials
+
 	sync.Mutex
 	grpcListener   net.Listener
 	ringpopChannel *tchannel.Channel
 }
 
 // NewFactory builds a new RPCFactory
-// conforming to the underlying configuration
-func (cfg *RPC) NewFactory(sName string, logger log.Logger) *RPCFactory {
-	return newRPCFactory(cfg, sName, logger)
+// conforming to the underlying configuration, systemCredentials are attached to the calls
+// to the frontend and may be nil
+func (cfg *RPC) NewFactory(sName string, logger log.Logger, tlsConfig *RootTLS, systemCredentials credentials.PerRPCCredentials) *RPCFactory {
+	return newRPCFactory(cfg, sName, logger, tlsConfig, systemCredentials)
 }
 
-func newRPCFactory(cfg *RPC, sName string, logger log.Logger) *RPCFactory {
-	factory := &RPCFactory{config: cfg, serviceName: sName, logger: logger}
+func newRPCFactory(cfg *RPC, sName string, logger log.Logger, tlsConfig *RootTLS, systemCredentials credentials.PerRPCCredentials) *RPCFactory {
+	factory := &RPCFactory{config: cfg, serviceName: sName, logger: logger, systemCredentials: systemCredentials}
+	if tlsConfig == nil {
+		return factory
+	}
+
+	var err error
+	if factory.serverCredentials, err = tlsConfig.NewServerCredentials(sName); err != nil {
+		logger.Fatal("Failed to load gRPC server TLS config", tag.Error(err), tag.Service(sName))
+	}
+	if factory.internodeCredentials, err = tlsConfig.NewInternodeClientCredentials(); err != nil {
+		logger.Fatal("Failed to load internode TLS config", tag.Error(err), tag.Service(sName))
+	}
+	if factory.frontendCredentials, err = tlsConfig.NewFrontendClientCredentials(); err != nil {
+		logger.Fatal("Failed to load frontend client TLS config", tag.Error(err), tag.Service(sName))
+	}
 	return factory
 }
 
+// GetGRPCServerOptions returns the options of the gRPC server of the service
+func (d *RPCFactory) GetGRPCServerOptions() []grpc.ServerOption {
+	if d.serverCredentials == nil {
+		return nil
+	}
+	return []grpc.ServerOption{grpc.Creds(d.serverCredentials)}
+}
+
 // GetGRPCListener returns cached dispatcher for gRPC inbound or creates one
 func (d *RPCFactory) GetGRPCListener() net.Listener {
 	if d.grpcListener != nil {
@@ -135,7 +165,16 @@ func (d *RPCFactory) getListenIP() net.IP {
 
 // CreateGRPCConnection creates connection for gRPC calls
 func (d *RPCFactory) CreateGRPCConnection(hostName string) *grpc.ClientConn {
-	connection, err := rpc.Dial(hostName)
+	return d.dial(hostName, d.internodeCredentials)
+}
+
+// CreateFrontendGRPCConnection creates connection for gRPC calls to the frontend
+func (d *RPCFactory) CreateFrontendGRPCConnection(hostName string) *grpc.ClientConn {
+	return d.dial(hostName, d.frontendCredentials, rpc.PerRPCCredentialsOptions(d.systemCredentials)...)
+}
+
+func (d *RPCFactory) dial(hostName string, creds credentials.TransportCredentials, opts ...grpc.DialOption) *grpc.ClientConn {
+	connection, err := rpc.Dial(hostName, creds, opts...)
 	if err != nil {
 		d.logger.Fatal("Failed to create gRPC connection", tag.Error(err))
 	}
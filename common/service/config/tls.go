@@ -0,0 +1,61 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"google.golang.org/grpc/credentials"
+
+	"github.com/temporalio/temporal/common/primitives"
+	"github.com/temporalio/temporal/common/rpc"
+)
+
+// NewServerCredentials returns the transport credentials of the gRPC server of a service,
+// they are nil when the server doesn't use TLS
+func (t *RootTLS) NewServerCredentials(serviceName string) (credentials.TransportCredentials, error) {
+	if serviceName == primitives.FrontendService {
+		return rpc.NewServerCredentials(&t.Frontend.Server, t.RefreshInterval)
+	}
+	return rpc.NewServerCredentials(&t.Internode.Server, t.RefreshInterval)
+}
+
+// NewInternodeClientCredentials returns the transport credentials of the connections
+// to the history and matching services
+func (t *RootTLS) NewInternodeClientCredentials() (credentials.TransportCredentials, error) {
+	return t.newClientCredentials(&t.Internode)
+}
+
+// NewFrontendClientCredentials returns the transport credentials of the connections
+// to the frontend service
+func (t *RootTLS) NewFrontendClientCredentials() (credentials.TransportCredentials, error) {
+	return t.newClientCredentials(&t.Frontend)
+}
+
+func (t *RootTLS) newClientCredentials(group *GroupTLS) (credentials.TransportCredentials, error) {
+	client := group.Client
+	if group.Server.IsEnabled() {
+		client.Enabled = true
+	}
+	return rpc.NewClientCredentials(&client, t.RefreshInterval)
+}
@@ -109,6 +109,7 @@ var keys = map[Key]string{
 	VisibilityArchivalQueryMaxPageSize:    "frontend.visibilityArchivalQueryMaxPageSize",
 	VisibilityArchivalQueryMaxRangeInDays: "frontend.visibilityArchivalQueryMaxRangeInDays",
 	VisibilityArchivalQueryMaxQPS:         "frontend.visibilityArchivalQueryMaxQPS",
+	FrontendMaxConcurrentBatchOperation:   "frontend.maxConcurrentBatchOperation",
 
 	// matching settings
 	MatchingRPS:                             "matching.rps",
@@ -212,10 +213,13 @@ var keys = map[Key]string{
 	HistoryMgrNumConns:                                     "history.historyMgrNumConns",
 	MaximumBufferedEventsBatch:                             "history.maximumBufferedEventsBatch",
 	MaximumSignalsPerExecution:                             "history.maximumSignalsPerExecution",
+	SignalDeduplicationWindow:                              "history.signalDeduplicationWindow",
+	MaximumSignalDeduplicationIDs:                          "history.maximumSignalDeduplicationIDs",
 	ShardUpdateMinInterval:                                 "history.shardUpdateMinInterval",
 	ShardSyncMinInterval:                                   "history.shardSyncMinInterval",
 	ShardSyncTimerJitterCoefficient:                        "history.shardSyncMinInterval",
 	DefaultEventEncoding:                                   "history.defaultEventEncoding",
+	EventCompression:                                       "history.eventCompression",
 	EnableAdminProtection:                                  "history.enableAdminProtection",
 	AdminOperationToken:                                    "history.adminOperationToken",
 	EnableParentClosePolicy:                                "history.enableParentClosePolicy",
@@ -242,6 +246,10 @@ var keys = map[Key]string{
 	EnableConsistentQuery:                                  "history.EnableConsistentQuery",
 	EnableConsistentQueryByNamespace:                       "history.EnableConsistentQueryByNamespace",
 	MaxBufferedQueryCount:                                  "history.MaxBufferedQueryCount",
+	MaxBufferedUpdateCount:                                 "history.MaxBufferedUpdateCount",
+	UpdateWorkflowExecutionTimeout:                         "history.UpdateWorkflowExecutionTimeout",
+	UpdateResultRetention:                                  "history.UpdateResultRetention",
+	MaxUpdateResultCount:                                   "history.MaxUpdateResultCount",
 	MutableStateChecksumGenProbability:                     "history.mutableStateChecksumGenProbability",
 	MutableStateChecksumVerifyProbability:                  "history.mutableStateChecksumVerifyProbability",
 	MutableStateChecksumInvalidateBefore:                   "history.mutableStateChecksumInvalidateBefore",
@@ -401,6 +409,8 @@ const (
 	VisibilityArchivalQueryMaxRangeInDays
 	// VisibilityArchivalQueryMaxQPS is the timeout for a visibility archival query
 	VisibilityArchivalQueryMaxQPS
+	// FrontendMaxConcurrentBatchOperation is the max number of concurrent batch operations per namespace
+	FrontendMaxConcurrentBatchOperation
 
 	// key for matching
 
@@ -605,6 +615,10 @@ const (
 	MaximumBufferedEventsBatch
 	// MaximumSignalsPerExecution is max number of signals supported by single execution
 	MaximumSignalsPerExecution
+	// SignalDeduplicationWindow is how long the request id of a signal is remembered to deduplicate retried signals
+	SignalDeduplicationWindow
+	// MaximumSignalDeduplicationIDs is max number of signal request ids remembered by single execution
+	MaximumSignalDeduplicationIDs
 	// ShardUpdateMinInterval is the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval
 	// ShardSyncMinInterval is the minimal time interval which the shard info should be sync to remote
@@ -613,6 +627,8 @@ const (
 	ShardSyncTimerJitterCoefficient
 	// DefaultEventEncoding is the encoding type for history events
 	DefaultEventEncoding
+	// EventCompression is the compression type for persisted history events, one of snappy or gzip
+	EventCompression
 	// NumArchiveSystemWorkflows is key for number of archive system workflows running in total
 	NumArchiveSystemWorkflows
 	// ArchiveRequestRPS is the rate limit on the number of archive request per second
@@ -737,6 +753,14 @@ const (
 	EnableConsistentQueryByNamespace
 	// MaxBufferedQueryCount indicates the maximum number of queries which can be buffered at a given time for a single workflow
 	MaxBufferedQueryCount
+	// MaxBufferedUpdateCount indicates the maximum number of updates which can be buffered at a given time for a single workflow
+	MaxBufferedUpdateCount
+	// UpdateWorkflowExecutionTimeout is the maximum time an update waits for the workflow to handle it
+	UpdateWorkflowExecutionTimeout
+	// UpdateResultRetention is how long the result of an update is kept to answer the retries of the update
+	UpdateResultRetention
+	// MaxUpdateResultCount is the maximum number of update results kept by a single workflow
+	MaxUpdateResultCount
 	// MutableStateChecksumGenProbability is the probability [0-100] that checksum will be generated for mutable state
 	MutableStateChecksumGenProbability
 	// MutableStateChecksumVerifyProbability is the probability [0-100] that checksum will be verified for mutable state
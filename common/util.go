@@ -44,6 +44,7 @@ import (
 
 	"github.com/temporalio/temporal/.gen/proto/historyservice"
 	"github.com/temporalio/temporal/.gen/proto/matchingservice"
+	namespacegenpb "github.com/temporalio/temporal/.gen/proto/namespace"
 	"github.com/temporalio/temporal/common/backoff"
 	"github.com/temporalio/temporal/common/log"
 	"github.com/temporalio/temporal/common/log/tag"
@@ -398,10 +399,46 @@ func ValidateRetryPolicy(policy *commonpb.RetryPolicy) error {
 	return nil
 }
 
-// CreateHistoryStartWorkflowRequest create a start workflow request for history
+// ParseTaskPriority converts the name of a task priority, one of high, normal or low, to its value
+func ParseTaskPriority(name string) (int32, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "high":
+		return TaskPriorityHigh, nil
+	case "normal":
+		return TaskPriorityNormal, nil
+	case "low":
+		return TaskPriorityLow, nil
+	default:
+		return TaskPriorityUnspecified, serviceerror.NewInvalidArgument(fmt.Sprintf("Unknown task priority %q, expected one of high, normal or low.", name))
+	}
+}
+
+// TaskPriorityName returns the name of a task priority, an unspecified priority is normal
+func TaskPriorityName(priority int32) string {
+	switch NormalizeTaskPriority(priority) {
+	case TaskPriorityHigh:
+		return "high"
+	case TaskPriorityLow:
+		return "low"
+	default:
+		return "normal"
+	}
+}
+
+// NormalizeTaskPriority returns TaskPriorityNormal for unspecified or unknown priorities
+func NormalizeTaskPriority(priority int32) int32 {
+	if priority < TaskPriorityHigh || priority > TaskPriorityLow {
+		return TaskPriorityNormal
+	}
+	return priority
+}
+
+// CreateHistoryStartWorkflowRequest create a start workflow request for history.
+// startDelaySeconds postpones the first decision task, for cron workflows the first run is the next schedule after the delay.
 func CreateHistoryStartWorkflowRequest(
 	namespaceID string,
 	startRequest *workflowservice.StartWorkflowExecutionRequest,
+	startDelaySeconds int32,
 ) *historyservice.StartWorkflowExecutionRequest {
 	now := time.Now()
 	histRequest := &historyservice.StartWorkflowExecutionRequest{
@@ -413,7 +450,9 @@ func CreateHistoryStartWorkflowRequest(
 		deadline := now.Add(time.Second * time.Duration(expirationInSeconds))
 		histRequest.ExpirationTimestamp = deadline.Round(time.Millisecond).UnixNano()
 	}
-	histRequest.FirstDecisionTaskBackoffSeconds = backoff.GetBackoffForNextScheduleInSeconds(startRequest.GetCronSchedule(), now, now)
+	firstRunTime := now.Add(time.Duration(startDelaySeconds) * time.Second)
+	histRequest.FirstDecisionTaskBackoffSeconds = startDelaySeconds +
+		backoff.GetBackoffForNextScheduleInSeconds(startRequest.GetCronSchedule(), firstRunTime, firstRunTime)
 	return histRequest
 }
 
@@ -587,3 +626,12 @@ func GetDefaultAdvancedVisibilityWritingMode(isAdvancedVisConfigExist bool) stri
 	}
 	return AdvancedVisibilityWritingModeOff
 }
+
+// GetWorkerVersionSetID returns the id of a worker version set, sets stored before ids were assigned are keyed by
+// their first build ID
+func GetWorkerVersionSetID(versionSet *namespacegenpb.WorkerVersionSet) string {
+	if versionSet.GetId() != "" {
+		return versionSet.GetId()
+	}
+	return versionSet.GetBuildIds()[0]
+}
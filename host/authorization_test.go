@@ -0,0 +1,179 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package host
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/temporal-proto/common"
+	"go.temporal.io/temporal-proto/serviceerror"
+	tasklistpb "go.temporal.io/temporal-proto/tasklist"
+	"go.temporal.io/temporal-proto/workflowservice"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/temporalio/temporal/.gen/proto/adminservice"
+	"github.com/temporalio/temporal/common/authorization"
+	"github.com/temporalio/temporal/common/rpc"
+)
+
+type authorizationIntegrationSuite struct {
+	// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+	// not merely log an error
+	*require.Assertions
+	IntegrationBase
+}
+
+const (
+	authorizationTestIssuer  = "temporal-integration-test"
+	authorizationTestKeyID   = "test-key"
+	authorizationTestKeyFile = "testdata/authorization/user.secret"
+)
+
+// This cluster authorizes every frontend call with JWT bearer tokens
+func (s *authorizationIntegrationSuite) SetupSuite() {
+	s.setupSuite("testdata/integration_authorization_cluster.yaml")
+}
+
+func (s *authorizationIntegrationSuite) TearDownSuite() {
+	s.tearDownSuite()
+}
+
+func (s *authorizationIntegrationSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+	if s.testCluster == nil {
+		s.T().Skip("authorization tests need a test cluster")
+	}
+}
+
+func TestAuthorizationIntegrationSuite(t *testing.T) {
+	flag.Parse()
+	suite.Run(t, new(authorizationIntegrationSuite))
+}
+
+func (s *authorizationIntegrationSuite) TestAnonymousCallsAreDenied() {
+	client := s.newFrontendClient("")
+	_, err := client.DescribeNamespace(NewContext(), &workflowservice.DescribeNamespaceRequest{Name: s.namespace})
+	s.IsType(&serviceerror.PermissionDenied{}, err)
+
+	adminClient := s.newAdminClient("")
+	_, err = adminClient.DescribeCluster(NewContext(), &adminservice.DescribeClusterRequest{})
+	s.IsType(&serviceerror.PermissionDenied{}, err)
+}
+
+func (s *authorizationIntegrationSuite) TestRolesPerNamespace() {
+	reader := s.newFrontendClient(s.signToken(s.namespace + ":reader"))
+	_, err := reader.DescribeNamespace(NewContext(), &workflowservice.DescribeNamespaceRequest{Name: s.namespace})
+	s.NoError(err)
+	_, err = reader.StartWorkflowExecution(NewContext(), s.startRequest("integration-authorization-reader-test"))
+	s.IsType(&serviceerror.PermissionDenied{}, err)
+	_, err = reader.DescribeNamespace(NewContext(), &workflowservice.DescribeNamespaceRequest{Name: s.foreignNamespace})
+	s.IsType(&serviceerror.PermissionDenied{}, err)
+
+	writer := s.newFrontendClient(s.signToken(s.namespace + ":writer"))
+	_, err = writer.StartWorkflowExecution(NewContext(), s.startRequest("integration-authorization-writer-test"))
+	s.NoError(err)
+	_, err = s.newAdminClient(s.signToken(s.namespace+":writer")).DescribeCluster(NewContext(), &adminservice.DescribeClusterRequest{})
+	s.IsType(&serviceerror.PermissionDenied{}, err)
+}
+
+func (s *authorizationIntegrationSuite) TestSystemCredentials() {
+	// the clients of the test cluster call the frontend with the same system credentials as the services
+	_, err := s.adminClient.DescribeCluster(NewContext(), &adminservice.DescribeClusterRequest{})
+	s.NoError(err)
+	_, err = s.engine.StartWorkflowExecution(NewContext(), s.startRequest("integration-authorization-system-test"))
+	s.NoError(err)
+
+	// a token signed with the user key does not become a system token by using the system key ID
+	_, err = s.newAdminClient(s.signTokenWithKeyID(authorization.SystemKeyID, "*:admin")).
+		DescribeCluster(NewContext(), &adminservice.DescribeClusterRequest{})
+	s.IsType(&serviceerror.PermissionDenied{}, err)
+}
+
+func (s *authorizationIntegrationSuite) startRequest(workflowID string) *workflowservice.StartWorkflowExecutionRequest {
+	return &workflowservice.StartWorkflowExecutionRequest{
+		RequestId:                           uuid.New(),
+		Namespace:                           s.namespace,
+		WorkflowId:                          workflowID,
+		WorkflowType:                        &commonpb.WorkflowType{Name: "integration-authorization-test-type"},
+		TaskList:                            &tasklistpb.TaskList{Name: "integration-authorization-test-tasklist"},
+		ExecutionStartToCloseTimeoutSeconds: 100,
+		TaskStartToCloseTimeoutSeconds:      1,
+		Identity:                            "worker1",
+	}
+}
+
+func (s *authorizationIntegrationSuite) newFrontendClient(token string) workflowservice.WorkflowServiceClient {
+	return NewFrontendClient(s.dial(token))
+}
+
+func (s *authorizationIntegrationSuite) newAdminClient(token string) AdminClient {
+	return NewAdminClient(s.dial(token))
+}
+
+func (s *authorizationIntegrationSuite) dial(token string) *grpc.ClientConn {
+	var creds credentials.PerRPCCredentials
+	if token != "" {
+		creds = authorization.NewTokenCredentials(token)
+	}
+	connection, err := rpc.Dial(s.testCluster.host.FrontendGRPCAddress(), nil, rpc.PerRPCCredentialsOptions(creds)...)
+	s.NoError(err)
+	return connection
+}
+
+func (s *authorizationIntegrationSuite) signToken(permissions ...string) string {
+	return s.signTokenWithKeyID(authorizationTestKeyID, permissions...)
+}
+
+// signTokenWithKeyID signs an HS256 token with the secret of the user key of the test cluster
+func (s *authorizationIntegrationSuite) signTokenWithKeyID(keyID string, permissions ...string) string {
+	secret, err := ioutil.ReadFile(authorizationTestKeyFile)
+	s.NoError(err)
+	header, err := json.Marshal(map[string]string{"alg": "HS256", "kid": keyID})
+	s.NoError(err)
+	payload, err := json.Marshal(map[string]interface{}{
+		"sub":         "integration-test",
+		"iss":         authorizationTestIssuer,
+		"exp":         time.Now().Add(time.Hour).Unix(),
+		"permissions": permissions,
+	})
+	s.NoError(err)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, bytes.TrimSpace(secret))
+	mac.Write([]byte(signed))
+	return signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
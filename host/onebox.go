@@ -35,6 +35,7 @@ import (
 	"go.temporal.io/temporal-proto/workflowservice"
 	sdkclient "go.temporal.io/temporal/client"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
 	"github.com/temporalio/temporal/.gen/proto/adminservice"
 	"github.com/temporalio/temporal/.gen/proto/historyservice"
@@ -75,6 +76,7 @@ type Cadence interface {
 	GetFrontendClient() workflowservice.WorkflowServiceClient
 	GetHistoryClient() historyservice.HistoryServiceClient
 	GetExecutionManagerFactory() persistence.ExecutionManagerFactory
+	FrontendGRPCAddress() string
 }
 
 type (
@@ -112,6 +114,8 @@ type (
 		workerConfig                     *WorkerConfig
 		mockAdminClient                  map[string]adminClient.Client
 		namespaceReplicationTaskExecutor namespace.ReplicationTaskExecutor
+		authorization                    *config.Authorization
+		systemCredentials                credentials.PerRPCCredentials
 	}
 
 	// HistoryConfig contains configs for history service
@@ -146,6 +150,7 @@ type (
 		WorkerConfig                     *WorkerConfig
 		MockAdminClient                  map[string]adminClient.Client
 		NamespaceReplicationTaskExecutor namespace.ReplicationTaskExecutor
+		Authorization                    *config.Authorization
 	}
 
 	membershipFactoryImpl struct {
@@ -156,6 +161,10 @@ type (
 
 // NewCadence returns an instance that hosts full cadence in one process
 func NewCadence(params *CadenceParams) Cadence {
+	systemCredentials, err := authorization.NewSystemCredentials(params.Authorization)
+	if err != nil {
+		params.Logger.Fatal("Failed to load system credentials", tag.Error(err))
+	}
 	return &cadenceImpl{
 		logger:                           params.Logger,
 		clusterMetadata:                  params.ClusterMetadata,
@@ -179,6 +188,8 @@ func NewCadence(params *CadenceParams) Cadence {
 		workerConfig:                     params.WorkerConfig,
 		mockAdminClient:                  params.MockAdminClient,
 		namespaceReplicationTaskExecutor: params.NamespaceReplicationTaskExecutor,
+		authorization:                    params.Authorization,
+		systemCredentials:                systemCredentials,
 	}
 }
 
@@ -375,7 +386,7 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 	params.Logger = c.logger
 	params.ThrottledLogger = c.logger
 	params.RPCFactory = newRPCFactoryImpl(common.FrontendServiceName, c.FrontendGRPCAddress(), c.FrontendRingpopAddress(),
-		c.logger)
+		c.logger, c.systemCredentials)
 	params.MetricScope = tally.NewTestScope(common.FrontendServiceName, make(map[string]string))
 	params.MembershipFactoryInitializer = func(x persistenceClient.Bean, y log.Logger) (resource.MembershipMonitorFactory, error) {
 		return newMembershipFactory(params.Name, hosts), nil
@@ -388,9 +399,16 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 	params.ArchiverProvider = c.archiverProvider
 	params.ESConfig = c.esConfig
 	params.ESClient = c.esClient
-	params.Authorizer = authorization.NewNopAuthorizer()
 
 	var err error
+	params.Authorizer = authorization.NewNopAuthorizer()
+	if c.authorization != nil {
+		params.Authorizer, err = authorization.NewJWTAuthorizer(c.authorization)
+		if err != nil {
+			c.logger.Fatal("Failed to create JWT authorizer", tag.Error(err))
+		}
+	}
+
 	params.PersistenceConfig, err = copyPersistenceConfig(c.persistenceConfig)
 	if err != nil {
 		c.logger.Fatal("Failed to copy persistence config for frontend", tag.Error(err))
@@ -419,7 +437,8 @@ func (c *cadenceImpl) startFrontend(hosts map[string][]string, startWG *sync.Wai
 	}
 
 	c.frontendService = frontendService
-	connection := params.RPCFactory.CreateGRPCConnection(c.FrontendGRPCAddress())
+	// the test clients call the frontend with the system credentials when authorization is enabled
+	connection := params.RPCFactory.CreateFrontendGRPCConnection(c.FrontendGRPCAddress())
 	c.frontendClient = NewFrontendClient(connection)
 	c.adminClient = NewAdminClient(connection)
 	go frontendService.Start()
@@ -439,7 +458,7 @@ func (c *cadenceImpl) startHistory(
 		params.Name = common.HistoryServiceName
 		params.Logger = c.logger
 		params.ThrottledLogger = c.logger
-		params.RPCFactory = newRPCFactoryImpl(common.HistoryServiceName, grpcPort, membershipPorts[i], c.logger)
+		params.RPCFactory = newRPCFactoryImpl(common.HistoryServiceName, grpcPort, membershipPorts[i], c.logger, c.systemCredentials)
 		params.MetricScope = tally.NewTestScope(common.HistoryServiceName, make(map[string]string))
 		params.MembershipFactoryInitializer = func(x persistenceClient.Bean, y log.Logger) (resource.MembershipMonitorFactory, error) {
 			return newMembershipFactory(params.Name, hosts), nil
@@ -456,6 +475,7 @@ func (c *cadenceImpl) startHistory(
 			HostPort:     c.FrontendGRPCAddress(),
 			Namespace:    common.SystemLocalNamespace,
 			MetricsScope: params.MetricScope,
+			GRPCDialer:   c.sdkDialer(),
 		})
 		if err != nil {
 			c.logger.Fatal("Failed to create client for history", tag.Error(err))
@@ -497,7 +517,7 @@ func (c *cadenceImpl) startHistory(
 		// However current interface for getting history client doesn't specify which client it needs and the tests that use this API
 		// depends on the fact that there's only one history host.
 		// Need to change those tests and modify the interface for getting history client.
-		historyConnection, err := rpc.Dial(c.HistoryServiceAddress(3)[0])
+		historyConnection, err := rpc.Dial(c.HistoryServiceAddress(3)[0], nil)
 		if err != nil {
 			c.logger.Fatal("Failed to create connection for history", tag.Error(err))
 		}
@@ -519,7 +539,7 @@ func (c *cadenceImpl) startMatching(hosts map[string][]string, startWG *sync.Wai
 	params.Name = common.MatchingServiceName
 	params.Logger = c.logger
 	params.ThrottledLogger = c.logger
-	params.RPCFactory = newRPCFactoryImpl(common.MatchingServiceName, c.MatchingGRPCServiceAddress(), c.MatchingServiceRingpopAddress(), c.logger)
+	params.RPCFactory = newRPCFactoryImpl(common.MatchingServiceName, c.MatchingGRPCServiceAddress(), c.MatchingServiceRingpopAddress(), c.logger, c.systemCredentials)
 	params.MetricScope = tally.NewTestScope(common.MatchingServiceName, make(map[string]string))
 	params.MembershipFactoryInitializer = func(x persistenceClient.Bean, y log.Logger) (resource.MembershipMonitorFactory, error) {
 		return newMembershipFactory(params.Name, hosts), nil
@@ -561,7 +581,7 @@ func (c *cadenceImpl) startWorker(hosts map[string][]string, startWG *sync.WaitG
 	params.Name = common.WorkerServiceName
 	params.Logger = c.logger
 	params.ThrottledLogger = c.logger
-	params.RPCFactory = newRPCFactoryImpl(common.WorkerServiceName, c.WorkerGRPCServiceAddress(), c.WorkerServiceRingpopAddress(), c.logger)
+	params.RPCFactory = newRPCFactoryImpl(common.WorkerServiceName, c.WorkerGRPCServiceAddress(), c.WorkerServiceRingpopAddress(), c.logger, c.systemCredentials)
 	params.MetricScope = tally.NewTestScope(common.WorkerServiceName, make(map[string]string))
 	params.MembershipFactoryInitializer = func(x persistenceClient.Bean, y log.Logger) (resource.MembershipMonitorFactory, error) {
 		return newMembershipFactory(params.Name, hosts), nil
@@ -582,6 +602,7 @@ func (c *cadenceImpl) startWorker(hosts map[string][]string, startWG *sync.WaitG
 		HostPort:     c.FrontendGRPCAddress(),
 		Namespace:    common.SystemLocalNamespace,
 		MetricsScope: params.MetricScope,
+		GRPCDialer:   c.sdkDialer(),
 	})
 	if err != nil {
 		c.logger.Fatal("Failed to create client for worker", tag.Error(err))
@@ -762,24 +783,35 @@ func (p *membershipFactoryImpl) GetMembershipMonitor() (membership.Monitor, erro
 	return newSimpleMonitor(p.serviceName, p.hosts), nil
 }
 
+// sdkDialer returns the dialer of the SDK clients of the services, they call the frontend
+// with the system credentials
+func (c *cadenceImpl) sdkDialer() func(params sdkclient.GRPCDialerParams) (*grpc.ClientConn, error) {
+	if c.systemCredentials == nil {
+		return nil
+	}
+	return rpc.NewSDKDialer(nil, rpc.PerRPCCredentialsOptions(c.systemCredentials)...)
+}
+
 type rpcFactoryImpl struct {
 	serviceName        string
 	ringpopServiceName string
 	grpcHostPort       string
 	ringpopHostPort    string
 	logger             log.Logger
+	systemCredentials  credentials.PerRPCCredentials
 
 	sync.Mutex
 	listener       net.Listener
 	ringpopChannel *tchannel.Channel
 }
 
-func newRPCFactoryImpl(sName, grpcHostPort, ringpopHostPort string, logger log.Logger) common.RPCFactory {
+func newRPCFactoryImpl(sName, grpcHostPort, ringpopHostPort string, logger log.Logger, systemCredentials credentials.PerRPCCredentials) common.RPCFactory {
 	return &rpcFactoryImpl{
-		serviceName:     sName,
-		grpcHostPort:    grpcHostPort,
-		ringpopHostPort: ringpopHostPort,
-		logger:          logger,
+		serviceName:       sName,
+		grpcHostPort:      grpcHostPort,
+		ringpopHostPort:   ringpopHostPort,
+		logger:            logger,
+		systemCredentials: systemCredentials,
 	}
 }
 
@@ -832,10 +864,24 @@ func (c *rpcFactoryImpl) GetRingpopChannel() *tchannel.Channel {
 
 // CreateGRPCConnection creates connection for gRPC calls
 func (c *rpcFactoryImpl) CreateGRPCConnection(hostName string) *grpc.ClientConn {
-	connection, err := rpc.Dial(hostName)
+	return c.dial(hostName)
+}
+
+// CreateFrontendGRPCConnection creates connection for gRPC calls to the frontend
+func (c *rpcFactoryImpl) CreateFrontendGRPCConnection(hostName string) *grpc.ClientConn {
+	return c.dial(hostName, rpc.PerRPCCredentialsOptions(c.systemCredentials)...)
+}
+
+func (c *rpcFactoryImpl) dial(hostName string, opts ...grpc.DialOption) *grpc.ClientConn {
+	connection, err := rpc.Dial(hostName, nil, opts...)
 	if err != nil {
 		c.logger.Fatal("Failed to create gRPC connection", tag.Error(err))
 	}
 
 	return connection
 }
+
+// GetGRPCServerOptions returns the options of the gRPC server, the test services don't use TLS
+func (c *rpcFactoryImpl) GetGRPCServerOptions() []grpc.ServerOption {
+	return nil
+}
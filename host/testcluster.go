@@ -47,6 +47,7 @@ import (
 	"github.com/temporalio/temporal/common/persistence"
 	pes "github.com/temporalio/temporal/common/persistence/elasticsearch"
 	persistencetests "github.com/temporalio/temporal/common/persistence/persistence-tests"
+	"github.com/temporalio/temporal/common/persistence/sql/sqlplugin/memory"
 	"github.com/temporalio/temporal/common/persistence/sql/sqlplugin/mysql"
 	"github.com/temporalio/temporal/common/service/config"
 	"github.com/temporalio/temporal/common/service/dynamicconfig"
@@ -84,6 +85,9 @@ type (
 		ESConfig              *elasticsearch.Config
 		WorkerConfig          *WorkerConfig
 		MockAdminClient       map[string]adminClient.Client
+		// Authorization enables the JWT authorizer of the frontend, the test clients and
+		// the services call it with the system credentials
+		Authorization *config.Authorization
 	}
 
 	// MessagingClientConfig is the config for messaging config
@@ -129,6 +133,10 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 		var ops *persistencetests.TestBaseOptions
 		if TestFlags.SQLPluginName == mysql.PluginName {
 			ops = mysql.GetTestClusterOption()
+		} else if TestFlags.SQLPluginName == memory.PluginName {
+			// onebox services open their stores from the persistence config below, the memory
+			// plugin shares data between connections that use the same database name
+			ops = memory.GetTestClusterOption()
 		} else {
 			panic("not supported plugin " + TestFlags.SQLPluginName)
 		}
@@ -167,7 +175,7 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 			ValidSearchAttributes:  dynamicconfig.GetMapPropertyFn(definition.GetDefaultIndexedKeys()),
 		}
 		esVisibilityStore := pes.NewElasticSearchVisibilityStore(esClient, indexName, visProducer, visConfig, logger)
-		esVisibilityMgr = persistence.NewVisibilityManagerImpl(esVisibilityStore, logger)
+		esVisibilityMgr = persistence.NewVisibilityManagerImpl(esVisibilityStore, persistence.NewPayloadSerializer(), logger)
 	}
 	visibilityMgr := persistence.NewVisibilityManagerWrapper(testBase.VisibilityMgr, esVisibilityMgr,
 		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(options.WorkerConfig.EnableIndexer), advancedVisibilityWritingMode)
@@ -196,6 +204,7 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 		WorkerConfig:                     options.WorkerConfig,
 		MockAdminClient:                  options.MockAdminClient,
 		NamespaceReplicationTaskExecutor: namespace.NewReplicationTaskExecutor(testBase.MetadataManager, logger),
+		Authorization:                    options.Authorization,
 	}
 
 	err := newPProfInitializerImpl(logger, pprofTestPort).Start()
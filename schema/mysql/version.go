@@ -30,4 +30,4 @@ package mysql
 const Version = "0.4"
 
 // VisibilityVersion is the MySQL visibility database release version
-const VisibilityVersion = "0.2"
+const VisibilityVersion = "0.3"
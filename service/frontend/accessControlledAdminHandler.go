@@ -0,0 +1,608 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+
+	"github.com/temporalio/temporal/.gen/proto/adminservice"
+	"github.com/temporalio/temporal/common/authorization"
+	"github.com/temporalio/temporal/common/metrics"
+)
+
+var _ adminservice.AdminServiceServer = (*AccessControlledAdminHandler)(nil)
+
+type (
+	// AccessControlledAdminHandler admin handler wrapper for authentication and authorization
+	AccessControlledAdminHandler struct {
+		adminHandler  adminservice.AdminServiceServer
+		authorizer    authorization.Authorizer
+		metricsClient metrics.Client
+	}
+)
+
+// NewAccessControlledAdminHandler creates admin handler with authentication support,
+// the API names of the admin APIs are prefixed with authorization.AdminAPIPrefix
+func NewAccessControlledAdminHandler(
+	adminHandler adminservice.AdminServiceServer,
+	authorizer authorization.Authorizer,
+	metricsClient metrics.Client,
+) *AccessControlledAdminHandler {
+	if authorizer == nil {
+		authorizer = authorization.NewNopAuthorizer()
+	}
+
+	return &AccessControlledAdminHandler{
+		adminHandler:  adminHandler,
+		authorizer:    authorizer,
+		metricsClient: metricsClient,
+	}
+}
+
+// DescribeWorkflowExecution API call
+func (a *AccessControlledAdminHandler) DescribeWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.DescribeWorkflowExecutionRequest,
+) (*adminservice.DescribeWorkflowExecutionResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminDescribeWorkflowExecutionScope, "DescribeWorkflowExecution", ""); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.DescribeWorkflowExecution(ctx, request)
+}
+
+// DescribeHistoryHost API call
+func (a *AccessControlledAdminHandler) DescribeHistoryHost(
+	ctx context.Context,
+	request *adminservice.DescribeHistoryHostRequest,
+) (*adminservice.DescribeHistoryHostResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminDescribeHistoryHostScope, "DescribeHistoryHost", ""); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.DescribeHistoryHost(ctx, request)
+}
+
+// CloseShard API call
+func (a *AccessControlledAdminHandler) CloseShard(
+	ctx context.Context,
+	request *adminservice.CloseShardRequest,
+) (*adminservice.CloseShardResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminCloseShardTaskScope, "CloseShard", ""); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.CloseShard(ctx, request)
+}
+
+// RemoveTask API call
+func (a *AccessControlledAdminHandler) RemoveTask(
+	ctx context.Context,
+	request *adminservice.RemoveTaskRequest,
+) (*adminservice.RemoveTaskResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminRemoveTaskScope, "RemoveTask", ""); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.RemoveTask(ctx, request)
+}
+
+// GetWorkflowExecutionRawHistory API call
+func (a *AccessControlledAdminHandler) GetWorkflowExecutionRawHistory(
+	ctx context.Context,
+	request *adminservice.GetWorkflowExecutionRawHistoryRequest,
+) (*adminservice.GetWorkflowExecutionRawHistoryResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminGetWorkflowExecutionRawHistoryScope, "GetWorkflowExecutionRawHistory", ""); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.GetWorkflowExecutionRawHistory(ctx, request)
+}
+
+// GetWorkflowExecutionRawHistoryV2 API call
+func (a *AccessControlledAdminHandler) GetWorkflowExecutionRawHistoryV2(
+	ctx context.Context,
+	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
+) (*adminservice.GetWorkflowExecutionRawHistoryV2Response, error) {
+
+	if err := a.authorize(ctx, metrics.AdminGetWorkflowExecutionRawHistoryV2Scope, "GetWorkflowExecutionRawHistoryV2", ""); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.GetWorkflowExecutionRawHistoryV2(ctx, request)
+}
+
+// AddSearchAttribute API call
+func (a *AccessControlledAdminHandler) AddSearchAttribute(
+	ctx context.Context,
+	request *adminservice.AddSearchAttributeRequest,
+) (*adminservice.AddSearchAttributeResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminAddSearchAttributeScope, "AddSearchAttribute", ""); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.AddSearchAttribute(ctx, request)
+}
+
+// DescribeCluster API call
+func (a *AccessControlledAdminHandler) DescribeCluster(
+	ctx context.Context,
+	request *adminservice.DescribeClusterRequest,
+) (*adminservice.DescribeClusterResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminDescribeClusterScope, "DescribeCluster", ""); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.DescribeCluster(ctx, request)
+}
+
+// GetReplicationMessages API call
+func (a *AccessControlledAdminHandler) GetReplicationMessages(
+	ctx context.Context,
+	request *adminservice.GetReplicationMessagesRequest,
+) (*adminservice.GetReplicationMessagesResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminGetReplicationMessagesScope, "GetReplicationMessages", ""); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.GetReplicationMessages(ctx, request)
+}
+
+// GetNamespaceReplicationMessages API call
+func (a *AccessControlledAdminHandler) GetNamespaceReplicationMessages(
+	ctx context.Context,
+	request *adminservice.GetNamespaceReplicationMessagesRequest,
+) (*adminservice.GetNamespaceReplicationMessagesResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminGetNamespaceReplicationMessagesScope, "GetNamespaceReplicationMessages", ""); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.GetNamespaceReplicationMessages(ctx, request)
+}
+
+// GetDLQReplicationMessages API call
+func (a *AccessControlledAdminHandler) GetDLQReplicationMessages(
+	ctx context.Context,
+	request *adminservice.GetDLQReplicationMessagesRequest,
+) (*adminservice.GetDLQReplicationMessagesResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminGetDLQReplicationMessagesScope, "GetDLQReplicationMessages", ""); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.GetDLQReplicationMessages(ctx, request)
+}
+
+// ReapplyEvents API call
+func (a *AccessControlledAdminHandler) ReapplyEvents(
+	ctx context.Context,
+	request *adminservice.ReapplyEventsRequest,
+) (*adminservice.ReapplyEventsResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminReapplyEventsScope, "ReapplyEvents", ""); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.ReapplyEvents(ctx, request)
+}
+
+// ReadDLQMessages API call
+func (a *AccessControlledAdminHandler) ReadDLQMessages(
+	ctx context.Context,
+	request *adminservice.ReadDLQMessagesRequest,
+) (*adminservice.ReadDLQMessagesResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminReadDLQMessagesScope, "ReadDLQMessages", ""); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.ReadDLQMessages(ctx, request)
+}
+
+// PurgeDLQMessages API call
+func (a *AccessControlledAdminHandler) PurgeDLQMessages(
+	ctx context.Context,
+	request *adminservice.PurgeDLQMessagesRequest,
+) (*adminservice.PurgeDLQMessagesResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminPurgeDLQMessagesScope, "PurgeDLQMessages", ""); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.PurgeDLQMessages(ctx, request)
+}
+
+// MergeDLQMessages API call
+func (a *AccessControlledAdminHandler) MergeDLQMessages(
+	ctx context.Context,
+	request *adminservice.MergeDLQMessagesRequest,
+) (*adminservice.MergeDLQMessagesResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminMergeDLQMessagesScope, "MergeDLQMessages", ""); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.MergeDLQMessages(ctx, request)
+}
+
+// RefreshWorkflowTasks API call
+func (a *AccessControlledAdminHandler) RefreshWorkflowTasks(
+	ctx context.Context,
+	request *adminservice.RefreshWorkflowTasksRequest,
+) (*adminservice.RefreshWorkflowTasksResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminRefreshWorkflowTasksScope, "RefreshWorkflowTasks", ""); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.RefreshWorkflowTasks(ctx, request)
+}
+
+// PauseWorkflowExecution API call
+func (a *AccessControlledAdminHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+) (*adminservice.PauseWorkflowExecutionResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminPauseWorkflowExecutionScope, "PauseWorkflowExecution", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.PauseWorkflowExecution(ctx, request)
+}
+
+// UnpauseWorkflowExecution API call
+func (a *AccessControlledAdminHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminUnpauseWorkflowExecutionScope, "UnpauseWorkflowExecution", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.UnpauseWorkflowExecution(ctx, request)
+}
+
+// TriggerCronRun API call
+func (a *AccessControlledAdminHandler) TriggerCronRun(
+	ctx context.Context,
+	request *adminservice.TriggerCronRunRequest,
+) (*adminservice.TriggerCronRunResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminTriggerCronRunScope, "TriggerCronRun", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.TriggerCronRun(ctx, request)
+}
+
+// SkipCronRuns API call
+func (a *AccessControlledAdminHandler) SkipCronRuns(
+	ctx context.Context,
+	request *adminservice.SkipCronRunsRequest,
+) (*adminservice.SkipCronRunsResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminSkipCronRunsScope, "SkipCronRuns", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.SkipCronRuns(ctx, request)
+}
+
+// UpdateActivityOptions API call
+func (a *AccessControlledAdminHandler) UpdateActivityOptions(
+	ctx context.Context,
+	request *adminservice.UpdateActivityOptionsRequest,
+) (*adminservice.UpdateActivityOptionsResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminUpdateActivityOptionsScope, "UpdateActivityOptions", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.UpdateActivityOptions(ctx, request)
+}
+
+// ResetActivity API call
+func (a *AccessControlledAdminHandler) ResetActivity(
+	ctx context.Context,
+	request *adminservice.ResetActivityRequest,
+) (*adminservice.ResetActivityResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminResetActivityScope, "ResetActivity", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.ResetActivity(ctx, request)
+}
+
+// StartBatchOperation API call
+func (a *AccessControlledAdminHandler) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+) (*adminservice.StartBatchOperationResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminStartBatchOperationScope, "StartBatchOperation", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.StartBatchOperation(ctx, request)
+}
+
+// DescribeBatchOperation API call
+func (a *AccessControlledAdminHandler) DescribeBatchOperation(
+	ctx context.Context,
+	request *adminservice.DescribeBatchOperationRequest,
+) (*adminservice.DescribeBatchOperationResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminDescribeBatchOperationScope, "DescribeBatchOperation", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.DescribeBatchOperation(ctx, request)
+}
+
+// ListBatchOperations API call
+func (a *AccessControlledAdminHandler) ListBatchOperations(
+	ctx context.Context,
+	request *adminservice.ListBatchOperationsRequest,
+) (*adminservice.ListBatchOperationsResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminListBatchOperationsScope, "ListBatchOperations", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.ListBatchOperations(ctx, request)
+}
+
+// StopBatchOperation API call
+func (a *AccessControlledAdminHandler) StopBatchOperation(
+	ctx context.Context,
+	request *adminservice.StopBatchOperationRequest,
+) (*adminservice.StopBatchOperationResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminStopBatchOperationScope, "StopBatchOperation", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.StopBatchOperation(ctx, request)
+}
+
+// DeleteWorkflowExecution API call
+func (a *AccessControlledAdminHandler) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.DeleteWorkflowExecutionRequest,
+) (*adminservice.DeleteWorkflowExecutionResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminDeleteWorkflowExecutionScope, "DeleteWorkflowExecution", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.DeleteWorkflowExecution(ctx, request)
+}
+
+// UpsertWorkflowAttributes API call
+func (a *AccessControlledAdminHandler) UpsertWorkflowAttributes(
+	ctx context.Context,
+	request *adminservice.UpsertWorkflowAttributesRequest,
+) (*adminservice.UpsertWorkflowAttributesResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminUpsertWorkflowAttributesScope, "UpsertWorkflowAttributes", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.UpsertWorkflowAttributes(ctx, request)
+}
+
+// DeleteNamespace API call
+func (a *AccessControlledAdminHandler) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+) (*adminservice.DeleteNamespaceResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminDeleteNamespaceScope, "DeleteNamespace", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.DeleteNamespace(ctx, request)
+}
+
+// DescribeNamespaceDeletion API call
+func (a *AccessControlledAdminHandler) DescribeNamespaceDeletion(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceDeletionRequest,
+) (*adminservice.DescribeNamespaceDeletionResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminDescribeNamespaceDeletionScope, "DescribeNamespaceDeletion", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.DescribeNamespaceDeletion(ctx, request)
+}
+
+// RenameNamespace API call
+func (a *AccessControlledAdminHandler) RenameNamespace(
+	ctx context.Context,
+	request *adminservice.RenameNamespaceRequest,
+) (*adminservice.RenameNamespaceResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminRenameNamespaceScope, "RenameNamespace", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.RenameNamespace(ctx, request)
+}
+
+// StartMigration API call
+func (a *AccessControlledAdminHandler) StartMigration(
+	ctx context.Context,
+	request *adminservice.StartMigrationRequest,
+) (*adminservice.StartMigrationResponse, error) {
+
+	// the workflow executions are read from the source namespace and written to the target namespace
+	if err := a.authorize(ctx, metrics.AdminStartMigrationScope, "StartMigration", request.GetSourceNamespace()); err != nil {
+		return nil, err
+	}
+	if err := a.authorize(ctx, metrics.AdminStartMigrationScope, "StartMigration", request.GetTargetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.StartMigration(ctx, request)
+}
+
+// DescribeMigration API call
+func (a *AccessControlledAdminHandler) DescribeMigration(
+	ctx context.Context,
+	request *adminservice.DescribeMigrationRequest,
+) (*adminservice.DescribeMigrationResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminDescribeMigrationScope, "DescribeMigration", ""); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.DescribeMigration(ctx, request)
+}
+
+// ExportWorkflowExecution API call
+func (a *AccessControlledAdminHandler) ExportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ExportWorkflowExecutionRequest,
+) (*adminservice.ExportWorkflowExecutionResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminExportWorkflowExecutionScope, "ExportWorkflowExecution", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.ExportWorkflowExecution(ctx, request)
+}
+
+// ImportWorkflowExecution API call
+func (a *AccessControlledAdminHandler) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+) (*adminservice.ImportWorkflowExecutionResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminImportWorkflowExecutionScope, "ImportWorkflowExecution", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.ImportWorkflowExecution(ctx, request)
+}
+
+// StreamWorkflowExecutionHistory API call
+func (a *AccessControlledAdminHandler) StreamWorkflowExecutionHistory(
+	request *adminservice.StreamWorkflowExecutionHistoryRequest,
+	server adminservice.AdminService_StreamWorkflowExecutionHistoryServer,
+) error {
+
+	if err := a.authorize(server.Context(), metrics.AdminStreamWorkflowExecutionHistoryScope, "StreamWorkflowExecutionHistory", request.GetNamespace()); err != nil {
+		return err
+	}
+
+	return a.adminHandler.StreamWorkflowExecutionHistory(request, server)
+}
+
+// UpdateWorkflowExecution API call
+func (a *AccessControlledAdminHandler) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowExecutionRequest,
+) (*adminservice.UpdateWorkflowExecutionResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminUpdateWorkflowExecutionScope, "UpdateWorkflowExecution", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.UpdateWorkflowExecution(ctx, request)
+}
+
+// UpdateWorkerVersionSets API call
+func (a *AccessControlledAdminHandler) UpdateWorkerVersionSets(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerVersionSetsRequest,
+) (*adminservice.UpdateWorkerVersionSetsResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminUpdateWorkerVersionSetsScope, "UpdateWorkerVersionSets", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.UpdateWorkerVersionSets(ctx, request)
+}
+
+// DescribeTaskListVersions API call
+func (a *AccessControlledAdminHandler) DescribeTaskListVersions(
+	ctx context.Context,
+	request *adminservice.DescribeTaskListVersionsRequest,
+) (*adminservice.DescribeTaskListVersionsResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminDescribeTaskListVersionsScope, "DescribeTaskListVersions", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.DescribeTaskListVersions(ctx, request)
+}
+
+// UpdateTaskListRateLimit API call
+func (a *AccessControlledAdminHandler) UpdateTaskListRateLimit(
+	ctx context.Context,
+	request *adminservice.UpdateTaskListRateLimitRequest,
+) (*adminservice.UpdateTaskListRateLimitResponse, error) {
+
+	if err := a.authorize(ctx, metrics.AdminUpdateTaskListRateLimitScope, "UpdateTaskListRateLimit", request.GetNamespace()); err != nil {
+		return nil, err
+	}
+
+	return a.adminHandler.UpdateTaskListRateLimit(ctx, request)
+}
+
+func (a *AccessControlledAdminHandler) authorize(
+	ctx context.Context,
+	scopeIdx int,
+	apiName string,
+	namespace string,
+) error {
+	scope := getMetricsScopeWithNamespace(scopeIdx, namespace, a.metricsClient)
+
+	attr := &authorization.Attributes{
+		APIName:   authorization.AdminAPIPrefix + apiName,
+		Namespace: namespace,
+	}
+	allowed, err := isAuthorized(ctx, a.authorizer, attr, scope)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return errUnauthorized
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+
+	"github.com/temporalio/temporal/.gen/proto/adminservice"
+	"github.com/temporalio/temporal/.gen/proto/adminservicemock"
+	"github.com/temporalio/temporal/common/authorization"
+	"github.com/temporalio/temporal/common/metrics"
+)
+
+type (
+	accessControlledAdminHandlerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller       *gomock.Controller
+		mockAdminHandler *adminservicemock.MockAdminServiceServer
+		mockAuthorizer   *authorization.MockAuthorizer
+
+		handler *AccessControlledAdminHandler
+	}
+)
+
+func TestAccessControlledAdminHandlerSuite(t *testing.T) {
+	s := new(accessControlledAdminHandlerSuite)
+	suite.Run(t, s)
+}
+
+func (s *accessControlledAdminHandlerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+
+	s.mockAdminHandler = adminservicemock.NewMockAdminServiceServer(s.controller)
+	s.mockAuthorizer = authorization.NewMockAuthorizer(s.controller)
+	s.handler = NewAccessControlledAdminHandler(
+		s.mockAdminHandler,
+		s.mockAuthorizer,
+		metrics.NewClient(tally.NoopScope, metrics.Frontend),
+	)
+}
+
+func (s *accessControlledAdminHandlerSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *accessControlledAdminHandlerSuite) TestStartMigration() {
+	ctx := context.Background()
+	request := &adminservice.StartMigrationRequest{
+		SourceNamespace: "source-namespace",
+		TargetNamespace: "target-namespace",
+	}
+
+	s.mockAuthorizer.EXPECT().Authorize(ctx, &authorization.Attributes{
+		APIName:   authorization.AdminAPIPrefix + "StartMigration",
+		Namespace: "source-namespace",
+	}).Return(authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(1)
+	s.mockAuthorizer.EXPECT().Authorize(ctx, &authorization.Attributes{
+		APIName:   authorization.AdminAPIPrefix + "StartMigration",
+		Namespace: "target-namespace",
+	}).Return(authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(1)
+	s.mockAdminHandler.EXPECT().StartMigration(ctx, request).Return(&adminservice.StartMigrationResponse{}, nil).Times(1)
+
+	resp, err := s.handler.StartMigration(ctx, request)
+	s.NoError(err)
+	s.NotNil(resp)
+}
+
+func (s *accessControlledAdminHandlerSuite) TestStartMigration_NoRoleOnSourceNamespace() {
+	ctx := context.Background()
+	request := &adminservice.StartMigrationRequest{
+		SourceNamespace: "source-namespace",
+		TargetNamespace: "target-namespace",
+	}
+
+	// the caller is an admin of the target namespace only
+	s.mockAuthorizer.EXPECT().Authorize(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, attr *authorization.Attributes) (authorization.Result, error) {
+			if attr.Namespace == "target-namespace" {
+				return authorization.Result{Decision: authorization.DecisionAllow}, nil
+			}
+			return authorization.Result{Decision: authorization.DecisionDeny}, nil
+		}).AnyTimes()
+
+	resp, err := s.handler.StartMigration(ctx, request)
+	s.Equal(errUnauthorized, err)
+	s.Nil(resp)
+}
@@ -30,8 +30,10 @@ import (
 	"go.temporal.io/temporal-proto/workflowservice"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
+	"github.com/temporalio/temporal/common"
 	"github.com/temporalio/temporal/common/authorization"
 	"github.com/temporalio/temporal/common/metrics"
+	"github.com/temporalio/temporal/common/primitives"
 	"github.com/temporalio/temporal/common/resource"
 )
 
@@ -41,6 +43,7 @@ import (
 type AccessControlledWorkflowHandler struct {
 	frontendHandler Handler
 	authorizer      authorization.Authorizer
+	tokenSerializer common.TaskTokenSerializer
 }
 
 var _ Handler = (*AccessControlledWorkflowHandler)(nil)
@@ -54,6 +57,7 @@ func NewAccessControlledHandlerImpl(wfHandler Handler, authorizer authorization.
 	return &AccessControlledWorkflowHandler{
 		frontendHandler: wfHandler,
 		authorizer:      authorizer,
+		tokenSerializer: common.NewProtoTaskTokenSerializer(),
 	}
 }
 
@@ -212,6 +216,20 @@ func (a *AccessControlledWorkflowHandler) GetSearchAttributes(
 	ctx context.Context,
 	request *workflowservice.GetSearchAttributesRequest,
 ) (*workflowservice.GetSearchAttributesResponse, error) {
+
+	scope := a.GetResource().GetMetricsClient().Scope(metrics.FrontendGetSearchAttributesScope)
+
+	attr := &authorization.Attributes{
+		APIName: "GetSearchAttributes",
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.GetSearchAttributes(ctx, request)
 }
 
@@ -426,6 +444,20 @@ func (a *AccessControlledWorkflowHandler) GetClusterInfo(
 	ctx context.Context,
 	request *workflowservice.GetClusterInfoRequest,
 ) (*workflowservice.GetClusterInfoResponse, error) {
+
+	scope := a.GetResource().GetMetricsClient().Scope(metrics.FrontendGetClusterInfoScope)
+
+	attr := &authorization.Attributes{
+		APIName: "GetClusterInfo",
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.GetClusterInfo(ctx, request)
 }
 
@@ -434,7 +466,22 @@ func (a *AccessControlledWorkflowHandler) RecordActivityTaskHeartbeat(
 	ctx context.Context,
 	request *workflowservice.RecordActivityTaskHeartbeatRequest,
 ) (*workflowservice.RecordActivityTaskHeartbeatResponse, error) {
-	// TODO(vancexu): add auth check for service API
+
+	namespace := a.getNamespaceFromTaskToken(request.GetTaskToken())
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRecordActivityTaskHeartbeatScope, namespace)
+
+	attr := &authorization.Attributes{
+		APIName:   "RecordActivityTaskHeartbeat",
+		Namespace: namespace,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RecordActivityTaskHeartbeat(ctx, request)
 }
 
@@ -443,6 +490,21 @@ func (a *AccessControlledWorkflowHandler) RecordActivityTaskHeartbeatById(
 	ctx context.Context,
 	request *workflowservice.RecordActivityTaskHeartbeatByIdRequest,
 ) (*workflowservice.RecordActivityTaskHeartbeatByIdResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRecordActivityTaskHeartbeatByIdScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "RecordActivityTaskHeartbeatById",
+		Namespace: request.GetNamespace(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RecordActivityTaskHeartbeatById(ctx, request)
 }
 
@@ -543,6 +605,22 @@ func (a *AccessControlledWorkflowHandler) RespondActivityTaskCanceled(
 	ctx context.Context,
 	request *workflowservice.RespondActivityTaskCanceledRequest,
 ) (*workflowservice.RespondActivityTaskCanceledResponse, error) {
+
+	namespace := a.getNamespaceFromTaskToken(request.GetTaskToken())
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRespondActivityTaskCanceledScope, namespace)
+
+	attr := &authorization.Attributes{
+		APIName:   "RespondActivityTaskCanceled",
+		Namespace: namespace,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RespondActivityTaskCanceled(ctx, request)
 }
 
@@ -551,6 +629,21 @@ func (a *AccessControlledWorkflowHandler) RespondActivityTaskCanceledById(
 	ctx context.Context,
 	request *workflowservice.RespondActivityTaskCanceledByIdRequest,
 ) (*workflowservice.RespondActivityTaskCanceledByIdResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRespondActivityTaskCanceledByIdScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "RespondActivityTaskCanceledById",
+		Namespace: request.GetNamespace(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RespondActivityTaskCanceledById(ctx, request)
 }
 
@@ -559,6 +652,22 @@ func (a *AccessControlledWorkflowHandler) RespondActivityTaskCompleted(
 	ctx context.Context,
 	request *workflowservice.RespondActivityTaskCompletedRequest,
 ) (*workflowservice.RespondActivityTaskCompletedResponse, error) {
+
+	namespace := a.getNamespaceFromTaskToken(request.GetTaskToken())
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRespondActivityTaskCompletedScope, namespace)
+
+	attr := &authorization.Attributes{
+		APIName:   "RespondActivityTaskCompleted",
+		Namespace: namespace,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RespondActivityTaskCompleted(ctx, request)
 }
 
@@ -567,6 +676,21 @@ func (a *AccessControlledWorkflowHandler) RespondActivityTaskCompletedById(
 	ctx context.Context,
 	request *workflowservice.RespondActivityTaskCompletedByIdRequest,
 ) (*workflowservice.RespondActivityTaskCompletedByIdResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRespondActivityTaskCompletedByIdScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "RespondActivityTaskCompletedById",
+		Namespace: request.GetNamespace(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RespondActivityTaskCompletedById(ctx, request)
 }
 
@@ -575,6 +699,22 @@ func (a *AccessControlledWorkflowHandler) RespondActivityTaskFailed(
 	ctx context.Context,
 	request *workflowservice.RespondActivityTaskFailedRequest,
 ) (*workflowservice.RespondActivityTaskFailedResponse, error) {
+
+	namespace := a.getNamespaceFromTaskToken(request.GetTaskToken())
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRespondActivityTaskFailedScope, namespace)
+
+	attr := &authorization.Attributes{
+		APIName:   "RespondActivityTaskFailed",
+		Namespace: namespace,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RespondActivityTaskFailed(ctx, request)
 }
 
@@ -583,6 +723,21 @@ func (a *AccessControlledWorkflowHandler) RespondActivityTaskFailedById(
 	ctx context.Context,
 	request *workflowservice.RespondActivityTaskFailedByIdRequest,
 ) (*workflowservice.RespondActivityTaskFailedByIdResponse, error) {
+
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRespondActivityTaskFailedByIdScope, request.GetNamespace())
+
+	attr := &authorization.Attributes{
+		APIName:   "RespondActivityTaskFailedById",
+		Namespace: request.GetNamespace(),
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RespondActivityTaskFailedById(ctx, request)
 }
 
@@ -591,6 +746,22 @@ func (a *AccessControlledWorkflowHandler) RespondDecisionTaskCompleted(
 	ctx context.Context,
 	request *workflowservice.RespondDecisionTaskCompletedRequest,
 ) (*workflowservice.RespondDecisionTaskCompletedResponse, error) {
+
+	namespace := a.getNamespaceFromTaskToken(request.GetTaskToken())
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRespondDecisionTaskCompletedScope, namespace)
+
+	attr := &authorization.Attributes{
+		APIName:   "RespondDecisionTaskCompleted",
+		Namespace: namespace,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RespondDecisionTaskCompleted(ctx, request)
 }
 
@@ -599,6 +770,22 @@ func (a *AccessControlledWorkflowHandler) RespondDecisionTaskFailed(
 	ctx context.Context,
 	request *workflowservice.RespondDecisionTaskFailedRequest,
 ) (*workflowservice.RespondDecisionTaskFailedResponse, error) {
+
+	namespace := a.getNamespaceFromTaskToken(request.GetTaskToken())
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRespondDecisionTaskFailedScope, namespace)
+
+	attr := &authorization.Attributes{
+		APIName:   "RespondDecisionTaskFailed",
+		Namespace: namespace,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RespondDecisionTaskFailed(ctx, request)
 }
 
@@ -607,6 +794,22 @@ func (a *AccessControlledWorkflowHandler) RespondQueryTaskCompleted(
 	ctx context.Context,
 	request *workflowservice.RespondQueryTaskCompletedRequest,
 ) (*workflowservice.RespondQueryTaskCompletedResponse, error) {
+
+	namespace := a.getNamespaceFromQueryTaskToken(request.GetTaskToken())
+	scope := a.getMetricsScopeWithNamespace(metrics.FrontendRespondQueryTaskCompletedScope, namespace)
+
+	attr := &authorization.Attributes{
+		APIName:   "RespondQueryTaskCompleted",
+		Namespace: namespace,
+	}
+	isAuthorized, err := a.isAuthorized(ctx, attr, scope)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
 	return a.frontendHandler.RespondQueryTaskCompleted(ctx, request)
 }
 
@@ -775,11 +978,20 @@ func (a *AccessControlledWorkflowHandler) isAuthorized(
 	ctx context.Context,
 	attr *authorization.Attributes,
 	scope metrics.Scope,
+) (bool, error) {
+	return isAuthorized(ctx, a.authorizer, attr, scope)
+}
+
+func isAuthorized(
+	ctx context.Context,
+	authorizer authorization.Authorizer,
+	attr *authorization.Attributes,
+	scope metrics.Scope,
 ) (bool, error) {
 	sw := scope.StartTimer(metrics.ServiceAuthorizationLatency)
 	defer sw.Stop()
 
-	result, err := a.authorizer.Authorize(ctx, attr)
+	result, err := authorizer.Authorize(ctx, attr)
 	if err != nil {
 		scope.IncCounter(metrics.ServiceErrAuthorizeFailedCounter)
 		return false, err
@@ -791,6 +1003,42 @@ func (a *AccessControlledWorkflowHandler) isAuthorized(
 	return isAuth, nil
 }
 
+// getNamespaceFromTaskToken returns the name of the namespace a task token belongs to,
+// it is empty when the token can't be resolved and the handler rejects such tokens
+func (a *AccessControlledWorkflowHandler) getNamespaceFromTaskToken(token []byte) string {
+	if token == nil {
+		return ""
+	}
+	taskToken, err := a.tokenSerializer.Deserialize(token)
+	if err != nil {
+		return ""
+	}
+	return a.getNamespaceName(primitives.UUIDString(taskToken.GetNamespaceId()))
+}
+
+// getNamespaceFromQueryTaskToken returns the name of the namespace a query task token belongs to
+func (a *AccessControlledWorkflowHandler) getNamespaceFromQueryTaskToken(token []byte) string {
+	if token == nil {
+		return ""
+	}
+	queryTaskToken, err := a.tokenSerializer.DeserializeQueryTaskToken(token)
+	if err != nil {
+		return ""
+	}
+	return a.getNamespaceName(queryTaskToken.GetNamespaceId())
+}
+
+func (a *AccessControlledWorkflowHandler) getNamespaceName(namespaceID string) string {
+	if namespaceID == "" {
+		return ""
+	}
+	namespace, err := a.GetResource().GetNamespaceCache().GetNamespaceName(namespaceID)
+	if err != nil {
+		return ""
+	}
+	return namespace
+}
+
 // getMetricsScopeWithNamespace return metrics scope with namespace tag
 func (a *AccessControlledWorkflowHandler) getMetricsScopeWithNamespace(
 	scope int,
@@ -26,26 +26,37 @@ package frontend
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/olivere/elastic"
 	"github.com/pborman/uuid"
 	commonpb "go.temporal.io/temporal-proto/common"
 	eventpb "go.temporal.io/temporal-proto/event"
+	executionpb "go.temporal.io/temporal-proto/execution"
 	"go.temporal.io/temporal-proto/serviceerror"
 	versionpb "go.temporal.io/temporal-proto/version"
+	"go.temporal.io/temporal-proto/workflowservice"
+	sdkclient "go.temporal.io/temporal/client"
 
 	"github.com/temporalio/temporal/.gen/proto/adminservice"
 	clustergenpb "github.com/temporalio/temporal/.gen/proto/cluster"
 	commongenpb "github.com/temporalio/temporal/.gen/proto/common"
+	executiongenpb "github.com/temporalio/temporal/.gen/proto/execution"
 	"github.com/temporalio/temporal/.gen/proto/historyservice"
+	"github.com/temporalio/temporal/.gen/proto/matchingservice"
 	replicationgenpb "github.com/temporalio/temporal/.gen/proto/replication"
 	tokengenpb "github.com/temporalio/temporal/.gen/proto/token"
 	"github.com/temporalio/temporal/common"
 	"github.com/temporalio/temporal/common/backoff"
+	"github.com/temporalio/temporal/common/cache"
 	"github.com/temporalio/temporal/common/definition"
+	"github.com/temporalio/temporal/common/elasticsearch/validator"
 	"github.com/temporalio/temporal/common/headers"
 	"github.com/temporalio/temporal/common/log"
 	"github.com/temporalio/temporal/common/log/tag"
@@ -54,8 +65,12 @@ import (
 	"github.com/temporalio/temporal/common/persistence"
 	"github.com/temporalio/temporal/common/primitives"
 	"github.com/temporalio/temporal/common/resource"
+	"github.com/temporalio/temporal/common/service/config"
 	"github.com/temporalio/temporal/common/service/dynamicconfig"
 	"github.com/temporalio/temporal/service/history"
+	"github.com/temporalio/temporal/service/worker/batcher"
+	"github.com/temporalio/temporal/service/worker/deletenamespace"
+	"github.com/temporalio/temporal/service/worker/migration"
 )
 
 const (
@@ -68,10 +83,13 @@ type (
 	AdminHandler struct {
 		resource.Resource
 
-		numberOfHistoryShards int
-		params                *resource.BootstrapParams
-		config                *Config
-		namespaceDLQHandler   namespace.DLQMessageHandler
+		numberOfHistoryShards     int
+		params                    *resource.BootstrapParams
+		config                    *Config
+		namespaceDLQHandler       namespace.DLQMessageHandler
+		namespaceHandler          namespace.Handler
+		searchAttributesValidator *validator.SearchAttributesValidator
+		historyStreamer           *historyStreamer
 	}
 )
 
@@ -86,6 +104,7 @@ func NewAdminHandler(
 	resource resource.Resource,
 	params *resource.BootstrapParams,
 	config *Config,
+	namespaceHandler namespace.Handler,
 ) *AdminHandler {
 
 	namespaceReplicationTaskExecutor := namespace.NewReplicationTaskExecutor(
@@ -102,6 +121,20 @@ func NewAdminHandler(
 			resource.GetNamespaceReplicationQueue(),
 			resource.GetLogger(),
 		),
+		namespaceHandler: namespaceHandler,
+		searchAttributesValidator: validator.NewSearchAttributesValidator(
+			resource.GetLogger(),
+			config.ValidSearchAttributes,
+			config.SearchAttributesNumberOfKeysLimit,
+			config.SearchAttributesSizeOfValueLimit,
+			config.SearchAttributesTotalSizeLimit,
+		),
+		historyStreamer: newHistoryStreamer(
+			resource.GetHistoryClient(),
+			resource.GetHistoryManager(),
+			params.PersistenceConfig.NumHistoryShards,
+			resource.GetLogger(),
+		),
 	}
 }
 
@@ -987,50 +1020,1426 @@ func (adh *AdminHandler) RefreshWorkflowTasks(
 	return &adminservice.RefreshWorkflowTasksResponse{}, nil
 }
 
-func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
-	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
-) error {
+// PauseWorkflowExecution stops dispatching decision and activity tasks and firing timers of a workflow
+func (adh *AdminHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.PauseWorkflowExecutionRequest,
+) (_ *adminservice.PauseWorkflowExecutionResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminPauseWorkflowExecutionScope)
+	defer sw.Stop()
 
-	execution := request.Execution
-	if execution.GetWorkflowId() == "" {
-		return errWorkflowIDNotSet
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
 	}
-	// TODO currently, this API is only going to be used by re-send history events
-	// to remote cluster if kafka is lossy again, in the future, this API can be used
-	// by CLI and client, then empty runID (meaning the current workflow) should be allowed
-	if execution.GetRunId() == "" || uuid.Parse(execution.GetRunId()) == nil {
-		return errInvalidRunID
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
 	}
 
-	pageSize := int(request.GetMaximumPageSize())
-	if pageSize <= 0 {
-		return errInvalidPageSize
+	_, err = adh.GetHistoryClient().PauseWorkflowExecution(ctx, &historyservice.PauseWorkflowExecutionRequest{
+		NamespaceId: primitives.UUIDString(namespaceEntry.GetInfo().Id),
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
 	}
+	return &adminservice.PauseWorkflowExecutionResponse{}, nil
+}
 
-	if request.GetStartEventId() == common.EmptyEventID &&
-		request.GetStartEventVersion() == common.EmptyVersion &&
-		request.GetEndEventId() == common.EmptyEventID &&
-		request.GetEndEventVersion() == common.EmptyVersion {
-		return errInvalidEventQueryRange
+// UnpauseWorkflowExecution releases the tasks and timers held back by PauseWorkflowExecution
+func (adh *AdminHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UnpauseWorkflowExecutionRequest,
+) (_ *adminservice.UnpauseWorkflowExecutionResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUnpauseWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
 	}
 
-	if (request.GetStartEventId() != common.EmptyEventID && request.GetStartEventVersion() == common.EmptyVersion) ||
-		(request.GetStartEventId() == common.EmptyEventID && request.GetStartEventVersion() != common.EmptyVersion) {
-		return errInvalidStartEventCombination
+	_, err = adh.GetHistoryClient().UnpauseWorkflowExecution(ctx, &historyservice.UnpauseWorkflowExecutionRequest{
+		NamespaceId: primitives.UUIDString(namespaceEntry.GetInfo().Id),
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
 	}
+	return &adminservice.UnpauseWorkflowExecutionResponse{}, nil
+}
 
-	if (request.GetEndEventId() != common.EmptyEventID && request.GetEndEventVersion() == common.EmptyVersion) ||
-		(request.GetEndEventId() == common.EmptyEventID && request.GetEndEventVersion() != common.EmptyVersion) {
-		return errInvalidEndEventCombination
+// TriggerCronRun starts the pending run of a cron workflow immediately instead of waiting for its schedule
+func (adh *AdminHandler) TriggerCronRun(
+	ctx context.Context,
+	request *adminservice.TriggerCronRunRequest,
+) (_ *adminservice.TriggerCronRunResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminTriggerCronRunScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
 	}
-	return nil
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	_, err = adh.GetHistoryClient().TriggerCronRun(ctx, &historyservice.TriggerCronRunRequest{
+		NamespaceId: primitives.UUIDString(namespaceEntry.GetInfo().Id),
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.TriggerCronRunResponse{}, nil
 }
 
-func (adh *AdminHandler) validateConfigForAdvanceVisibility() error {
-	if adh.params.ESConfig == nil || adh.params.ESClient == nil {
-		return errors.New("ES related config not found")
+// SkipCronRuns skips the given number of upcoming runs of a cron workflow
+func (adh *AdminHandler) SkipCronRuns(
+	ctx context.Context,
+	request *adminservice.SkipCronRunsRequest,
+) (_ *adminservice.SkipCronRunsResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminSkipCronRunsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
 	}
-	return nil
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetCount() <= 0 {
+		return nil, adh.error(errInvalidCronSkipCount, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.GetHistoryClient().SkipCronRuns(ctx, &historyservice.SkipCronRunsRequest{
+		NamespaceId: primitives.UUIDString(namespaceEntry.GetInfo().Id),
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.SkipCronRunsResponse{NextRunTime: resp.GetNextRunTime()}, nil
+}
+
+// UpdateActivityOptions changes the timeouts, task list or retry policy of a pending activity
+func (adh *AdminHandler) UpdateActivityOptions(
+	ctx context.Context,
+	request *adminservice.UpdateActivityOptionsRequest,
+) (_ *adminservice.UpdateActivityOptionsResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateActivityOptionsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetActivityId() == "" {
+		return nil, adh.error(errActivityIDNotSet, scope)
+	}
+	if err := adh.validateActivityOptions(request); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	_, err = adh.GetHistoryClient().UpdateActivityOptions(ctx, &historyservice.UpdateActivityOptionsRequest{
+		NamespaceId: primitives.UUIDString(namespaceEntry.GetInfo().Id),
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.UpdateActivityOptionsResponse{}, nil
+}
+
+// ResetActivity retries an activity waiting for its retry backoff immediately and optionally resets its attempts
+func (adh *AdminHandler) ResetActivity(
+	ctx context.Context,
+	request *adminservice.ResetActivityRequest,
+) (_ *adminservice.ResetActivityResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminResetActivityScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetActivityId() == "" {
+		return nil, adh.error(errActivityIDNotSet, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	_, err = adh.GetHistoryClient().ResetActivity(ctx, &historyservice.ResetActivityRequest{
+		NamespaceId: primitives.UUIDString(namespaceEntry.GetInfo().Id),
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.ResetActivityResponse{}, nil
+}
+
+// DeleteWorkflowExecution deletes a workflow execution along with all its data, terminating it first if it is running
+func (adh *AdminHandler) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.DeleteWorkflowExecutionRequest,
+) (_ *adminservice.DeleteWorkflowExecutionResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDeleteWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	_, err = adh.GetHistoryClient().DeleteWorkflowExecution(ctx, &historyservice.DeleteWorkflowExecutionRequest{
+		NamespaceId: primitives.UUIDString(namespaceEntry.GetInfo().Id),
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.DeleteWorkflowExecutionResponse{}, nil
+}
+
+// UpsertWorkflowAttributes merges search attributes and memo into a workflow execution, or into the visibility record
+// of a closed one
+func (adh *AdminHandler) UpsertWorkflowAttributes(
+	ctx context.Context,
+	request *adminservice.UpsertWorkflowAttributesRequest,
+) (_ *adminservice.UpsertWorkflowAttributesResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUpsertWorkflowAttributesScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if len(request.GetSearchAttributes().GetIndexedFields()) == 0 && len(request.GetMemo().GetFields()) == 0 {
+		return nil, adh.error(errWorkflowAttributesNotSet, scope)
+	}
+	if err := adh.searchAttributesValidator.ValidateSearchAttributes(request.GetSearchAttributes(), request.GetNamespace()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	_, err = adh.GetHistoryClient().UpsertWorkflowAttributes(ctx, &historyservice.UpsertWorkflowAttributesRequest{
+		NamespaceId: primitives.UUIDString(namespaceEntry.GetInfo().Id),
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.UpsertWorkflowAttributesResponse{}, nil
+}
+
+// DeleteNamespace starts the system workflow deleting a namespace along with all its data
+func (adh *AdminHandler) DeleteNamespace(
+	ctx context.Context,
+	request *adminservice.DeleteNamespaceRequest,
+) (_ *adminservice.DeleteNamespaceResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDeleteNamespaceScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetNamespace() == common.SystemLocalNamespace {
+		return nil, adh.error(errCannotDeleteSystemNamespace, scope)
+	}
+	if request.GetRps() < 0 {
+		return nil, adh.error(errInvalidNamespaceDeletionRPS, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if namespaceEntry.IsGlobalNamespace() {
+		return nil, adh.error(errCannotDeleteGlobalNamespace, scope)
+	}
+
+	// a deletion which failed or was stopped is resumed by starting the workflow again
+	options := sdkclient.StartWorkflowOptions{
+		ID:                           deletenamespace.GetWorkflowID(request.GetNamespace()),
+		TaskList:                     deletenamespace.TaskListName,
+		ExecutionStartToCloseTimeout: deletenamespace.InfiniteDuration,
+		WorkflowIDReusePolicy:        sdkclient.WorkflowIDReusePolicyAllowDuplicate,
+	}
+	params := deletenamespace.Params{
+		Namespace:               request.GetNamespace(),
+		NamespaceID:             primitives.UUIDString(namespaceEntry.GetInfo().Id),
+		Reason:                  request.GetReason(),
+		Identity:                request.GetIdentity(),
+		TerminateOpenExecutions: request.GetTerminateOpenExecutions(),
+		RPS:                     int(request.GetRps()),
+	}
+	if _, err := adh.GetSDKClient().ExecuteWorkflow(ctx, options, deletenamespace.WorkflowTypeName, params); err != nil {
+		if _, ok := err.(*serviceerror.WorkflowExecutionAlreadyStarted); !ok {
+			return nil, adh.error(err, scope)
+		}
+	}
+
+	adh.GetLogger().Info("Namespace deletion started.",
+		tag.WorkflowNamespace(request.GetNamespace()),
+		tag.Identity(request.GetIdentity()),
+	)
+	return &adminservice.DeleteNamespaceResponse{}, nil
+}
+
+// DescribeNamespaceDeletion returns the progress of the deletion of a namespace
+func (adh *AdminHandler) DescribeNamespaceDeletion(
+	ctx context.Context,
+	request *adminservice.DescribeNamespaceDeletionRequest,
+) (_ *adminservice.DescribeNamespaceDeletionResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeNamespaceDeletionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+
+	workflowID := deletenamespace.GetWorkflowID(request.GetNamespace())
+	resp, err := adh.GetSDKClient().DescribeWorkflowExecution(ctx, workflowID, "")
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			return nil, adh.error(errNamespaceDeletionNotFound, scope)
+		}
+		return nil, adh.error(err, scope)
+	}
+	info := resp.GetWorkflowExecutionInfo()
+
+	progress := deletenamespace.Progress{}
+	value, err := adh.GetSDKClient().QueryWorkflow(ctx, workflowID, info.GetExecution().GetRunId(), deletenamespace.ProgressQueryType)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if err := value.Get(&progress); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	// the counts of the stage in progress are only in the heartbeat of its activity
+	if len(resp.GetPendingActivities()) > 0 && len(resp.GetPendingActivities()[0].GetHeartbeatDetails()) > 0 {
+		hbd := deletenamespace.HeartbeatDetails{}
+		if err := json.Unmarshal(resp.GetPendingActivities()[0].GetHeartbeatDetails(), &hbd); err != nil {
+			return nil, adh.error(err, scope)
+		}
+		hbd.Progress.Stage = progress.Stage
+		progress = hbd.Progress
+	}
+
+	return &adminservice.DescribeNamespaceDeletionResponse{
+		State:                    toBatchOperationState(info.GetStatus()),
+		Stage:                    progress.Stage,
+		TerminatedExecutionCount: progress.TerminatedExecutionCount,
+		DeletedExecutionCount:    progress.DeletedExecutionCount,
+		DeletedTaskListCount:     progress.DeletedTaskListCount,
+		StartTime:                info.GetStartTime().GetValue(),
+		CloseTime:                info.GetCloseTime().GetValue(),
+	}, nil
+}
+
+// RenameNamespace renames a namespace keeping its ID, optionally keeping the old name as an alias for a grace period
+func (adh *AdminHandler) RenameNamespace(
+	ctx context.Context,
+	request *adminservice.RenameNamespaceRequest,
+) (_ *adminservice.RenameNamespaceResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminRenameNamespaceScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetNamespace() == common.SystemLocalNamespace {
+		return nil, adh.error(errCannotRenameSystemNamespace, scope)
+	}
+	if len(request.GetNewName()) > adh.config.MaxIDLengthLimit() {
+		return nil, adh.error(errNamespaceTooLong, scope)
+	}
+
+	resp, err := adh.namespaceHandler.RenameNamespace(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// StartMigration starts the system workflow moving workflow executions to a namespace of the current cluster
+func (adh *AdminHandler) StartMigration(
+	ctx context.Context,
+	request *adminservice.StartMigrationRequest,
+) (_ *adminservice.StartMigrationResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminStartMigrationScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateStartMigrationRequest(request); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	sourceCluster := request.GetSourceCluster()
+	if sourceCluster == "" {
+		sourceCluster = adh.GetClusterMetadata().GetCurrentClusterName()
+	}
+	targetNamespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetTargetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if err := adh.validateMigrationNamespaces(request, sourceCluster, targetNamespaceEntry); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	migrationID := uuid.New()
+	options := sdkclient.StartWorkflowOptions{
+		ID:                           migration.GetWorkflowID(migrationID),
+		TaskList:                     migration.TaskListName,
+		ExecutionStartToCloseTimeout: migration.InfiniteDuration,
+		Memo: map[string]interface{}{
+			migration.SourceClusterMemo:   sourceCluster,
+			migration.SourceNamespaceMemo: request.GetSourceNamespace(),
+			migration.TargetNamespaceMemo: request.GetTargetNamespace(),
+		},
+	}
+	params := migration.Params{
+		MigrationID:       migrationID,
+		SourceCluster:     sourceCluster,
+		SourceNamespace:   request.GetSourceNamespace(),
+		TargetNamespace:   request.GetTargetNamespace(),
+		TargetNamespaceID: primitives.UUIDString(targetNamespaceEntry.GetInfo().Id),
+		Query:             request.GetQuery(),
+		Reason:            request.GetReason(),
+		Identity:          request.GetIdentity(),
+		RPS:               int(request.GetRps()),
+	}
+	if request.GetExecution().GetWorkflowId() != "" {
+		params.Execution = &migration.Execution{
+			WorkflowID: request.GetExecution().GetWorkflowId(),
+			RunID:      request.GetExecution().GetRunId(),
+		}
+	}
+	if _, err := adh.GetSDKClient().ExecuteWorkflow(ctx, options, migration.WorkflowTypeName, params); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	adh.GetLogger().Info("Migration started.",
+		tag.SourceCluster(sourceCluster),
+		tag.WorkflowNamespace(request.GetTargetNamespace()),
+		tag.Identity(request.GetIdentity()),
+	)
+	return &adminservice.StartMigrationResponse{MigrationId: migrationID}, nil
+}
+
+// DescribeMigration returns the progress of a migration of workflow executions
+func (adh *AdminHandler) DescribeMigration(
+	ctx context.Context,
+	request *adminservice.DescribeMigrationRequest,
+) (_ *adminservice.DescribeMigrationResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeMigrationScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetMigrationId() == "" {
+		return nil, adh.error(errMigrationIDNotSet, scope)
+	}
+
+	workflowID := migration.GetWorkflowID(request.GetMigrationId())
+	resp, err := adh.GetSDKClient().DescribeWorkflowExecution(ctx, workflowID, "")
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			return nil, adh.error(errMigrationNotFound, scope)
+		}
+		return nil, adh.error(err, scope)
+	}
+	info := resp.GetWorkflowExecutionInfo()
+
+	progress := migration.Progress{}
+	value, err := adh.GetSDKClient().QueryWorkflow(ctx, workflowID, info.GetExecution().GetRunId(), migration.ProgressQueryType)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if err := value.Get(&progress); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	memo := info.GetMemo().GetFields()
+	return &adminservice.DescribeMigrationResponse{
+		State:                  toBatchOperationState(info.GetStatus()),
+		SourceCluster:          decodeBatchOperationField(memo[migration.SourceClusterMemo]),
+		SourceNamespace:        decodeBatchOperationField(memo[migration.SourceNamespaceMemo]),
+		TargetNamespace:        decodeBatchOperationField(memo[migration.TargetNamespaceMemo]),
+		MigratedExecutionCount: progress.MigratedExecutionCount,
+		SkippedExecutionCount:  progress.SkippedExecutionCount,
+		FailedExecutionCount:   progress.FailedExecutionCount,
+		LastFailure:            progress.LastFailure,
+		StartTime:              info.GetStartTime().GetValue(),
+		CloseTime:              info.GetCloseTime().GetValue(),
+	}, nil
+}
+
+// ExportWorkflowExecution returns a page of the portable representation of a workflow execution,
+// the history of its current branch is read from the database so local namespaces can be exported
+func (adh *AdminHandler) ExportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ExportWorkflowExecutionRequest,
+) (_ *adminservice.ExportWorkflowExecutionResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminExportWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	execution := request.GetExecution()
+	if execution.GetWorkflowId() == "" {
+		return nil, adh.error(errWorkflowIDNotSet, scope)
+	}
+	if execution.GetRunId() != "" && uuid.Parse(execution.GetRunId()) == nil {
+		return nil, adh.error(errInvalidRunID, scope)
+	}
+	if request.GetMaximumPageSize() <= 0 {
+		return nil, adh.error(errInvalidPageSize, scope)
+	}
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	scope = scope.Tagged(metrics.NamespaceTag(request.GetNamespace()))
+
+	export := &executiongenpb.WorkflowExecutionExport{Namespace: request.GetNamespace()}
+	var continuationToken *tokengenpb.HistoryContinuation
+	if request.NextPageToken != nil {
+		if continuationToken, err = deserializeHistoryToken(request.NextPageToken); err != nil {
+			return nil, adh.error(errInvalidPaginationToken, scope)
+		}
+		if execution.GetRunId() != "" && execution.GetRunId() != continuationToken.GetRunId() {
+			return nil, adh.error(errInvalidPaginationToken, scope)
+		}
+	} else {
+		response, err := adh.GetHistoryClient().GetMutableState(ctx, &historyservice.GetMutableStateRequest{
+			NamespaceId: namespaceID,
+			Execution:   execution,
+		})
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		export.MutableState = &executiongenpb.MutableStateSummary{
+			WorkflowType:           response.GetWorkflowType(),
+			TaskList:               response.GetTaskList(),
+			NextEventId:            response.GetNextEventId(),
+			LastFirstEventId:       response.GetLastFirstEventId(),
+			PreviousStartedEventId: response.GetPreviousStartedEventId(),
+			WorkflowState:          response.GetWorkflowState(),
+			WorkflowStatus:         response.GetWorkflowStatus(),
+		}
+		export.VersionHistories = response.GetVersionHistories()
+		continuationToken = &tokengenpb.HistoryContinuation{
+			RunId:        response.GetExecution().GetRunId(),
+			BranchToken:  response.GetCurrentBranchToken(),
+			FirstEventId: common.FirstEventID,
+			NextEventId:  response.GetNextEventId(),
+		}
+	}
+	export.Execution = &executionpb.WorkflowExecution{
+		WorkflowId: execution.GetWorkflowId(),
+		RunId:      continuationToken.GetRunId(),
+	}
+
+	shardID := common.WorkflowIDToHistoryShard(execution.GetWorkflowId(), adh.numberOfHistoryShards)
+	_, export.HistoryBatches, continuationToken.PersistenceToken, _, err = history.PaginateHistory(
+		adh.GetHistoryManager(),
+		true, // this means that we are getting history by batch
+		continuationToken.GetBranchToken(),
+		continuationToken.GetFirstEventId(),
+		continuationToken.GetNextEventId(),
+		continuationToken.GetPersistenceToken(),
+		int(request.GetMaximumPageSize()),
+		&shardID,
+	)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	result := &adminservice.ExportWorkflowExecutionResponse{Export: export}
+	if len(continuationToken.PersistenceToken) != 0 {
+		if result.NextPageToken, err = serializeHistoryToken(continuationToken); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+	return result, nil
+}
+
+// ImportWorkflowExecution recreates a workflow execution from exported history batches, each batch is applied
+// by the replication of the history events so importing the same batches again is a no-op
+func (adh *AdminHandler) ImportWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.ImportWorkflowExecutionRequest,
+) (_ *adminservice.ImportWorkflowExecutionResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminImportWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateImportWorkflowExecutionRequest(request); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	scope = scope.Tagged(metrics.NamespaceTag(request.GetNamespace()))
+
+	var items []*persistence.VersionHistoryItem
+	for _, item := range request.GetVersionHistoryItems() {
+		items = append(items, persistence.NewVersionHistoryItemFromProto(item))
+	}
+	versionHistory := persistence.NewVersionHistory(nil, items)
+	for _, batch := range request.GetHistoryBatches() {
+		lastEvent := batch.Events[len(batch.Events)-1]
+		batchVersionHistory, err := versionHistory.DuplicateUntilLCAItem(
+			persistence.NewVersionHistoryItem(lastEvent.GetEventId(), lastEvent.GetVersion()),
+		)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		blob, err := adh.GetPayloadSerializer().SerializeBatchEvents(batch.Events, common.EncodingTypeProto3)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		if _, err := adh.GetHistoryClient().ReplicateEventsV2(ctx, &historyservice.ReplicateEventsV2Request{
+			NamespaceId:         namespaceID,
+			WorkflowExecution:   request.GetExecution(),
+			VersionHistoryItems: batchVersionHistory.ToProto().GetItems(),
+			Events:              blob.ToProto(),
+			CreatePaused:        request.GetPaused(),
+		}); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+	return &adminservice.ImportWorkflowExecutionResponse{}, nil
+}
+
+// StreamWorkflowExecutionHistory streams the history events of a workflow execution as they are committed,
+// the stream ends after the close event of the workflow execution
+func (adh *AdminHandler) StreamWorkflowExecutionHistory(
+	request *adminservice.StreamWorkflowExecutionHistoryRequest,
+	server adminservice.AdminService_StreamWorkflowExecutionHistoryServer,
+) (err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminStreamWorkflowExecutionHistoryScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return adh.error(errNamespaceNotSet, scope)
+	}
+	execution := request.GetExecution()
+	if execution.GetWorkflowId() == "" {
+		return adh.error(errWorkflowIDNotSet, scope)
+	}
+	if execution.GetRunId() != "" && uuid.Parse(execution.GetRunId()) == nil {
+		return adh.error(errInvalidRunID, scope)
+	}
+	if request.GetFirstEventId() < 0 {
+		return adh.error(errInvalidFirstEventID, scope)
+	}
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	scope = scope.Tagged(metrics.NamespaceTag(request.GetNamespace()))
+
+	firstEventID := request.GetFirstEventId()
+	if firstEventID == 0 {
+		firstEventID = common.FirstEventID
+	}
+	if err := adh.historyStreamer.stream(server.Context(), namespaceID, execution, firstEventID, request.GetEventTypes(),
+		func(events []*eventpb.HistoryEvent) error {
+			return server.Send(&adminservice.StreamWorkflowExecutionHistoryResponse{Events: events})
+		},
+	); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// UpdateWorkflowExecution delivers an update to a running workflow on a decision task and waits for its result
+func (adh *AdminHandler) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *adminservice.UpdateWorkflowExecutionRequest,
+) (_ *adminservice.UpdateWorkflowExecutionResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateWorkflowExecutionScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetName() == "" {
+		return nil, adh.error(errUpdateNameNotSet, scope)
+	}
+	if len(request.GetName()) > adh.config.MaxIDLengthLimit() {
+		return nil, adh.error(errUpdateNameTooLong, scope)
+	}
+	if len(request.GetUpdateId()) > adh.config.MaxIDLengthLimit() {
+		return nil, adh.error(errUpdateIDTooLong, scope)
+	}
+	if len(request.GetInput()) > adh.config.BlobSizeLimitError(request.GetNamespace()) {
+		return nil, adh.error(errUpdateInputTooLarge, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	if request.GetUpdateId() == "" {
+		request.UpdateId = uuid.New()
+	}
+	resp, err := adh.GetHistoryClient().UpdateWorkflowExecution(ctx, &historyservice.UpdateWorkflowExecutionRequest{
+		NamespaceId: primitives.UUIDString(namespaceEntry.GetInfo().Id),
+		Request:     request,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp.GetResponse(), nil
+}
+
+// UpdateWorkerVersionSets replaces the sets of compatible worker build IDs of a namespace
+func (adh *AdminHandler) UpdateWorkerVersionSets(
+	ctx context.Context,
+	request *adminservice.UpdateWorkerVersionSetsRequest,
+) (_ *adminservice.UpdateWorkerVersionSetsResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateWorkerVersionSetsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	for _, versionSet := range request.GetVersionSets() {
+		for _, buildID := range versionSet.GetBuildIds() {
+			if len(buildID) > adh.config.MaxIDLengthLimit() {
+				return nil, adh.error(errWorkerBuildIDTooLong, scope)
+			}
+		}
+	}
+
+	resp, err := adh.namespaceHandler.UpdateWorkerVersionSets(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// DescribeTaskListVersions returns the worker version sets of the namespace and the pollers of a task list grouped by build ID
+func (adh *AdminHandler) DescribeTaskListVersions(
+	ctx context.Context,
+	request *adminservice.DescribeTaskListVersionsRequest,
+) (_ *adminservice.DescribeTaskListVersionsResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeTaskListVersionsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetTaskList().GetName() == "" {
+		return nil, adh.error(errTaskListNotSet, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	matchingResp, err := adh.GetMatchingClient().DescribeTaskList(ctx, &matchingservice.DescribeTaskListRequest{
+		NamespaceId: primitives.UUIDString(namespaceEntry.GetInfo().Id),
+		DescRequest: &workflowservice.DescribeTaskListRequest{
+			Namespace:    request.GetNamespace(),
+			TaskList:     request.GetTaskList(),
+			TaskListType: request.GetTaskListType(),
+		},
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	pollersByBuildID := make(map[string]*adminservice.WorkerVersionPollers)
+	var buildIDs []string
+	for _, poller := range matchingResp.GetPollers() {
+		buildID := matchingResp.GetPollerBuildIds()[poller.GetIdentity()]
+		versionPollers, ok := pollersByBuildID[buildID]
+		if !ok {
+			versionPollers = &adminservice.WorkerVersionPollers{BuildId: buildID}
+			pollersByBuildID[buildID] = versionPollers
+			buildIDs = append(buildIDs, buildID)
+		}
+		versionPollers.Pollers = append(versionPollers.Pollers, poller)
+	}
+	sort.Strings(buildIDs)
+
+	resp := &adminservice.DescribeTaskListVersionsResponse{
+		VersionSets: namespaceEntry.GetConfig().WorkerVersionSets,
+	}
+	for _, buildID := range buildIDs {
+		resp.Pollers = append(resp.Pollers, pollersByBuildID[buildID])
+	}
+	return resp, nil
+}
+
+// UpdateTaskListRateLimit persists the rate at which tasks of a task list are dispatched across all its partitions
+func (adh *AdminHandler) UpdateTaskListRateLimit(
+	ctx context.Context,
+	request *adminservice.UpdateTaskListRateLimitRequest,
+) (_ *adminservice.UpdateTaskListRateLimitResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminUpdateTaskListRateLimitScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetTaskList().GetName() == "" {
+		return nil, adh.error(errTaskListNotSet, scope)
+	}
+	if request.GetMaxTasksPerSecond() < 0 {
+		return nil, adh.error(errInvalidMaxTasksPerSecond, scope)
+	}
+	namespaceID, err := adh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	_, err = adh.GetMatchingClient().UpdateTaskListRateLimit(ctx, &matchingservice.UpdateTaskListRateLimitRequest{
+		NamespaceId:       namespaceID,
+		TaskList:          request.GetTaskList(),
+		TaskListType:      request.GetTaskListType(),
+		MaxTasksPerSecond: request.GetMaxTasksPerSecond(),
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.UpdateTaskListRateLimitResponse{}, nil
+}
+
+// StartBatchOperation starts a batch job operating on the workflows matching a visibility query
+func (adh *AdminHandler) StartBatchOperation(
+	ctx context.Context,
+	request *adminservice.StartBatchOperationRequest,
+) (_ *adminservice.StartBatchOperationResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminStartBatchOperationScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := validateStartBatchOperationRequest(request); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.GetBatchType() == batcher.BatchTypeUpsertAttributes {
+		if err := adh.searchAttributesValidator.ValidateSearchAttributes(request.GetSearchAttributes(), request.GetNamespace()); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+	if err := adh.validateConfigForVisibilityQuery(); err != nil {
+		return nil, adh.error(errVisibilityQueryIsNotSupported, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	batchQuery, err := getBatchOperationQuery(namespaceEntry.GetInfo().Name)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	// the limit is best effort, batch jobs started concurrently may all pass the check
+	runningResp, err := adh.GetSDKClient().CountWorkflow(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: common.SystemLocalNamespace,
+		Query:     batchQuery + " AND CloseTime = missing",
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if runningResp.GetCount() >= int64(adh.config.MaxConcurrentBatchOperation(request.GetNamespace())) {
+		return nil, adh.error(errTooManyConcurrentBatchJobs, scope)
+	}
+
+	jobID := request.GetJobId()
+	if jobID == "" {
+		jobID = uuid.New()
+	}
+	options := sdkclient.StartWorkflowOptions{
+		ID:                           jobID,
+		TaskList:                     batcher.BatcherTaskListName,
+		ExecutionStartToCloseTimeout: batcher.InfiniteDuration,
+		Memo: map[string]interface{}{
+			batcher.ReasonMemo:    request.GetReason(),
+			batcher.BatchTypeMemo: request.GetBatchType(),
+			batcher.QueryMemo:     request.GetQuery(),
+		},
+		SearchAttributes: map[string]interface{}{
+			batcher.NamespaceSearchAttribute: request.GetNamespace(),
+			batcher.OperatorSearchAttribute:  request.GetIdentity(),
+		},
+	}
+	params := batcher.BatchParams{
+		Namespace: request.GetNamespace(),
+		Query:     request.GetQuery(),
+		Reason:    request.GetReason(),
+		BatchType: request.GetBatchType(),
+		SignalParams: batcher.SignalParams{
+			SignalName: request.GetSignalName(),
+			Input:      request.GetSignalInput(),
+		},
+		ResetParams: batcher.ResetParams{
+			ResetType:         request.GetResetType(),
+			BadBinaryChecksum: request.GetResetBadBinaryChecksum(),
+		},
+		UpsertAttributesParams: batcher.UpsertAttributesParams{
+			SearchAttributes: toStringMap(request.GetSearchAttributes().GetIndexedFields()),
+			Memo:             toStringMap(request.GetMemo().GetFields()),
+		},
+		RPS:         int(request.GetRps()),
+		Concurrency: int(request.GetConcurrency()),
+	}
+	if _, err := adh.GetSDKClient().ExecuteWorkflow(ctx, options, batcher.BatchWFTypeName, params); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.StartBatchOperationResponse{JobId: jobID}, nil
+}
+
+// DescribeBatchOperation returns the state and the progress of a batch job
+func (adh *AdminHandler) DescribeBatchOperation(
+	ctx context.Context,
+	request *adminservice.DescribeBatchOperationRequest,
+) (_ *adminservice.DescribeBatchOperationResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminDescribeBatchOperationScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetJobId() == "" {
+		return nil, adh.error(errBatchJobIDNotSet, scope)
+	}
+
+	resp, err := adh.describeBatchOperation(ctx, request.GetNamespace(), request.GetJobId())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	operationInfo := toBatchOperationInfo(resp.GetWorkflowExecutionInfo())
+
+	// the progress is the heartbeat of the batch activity while the job is running, and the result once completed
+	hbd := batcher.HeartBeatDetails{}
+	switch operationInfo.GetState() {
+	case commongenpb.BatchOperationState_Running:
+		if len(resp.GetPendingActivities()) > 0 && len(resp.GetPendingActivities()[0].GetHeartbeatDetails()) > 0 {
+			if err := json.Unmarshal(resp.GetPendingActivities()[0].GetHeartbeatDetails(), &hbd); err != nil {
+				return nil, adh.error(err, scope)
+			}
+		}
+	case commongenpb.BatchOperationState_Completed:
+		runID := resp.GetWorkflowExecutionInfo().GetExecution().GetRunId()
+		if err := adh.GetSDKClient().GetWorkflow(ctx, request.GetJobId(), runID).Get(ctx, &hbd); err != nil {
+			return nil, adh.error(err, scope)
+		}
+	}
+	operationInfo.TotalOperationCount = hbd.TotalEstimate
+	operationInfo.CompleteOperationCount = int64(hbd.SuccessCount)
+	operationInfo.FailureOperationCount = int64(hbd.ErrorCount)
+
+	return &adminservice.DescribeBatchOperationResponse{OperationInfo: operationInfo}, nil
+}
+
+// ListBatchOperations lists the batch jobs of a namespace
+func (adh *AdminHandler) ListBatchOperations(
+	ctx context.Context,
+	request *adminservice.ListBatchOperationsRequest,
+) (_ *adminservice.ListBatchOperationsResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminListBatchOperationsScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetPageSize() < 0 {
+		return nil, adh.error(errInvalidPageSize, scope)
+	}
+	if err := adh.validateConfigForVisibilityQuery(); err != nil {
+		return nil, adh.error(errVisibilityQueryIsNotSupported, scope)
+	}
+	namespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetNamespace())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	batchQuery, err := getBatchOperationQuery(namespaceEntry.GetInfo().Name)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.GetSDKClient().ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+		Namespace:     common.SystemLocalNamespace,
+		PageSize:      request.GetPageSize(),
+		NextPageToken: request.GetNextPageToken(),
+		Query:         batchQuery,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	operationInfo := make([]*adminservice.BatchOperationInfo, 0, len(resp.GetExecutions()))
+	for _, info := range resp.GetExecutions() {
+		operationInfo = append(operationInfo, toBatchOperationInfo(info))
+	}
+	return &adminservice.ListBatchOperationsResponse{
+		OperationInfo: operationInfo,
+		NextPageToken: resp.GetNextPageToken(),
+	}, nil
+}
+
+// StopBatchOperation stops a running batch job
+func (adh *AdminHandler) StopBatchOperation(
+	ctx context.Context,
+	request *adminservice.StopBatchOperationRequest,
+) (_ *adminservice.StopBatchOperationResponse, err error) {
+	defer log.CapturePanicGRPC(adh.GetLogger(), &err)
+	scope, sw := adh.startRequestProfile(metrics.AdminStopBatchOperationScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetNamespace() == "" {
+		return nil, adh.error(errNamespaceNotSet, scope)
+	}
+	if request.GetJobId() == "" {
+		return nil, adh.error(errBatchJobIDNotSet, scope)
+	}
+	if request.GetReason() == "" {
+		return nil, adh.error(errBatchReasonNotSet, scope)
+	}
+
+	// make sure the batch job belongs to the namespace before stopping it
+	if _, err := adh.describeBatchOperation(ctx, request.GetNamespace(), request.GetJobId()); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if err := adh.GetSDKClient().TerminateWorkflow(ctx, request.GetJobId(), "", request.GetReason(), nil); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &adminservice.StopBatchOperationResponse{}, nil
+}
+
+func (adh *AdminHandler) describeBatchOperation(
+	ctx context.Context,
+	namespace string,
+	jobID string,
+) (*workflowservice.DescribeWorkflowExecutionResponse, error) {
+
+	resp, err := adh.GetSDKClient().DescribeWorkflowExecution(ctx, jobID, "")
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			return nil, errBatchOperationNotFound
+		}
+		return nil, err
+	}
+	info := resp.GetWorkflowExecutionInfo()
+	if info.GetType().GetName() != batcher.BatchWFTypeName ||
+		decodeBatchOperationField(info.GetSearchAttributes().GetIndexedFields()[batcher.NamespaceSearchAttribute]) != namespace {
+		return nil, errBatchOperationNotFound
+	}
+	return resp, nil
+}
+
+func (adh *AdminHandler) validateStartMigrationRequest(
+	request *adminservice.StartMigrationRequest,
+) error {
+
+	if request.GetSourceNamespace() == "" {
+		return errSourceNamespaceNotSet
+	}
+	if request.GetTargetNamespace() == "" {
+		return errTargetNamespaceNotSet
+	}
+	if request.GetRps() < 0 {
+		return errInvalidMigrationRPS
+	}
+	if request.GetExecution().GetRunId() != "" && uuid.Parse(request.GetExecution().GetRunId()) == nil {
+		return errInvalidRunID
+	}
+	if sourceCluster := request.GetSourceCluster(); sourceCluster != "" {
+		if info, ok := adh.GetClusterMetadata().GetAllClusterInfo()[sourceCluster]; !ok || !info.Enabled {
+			return errUnknownSourceCluster
+		}
+	}
+	return nil
+}
+
+// validateMigrationNamespaces checks the namespaces can be used by the replication of the history events,
+// the target must be a global namespace active in the current cluster
+func (adh *AdminHandler) validateMigrationNamespaces(
+	request *adminservice.StartMigrationRequest,
+	sourceCluster string,
+	targetNamespaceEntry *cache.NamespaceCacheEntry,
+) error {
+
+	if !targetNamespaceEntry.IsGlobalNamespace() {
+		return errMigrationNamespaceNotGlobal
+	}
+	if !targetNamespaceEntry.IsNamespaceActive() {
+		return errMigrationTargetNotActive
+	}
+	if sourceCluster != adh.GetClusterMetadata().GetCurrentClusterName() {
+		// the source namespace is checked by the source cluster
+		return nil
+	}
+	if request.GetSourceNamespace() == request.GetTargetNamespace() {
+		return errMigrationSameNamespace
+	}
+	sourceNamespaceEntry, err := adh.GetNamespaceCache().GetNamespace(request.GetSourceNamespace())
+	if err != nil {
+		return err
+	}
+	if !sourceNamespaceEntry.IsGlobalNamespace() {
+		return errMigrationNamespaceNotGlobal
+	}
+	return nil
+}
+
+func (adh *AdminHandler) validateImportWorkflowExecutionRequest(
+	request *adminservice.ImportWorkflowExecutionRequest,
+) error {
+
+	if request.GetNamespace() == "" {
+		return errNamespaceNotSet
+	}
+	if request.GetExecution().GetWorkflowId() == "" {
+		return errWorkflowIDNotSet
+	}
+	if request.GetExecution().GetRunId() == "" || uuid.Parse(request.GetExecution().GetRunId()) == nil {
+		return errInvalidRunID
+	}
+	if len(request.GetHistoryBatches()) == 0 {
+		return errHistoryBatchesNotSet
+	}
+	if len(request.GetVersionHistoryItems()) == 0 {
+		return errVersionHistoryItemsNotSet
+	}
+	for _, batch := range request.GetHistoryBatches() {
+		if len(batch.GetEvents()) == 0 {
+			return errEmptyHistoryBatch
+		}
+	}
+	// the replication of the events resolves the cluster of each version
+	for _, item := range request.GetVersionHistoryItems() {
+		if !adh.isKnownFailoverVersion(item.GetVersion()) {
+			return errUnknownFailoverVersion
+		}
+	}
+	return nil
+}
+
+func (adh *AdminHandler) isKnownFailoverVersion(version int64) bool {
+	if version == common.EmptyVersion {
+		return true
+	}
+	for _, info := range adh.GetClusterMetadata().GetAllClusterInfo() {
+		if adh.GetClusterMetadata().IsVersionFromSameCluster(version, info.InitialFailoverVersion) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateStartBatchOperationRequest(
+	request *adminservice.StartBatchOperationRequest,
+) error {
+
+	if request.GetNamespace() == "" {
+		return errNamespaceNotSet
+	}
+	if request.GetQuery() == "" {
+		return errQueryNotSet
+	}
+	if request.GetReason() == "" {
+		return errBatchReasonNotSet
+	}
+	if request.GetRps() < 0 || request.GetConcurrency() < 0 {
+		return errInvalidBatchRPSOrConcurrency
+	}
+
+	validBatchType := false
+	for _, batchType := range batcher.AllBatchTypes {
+		if batchType == request.GetBatchType() {
+			validBatchType = true
+			break
+		}
+	}
+	if !validBatchType {
+		return errInvalidBatchType
+	}
+	switch request.GetBatchType() {
+	case batcher.BatchTypeSignal:
+		if request.GetSignalName() == "" {
+			return errSignalNameNotSet
+		}
+	case batcher.BatchTypeReset:
+		validResetType := false
+		for _, resetType := range batcher.AllResetTypes {
+			if resetType == request.GetResetType() {
+				validResetType = true
+				break
+			}
+		}
+		if !validResetType {
+			return errInvalidResetType
+		}
+		if request.GetResetType() == batcher.ResetTypeBadBinary && request.GetResetBadBinaryChecksum() == "" {
+			return errResetBadBinaryChecksumNotSet
+		}
+	case batcher.BatchTypeUpsertAttributes:
+		if len(request.GetSearchAttributes().GetIndexedFields()) == 0 && len(request.GetMemo().GetFields()) == 0 {
+			return errWorkflowAttributesNotSet
+		}
+	}
+	return nil
+}
+
+// toStringMap converts the payloads to strings as batch params are json encoded
+func toStringMap(
+	fields map[string][]byte,
+) map[string]string {
+
+	if len(fields) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(fields))
+	for k, v := range fields {
+		result[k] = string(v)
+	}
+	return result
+}
+
+// getBatchOperationQuery returns the visibility query matching the batch jobs of a namespace,
+// namespaces whose name could alter the query are rejected
+func getBatchOperationQuery(
+	namespace string,
+) (string, error) {
+	if strings.ContainsAny(namespace, `'"\`) {
+		return "", errInvalidBatchOperationNamespace
+	}
+	return fmt.Sprintf("WorkflowType = '%v' AND %v = '%v'", batcher.BatchWFTypeName, batcher.NamespaceSearchAttribute, namespace), nil
+}
+
+func toBatchOperationInfo(
+	info *executionpb.WorkflowExecutionInfo,
+) *adminservice.BatchOperationInfo {
+
+	memo := info.GetMemo().GetFields()
+	searchAttributes := info.GetSearchAttributes().GetIndexedFields()
+	return &adminservice.BatchOperationInfo{
+		JobId:     info.GetExecution().GetWorkflowId(),
+		State:     toBatchOperationState(info.GetStatus()),
+		BatchType: decodeBatchOperationField(memo[batcher.BatchTypeMemo]),
+		Query:     decodeBatchOperationField(memo[batcher.QueryMemo]),
+		Reason:    decodeBatchOperationField(memo[batcher.ReasonMemo]),
+		Identity:  decodeBatchOperationField(searchAttributes[batcher.OperatorSearchAttribute]),
+		StartTime: info.GetStartTime().GetValue(),
+		CloseTime: info.GetCloseTime().GetValue(),
+	}
+}
+
+func toBatchOperationState(
+	status executionpb.WorkflowExecutionStatus,
+) commongenpb.BatchOperationState {
+
+	switch status {
+	case executionpb.WorkflowExecutionStatus_Running:
+		return commongenpb.BatchOperationState_Running
+	case executionpb.WorkflowExecutionStatus_Completed:
+		return commongenpb.BatchOperationState_Completed
+	case executionpb.WorkflowExecutionStatus_Canceled, executionpb.WorkflowExecutionStatus_Terminated:
+		return commongenpb.BatchOperationState_Stopped
+	default:
+		return commongenpb.BatchOperationState_Failed
+	}
+}
+
+// decodeBatchOperationField decodes a memo or search attribute of a batch job, which are json encoded by the sdk
+func decodeBatchOperationField(
+	data []byte,
+) string {
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return string(data)
+	}
+	return value
+}
+
+func (adh *AdminHandler) validateActivityOptions(
+	request *adminservice.UpdateActivityOptionsRequest,
+) error {
+
+	if request.GetScheduleToStartTimeoutSeconds() < 0 ||
+		request.GetScheduleToCloseTimeoutSeconds() < 0 ||
+		request.GetStartToCloseTimeoutSeconds() < 0 ||
+		request.GetHeartbeatTimeoutSeconds() < 0 {
+		return errInvalidActivityTimeoutSeconds
+	}
+	if request.GetScheduleToStartTimeoutSeconds() == 0 &&
+		request.GetScheduleToCloseTimeoutSeconds() == 0 &&
+		request.GetStartToCloseTimeoutSeconds() == 0 &&
+		request.GetHeartbeatTimeoutSeconds() == 0 &&
+		request.GetTaskList() == "" &&
+		request.GetRetryPolicy() == nil {
+		return errActivityOptionsNotSet
+	}
+	return common.ValidateRetryPolicy(request.GetRetryPolicy())
+}
+
+func (adh *AdminHandler) validateGetWorkflowExecutionRawHistoryV2Request(
+	request *adminservice.GetWorkflowExecutionRawHistoryV2Request,
+) error {
+
+	execution := request.Execution
+	if execution.GetWorkflowId() == "" {
+		return errWorkflowIDNotSet
+	}
+	// TODO currently, this API is only going to be used by re-send history events
+	// to remote cluster if kafka is lossy again, in the future, this API can be used
+	// by CLI and client, then empty runID (meaning the current workflow) should be allowed
+	if execution.GetRunId() == "" || uuid.Parse(execution.GetRunId()) == nil {
+		return errInvalidRunID
+	}
+
+	pageSize := int(request.GetMaximumPageSize())
+	if pageSize <= 0 {
+		return errInvalidPageSize
+	}
+
+	if request.GetStartEventId() == common.EmptyEventID &&
+		request.GetStartEventVersion() == common.EmptyVersion &&
+		request.GetEndEventId() == common.EmptyEventID &&
+		request.GetEndEventVersion() == common.EmptyVersion {
+		return errInvalidEventQueryRange
+	}
+
+	if (request.GetStartEventId() != common.EmptyEventID && request.GetStartEventVersion() == common.EmptyVersion) ||
+		(request.GetStartEventId() == common.EmptyEventID && request.GetStartEventVersion() != common.EmptyVersion) {
+		return errInvalidStartEventCombination
+	}
+
+	if (request.GetEndEventId() != common.EmptyEventID && request.GetEndEventVersion() == common.EmptyVersion) ||
+		(request.GetEndEventId() == common.EmptyEventID && request.GetEndEventVersion() != common.EmptyVersion) {
+		return errInvalidEndEventCombination
+	}
+	return nil
+}
+
+func (adh *AdminHandler) validateConfigForAdvanceVisibility() error {
+	if adh.params.ESConfig == nil || adh.params.ESClient == nil {
+		return errors.New("ES related config not found")
+	}
+	return nil
+}
+
+// validateConfigForVisibilityQuery checks that list and count queries are served, by elasticsearch or by the sql visibility store
+func (adh *AdminHandler) validateConfigForVisibilityQuery() error {
+	if adh.validateConfigForAdvanceVisibility() == nil {
+		return nil
+	}
+	if adh.params.PersistenceConfig.VisibilityStoreType() == config.StoreTypeSQL {
+		return nil
+	}
+	return errors.New("visibility store does not support queries")
 }
 
 func (adh *AdminHandler) setRequestDefaultValueAndGetTargetVersionHistory(
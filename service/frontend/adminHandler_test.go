@@ -28,34 +28,54 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	replicationgenpb "github.com/temporalio/temporal/.gen/proto/replication"
 	"github.com/temporalio/temporal/common/persistence/serialization"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/temporal-proto/common"
+	eventpb "go.temporal.io/temporal-proto/event"
 	executionpb "go.temporal.io/temporal-proto/execution"
 	"go.temporal.io/temporal-proto/serviceerror"
+	tasklistpb "go.temporal.io/temporal-proto/tasklist"
+	"go.temporal.io/temporal-proto/workflowservice"
+	sdkclient "go.temporal.io/temporal/client"
+	sdkmocks "go.temporal.io/temporal/mocks"
+	"google.golang.org/grpc"
 
 	"github.com/temporalio/temporal/.gen/proto/adminservice"
+	commongenpb "github.com/temporalio/temporal/.gen/proto/common"
+	eventgenpb "github.com/temporalio/temporal/.gen/proto/event"
 	"github.com/temporalio/temporal/.gen/proto/historyservice"
 	"github.com/temporalio/temporal/.gen/proto/historyservicemock"
+	"github.com/temporalio/temporal/.gen/proto/matchingservice"
+	namespacegenpb "github.com/temporalio/temporal/.gen/proto/namespace"
+	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
+	tokengenpb "github.com/temporalio/temporal/.gen/proto/token"
 	"github.com/temporalio/temporal/common"
 	"github.com/temporalio/temporal/common/cache"
+	"github.com/temporalio/temporal/common/cluster"
 	"github.com/temporalio/temporal/common/definition"
 	"github.com/temporalio/temporal/common/elasticsearch"
 	esmock "github.com/temporalio/temporal/common/elasticsearch/mocks"
 	"github.com/temporalio/temporal/common/metrics"
 	"github.com/temporalio/temporal/common/mocks"
+	"github.com/temporalio/temporal/common/namespace"
 	"github.com/temporalio/temporal/common/persistence"
+	"github.com/temporalio/temporal/common/primitives"
 	"github.com/temporalio/temporal/common/resource"
 	"github.com/temporalio/temporal/common/service/config"
 	"github.com/temporalio/temporal/common/service/dynamicconfig"
+	"github.com/temporalio/temporal/service/worker/batcher"
+	"github.com/temporalio/temporal/service/worker/deletenamespace"
+	"github.com/temporalio/temporal/service/worker/migration"
 )
 
 type (
@@ -63,10 +83,11 @@ type (
 		suite.Suite
 		*require.Assertions
 
-		controller         *gomock.Controller
-		mockResource       *resource.Test
-		mockHistoryClient  *historyservicemock.MockHistoryServiceClient
-		mockNamespaceCache *cache.MockNamespaceCache
+		controller           *gomock.Controller
+		mockResource         *resource.Test
+		mockHistoryClient    *historyservicemock.MockHistoryServiceClient
+		mockNamespaceCache   *cache.MockNamespaceCache
+		mockNamespaceHandler *namespace.MockHandler
 
 		mockHistoryV2Mgr *mocks.HistoryV2Manager
 
@@ -101,8 +122,11 @@ func (s *adminHandlerSuite) SetupTest() {
 	}
 	config := &Config{
 		EnableAdminProtection: dynamicconfig.GetBoolPropertyFn(false),
+		MaxIDLengthLimit:      dynamicconfig.GetIntPropertyFn(1000),
+		BlobSizeLimitError:    dynamicconfig.GetIntPropertyFilteredByNamespace(2 * 1024 * 1024),
 	}
-	s.handler = NewAdminHandler(s.mockResource, params, config)
+	s.mockNamespaceHandler = namespace.NewMockHandler(s.controller)
+	s.handler = NewAdminHandler(s.mockResource, params, config, s.mockNamespaceHandler)
 	s.handler.Start()
 }
 
@@ -604,3 +628,793 @@ func (s *adminHandlerSuite) Test_AddSearchAttribute_Permission() {
 		s.Nil(resp)
 	}
 }
+
+func (s *adminHandlerSuite) Test_StartBatchOperation_Validate() {
+	ctx := context.Background()
+
+	type test struct {
+		Name     string
+		Request  *adminservice.StartBatchOperationRequest
+		Expected error
+	}
+	testCases := []test{
+		{
+			Name:     "nil request",
+			Request:  nil,
+			Expected: errRequestNotSet,
+		},
+		{
+			Name: "no query",
+			Request: &adminservice.StartBatchOperationRequest{
+				Namespace: s.namespace,
+				BatchType: batcher.BatchTypeTerminate,
+				Reason:    "test reason",
+			},
+			Expected: errQueryNotSet,
+		},
+		{
+			Name: "unknown batch type",
+			Request: &adminservice.StartBatchOperationRequest{
+				Namespace: s.namespace,
+				BatchType: "unknown",
+				Query:     "WorkflowType = 'test'",
+				Reason:    "test reason",
+			},
+			Expected: errInvalidBatchType,
+		},
+		{
+			Name: "no signal name",
+			Request: &adminservice.StartBatchOperationRequest{
+				Namespace: s.namespace,
+				BatchType: batcher.BatchTypeSignal,
+				Query:     "WorkflowType = 'test'",
+				Reason:    "test reason",
+			},
+			Expected: errSignalNameNotSet,
+		},
+		{
+			Name: "unknown reset type",
+			Request: &adminservice.StartBatchOperationRequest{
+				Namespace: s.namespace,
+				BatchType: batcher.BatchTypeReset,
+				Query:     "WorkflowType = 'test'",
+				Reason:    "test reason",
+				ResetType: "unknown",
+			},
+			Expected: errInvalidResetType,
+		},
+		{
+			Name: "no bad binary checksum",
+			Request: &adminservice.StartBatchOperationRequest{
+				Namespace: s.namespace,
+				BatchType: batcher.BatchTypeReset,
+				Query:     "WorkflowType = 'test'",
+				Reason:    "test reason",
+				ResetType: batcher.ResetTypeBadBinary,
+			},
+			Expected: errResetBadBinaryChecksumNotSet,
+		},
+		{
+			Name: "no attributes to upsert",
+			Request: &adminservice.StartBatchOperationRequest{
+				Namespace: s.namespace,
+				BatchType: batcher.BatchTypeUpsertAttributes,
+				Query:     "WorkflowType = 'test'",
+				Reason:    "test reason",
+			},
+			Expected: errWorkflowAttributesNotSet,
+		},
+	}
+	for _, testCase := range testCases {
+		resp, err := s.handler.StartBatchOperation(ctx, testCase.Request)
+		s.Equal(testCase.Expected, err, testCase.Name)
+		s.Nil(resp)
+	}
+}
+
+func (s *adminHandlerSuite) Test_StartBatchOperation_TooManyConcurrentBatchJobs() {
+	handler := s.handler
+	handler.params = &resource.BootstrapParams{
+		ESConfig: &elasticsearch.Config{},
+		ESClient: &esmock.Client{},
+	}
+	handler.config = &Config{
+		EnableAdminProtection:       dynamicconfig.GetBoolPropertyFn(false),
+		MaxConcurrentBatchOperation: dynamicconfig.GetIntPropertyFilteredByNamespace(1),
+	}
+	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Name: s.namespace},
+		&persistenceblobs.NamespaceConfig{},
+		"",
+		nil,
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil)
+	batchQuery, err := getBatchOperationQuery(s.namespace)
+	s.NoError(err)
+	sdkClient := s.mockResource.SDKClient.(*sdkmocks.Client)
+	sdkClient.On("CountWorkflow", mock.Anything, &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: common.SystemLocalNamespace,
+		Query:     batchQuery + " AND CloseTime = missing",
+	}).Return(&workflowservice.CountWorkflowExecutionsResponse{Count: 1}, nil).Once()
+
+	resp, err := handler.StartBatchOperation(context.Background(), &adminservice.StartBatchOperationRequest{
+		Namespace: s.namespace,
+		BatchType: batcher.BatchTypeTerminate,
+		Query:     "WorkflowType = 'test'",
+		Reason:    "test reason",
+	})
+	s.Equal(errTooManyConcurrentBatchJobs, err)
+	s.Nil(resp)
+	sdkClient.AssertExpectations(s.T())
+}
+
+func (s *adminHandlerSuite) Test_ListBatchOperations_NamespaceNotExists() {
+	handler := s.handler
+	handler.params = &resource.BootstrapParams{
+		ESConfig: &elasticsearch.Config{},
+		ESClient: &esmock.Client{},
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(nil, serviceerror.NewNotFound("namespace not found"))
+
+	resp, err := handler.ListBatchOperations(context.Background(), &adminservice.ListBatchOperationsRequest{
+		Namespace: s.namespace,
+	})
+	s.IsType(&serviceerror.NotFound{}, err)
+	s.Nil(resp)
+}
+
+func (s *adminHandlerSuite) Test_ListBatchOperations_SQLVisibility() {
+	handler := s.handler
+	handler.params = &resource.BootstrapParams{
+		PersistenceConfig: config.Persistence{
+			VisibilityStore: "sql-visibility",
+			DataStores: map[string]config.DataStore{
+				"sql-visibility": {SQL: &config.SQL{}},
+			},
+		},
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(nil, serviceerror.NewNotFound("namespace not found"))
+
+	resp, err := handler.ListBatchOperations(context.Background(), &adminservice.ListBatchOperationsRequest{
+		Namespace: s.namespace,
+	})
+	s.IsType(&serviceerror.NotFound{}, err)
+	s.Nil(resp)
+}
+
+func (s *adminHandlerSuite) Test_BatchOperations_VisibilityQueryNotSupported() {
+	handler := s.handler
+	handler.params = &resource.BootstrapParams{
+		PersistenceConfig: config.Persistence{
+			VisibilityStore: "cassandra-visibility",
+			DataStores: map[string]config.DataStore{
+				"cassandra-visibility": {Cassandra: &config.Cassandra{}},
+			},
+		},
+	}
+
+	listResp, err := handler.ListBatchOperations(context.Background(), &adminservice.ListBatchOperationsRequest{
+		Namespace: s.namespace,
+	})
+	s.Equal(errVisibilityQueryIsNotSupported, err)
+	s.Nil(listResp)
+
+	startResp, err := handler.StartBatchOperation(context.Background(), &adminservice.StartBatchOperationRequest{
+		Namespace: s.namespace,
+		BatchType: batcher.BatchTypeTerminate,
+		Query:     "WorkflowType = 'test'",
+		Reason:    "test reason",
+	})
+	s.Equal(errVisibilityQueryIsNotSupported, err)
+	s.Nil(startResp)
+}
+
+func (s *adminHandlerSuite) Test_GetBatchOperationQuery() {
+	query, err := getBatchOperationQuery("test-namespace")
+	s.NoError(err)
+	s.Equal("WorkflowType = 'temporal-sys-batch-workflow' AND "+batcher.NamespaceSearchAttribute+" = 'test-namespace'", query)
+
+	for _, namespace := range []string{"test' OR WorkflowType != '", `test"namespace`, `test\namespace`} {
+		_, err := getBatchOperationQuery(namespace)
+		s.Equal(errInvalidBatchOperationNamespace, err)
+	}
+}
+
+func (s *adminHandlerSuite) Test_DescribeBatchOperation_OtherNamespace() {
+	sdkClient := s.mockResource.SDKClient.(*sdkmocks.Client)
+	sdkClient.On("DescribeWorkflowExecution", mock.Anything, "test-job-id", "").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &executionpb.WorkflowExecutionInfo{
+			Execution: &executionpb.WorkflowExecution{WorkflowId: "test-job-id", RunId: uuid.New()},
+			Type:      &commonpb.WorkflowType{Name: batcher.BatchWFTypeName},
+			Status:    executionpb.WorkflowExecutionStatus_Running,
+			SearchAttributes: &commonpb.SearchAttributes{
+				IndexedFields: map[string][]byte{batcher.NamespaceSearchAttribute: []byte(`"other namespace"`)},
+			},
+		},
+	}, nil).Once()
+
+	resp, err := s.handler.DescribeBatchOperation(context.Background(), &adminservice.DescribeBatchOperationRequest{
+		Namespace: s.namespace,
+		JobId:     "test-job-id",
+	})
+	s.Equal(errBatchOperationNotFound, err)
+	s.Nil(resp)
+}
+
+func (s *adminHandlerSuite) Test_DeleteNamespace_Validate() {
+	resp, err := s.handler.DeleteNamespace(context.Background(), &adminservice.DeleteNamespaceRequest{})
+	s.Equal(errNamespaceNotSet, err)
+	s.Nil(resp)
+
+	resp, err = s.handler.DeleteNamespace(context.Background(), &adminservice.DeleteNamespaceRequest{
+		Namespace: common.SystemLocalNamespace,
+	})
+	s.Equal(errCannotDeleteSystemNamespace, err)
+	s.Nil(resp)
+
+	resp, err = s.handler.DeleteNamespace(context.Background(), &adminservice.DeleteNamespaceRequest{
+		Namespace: s.namespace,
+		Rps:       -1,
+	})
+	s.Equal(errInvalidNamespaceDeletionRPS, err)
+	s.Nil(resp)
+}
+
+func (s *adminHandlerSuite) Test_DeleteNamespace_GlobalNamespace() {
+	namespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Id: primitives.MustParseUUID(s.namespaceID), Name: s.namespace},
+		&persistenceblobs.NamespaceConfig{},
+		&persistenceblobs.NamespaceReplicationConfig{},
+		0,
+		nil,
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil)
+
+	resp, err := s.handler.DeleteNamespace(context.Background(), &adminservice.DeleteNamespaceRequest{
+		Namespace: s.namespace,
+	})
+	s.Equal(errCannotDeleteGlobalNamespace, err)
+	s.Nil(resp)
+}
+
+func (s *adminHandlerSuite) Test_DeleteNamespace() {
+	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Id: primitives.MustParseUUID(s.namespaceID), Name: s.namespace},
+		&persistenceblobs.NamespaceConfig{},
+		"",
+		nil,
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil)
+	sdkClient := s.mockResource.SDKClient.(*sdkmocks.Client)
+	sdkClient.On("ExecuteWorkflow", mock.Anything, mock.MatchedBy(func(options sdkclient.StartWorkflowOptions) bool {
+		return options.ID == deletenamespace.GetWorkflowID(s.namespace) && options.TaskList == deletenamespace.TaskListName
+	}), deletenamespace.WorkflowTypeName, deletenamespace.Params{
+		Namespace:               s.namespace,
+		NamespaceID:             s.namespaceID,
+		Reason:                  "test reason",
+		Identity:                "test identity",
+		TerminateOpenExecutions: true,
+	}).Return(nil, nil).Once()
+
+	resp, err := s.handler.DeleteNamespace(context.Background(), &adminservice.DeleteNamespaceRequest{
+		Namespace:               s.namespace,
+		Reason:                  "test reason",
+		Identity:                "test identity",
+		TerminateOpenExecutions: true,
+	})
+	s.NoError(err)
+	s.NotNil(resp)
+	sdkClient.AssertExpectations(s.T())
+}
+
+func (s *adminHandlerSuite) Test_ToBatchOperationInfo() {
+	info := toBatchOperationInfo(&executionpb.WorkflowExecutionInfo{
+		Execution: &executionpb.WorkflowExecution{WorkflowId: "test-job-id", RunId: uuid.New()},
+		Type:      &commonpb.WorkflowType{Name: batcher.BatchWFTypeName},
+		StartTime: &types.Int64Value{Value: 100},
+		CloseTime: &types.Int64Value{Value: 200},
+		Status:    executionpb.WorkflowExecutionStatus_Terminated,
+		Memo: &commonpb.Memo{Fields: map[string][]byte{
+			batcher.BatchTypeMemo: []byte(`"terminate"`),
+			batcher.ReasonMemo:    []byte(`"test reason"`),
+			batcher.QueryMemo:     []byte(`"WorkflowType = 'test'"`),
+		}},
+		SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string][]byte{
+			batcher.OperatorSearchAttribute: []byte(`"test operator"`),
+		}},
+	})
+	s.Equal(&adminservice.BatchOperationInfo{
+		JobId:     "test-job-id",
+		State:     commongenpb.BatchOperationState_Stopped,
+		BatchType: batcher.BatchTypeTerminate,
+		Query:     "WorkflowType = 'test'",
+		Reason:    "test reason",
+		Identity:  "test operator",
+		StartTime: 100,
+		CloseTime: 200,
+	}, info)
+}
+
+func (s *adminHandlerSuite) Test_RenameNamespace_Validate() {
+	handler := s.handler
+	ctx := context.Background()
+
+	_, err := handler.RenameNamespace(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	_, err = handler.RenameNamespace(ctx, &adminservice.RenameNamespaceRequest{NewName: "new name"})
+	s.Equal(errNamespaceNotSet, err)
+
+	_, err = handler.RenameNamespace(ctx, &adminservice.RenameNamespaceRequest{
+		Namespace: common.SystemLocalNamespace,
+		NewName:   "new name",
+	})
+	s.Equal(errCannotRenameSystemNamespace, err)
+}
+
+func (s *adminHandlerSuite) Test_RenameNamespace() {
+	request := &adminservice.RenameNamespaceRequest{
+		Namespace:               s.namespace,
+		NewName:                 "new name",
+		AliasGracePeriodSeconds: 3600,
+	}
+	s.mockNamespaceHandler.EXPECT().RenameNamespace(gomock.Any(), request).Return(&adminservice.RenameNamespaceResponse{}, nil)
+	resp, err := s.handler.RenameNamespace(context.Background(), request)
+	s.NoError(err)
+	s.NotNil(resp)
+}
+
+func (s *adminHandlerSuite) Test_UpdateWorkerVersionSets() {
+	_, err := s.handler.UpdateWorkerVersionSets(context.Background(), &adminservice.UpdateWorkerVersionSetsRequest{})
+	s.Equal(errNamespaceNotSet, err)
+
+	request := &adminservice.UpdateWorkerVersionSetsRequest{
+		Namespace: s.namespace,
+		VersionSets: []*namespacegenpb.WorkerVersionSet{
+			{BuildIds: []string{"1.0", "1.1"}},
+			{BuildIds: []string{"2.0"}},
+		},
+	}
+	s.mockNamespaceHandler.EXPECT().UpdateWorkerVersionSets(gomock.Any(), request).Return(&adminservice.UpdateWorkerVersionSetsResponse{}, nil)
+	resp, err := s.handler.UpdateWorkerVersionSets(context.Background(), request)
+	s.NoError(err)
+	s.NotNil(resp)
+}
+
+func (s *adminHandlerSuite) Test_DescribeTaskListVersions() {
+	versionSets := []*namespacegenpb.WorkerVersionSet{{BuildIds: []string{"1.0", "1.1"}}}
+	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Id: primitives.MustParseUUID(s.namespaceID), Name: s.namespace},
+		&persistenceblobs.NamespaceConfig{WorkerVersionSets: versionSets},
+		"",
+		nil,
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil)
+	taskList := &tasklistpb.TaskList{Name: "test-task-list"}
+	pollers := []*tasklistpb.PollerInfo{{Identity: "worker-1"}, {Identity: "worker-2"}, {Identity: "worker-3"}}
+	s.mockResource.MatchingClient.EXPECT().DescribeTaskList(gomock.Any(), &matchingservice.DescribeTaskListRequest{
+		NamespaceId: s.namespaceID,
+		DescRequest: &workflowservice.DescribeTaskListRequest{
+			Namespace:    s.namespace,
+			TaskList:     taskList,
+			TaskListType: tasklistpb.TaskListType_Decision,
+		},
+	}).Return(&matchingservice.DescribeTaskListResponse{
+		Pollers:        pollers,
+		PollerBuildIds: map[string]string{"worker-1": "1.1", "worker-3": "1.1"},
+	}, nil)
+
+	resp, err := s.handler.DescribeTaskListVersions(context.Background(), &adminservice.DescribeTaskListVersionsRequest{
+		Namespace: s.namespace,
+		TaskList:  taskList,
+	})
+	s.NoError(err)
+	s.Equal(versionSets, resp.VersionSets)
+	s.Equal([]*adminservice.WorkerVersionPollers{
+		{BuildId: "", Pollers: []*tasklistpb.PollerInfo{pollers[1]}},
+		{BuildId: "1.1", Pollers: []*tasklistpb.PollerInfo{pollers[0], pollers[2]}},
+	}, resp.Pollers)
+}
+
+func (s *adminHandlerSuite) Test_UpdateTaskListRateLimit() {
+	taskList := &tasklistpb.TaskList{Name: "test-task-list"}
+
+	_, err := s.handler.UpdateTaskListRateLimit(context.Background(), &adminservice.UpdateTaskListRateLimitRequest{
+		Namespace: s.namespace,
+	})
+	s.Equal(errTaskListNotSet, err)
+
+	_, err = s.handler.UpdateTaskListRateLimit(context.Background(), &adminservice.UpdateTaskListRateLimitRequest{
+		Namespace:         s.namespace,
+		TaskList:          taskList,
+		MaxTasksPerSecond: -1,
+	})
+	s.Equal(errInvalidMaxTasksPerSecond, err)
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil)
+	s.mockResource.MatchingClient.EXPECT().UpdateTaskListRateLimit(gomock.Any(), &matchingservice.UpdateTaskListRateLimitRequest{
+		NamespaceId:       s.namespaceID,
+		TaskList:          taskList,
+		TaskListType:      tasklistpb.TaskListType_Activity,
+		MaxTasksPerSecond: 10,
+	}).Return(&matchingservice.UpdateTaskListRateLimitResponse{}, nil)
+	_, err = s.handler.UpdateTaskListRateLimit(context.Background(), &adminservice.UpdateTaskListRateLimitRequest{
+		Namespace:         s.namespace,
+		TaskList:          taskList,
+		TaskListType:      tasklistpb.TaskListType_Activity,
+		MaxTasksPerSecond: 10,
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) Test_StartMigration_Validate() {
+	handler := s.handler
+	ctx := context.Background()
+	s.mockResource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+
+	_, err := handler.StartMigration(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	_, err = handler.StartMigration(ctx, &adminservice.StartMigrationRequest{TargetNamespace: "target"})
+	s.Equal(errSourceNamespaceNotSet, err)
+
+	_, err = handler.StartMigration(ctx, &adminservice.StartMigrationRequest{SourceNamespace: "source"})
+	s.Equal(errTargetNamespaceNotSet, err)
+
+	_, err = handler.StartMigration(ctx, &adminservice.StartMigrationRequest{
+		SourceNamespace: "source",
+		TargetNamespace: "target",
+		Rps:             -1,
+	})
+	s.Equal(errInvalidMigrationRPS, err)
+
+	_, err = handler.StartMigration(ctx, &adminservice.StartMigrationRequest{
+		SourceNamespace: "source",
+		TargetNamespace: "target",
+		Execution:       &executionpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: "invalid"},
+	})
+	s.Equal(errInvalidRunID, err)
+
+	_, err = handler.StartMigration(ctx, &adminservice.StartMigrationRequest{
+		SourceCluster:   "unknown",
+		SourceNamespace: "source",
+		TargetNamespace: "target",
+	})
+	s.Equal(errUnknownSourceCluster, err)
+}
+
+func (s *adminHandlerSuite) Test_StartMigration_LocalTargetNamespace() {
+	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Id: primitives.MustParseUUID(s.namespaceID), Name: s.namespace},
+		&persistenceblobs.NamespaceConfig{},
+		cluster.TestCurrentClusterName,
+		nil,
+	)
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil)
+
+	resp, err := s.handler.StartMigration(context.Background(), &adminservice.StartMigrationRequest{
+		SourceNamespace: "source",
+		TargetNamespace: s.namespace,
+	})
+	s.Equal(errMigrationNamespaceNotGlobal, err)
+	s.Nil(resp)
+}
+
+func (s *adminHandlerSuite) Test_StartMigration() {
+	namespaceEntry := cache.NewGlobalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Id: primitives.MustParseUUID(s.namespaceID), Name: s.namespace},
+		&persistenceblobs.NamespaceConfig{},
+		&persistenceblobs.NamespaceReplicationConfig{ActiveClusterName: cluster.TestCurrentClusterName},
+		0,
+		s.mockResource.ClusterMetadata,
+	)
+	s.mockResource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+	s.mockResource.ClusterMetadata.EXPECT().GetCurrentClusterName().Return(cluster.TestCurrentClusterName).AnyTimes()
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil)
+	sdkClient := s.mockResource.SDKClient.(*sdkmocks.Client)
+	sdkClient.On("ExecuteWorkflow", mock.Anything, mock.MatchedBy(func(options sdkclient.StartWorkflowOptions) bool {
+		return options.TaskList == migration.TaskListName
+	}), migration.WorkflowTypeName, mock.MatchedBy(func(params migration.Params) bool {
+		return params.SourceCluster == cluster.TestAlternativeClusterName &&
+			params.SourceNamespace == "source" &&
+			params.TargetNamespace == s.namespace &&
+			params.TargetNamespaceID == s.namespaceID &&
+			params.Execution == nil &&
+			params.Query == "WorkflowType = 'test'"
+	})).Return(nil, nil).Once()
+
+	resp, err := s.handler.StartMigration(context.Background(), &adminservice.StartMigrationRequest{
+		SourceCluster:   cluster.TestAlternativeClusterName,
+		SourceNamespace: "source",
+		TargetNamespace: s.namespace,
+		Query:           "WorkflowType = 'test'",
+	})
+	s.NoError(err)
+	s.NotEmpty(resp.GetMigrationId())
+	sdkClient.AssertExpectations(s.T())
+}
+
+func (s *adminHandlerSuite) Test_DescribeMigration_Validate() {
+	_, err := s.handler.DescribeMigration(context.Background(), &adminservice.DescribeMigrationRequest{})
+	s.Equal(errMigrationIDNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_ExportWorkflowExecution_Validate() {
+	handler := s.handler
+	ctx := context.Background()
+
+	_, err := handler.ExportWorkflowExecution(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	_, err = handler.ExportWorkflowExecution(ctx, &adminservice.ExportWorkflowExecutionRequest{})
+	s.Equal(errNamespaceNotSet, err)
+
+	_, err = handler.ExportWorkflowExecution(ctx, &adminservice.ExportWorkflowExecutionRequest{
+		Namespace: s.namespace,
+		Execution: &executionpb.WorkflowExecution{},
+	})
+	s.Equal(errWorkflowIDNotSet, err)
+
+	_, err = handler.ExportWorkflowExecution(ctx, &adminservice.ExportWorkflowExecutionRequest{
+		Namespace: s.namespace,
+		Execution: &executionpb.WorkflowExecution{WorkflowId: "workflowID", RunId: "invalid"},
+	})
+	s.Equal(errInvalidRunID, err)
+
+	_, err = handler.ExportWorkflowExecution(ctx, &adminservice.ExportWorkflowExecutionRequest{
+		Namespace: s.namespace,
+		Execution: &executionpb.WorkflowExecution{WorkflowId: "workflowID"},
+	})
+	s.Equal(errInvalidPageSize, err)
+}
+
+func (s *adminHandlerSuite) Test_ExportWorkflowExecution() {
+	ctx := context.Background()
+	runID := uuid.New()
+	branchToken := []byte{1}
+	versionHistories := persistence.NewVersionHistories(persistence.NewVersionHistory(branchToken, []*persistence.VersionHistoryItem{
+		persistence.NewVersionHistoryItem(int64(3), common.EmptyVersion),
+	})).ToProto()
+	batches := []*eventpb.History{
+		{Events: []*eventpb.HistoryEvent{{EventId: 1, Version: common.EmptyVersion}, {EventId: 2, Version: common.EmptyVersion}}},
+	}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), &historyservice.GetMutableStateRequest{
+		NamespaceId: s.namespaceID,
+		Execution:   &executionpb.WorkflowExecution{WorkflowId: "workflowID"},
+	}).Return(&historyservice.GetMutableStateResponse{
+		Execution:          &executionpb.WorkflowExecution{WorkflowId: "workflowID", RunId: runID},
+		NextEventId:        4,
+		CurrentBranchToken: branchToken,
+		VersionHistories:   versionHistories,
+	}, nil)
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return request.MinEventID == common.FirstEventID && request.MaxEventID == 4 && request.NextPageToken == nil
+	})).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History:       batches,
+		NextPageToken: []byte{2},
+	}, nil).Once()
+
+	resp, err := s.handler.ExportWorkflowExecution(ctx, &adminservice.ExportWorkflowExecutionRequest{
+		Namespace:       s.namespace,
+		Execution:       &executionpb.WorkflowExecution{WorkflowId: "workflowID"},
+		MaximumPageSize: 1,
+	})
+	s.NoError(err)
+	s.Equal(runID, resp.GetExport().GetExecution().GetRunId())
+	s.Equal(int64(4), resp.GetExport().GetMutableState().GetNextEventId())
+	s.Equal(versionHistories, resp.GetExport().GetVersionHistories())
+	s.Equal(batches, resp.GetExport().GetHistoryBatches())
+	token, err := deserializeHistoryToken(resp.GetNextPageToken())
+	s.NoError(err)
+	s.Equal(&tokengenpb.HistoryContinuation{
+		RunId:            runID,
+		BranchToken:      branchToken,
+		FirstEventId:     common.FirstEventID,
+		NextEventId:      4,
+		PersistenceToken: []byte{2},
+	}, token)
+
+	// the following pages only read the history
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return string(request.NextPageToken) == string([]byte{2})
+	})).Return(&persistence.ReadHistoryBranchByBatchResponse{
+		History: []*eventpb.History{{Events: []*eventpb.HistoryEvent{{EventId: 3, Version: common.EmptyVersion}}}},
+	}, nil).Once()
+	resp, err = s.handler.ExportWorkflowExecution(ctx, &adminservice.ExportWorkflowExecutionRequest{
+		Namespace:       s.namespace,
+		Execution:       &executionpb.WorkflowExecution{WorkflowId: "workflowID", RunId: runID},
+		MaximumPageSize: 1,
+		NextPageToken:   resp.GetNextPageToken(),
+	})
+	s.NoError(err)
+	s.Nil(resp.GetExport().GetMutableState())
+	s.Len(resp.GetExport().GetHistoryBatches(), 1)
+	s.Nil(resp.GetNextPageToken())
+}
+
+func (s *adminHandlerSuite) Test_ImportWorkflowExecution_Validate() {
+	handler := s.handler
+	ctx := context.Background()
+	execution := &executionpb.WorkflowExecution{WorkflowId: "workflowID", RunId: uuid.New()}
+	batches := []*eventpb.History{{Events: []*eventpb.HistoryEvent{{EventId: 1, Version: 5}}}}
+	items := []*eventgenpb.VersionHistoryItem{{EventId: 1, Version: 5}}
+
+	_, err := handler.ImportWorkflowExecution(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	_, err = handler.ImportWorkflowExecution(ctx, &adminservice.ImportWorkflowExecutionRequest{Execution: execution})
+	s.Equal(errNamespaceNotSet, err)
+
+	_, err = handler.ImportWorkflowExecution(ctx, &adminservice.ImportWorkflowExecutionRequest{
+		Namespace: s.namespace,
+		Execution: &executionpb.WorkflowExecution{WorkflowId: "workflowID"},
+	})
+	s.Equal(errInvalidRunID, err)
+
+	_, err = handler.ImportWorkflowExecution(ctx, &adminservice.ImportWorkflowExecutionRequest{
+		Namespace:           s.namespace,
+		Execution:           execution,
+		VersionHistoryItems: items,
+	})
+	s.Equal(errHistoryBatchesNotSet, err)
+
+	_, err = handler.ImportWorkflowExecution(ctx, &adminservice.ImportWorkflowExecutionRequest{
+		Namespace:      s.namespace,
+		Execution:      execution,
+		HistoryBatches: batches,
+	})
+	s.Equal(errVersionHistoryItemsNotSet, err)
+
+	_, err = handler.ImportWorkflowExecution(ctx, &adminservice.ImportWorkflowExecutionRequest{
+		Namespace:           s.namespace,
+		Execution:           execution,
+		VersionHistoryItems: items,
+		HistoryBatches:      []*eventpb.History{{}},
+	})
+	s.Equal(errEmptyHistoryBatch, err)
+
+	s.mockResource.ClusterMetadata.EXPECT().GetAllClusterInfo().Return(cluster.TestAllClusterInfo).AnyTimes()
+	s.mockResource.ClusterMetadata.EXPECT().IsVersionFromSameCluster(int64(5), gomock.Any()).Return(false).AnyTimes()
+	_, err = handler.ImportWorkflowExecution(ctx, &adminservice.ImportWorkflowExecutionRequest{
+		Namespace:           s.namespace,
+		Execution:           execution,
+		VersionHistoryItems: items,
+		HistoryBatches:      batches,
+	})
+	s.Equal(errUnknownFailoverVersion, err)
+}
+
+func (s *adminHandlerSuite) Test_ImportWorkflowExecution() {
+	execution := &executionpb.WorkflowExecution{WorkflowId: "workflowID", RunId: uuid.New()}
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(s.namespace).Return(s.namespaceID, nil).AnyTimes()
+	var replicatedItems [][]*eventgenpb.VersionHistoryItem
+	s.mockHistoryClient.EXPECT().ReplicateEventsV2(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.ReplicateEventsV2Request, _ ...grpc.CallOption) (*historyservice.ReplicateEventsV2Response, error) {
+			s.Equal(s.namespaceID, request.GetNamespaceId())
+			s.Equal(execution, request.GetWorkflowExecution())
+			s.True(request.GetCreatePaused())
+			replicatedItems = append(replicatedItems, request.GetVersionHistoryItems())
+			return &historyservice.ReplicateEventsV2Response{}, nil
+		}).Times(2)
+
+	_, err := s.handler.ImportWorkflowExecution(context.Background(), &adminservice.ImportWorkflowExecutionRequest{
+		Namespace:           s.namespace,
+		Execution:           execution,
+		VersionHistoryItems: []*eventgenpb.VersionHistoryItem{{EventId: 4, Version: common.EmptyVersion}},
+		HistoryBatches: []*eventpb.History{
+			{Events: []*eventpb.HistoryEvent{{EventId: 1, Version: common.EmptyVersion}, {EventId: 2, Version: common.EmptyVersion}}},
+			{Events: []*eventpb.HistoryEvent{{EventId: 3, Version: common.EmptyVersion}}},
+		},
+		Paused: true,
+	})
+	s.NoError(err)
+	s.Equal([][]*eventgenpb.VersionHistoryItem{
+		{{EventId: 2, Version: common.EmptyVersion}},
+		{{EventId: 3, Version: common.EmptyVersion}},
+	}, replicatedItems)
+}
+
+func (s *adminHandlerSuite) Test_StreamWorkflowExecutionHistory_Validate() {
+	handler := s.handler
+
+	err := handler.StreamWorkflowExecutionHistory(nil, nil)
+	s.Equal(errRequestNotSet, err)
+
+	err = handler.StreamWorkflowExecutionHistory(&adminservice.StreamWorkflowExecutionHistoryRequest{}, nil)
+	s.Equal(errNamespaceNotSet, err)
+
+	err = handler.StreamWorkflowExecutionHistory(&adminservice.StreamWorkflowExecutionHistoryRequest{
+		Namespace: s.namespace,
+		Execution: &executionpb.WorkflowExecution{},
+	}, nil)
+	s.Equal(errWorkflowIDNotSet, err)
+
+	err = handler.StreamWorkflowExecutionHistory(&adminservice.StreamWorkflowExecutionHistoryRequest{
+		Namespace: s.namespace,
+		Execution: &executionpb.WorkflowExecution{WorkflowId: "workflowID", RunId: "invalid"},
+	}, nil)
+	s.Equal(errInvalidRunID, err)
+
+	err = handler.StreamWorkflowExecutionHistory(&adminservice.StreamWorkflowExecutionHistoryRequest{
+		Namespace:    s.namespace,
+		Execution:    &executionpb.WorkflowExecution{WorkflowId: "workflowID"},
+		FirstEventId: -1,
+	}, nil)
+	s.Equal(errInvalidFirstEventID, err)
+}
+
+func (s *adminHandlerSuite) Test_UpdateWorkflowExecution_Validate() {
+	handler := s.handler
+	ctx := context.Background()
+
+	_, err := handler.UpdateWorkflowExecution(ctx, nil)
+	s.Equal(errRequestNotSet, err)
+
+	_, err = handler.UpdateWorkflowExecution(ctx, &adminservice.UpdateWorkflowExecutionRequest{
+		Namespace: s.namespace,
+	})
+	s.Equal(errExecutionNotSet, err)
+
+	_, err = handler.UpdateWorkflowExecution(ctx, &adminservice.UpdateWorkflowExecutionRequest{
+		Namespace: s.namespace,
+		Execution: &executionpb.WorkflowExecution{WorkflowId: "workflowID"},
+	})
+	s.Equal(errUpdateNameNotSet, err)
+
+	_, err = handler.UpdateWorkflowExecution(ctx, &adminservice.UpdateWorkflowExecutionRequest{
+		Namespace: s.namespace,
+		Execution: &executionpb.WorkflowExecution{WorkflowId: "workflowID"},
+		Name:      strings.Repeat("a", 1001),
+	})
+	s.Equal(errUpdateNameTooLong, err)
+
+	_, err = handler.UpdateWorkflowExecution(ctx, &adminservice.UpdateWorkflowExecutionRequest{
+		Namespace: s.namespace,
+		Execution: &executionpb.WorkflowExecution{WorkflowId: "workflowID"},
+		Name:      "update",
+		UpdateId:  strings.Repeat("a", 1001),
+	})
+	s.Equal(errUpdateIDTooLong, err)
+
+	_, err = handler.UpdateWorkflowExecution(ctx, &adminservice.UpdateWorkflowExecutionRequest{
+		Namespace: s.namespace,
+		Execution: &executionpb.WorkflowExecution{WorkflowId: "workflowID"},
+		Name:      "update",
+		Input:     make([]byte, 2*1024*1024+1),
+	})
+	s.Equal(errUpdateInputTooLarge, err)
+}
+
+func (s *adminHandlerSuite) Test_UpdateWorkflowExecution() {
+	namespaceEntry := cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Id: primitives.MustParseUUID(s.namespaceID), Name: s.namespace},
+		&persistenceblobs.NamespaceConfig{},
+		"",
+		nil,
+	)
+	s.mockNamespaceCache.EXPECT().GetNamespace(s.namespace).Return(namespaceEntry, nil)
+	s.mockHistoryClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.UpdateWorkflowExecutionRequest, _ ...grpc.CallOption) (*historyservice.UpdateWorkflowExecutionResponse, error) {
+			s.Equal(s.namespaceID, request.GetNamespaceId())
+			s.Equal("update", request.GetRequest().GetName())
+			// the update id is generated when it is not set
+			s.NotEmpty(request.GetRequest().GetUpdateId())
+			return &historyservice.UpdateWorkflowExecutionResponse{
+				Response: &adminservice.UpdateWorkflowExecutionResponse{
+					UpdateId: request.GetRequest().GetUpdateId(),
+					Result:   []byte("result"),
+				},
+			}, nil
+		})
+
+	resp, err := s.handler.UpdateWorkflowExecution(context.Background(), &adminservice.UpdateWorkflowExecutionRequest{
+		Namespace: s.namespace,
+		Execution: &executionpb.WorkflowExecution{WorkflowId: "workflowID"},
+		Name:      "update",
+	})
+	s.NoError(err)
+	s.NotEmpty(resp.GetUpdateId())
+	s.Equal([]byte("result"), resp.GetResult())
+}
@@ -197,3 +197,216 @@ func (adh *AdminNilCheckHandler) RefreshWorkflowTasks(ctx context.Context, reque
 	}
 	return resp, err
 }
+
+// PauseWorkflowExecution stops dispatching the tasks of a workflow
+func (adh *AdminNilCheckHandler) PauseWorkflowExecution(ctx context.Context, request *adminservice.PauseWorkflowExecutionRequest) (*adminservice.PauseWorkflowExecutionResponse, error) {
+	resp, err := adh.parentHandler.PauseWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.PauseWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+// UnpauseWorkflowExecution releases the tasks of a paused workflow
+func (adh *AdminNilCheckHandler) UnpauseWorkflowExecution(ctx context.Context, request *adminservice.UnpauseWorkflowExecutionRequest) (*adminservice.UnpauseWorkflowExecutionResponse, error) {
+	resp, err := adh.parentHandler.UnpauseWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.UnpauseWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+// TriggerCronRun starts the pending run of a cron workflow right away
+func (adh *AdminNilCheckHandler) TriggerCronRun(ctx context.Context, request *adminservice.TriggerCronRunRequest) (*adminservice.TriggerCronRunResponse, error) {
+	resp, err := adh.parentHandler.TriggerCronRun(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.TriggerCronRunResponse{}
+	}
+	return resp, err
+}
+
+// SkipCronRuns skips a number of upcoming runs of a cron workflow
+func (adh *AdminNilCheckHandler) SkipCronRuns(ctx context.Context, request *adminservice.SkipCronRunsRequest) (*adminservice.SkipCronRunsResponse, error) {
+	resp, err := adh.parentHandler.SkipCronRuns(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.SkipCronRunsResponse{}
+	}
+	return resp, err
+}
+
+// UpdateActivityOptions changes the timeouts, task list or retry policy of a pending activity
+func (adh *AdminNilCheckHandler) UpdateActivityOptions(ctx context.Context, request *adminservice.UpdateActivityOptionsRequest) (*adminservice.UpdateActivityOptionsResponse, error) {
+	resp, err := adh.parentHandler.UpdateActivityOptions(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.UpdateActivityOptionsResponse{}
+	}
+	return resp, err
+}
+
+// ResetActivity retries an activity waiting for its retry backoff immediately and optionally resets its attempts
+func (adh *AdminNilCheckHandler) ResetActivity(ctx context.Context, request *adminservice.ResetActivityRequest) (*adminservice.ResetActivityResponse, error) {
+	resp, err := adh.parentHandler.ResetActivity(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.ResetActivityResponse{}
+	}
+	return resp, err
+}
+
+// StartBatchOperation starts a batch job operating on the workflows matching a visibility query
+func (adh *AdminNilCheckHandler) StartBatchOperation(ctx context.Context, request *adminservice.StartBatchOperationRequest) (*adminservice.StartBatchOperationResponse, error) {
+	resp, err := adh.parentHandler.StartBatchOperation(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.StartBatchOperationResponse{}
+	}
+	return resp, err
+}
+
+// DescribeBatchOperation returns the state and the progress of a batch job
+func (adh *AdminNilCheckHandler) DescribeBatchOperation(ctx context.Context, request *adminservice.DescribeBatchOperationRequest) (*adminservice.DescribeBatchOperationResponse, error) {
+	resp, err := adh.parentHandler.DescribeBatchOperation(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.DescribeBatchOperationResponse{}
+	}
+	return resp, err
+}
+
+// ListBatchOperations lists the batch jobs of a namespace
+func (adh *AdminNilCheckHandler) ListBatchOperations(ctx context.Context, request *adminservice.ListBatchOperationsRequest) (*adminservice.ListBatchOperationsResponse, error) {
+	resp, err := adh.parentHandler.ListBatchOperations(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.ListBatchOperationsResponse{}
+	}
+	return resp, err
+}
+
+// StopBatchOperation stops a running batch job
+func (adh *AdminNilCheckHandler) StopBatchOperation(ctx context.Context, request *adminservice.StopBatchOperationRequest) (*adminservice.StopBatchOperationResponse, error) {
+	resp, err := adh.parentHandler.StopBatchOperation(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.StopBatchOperationResponse{}
+	}
+	return resp, err
+}
+
+// DeleteWorkflowExecution deletes a workflow execution along with all its data, terminating it first if it is running
+func (adh *AdminNilCheckHandler) DeleteWorkflowExecution(ctx context.Context, request *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
+	resp, err := adh.parentHandler.DeleteWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.DeleteWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+// UpsertWorkflowAttributes merges search attributes and memo into a workflow execution, or into the visibility record
+// of a closed one
+func (adh *AdminNilCheckHandler) UpsertWorkflowAttributes(ctx context.Context, request *adminservice.UpsertWorkflowAttributesRequest) (*adminservice.UpsertWorkflowAttributesResponse, error) {
+	resp, err := adh.parentHandler.UpsertWorkflowAttributes(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.UpsertWorkflowAttributesResponse{}
+	}
+	return resp, err
+}
+
+// DeleteNamespace starts the system workflow deleting a namespace along with all its data
+func (adh *AdminNilCheckHandler) DeleteNamespace(ctx context.Context, request *adminservice.DeleteNamespaceRequest) (*adminservice.DeleteNamespaceResponse, error) {
+	resp, err := adh.parentHandler.DeleteNamespace(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.DeleteNamespaceResponse{}
+	}
+	return resp, err
+}
+
+// DescribeNamespaceDeletion returns the progress of the deletion of a namespace
+func (adh *AdminNilCheckHandler) DescribeNamespaceDeletion(ctx context.Context, request *adminservice.DescribeNamespaceDeletionRequest) (*adminservice.DescribeNamespaceDeletionResponse, error) {
+	resp, err := adh.parentHandler.DescribeNamespaceDeletion(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.DescribeNamespaceDeletionResponse{}
+	}
+	return resp, err
+}
+
+// RenameNamespace renames a namespace keeping its ID, optionally keeping the old name as an alias for a grace period
+func (adh *AdminNilCheckHandler) RenameNamespace(ctx context.Context, request *adminservice.RenameNamespaceRequest) (*adminservice.RenameNamespaceResponse, error) {
+	resp, err := adh.parentHandler.RenameNamespace(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.RenameNamespaceResponse{}
+	}
+	return resp, err
+}
+
+// StartMigration starts the system workflow moving workflow executions to a namespace of the current cluster
+func (adh *AdminNilCheckHandler) StartMigration(ctx context.Context, request *adminservice.StartMigrationRequest) (*adminservice.StartMigrationResponse, error) {
+	resp, err := adh.parentHandler.StartMigration(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.StartMigrationResponse{}
+	}
+	return resp, err
+}
+
+// DescribeMigration returns the progress of a migration of workflow executions
+func (adh *AdminNilCheckHandler) DescribeMigration(ctx context.Context, request *adminservice.DescribeMigrationRequest) (*adminservice.DescribeMigrationResponse, error) {
+	resp, err := adh.parentHandler.DescribeMigration(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.DescribeMigrationResponse{}
+	}
+	return resp, err
+}
+
+// ExportWorkflowExecution returns a page of the portable representation of a workflow execution
+func (adh *AdminNilCheckHandler) ExportWorkflowExecution(ctx context.Context, request *adminservice.ExportWorkflowExecutionRequest) (*adminservice.ExportWorkflowExecutionResponse, error) {
+	resp, err := adh.parentHandler.ExportWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.ExportWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+// ImportWorkflowExecution recreates a workflow execution from exported history batches
+func (adh *AdminNilCheckHandler) ImportWorkflowExecution(ctx context.Context, request *adminservice.ImportWorkflowExecutionRequest) (*adminservice.ImportWorkflowExecutionResponse, error) {
+	resp, err := adh.parentHandler.ImportWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.ImportWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+// StreamWorkflowExecutionHistory streams the history events of a workflow execution as they are committed
+func (adh *AdminNilCheckHandler) StreamWorkflowExecutionHistory(request *adminservice.StreamWorkflowExecutionHistoryRequest, server adminservice.AdminService_StreamWorkflowExecutionHistoryServer) error {
+	return adh.parentHandler.StreamWorkflowExecutionHistory(request, server)
+}
+
+// UpdateWorkflowExecution delivers an update to a running workflow on a decision task and waits for its result
+func (adh *AdminNilCheckHandler) UpdateWorkflowExecution(ctx context.Context, request *adminservice.UpdateWorkflowExecutionRequest) (*adminservice.UpdateWorkflowExecutionResponse, error) {
+	resp, err := adh.parentHandler.UpdateWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.UpdateWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+// UpdateWorkerVersionSets replaces the sets of compatible worker build IDs of a namespace
+func (adh *AdminNilCheckHandler) UpdateWorkerVersionSets(ctx context.Context, request *adminservice.UpdateWorkerVersionSetsRequest) (*adminservice.UpdateWorkerVersionSetsResponse, error) {
+	resp, err := adh.parentHandler.UpdateWorkerVersionSets(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.UpdateWorkerVersionSetsResponse{}
+	}
+	return resp, err
+}
+
+// DescribeTaskListVersions returns the worker version sets of the namespace and the pollers of a task list grouped by build ID
+func (adh *AdminNilCheckHandler) DescribeTaskListVersions(ctx context.Context, request *adminservice.DescribeTaskListVersionsRequest) (*adminservice.DescribeTaskListVersionsResponse, error) {
+	resp, err := adh.parentHandler.DescribeTaskListVersions(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.DescribeTaskListVersionsResponse{}
+	}
+	return resp, err
+}
+
+// UpdateTaskListRateLimit persists the rate at which tasks of a task list are dispatched across all its partitions
+func (adh *AdminNilCheckHandler) UpdateTaskListRateLimit(ctx context.Context, request *adminservice.UpdateTaskListRateLimitRequest) (*adminservice.UpdateTaskListRateLimitResponse, error) {
+	resp, err := adh.parentHandler.UpdateTaskListRateLimit(ctx, request)
+	if resp == nil && err == nil {
+		resp = &adminservice.UpdateTaskListRateLimitResponse{}
+	}
+	return resp, err
+}
@@ -46,6 +46,10 @@ var (
 	errInvalidRetention                                   = serviceerror.NewInvalidArgument("RetentionDays is invalid.")
 	errInvalidExecutionStartToCloseTimeoutSeconds         = serviceerror.NewInvalidArgument("A valid ExecutionStartToCloseTimeoutSeconds is not set on request.")
 	errInvalidTaskStartToCloseTimeoutSeconds              = serviceerror.NewInvalidArgument("A valid TaskStartToCloseTimeoutSeconds is not set on request.")
+	errInvalidStartDelay                                  = serviceerror.NewInvalidArgument("A valid start delay is not set on request.")
+	errInvalidCronSkipCount                               = serviceerror.NewInvalidArgument("Count of cron runs to skip must be positive.")
+	errInvalidActivityTimeoutSeconds                      = serviceerror.NewInvalidArgument("Activity timeouts cannot be negative.")
+	errActivityOptionsNotSet                              = serviceerror.NewInvalidArgument("None of the activity options is set on request.")
 	errQueryDisallowedForNamespace                        = serviceerror.NewInvalidArgument("Namespace is not allowed to query, please contact temporal team to re-enable queries.")
 	errClusterNameNotSet                                  = serviceerror.NewInvalidArgument("Cluster name is not set.")
 	errEmptyReplicationInfo                               = serviceerror.NewInvalidArgument("Replication task info is not set.")
@@ -54,9 +58,11 @@ var (
 	errWorkflowTypeTooLong                                = serviceerror.NewInvalidArgument("WorkflowType length exceeds limit.")
 	errWorkflowIDTooLong                                  = serviceerror.NewInvalidArgument("WorkflowId length exceeds limit.")
 	errSignalNameTooLong                                  = serviceerror.NewInvalidArgument("SignalName length exceeds limit.")
+	errSignalNameReserved                                 = serviceerror.NewInvalidArgument("SignalName uses a prefix reserved for system signals.")
 	errTaskListTooLong                                    = serviceerror.NewInvalidArgument("TaskList length exceeds limit.")
 	errRequestIDTooLong                                   = serviceerror.NewInvalidArgument("RequestId length exceeds limit.")
 	errIdentityTooLong                                    = serviceerror.NewInvalidArgument("Identity length exceeds limit.")
+	errWorkerBuildIDTooLong                               = serviceerror.NewInvalidArgument("Worker build ID length exceeds limit.")
 	errStartTimeFilterNotSet                              = serviceerror.NewInvalidArgument("StartTimeFilter is not set on request.")
 	errEarliestTimeIsGreaterThanLatestTime                = serviceerror.NewInvalidArgument("EarliestTime in StartTimeFilter should not be larger than LatestTime.")
 	errPageSizeTooBig                                     = serviceerror.NewInvalidArgument("PageSize is larger than allowed %d.")
@@ -65,6 +71,7 @@ var (
 	errNamespaceIsNotConfiguredForVisibilityArchival      = serviceerror.NewInvalidArgument("Namespace is not configured for visibility archival.")
 	errSearchAttributesNotSet                             = serviceerror.NewInvalidArgument("SearchAttributes are not set on request.")
 	errAdvancedVisibilityStoreIsNotConfigured             = serviceerror.NewInvalidArgument("AdvancedVisibilityStore is not configured for this cluster.")
+	errVisibilityQueryIsNotSupported                      = serviceerror.NewInvalidArgument("Visibility store of this cluster does not support queries.")
 	errKeyIsReservedBySystem                              = serviceerror.NewInvalidArgument("Key [%s] is reserved by system.")
 	errKeyIsAlreadyWhitelisted                            = serviceerror.NewInvalidArgument("Key [%s] is already whitelist.")
 	errInvalidPageSize                                    = serviceerror.NewInvalidArgument("Invalid PageSize.")
@@ -76,6 +83,36 @@ var (
 	errInvalidEventQueryRange                             = serviceerror.NewInvalidArgument("Invalid event query range.")
 	errUnknownValueType                                   = serviceerror.NewInvalidArgument("Unknown value type, %v.")
 	errDLQTypeIsNotSupported                              = serviceerror.NewInvalidArgument("The DLQ type is not supported.")
+	errBatchJobIDNotSet                                   = serviceerror.NewInvalidArgument("JobId is not set on request.")
+	errBatchReasonNotSet                                  = serviceerror.NewInvalidArgument("Reason is not set on request.")
+	errInvalidBatchType                                   = serviceerror.NewInvalidArgument("BatchType is not supported.")
+	errInvalidBatchOperationNamespace                     = serviceerror.NewInvalidArgument("Namespace name contains quotes or backslashes, batch operations are not supported.")
+	errWorkflowAttributesNotSet                           = serviceerror.NewInvalidArgument("Neither SearchAttributes nor Memo is set on request.")
+	errInvalidResetType                                   = serviceerror.NewInvalidArgument("ResetType is not supported.")
+	errResetBadBinaryChecksumNotSet                       = serviceerror.NewInvalidArgument("ResetBadBinaryChecksum is not set on request.")
+	errInvalidBatchRPSOrConcurrency                       = serviceerror.NewInvalidArgument("Rps and Concurrency cannot be negative.")
+	errInvalidNamespaceDeletionRPS                        = serviceerror.NewInvalidArgument("Rps cannot be negative.")
+	errCannotDeleteSystemNamespace                        = serviceerror.NewInvalidArgument("System namespace cannot be deleted.")
+	errCannotDeleteGlobalNamespace                        = serviceerror.NewInvalidArgument("Deleting global namespace is not supported.")
+	errCannotRenameSystemNamespace                        = serviceerror.NewInvalidArgument("System namespace cannot be renamed.")
+	errSourceNamespaceNotSet                              = serviceerror.NewInvalidArgument("SourceNamespace is not set on request.")
+	errTargetNamespaceNotSet                              = serviceerror.NewInvalidArgument("TargetNamespace is not set on request.")
+	errMigrationIDNotSet                                  = serviceerror.NewInvalidArgument("MigrationId is not set on request.")
+	errInvalidMigrationRPS                                = serviceerror.NewInvalidArgument("Rps cannot be negative.")
+	errUnknownSourceCluster                               = serviceerror.NewInvalidArgument("SourceCluster is unknown or disabled.")
+	errMigrationSameNamespace                             = serviceerror.NewInvalidArgument("Source and target namespace cannot be the same.")
+	errMigrationNamespaceNotGlobal                        = serviceerror.NewInvalidArgument("Workflow executions can only be migrated between global namespaces.")
+	errMigrationTargetNotActive                           = serviceerror.NewInvalidArgument("Target namespace is not active in the current cluster.")
+	errHistoryBatchesNotSet                               = serviceerror.NewInvalidArgument("HistoryBatches is not set on request.")
+	errEmptyHistoryBatch                                  = serviceerror.NewInvalidArgument("History batch has no events.")
+	errVersionHistoryItemsNotSet                          = serviceerror.NewInvalidArgument("VersionHistoryItems is not set on request.")
+	errInvalidFirstEventID                                = serviceerror.NewInvalidArgument("FirstEventId cannot be negative.")
+	errUnknownFailoverVersion                             = serviceerror.NewInvalidArgument("Version of the history events does not belong to any cluster.")
+	errUpdateNameNotSet                                   = serviceerror.NewInvalidArgument("Name is not set on request.")
+	errUpdateNameTooLong                                  = serviceerror.NewInvalidArgument("Name length exceeds limit.")
+	errUpdateIDTooLong                                    = serviceerror.NewInvalidArgument("UpdateId length exceeds limit.")
+	errUpdateInputTooLarge                                = serviceerror.NewInvalidArgument("Input size exceeds limit.")
+	errInvalidMaxTasksPerSecond                           = serviceerror.NewInvalidArgument("MaxTasksPerSecond cannot be negative.")
 	errShuttingDown                                       = serviceerror.NewInternal("Shutting down")
 
 	errFailedUpdateDynamicConfig = serviceerror.NewInternal("Failed to update dynamic config, err: %v.")
@@ -85,5 +122,10 @@ var (
 	errNoPermission = serviceerror.NewPermissionDenied("No permission to do this operation.")
 	errUnauthorized = serviceerror.NewPermissionDenied("Request unauthorized.")
 
-	errServiceBusy = serviceerror.NewResourceExhausted("Too many outstanding requests to the service.")
+	errBatchOperationNotFound    = serviceerror.NewNotFound("Batch operation not found.")
+	errNamespaceDeletionNotFound = serviceerror.NewNotFound("Namespace deletion not found.")
+	errMigrationNotFound         = serviceerror.NewNotFound("Migration not found.")
+
+	errServiceBusy                = serviceerror.NewResourceExhausted("Too many outstanding requests to the service.")
+	errTooManyConcurrentBatchJobs = serviceerror.NewResourceExhausted("Too many running batch operations in the namespace.")
 )
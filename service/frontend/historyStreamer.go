@@ -0,0 +1,372 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	eventpb "go.temporal.io/temporal-proto/event"
+	executionpb "go.temporal.io/temporal-proto/execution"
+
+	"github.com/temporalio/temporal/.gen/proto/historyservice"
+	"github.com/temporalio/temporal/common"
+	"github.com/temporalio/temporal/common/definition"
+	"github.com/temporalio/temporal/common/log"
+	"github.com/temporalio/temporal/common/log/tag"
+	"github.com/temporalio/temporal/common/persistence"
+	"github.com/temporalio/temporal/service/history"
+)
+
+const (
+	historyStreamPollTimeout   = time.Minute
+	historyStreamRetryInterval = time.Second
+	historyStreamPageSize      = 100
+)
+
+type (
+	// historyStreamer streams the committed history events of workflow executions. The subscribers of
+	// a run share one historyWatcher, which long polls the history service and reads each new range
+	// of events from the database once for all of them.
+	historyStreamer struct {
+		historyClient         historyservice.HistoryServiceClient
+		historyManager        persistence.HistoryManager
+		numberOfHistoryShards int
+		logger                log.Logger
+
+		sync.Mutex
+		watchers map[definition.WorkflowIdentifier]*historyWatcher
+	}
+
+	// historyWatcher publishes the updates of one run to its subscribers,
+	// its fields are protected by the lock of the streamer
+	historyWatcher struct {
+		identifier       definition.WorkflowIdentifier
+		cancel           context.CancelFunc
+		subscribers      map[int64]chan *historyUpdate
+		nextSubscriberID int64
+		lastUpdate       *historyUpdate
+	}
+
+	// historyUpdate contains the batches of the events from firstEventID to nextEventID exclusively
+	historyUpdate struct {
+		firstEventID int64
+		nextEventID  int64
+		batches      []*eventpb.History
+		closed       bool
+		err          error
+	}
+)
+
+func newHistoryStreamer(
+	historyClient historyservice.HistoryServiceClient,
+	historyManager persistence.HistoryManager,
+	numberOfHistoryShards int,
+	logger log.Logger,
+) *historyStreamer {
+
+	return &historyStreamer{
+		historyClient:         historyClient,
+		historyManager:        historyManager,
+		numberOfHistoryShards: numberOfHistoryShards,
+		logger:                logger,
+		watchers:              make(map[definition.WorkflowIdentifier]*historyWatcher),
+	}
+}
+
+// stream sends the history batches of the workflow execution from firstEventID on, filtered by the event
+// types if set, until the workflow execution is closed or the context is done
+func (s *historyStreamer) stream(
+	ctx context.Context,
+	namespaceID string,
+	execution *executionpb.WorkflowExecution,
+	firstEventID int64,
+	eventTypes []eventpb.EventType,
+	send func(events []*eventpb.HistoryEvent) error,
+) error {
+
+	response, err := s.historyClient.GetMutableState(ctx, &historyservice.GetMutableStateRequest{
+		NamespaceId: namespaceID,
+		Execution:   execution,
+	})
+	if err != nil {
+		return err
+	}
+	workflowID := execution.GetWorkflowId()
+	branchToken := response.GetCurrentBranchToken()
+	filter := make(map[eventpb.EventType]struct{}, len(eventTypes))
+	for _, eventType := range eventTypes {
+		filter[eventType] = struct{}{}
+	}
+
+	// history is read by batch, so the read position must be the first event of a batch
+	position := common.FirstEventID
+	if firstEventID >= response.GetLastFirstEventId() {
+		position = response.GetLastFirstEventId()
+	}
+	sendBatches := func(batches []*eventpb.History, nextEventID int64) error {
+		for _, batch := range batches {
+			var events []*eventpb.HistoryEvent
+			for _, event := range batch.GetEvents() {
+				if event.GetEventId() < position || event.GetEventId() < firstEventID || event.GetEventId() >= nextEventID {
+					continue
+				}
+				if _, ok := filter[event.GetEventType()]; len(filter) == 0 || ok {
+					events = append(events, event)
+				}
+			}
+			if len(events) != 0 {
+				if err := send(events); err != nil {
+					return err
+				}
+			}
+		}
+		if nextEventID > position {
+			position = nextEventID
+		}
+		return nil
+	}
+	readBatches := func(nextEventID int64) error {
+		if position >= nextEventID {
+			return nil
+		}
+		batches, err := s.readHistory(workflowID, branchToken, position, nextEventID)
+		if err != nil {
+			return err
+		}
+		return sendBatches(batches, nextEventID)
+	}
+
+	if err := readBatches(response.GetNextEventId()); err != nil {
+		return err
+	}
+	if response.GetWorkflowStatus() != executionpb.WorkflowExecutionStatus_Running {
+		return nil
+	}
+
+	identifier := definition.NewWorkflowIdentifier(namespaceID, workflowID, response.GetExecution().GetRunId())
+	watcher, subscriberID, updates := s.subscribe(identifier)
+	defer s.unsubscribe(watcher, subscriberID)
+	for {
+		select {
+		case update := <-updates:
+			if update.err != nil {
+				return update.err
+			}
+			// updates are dropped for slow subscribers, the missing events are read from the database
+			if err := readBatches(update.firstEventID); err != nil {
+				return err
+			}
+			if err := sendBatches(update.batches, update.nextEventID); err != nil {
+				return err
+			}
+			if update.closed {
+				return nil
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *historyStreamer) subscribe(
+	identifier definition.WorkflowIdentifier,
+) (*historyWatcher, int64, <-chan *historyUpdate) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	watcher, ok := s.watchers[identifier]
+	if !ok {
+		ctx, cancel := context.WithCancel(context.Background())
+		watcher = &historyWatcher{
+			identifier:  identifier,
+			cancel:      cancel,
+			subscribers: make(map[int64]chan *historyUpdate),
+		}
+		s.watchers[identifier] = watcher
+		go s.watch(ctx, watcher)
+	}
+
+	subscriberID := watcher.nextSubscriberID
+	watcher.nextSubscriberID++
+	updates := make(chan *historyUpdate, 1)
+	if watcher.lastUpdate != nil {
+		updates <- watcher.lastUpdate
+	}
+	watcher.subscribers[subscriberID] = updates
+	return watcher, subscriberID, updates
+}
+
+func (s *historyStreamer) unsubscribe(
+	watcher *historyWatcher,
+	subscriberID int64,
+) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	delete(watcher.subscribers, subscriberID)
+	if len(watcher.subscribers) == 0 {
+		s.removeWatcherLocked(watcher)
+	}
+}
+
+// publish sends the update to the subscribers, it returns true if the watcher is done
+func (s *historyStreamer) publish(
+	watcher *historyWatcher,
+	update *historyUpdate,
+) bool {
+
+	s.Lock()
+	defer s.Unlock()
+
+	watcher.lastUpdate = update
+	for _, updates := range watcher.subscribers {
+		select {
+		case updates <- update:
+		default:
+			// replace the update the subscriber has not received yet, only the publisher sends
+			// to the channel and it holds the lock, so the channel cannot be full again
+			select {
+			case <-updates:
+			default:
+			}
+			updates <- update
+		}
+	}
+	if update.closed || update.err != nil {
+		s.removeWatcherLocked(watcher)
+		return true
+	}
+	return false
+}
+
+func (s *historyStreamer) removeWatcherLocked(
+	watcher *historyWatcher,
+) {
+
+	if s.watchers[watcher.identifier] == watcher {
+		delete(s.watchers, watcher.identifier)
+	}
+	watcher.cancel()
+}
+
+// watch long polls the mutable state of the run and publishes the new events until the run is closed
+func (s *historyStreamer) watch(
+	ctx context.Context,
+	watcher *historyWatcher,
+) {
+
+	execution := &executionpb.WorkflowExecution{
+		WorkflowId: watcher.identifier.WorkflowID,
+		RunId:      watcher.identifier.RunID,
+	}
+	// the first poll returns the current state without waiting
+	expectedNextEventID := int64(0)
+	var branchToken []byte
+	for {
+		pollCtx, cancel := context.WithTimeout(ctx, historyStreamPollTimeout)
+		response, err := s.historyClient.PollMutableState(pollCtx, &historyservice.PollMutableStateRequest{
+			NamespaceId:         watcher.identifier.NamespaceID,
+			Execution:           execution,
+			ExpectedNextEventId: expectedNextEventID,
+			CurrentBranchToken:  branchToken,
+		})
+		cancel()
+		if ctx.Err() != nil {
+			// no subscriber left
+			return
+		}
+		if err != nil {
+			if common.IsWhitelistServiceTransientError(err) {
+				s.logger.Warn("Failed to poll mutable state for history stream.", tag.WorkflowID(execution.GetWorkflowId()), tag.Error(err))
+				select {
+				case <-time.After(historyStreamRetryInterval):
+					continue
+				case <-ctx.Done():
+					return
+				}
+			}
+			s.publish(watcher, &historyUpdate{err: err})
+			return
+		}
+
+		update := &historyUpdate{
+			firstEventID: expectedNextEventID,
+			nextEventID:  response.GetNextEventId(),
+			closed:       response.GetWorkflowStatus() != executionpb.WorkflowExecutionStatus_Running,
+		}
+		if branchToken == nil {
+			update.firstEventID = update.nextEventID
+		} else if update.firstEventID < update.nextEventID {
+			if update.batches, err = s.readHistory(execution.GetWorkflowId(), branchToken, update.firstEventID, update.nextEventID); err != nil {
+				s.publish(watcher, &historyUpdate{err: err})
+				return
+			}
+		} else if !update.closed {
+			// long poll expired without new events
+			continue
+		}
+		if s.publish(watcher, update) {
+			return
+		}
+		expectedNextEventID = response.GetNextEventId()
+		branchToken = response.GetCurrentBranchToken()
+	}
+}
+
+func (s *historyStreamer) readHistory(
+	workflowID string,
+	branchToken []byte,
+	firstEventID int64,
+	nextEventID int64,
+) ([]*eventpb.History, error) {
+
+	shardID := common.WorkflowIDToHistoryShard(workflowID, s.numberOfHistoryShards)
+	var batches []*eventpb.History
+	var token []byte
+	for {
+		_, page, nextToken, _, err := history.PaginateHistory(
+			s.historyManager,
+			true, // this means that we are getting history by batch
+			branchToken,
+			firstEventID,
+			nextEventID,
+			token,
+			historyStreamPageSize,
+			&shardID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		batches = append(batches, page...)
+		if len(nextToken) == 0 {
+			return batches, nil
+		}
+		token = nextToken
+	}
+}
@@ -0,0 +1,245 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	eventpb "go.temporal.io/temporal-proto/event"
+	executionpb "go.temporal.io/temporal-proto/execution"
+	"go.temporal.io/temporal-proto/serviceerror"
+	"google.golang.org/grpc"
+
+	"github.com/temporalio/temporal/.gen/proto/historyservice"
+	"github.com/temporalio/temporal/.gen/proto/historyservicemock"
+	"github.com/temporalio/temporal/common"
+	"github.com/temporalio/temporal/common/definition"
+	"github.com/temporalio/temporal/common/log/loggerimpl"
+	"github.com/temporalio/temporal/common/mocks"
+	"github.com/temporalio/temporal/common/persistence"
+)
+
+type (
+	historyStreamerSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller        *gomock.Controller
+		mockHistoryClient *historyservicemock.MockHistoryServiceClient
+		mockHistoryV2Mgr  *mocks.HistoryV2Manager
+
+		namespaceID string
+		execution   *executionpb.WorkflowExecution
+		branchToken []byte
+		streamer    *historyStreamer
+	}
+)
+
+func TestHistoryStreamerSuite(t *testing.T) {
+	s := new(historyStreamerSuite)
+	suite.Run(t, s)
+}
+
+func (s *historyStreamerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockHistoryClient = historyservicemock.NewMockHistoryServiceClient(s.controller)
+	s.mockHistoryV2Mgr = &mocks.HistoryV2Manager{}
+
+	s.namespaceID = uuid.New()
+	s.execution = &executionpb.WorkflowExecution{WorkflowId: "workflow-id", RunId: uuid.New()}
+	s.branchToken = []byte{1}
+	s.streamer = newHistoryStreamer(s.mockHistoryClient, s.mockHistoryV2Mgr, 1, loggerimpl.NewNopLogger())
+}
+
+func (s *historyStreamerSuite) TearDownTest() {
+	s.controller.Finish()
+	s.mockHistoryV2Mgr.AssertExpectations(s.T())
+}
+
+func (s *historyStreamerSuite) TestStream_ClosedWorkflow() {
+	s.expectGetMutableState(5, 4, executionpb.WorkflowExecutionStatus_Completed)
+	s.expectReadHistory(common.FirstEventID, 5, []*eventpb.History{
+		s.newBatch(1, 2),
+		s.newBatch(3),
+		s.newBatch(4),
+	})
+
+	var events []int64
+	err := s.streamer.stream(context.Background(), s.namespaceID, s.execution, 2, []eventpb.EventType{eventpb.EventType_ActivityTaskScheduled},
+		func(batch []*eventpb.HistoryEvent) error {
+			for _, event := range batch {
+				events = append(events, event.GetEventId())
+			}
+			return nil
+		})
+	s.NoError(err)
+	// event 1 is before the first event ID and event 3 is filtered out by its type
+	s.Equal([]int64{2, 4}, events)
+}
+
+func (s *historyStreamerSuite) TestStream_FromLastBatch() {
+	s.expectGetMutableState(5, 4, executionpb.WorkflowExecutionStatus_Completed)
+	s.expectReadHistory(4, 5, []*eventpb.History{s.newBatch(4)})
+
+	var batches [][]*eventpb.HistoryEvent
+	err := s.streamer.stream(context.Background(), s.namespaceID, s.execution, 4, nil,
+		func(batch []*eventpb.HistoryEvent) error {
+			batches = append(batches, batch)
+			return nil
+		})
+	s.NoError(err)
+	s.Len(batches, 1)
+}
+
+func (s *historyStreamerSuite) TestStream_RunningWorkflow() {
+	s.expectGetMutableState(3, 1, executionpb.WorkflowExecutionStatus_Running)
+	s.expectReadHistory(common.FirstEventID, 3, []*eventpb.History{s.newBatch(1, 2)})
+	s.expectReadHistory(3, 5, []*eventpb.History{s.newBatch(3, 4)})
+	gomock.InOrder(
+		s.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), s.newPollRequest(0, nil)).
+			Return(s.newPollResponse(3, executionpb.WorkflowExecutionStatus_Running), nil),
+		s.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), s.newPollRequest(3, s.branchToken)).
+			Return(s.newPollResponse(3, executionpb.WorkflowExecutionStatus_Running), nil),
+		s.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), s.newPollRequest(3, s.branchToken)).
+			Return(s.newPollResponse(5, executionpb.WorkflowExecutionStatus_Completed), nil),
+	)
+
+	var events []int64
+	err := s.streamer.stream(context.Background(), s.namespaceID, s.execution, common.FirstEventID, nil,
+		func(batch []*eventpb.HistoryEvent) error {
+			for _, event := range batch {
+				events = append(events, event.GetEventId())
+			}
+			return nil
+		})
+	s.NoError(err)
+	s.Equal([]int64{1, 2, 3, 4}, events)
+	s.Empty(s.streamer.watchers)
+}
+
+func (s *historyStreamerSuite) TestStream_PollFailed() {
+	s.expectGetMutableState(3, 1, executionpb.WorkflowExecutionStatus_Running)
+	s.expectReadHistory(common.FirstEventID, 3, []*eventpb.History{s.newBatch(1, 2)})
+	s.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), gomock.Any()).
+		Return(nil, serviceerror.NewCurrentBranchChanged("branch changed", []byte{2}))
+
+	err := s.streamer.stream(context.Background(), s.namespaceID, s.execution, common.FirstEventID, nil,
+		func(batch []*eventpb.HistoryEvent) error {
+			return nil
+		})
+	s.IsType(&serviceerror.CurrentBranchChanged{}, err)
+	s.Empty(s.streamer.watchers)
+}
+
+func (s *historyStreamerSuite) TestSubscribe_SharedWatcher() {
+	// the watcher polls until all its subscribers are gone
+	s.mockHistoryClient.EXPECT().PollMutableState(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ *historyservice.PollMutableStateRequest, _ ...grpc.CallOption) (*historyservice.PollMutableStateResponse, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}).AnyTimes()
+
+	identifier := definition.NewWorkflowIdentifier(s.namespaceID, s.execution.GetWorkflowId(), s.execution.GetRunId())
+	watcher1, subscriberID1, updates1 := s.streamer.subscribe(identifier)
+	watcher2, subscriberID2, updates2 := s.streamer.subscribe(identifier)
+	s.Equal(watcher1, watcher2)
+	s.Len(s.streamer.watchers, 1)
+
+	// the slow subscriber only gets the latest update
+	update1 := &historyUpdate{firstEventID: 1, nextEventID: 3}
+	update2 := &historyUpdate{firstEventID: 3, nextEventID: 5}
+	s.False(s.streamer.publish(watcher1, update1))
+	s.Equal(update1, <-updates1)
+	s.False(s.streamer.publish(watcher1, update2))
+	s.Equal(update2, <-updates1)
+	s.Equal(update2, <-updates2)
+
+	// a new subscriber gets the latest update
+	_, subscriberID3, updates3 := s.streamer.subscribe(identifier)
+	s.Equal(update2, <-updates3)
+
+	s.streamer.unsubscribe(watcher1, subscriberID1)
+	s.streamer.unsubscribe(watcher1, subscriberID2)
+	s.Len(s.streamer.watchers, 1)
+	s.streamer.unsubscribe(watcher1, subscriberID3)
+	s.Empty(s.streamer.watchers)
+}
+
+func (s *historyStreamerSuite) expectGetMutableState(nextEventID int64, lastFirstEventID int64, status executionpb.WorkflowExecutionStatus) {
+	s.mockHistoryClient.EXPECT().GetMutableState(gomock.Any(), &historyservice.GetMutableStateRequest{
+		NamespaceId: s.namespaceID,
+		Execution:   s.execution,
+	}).Return(&historyservice.GetMutableStateResponse{
+		Execution:          s.execution,
+		NextEventId:        nextEventID,
+		LastFirstEventId:   lastFirstEventID,
+		CurrentBranchToken: s.branchToken,
+		WorkflowStatus:     status,
+	}, nil)
+}
+
+func (s *historyStreamerSuite) expectReadHistory(firstEventID int64, nextEventID int64, batches []*eventpb.History) {
+	s.mockHistoryV2Mgr.On("ReadHistoryBranchByBatch", mock.MatchedBy(func(request *persistence.ReadHistoryBranchRequest) bool {
+		return request.MinEventID == firstEventID && request.MaxEventID == nextEventID
+	})).Return(&persistence.ReadHistoryBranchByBatchResponse{History: batches}, nil).Once()
+}
+
+func (s *historyStreamerSuite) newPollRequest(expectedNextEventID int64, branchToken []byte) *historyservice.PollMutableStateRequest {
+	return &historyservice.PollMutableStateRequest{
+		NamespaceId:         s.namespaceID,
+		Execution:           s.execution,
+		ExpectedNextEventId: expectedNextEventID,
+		CurrentBranchToken:  branchToken,
+	}
+}
+
+func (s *historyStreamerSuite) newPollResponse(nextEventID int64, status executionpb.WorkflowExecutionStatus) *historyservice.PollMutableStateResponse {
+	return &historyservice.PollMutableStateResponse{
+		Execution:          s.execution,
+		NextEventId:        nextEventID,
+		CurrentBranchToken: s.branchToken,
+		WorkflowStatus:     status,
+	}
+}
+
+func (s *historyStreamerSuite) newBatch(eventIDs ...int64) *eventpb.History {
+	batch := &eventpb.History{}
+	for _, eventID := range eventIDs {
+		eventType := eventpb.EventType_ActivityTaskScheduled
+		if eventID%2 == 1 {
+			eventType = eventpb.EventType_DecisionTaskScheduled
+		}
+		batch.Events = append(batch.Events, &eventpb.HistoryEvent{EventId: eventID, EventType: eventType})
+	}
+	return batch
+}
@@ -102,6 +102,9 @@ type Config struct {
 	VisibilityArchivalQueryMaxPageSize dynamicconfig.IntPropertyFn
 
 	SendRawWorkflowHistory dynamicconfig.BoolPropertyFnWithNamespaceFilter
+
+	// MaxConcurrentBatchOperation is the max number of running batch operations of a namespace
+	MaxConcurrentBatchOperation dynamicconfig.IntPropertyFnWithNamespaceFilter
 }
 
 // NewConfig returns new service config with default values
@@ -141,6 +144,7 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableReadFro
 		VisibilityArchivalQueryMaxPageSize:     dc.GetIntProperty(dynamicconfig.VisibilityArchivalQueryMaxPageSize, 10000),
 		DisallowQuery:                          dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.DisallowQuery, false),
 		SendRawWorkflowHistory:                 dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.SendRawWorkflowHistory, false),
+		MaxConcurrentBatchOperation:            dc.GetIntPropertyFilteredByNamespace(dynamicconfig.FrontendMaxConcurrentBatchOperation, 1),
 	}
 }
 
@@ -187,8 +191,12 @@ func NewService(
 				ESIndexMaxResultWindow: serviceConfig.ESIndexMaxResultWindow,
 				ValidSearchAttributes:  serviceConfig.ValidSearchAttributes,
 			}
+			serializer, err := persistence.NewPayloadSerializerWithEncryption(params.PersistenceConfig.Encryption)
+			if err != nil {
+				logger.Fatal("Creating payload serializer failed", tag.Error(err))
+			}
 			visibilityFromES = espersistence.NewESVisibilityManager(visibilityIndexName, params.ESClient, visibilityConfigForES,
-				nil, params.MetricsClient, logger)
+				nil, serializer, params.MetricsClient, logger)
 		}
 		return persistence.NewVisibilityManagerWrapper(
 			visibilityFromDB,
@@ -246,7 +254,8 @@ func (s *Service) Start() {
 		replicationMessageSink.(*mocks.KafkaProducer).On("Publish", mock.Anything).Return(nil)
 	}
 
-	s.server = grpc.NewServer(grpc.UnaryInterceptor(interceptor))
+	opts := append(s.GetGRPCServerOptions(), grpc.UnaryInterceptor(interceptor))
+	s.server = grpc.NewServer(opts...)
 
 	wfHandler := NewWorkflowHandler(s, s.config, replicationMessageSink)
 	s.handler = NewDCRedirectionHandler(wfHandler, s.params.DCRedirectionPolicy)
@@ -258,8 +267,12 @@ func (s *Service) Start() {
 	workflowservice.RegisterWorkflowServiceServer(s.server, workflowNilCheckHandler)
 	healthpb.RegisterHealthServer(s.server, s.handler)
 
-	s.adminHandler = NewAdminHandler(s, s.params, s.config)
-	adminNilCheckHandler := NewAdminNilCheckHandler(s.adminHandler)
+	s.adminHandler = NewAdminHandler(s, s.params, s.config, wfHandler.namespaceHandler)
+	var adminHandler adminservice.AdminServiceServer = s.adminHandler
+	if s.params.Authorizer != nil {
+		adminHandler = NewAccessControlledAdminHandler(adminHandler, s.params.Authorizer, s.GetMetricsClient())
+	}
+	adminNilCheckHandler := NewAdminNilCheckHandler(adminHandler)
 
 	adminservice.RegisterAdminServiceServer(s.server, adminNilCheckHandler)
 
@@ -27,6 +27,8 @@ package frontend
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -418,6 +420,16 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 		return nil, wh.error(err, scope)
 	}
 
+	startDelaySeconds, err := getStartDelaySeconds(ctx)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	priority, err := getPriority(ctx)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	wh.GetLogger().Debug(
 		"Received StartWorkflowExecution",
 		tag.WorkflowID(request.GetWorkflowId()))
@@ -484,7 +496,9 @@ func (wh *WorkflowHandler) StartWorkflowExecution(ctx context.Context, request *
 	}
 
 	wh.GetLogger().Debug("Start workflow execution request namespaceID", tag.WorkflowNamespaceID(namespaceID))
-	resp, err := wh.GetHistoryClient().StartWorkflowExecution(ctx, common.CreateHistoryStartWorkflowRequest(namespaceID, request))
+	histRequest := common.CreateHistoryStartWorkflowRequest(namespaceID, request, startDelaySeconds)
+	histRequest.Priority = priority
+	resp, err := wh.GetHistoryClient().StartWorkflowExecution(ctx, histRequest)
 
 	if err != nil {
 		return nil, wh.error(err, scope)
@@ -790,6 +804,11 @@ func (wh *WorkflowHandler) PollForDecisionTask(ctx context.Context, request *wor
 		return nil, wh.error(errIdentityTooLong, scope, tagsForErrorLog...)
 	}
 
+	workerBuildID := getWorkerBuildID(ctx)
+	if len(workerBuildID) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errWorkerBuildIDTooLong, scope, tagsForErrorLog...)
+	}
+
 	if err := wh.validateTaskList(request.TaskList, scope); err != nil {
 		return nil, err
 	}
@@ -811,16 +830,17 @@ func (wh *WorkflowHandler) PollForDecisionTask(ctx context.Context, request *wor
 	op := func() error {
 		var err error
 		matchingResp, err = wh.GetMatchingClient().PollForDecisionTask(ctx, &matchingservice.PollForDecisionTaskRequest{
-			NamespaceId: namespaceID,
-			PollerId:    pollerID,
-			PollRequest: request,
+			NamespaceId:   namespaceID,
+			PollerId:      pollerID,
+			PollRequest:   request,
+			WorkerBuildId: workerBuildID,
 		})
 		return err
 	}
 
 	err = backoff.Retry(op, frontendServiceRetryPolicy, common.IsServiceTransientError)
 	if err != nil {
-		err = wh.cancelOutstandingPoll(ctx, err, namespaceID, persistence.TaskListTypeDecision, request.TaskList, pollerID)
+		err = wh.cancelOutstandingPoll(ctx, err, namespaceID, persistence.TaskListTypeDecision, request.TaskList, pollerID, workerBuildID)
 		if err != nil {
 			// For all other errors log an error and return it back to client.
 			ctxTimeout := "not-set"
@@ -897,9 +917,15 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(ctx context.Context, req
 		return nil, errShuttingDown
 	}
 
+	workerBuildID := getWorkerBuildID(ctx)
+	if len(workerBuildID) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errWorkerBuildIDTooLong, scope)
+	}
+
 	histResp, err := wh.GetHistoryClient().RespondDecisionTaskCompleted(ctx, &historyservice.RespondDecisionTaskCompletedRequest{
 		NamespaceId:     namespaceId,
-		CompleteRequest: request},
+		CompleteRequest: request,
+		WorkerBuildId:   workerBuildID},
 	)
 	if err != nil {
 		return nil, wh.error(err, scope)
@@ -1060,6 +1086,11 @@ func (wh *WorkflowHandler) PollForActivityTask(ctx context.Context, request *wor
 		return nil, wh.error(errIdentityTooLong, scope)
 	}
 
+	workerBuildID := getWorkerBuildID(ctx)
+	if len(workerBuildID) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errWorkerBuildIDTooLong, scope)
+	}
+
 	namespaceID, err := wh.GetNamespaceCache().GetNamespaceID(request.GetNamespace())
 	if err != nil {
 		return nil, wh.error(err, scope)
@@ -1070,16 +1101,17 @@ func (wh *WorkflowHandler) PollForActivityTask(ctx context.Context, request *wor
 	op := func() error {
 		var err error
 		matchingResponse, err = wh.GetMatchingClient().PollForActivityTask(ctx, &matchingservice.PollForActivityTaskRequest{
-			NamespaceId: namespaceID,
-			PollerId:    pollerID,
-			PollRequest: request,
+			NamespaceId:   namespaceID,
+			PollerId:      pollerID,
+			PollRequest:   request,
+			WorkerBuildId: workerBuildID,
 		})
 		return err
 	}
 
 	err = backoff.Retry(op, frontendServiceRetryPolicy, common.IsServiceTransientError)
 	if err != nil {
-		err = wh.cancelOutstandingPoll(ctx, err, namespaceID, persistence.TaskListTypeActivity, request.TaskList, pollerID)
+		err = wh.cancelOutstandingPoll(ctx, err, namespaceID, persistence.TaskListTypeActivity, request.TaskList, pollerID, workerBuildID)
 		if err != nil {
 			// For all other errors log an error and return it back to client.
 			ctxTimeout := "not-set"
@@ -2012,6 +2044,10 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx context.Context, request
 		return nil, wh.error(errSignalNameTooLong, scope)
 	}
 
+	if strings.HasPrefix(request.GetSignalName(), common.SystemSignalNamePrefix) {
+		return nil, wh.error(errSignalNameReserved, scope)
+	}
+
 	if len(request.GetRequestId()) > wh.config.MaxIDLengthLimit() {
 		return nil, wh.error(errRequestIDTooLong, scope)
 	}
@@ -2100,6 +2136,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(errSignalNameTooLong, scope)
 	}
 
+	if strings.HasPrefix(request.GetSignalName(), common.SystemSignalNamePrefix) {
+		return nil, wh.error(errSignalNameReserved, scope)
+	}
+
 	if request.WorkflowType == nil || request.WorkflowType.GetName() == "" {
 		return nil, wh.error(errWorkflowTypeNotSet, scope)
 	}
@@ -2132,6 +2172,16 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		return nil, wh.error(err, scope)
 	}
 
+	startDelaySeconds, err := getStartDelaySeconds(ctx)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	priority, err := getPriority(ctx)
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	if err := wh.searchAttributesValidator.ValidateSearchAttributes(request.SearchAttributes, namespace); err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2177,6 +2227,8 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Context,
 		resp, err := wh.GetHistoryClient().SignalWithStartWorkflowExecution(ctx, &historyservice.SignalWithStartWorkflowExecutionRequest{
 			NamespaceId:            namespaceID,
 			SignalWithStartRequest: request,
+			StartDelaySeconds:      startDelaySeconds,
+			Priority:               priority,
 		})
 		runId = resp.GetRunId()
 		return err
@@ -3654,16 +3706,17 @@ func (wh *WorkflowHandler) checkPermission(
 }
 
 func (wh *WorkflowHandler) cancelOutstandingPoll(ctx context.Context, err error, namespaceID string, taskListType int32,
-	taskList *tasklistpb.TaskList, pollerID string) error {
+	taskList *tasklistpb.TaskList, pollerID string, workerBuildID string) error {
 	// First check if this err is due to context cancellation.  This means client connection to frontend is closed.
 	if ctx.Err() == context.Canceled {
 		// Our rpc stack does not propagates context cancellation to the other service.  Lets make an explicit
 		// call to matching to notify this poller is gone to prevent any tasks being dispatched to zombie pollers.
 		_, err = wh.GetMatchingClient().CancelOutstandingPoll(context.Background(), &matchingservice.CancelOutstandingPollRequest{
-			NamespaceId:  namespaceID,
-			TaskListType: taskListType,
-			TaskList:     taskList,
-			PollerId:     pollerID,
+			NamespaceId:   namespaceID,
+			TaskListType:  taskListType,
+			TaskList:      taskList,
+			PollerId:      pollerID,
+			WorkerBuildId: workerBuildID,
 		})
 		// We can not do much if this call fails.  Just log the error and move on
 		if err != nil {
@@ -3690,6 +3743,33 @@ func (wh *WorkflowHandler) checkBadBinary(namespaceEntry *cache.NamespaceCacheEn
 	return nil
 }
 
+// getStartDelaySeconds returns the start delay requested through the gRPC metadata header, 0 if it is not set.
+func getStartDelaySeconds(ctx context.Context) (int32, error) {
+	value := headers.GetValues(ctx, headers.StartDelayHeaderName)[0]
+	if value == "" {
+		return 0, nil
+	}
+	delaySeconds, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || delaySeconds < 0 {
+		return 0, errInvalidStartDelay
+	}
+	return int32(delaySeconds), nil
+}
+
+// getPriority returns the priority requested through the gRPC metadata header, unspecified if it is not set.
+func getPriority(ctx context.Context) (int32, error) {
+	value := headers.GetValues(ctx, headers.PriorityHeaderName)[0]
+	if value == "" {
+		return common.TaskPriorityUnspecified, nil
+	}
+	return common.ParseTaskPriority(value)
+}
+
+// getWorkerBuildID returns the worker build ID reported through the gRPC metadata header, empty if it is not set.
+func getWorkerBuildID(ctx context.Context) string {
+	return headers.GetValues(ctx, headers.WorkerBuildIDHeaderName)[0]
+}
+
 func (hs HealthStatus) String() string {
 	switch hs {
 	case HealthStatusOK:
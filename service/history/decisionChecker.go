@@ -226,6 +226,12 @@ func (v *decisionAttrValidator) validateActivityScheduleAttributes(
 		return err
 	}
 
+	if value, ok := attributes.GetHeader().GetFields()[common.ActivityPriorityHeaderField]; ok {
+		if _, err := common.ParseTaskPriority(strings.Trim(string(value), `"`)); err != nil {
+			return err
+		}
+	}
+
 	if len(attributes.GetActivityId()) > v.maxIDLengthLimit {
 		return serviceerror.NewInvalidArgument("ActivityID exceeds length limit.")
 	}
@@ -473,6 +479,9 @@ func (v *decisionAttrValidator) validateSignalExternalWorkflowExecutionAttribute
 	if attributes.GetSignalName() == "" {
 		return serviceerror.NewInvalidArgument("SignalName is not set on decision.")
 	}
+	if strings.HasPrefix(attributes.GetSignalName(), common.SystemSignalNamePrefix) {
+		return serviceerror.NewInvalidArgument("SignalName uses a prefix reserved for system signals.")
+	}
 
 	return nil
 }
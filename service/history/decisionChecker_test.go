@@ -131,6 +131,10 @@ func (s *decisionAttrValidatorSuite) TestValidateSignalExternalWorkflowExecution
 	s.EqualError(err, "Invalid RunId set on decision.")
 	attributes.Execution.RunId = testRunID
 
+	attributes.SignalName = "__temporal_pause"
+	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(s.testNamespaceID, s.testTargetNamespaceID, attributes)
+	s.EqualError(err, "SignalName uses a prefix reserved for system signals.")
+
 	attributes.SignalName = "my signal name"
 	err = s.validator.validateSignalExternalWorkflowExecutionAttributes(s.testNamespaceID, s.testTargetNamespaceID, attributes)
 	s.NoError(err)
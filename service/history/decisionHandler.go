@@ -172,6 +172,10 @@ func (handler *decisionHandlerImpl) handleDecisionTaskStarted(
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return nil, ErrWorkflowCompleted
 			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				// the decision task is dispatched again when the workflow is unpaused
+				return nil, ErrWorkflowPaused
+			}
 
 			decision, isRunning := mutableState.GetDecisionInfo(scheduleID)
 
@@ -375,6 +379,9 @@ Update_History_Loop:
 			continueAsNewBuilder        mutableState
 
 			hasUnhandledEvents bool
+
+			updateRegistry = msBuilder.GetUpdateRegistry()
+			updateResults  map[string]*eventgenpb.WorkflowUpdateResult
 		)
 		hasUnhandledEvents = msBuilder.HasBufferedEvents()
 
@@ -390,6 +397,10 @@ Update_History_Loop:
 		executionInfo.ClientLibraryVersion = clientLibVersion
 		executionInfo.ClientFeatureVersion = clientFeatureVersion
 		executionInfo.ClientImpl = clientImpl
+		// subsequent decision tasks are routed to workers compatible with the one that completed this one
+		if req.GetWorkerBuildId() != "" {
+			executionInfo.WorkerBuildID = req.GetWorkerBuildId()
+		}
 
 		binChecksum := request.GetBinaryChecksum()
 		if _, ok := namespaceEntry.GetConfig().GetBadBinaries().GetBinaries()[binChecksum]; ok {
@@ -414,6 +425,16 @@ Update_History_Loop:
 				handler.throttledLogger,
 			)
 
+			updateResults, err = handler.recordUpdateResults(
+				msBuilder,
+				completedEvent.GetEventId(),
+				req.GetCompleteRequest().GetQueryResults(),
+				namespaceEntry,
+			)
+			if err != nil {
+				return nil, err
+			}
+
 			decisionTaskHandler := newDecisionTaskHandler(
 				request.GetIdentity(),
 				completedEvent.GetEventId(),
@@ -459,8 +480,12 @@ Update_History_Loop:
 			}
 			hasUnhandledEvents = true
 			continueAsNewBuilder = nil
+			updateResults = nil
 		}
 
+		// updates received while the decision task was running are delivered on a new decision task
+		hasUnhandledEvents = hasUnhandledEvents || updateRegistry.hasBufferedUpdate()
+
 		createNewDecisionTask := msBuilder.IsWorkflowExecutionRunning() && (hasUnhandledEvents || request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled)
 		var newDecisionTaskScheduledID int64
 		if createNewDecisionTask {
@@ -556,6 +581,19 @@ Update_History_Loop:
 
 		handler.handleBufferedQueries(msBuilder, req.GetCompleteRequest().GetQueryResults(), createNewDecisionTask, namespaceEntry, decisionHeartbeating)
 
+		switch {
+		case failDecision != nil:
+			// the decision task failure reloaded the mutable state, which dropped all the updates of the previous one
+			handler.handleUpdates(updateRegistry, nil, ErrUpdateNotHandled, true, namespaceEntry, msBuilder)
+		case !msBuilder.IsWorkflowExecutionRunning():
+			handler.handleUpdates(updateRegistry, updateResults, ErrWorkflowCompleted, true, namespaceEntry, msBuilder)
+		case decisionHeartbeating:
+			// updates delivered on a heartbeating decision task are delivered again on the next one
+			handler.handleUpdates(updateRegistry, updateResults, nil, false, namespaceEntry, msBuilder)
+		default:
+			handler.handleUpdates(updateRegistry, updateResults, ErrUpdateNotHandled, false, namespaceEntry, msBuilder)
+		}
+
 		if decisionHeartbeatTimeout {
 			// at this point, update is successful, but we still return an error to client so that the worker will give up this workflow
 			return nil, serviceerror.NewNotFound(fmt.Sprintf("decision heartbeat timeout"))
@@ -630,6 +668,25 @@ func (handler *decisionHandlerImpl) createRecordDecisionTaskStartedResponse(
 		}
 		queries[id] = input
 	}
+
+	// updates are delivered as queries with a reserved query type, updates delivered on a previous
+	// decision task which did not complete are delivered again
+	ur := msBuilder.GetUpdateRegistry()
+	for _, id := range ur.getBufferedIDs() {
+		if err := ur.deliverUpdate(id); err != nil {
+			continue
+		}
+	}
+	for _, id := range ur.getDeliveredIDs() {
+		input, err := ur.getUpdateInput(id)
+		if err != nil {
+			continue
+		}
+		queries[id] = &querypb.WorkflowQuery{
+			QueryType: common.UpdateQueryTypePrefix + input.GetName(),
+			QueryArgs: input.GetInput(),
+		}
+	}
 	response.Queries = queries
 	return response, nil
 }
@@ -731,3 +788,122 @@ func (handler *decisionHandlerImpl) handleBufferedQueries(msBuilder mutableState
 		}
 	}
 }
+
+// recordUpdateResults records the results of the updates answered by the decision task in the mutable state,
+// the results are persisted and replicated with the decision task completion and complete the updates once it
+// is persisted. Updates are handled by the workflow as queries, they are not recorded in the history so
+// replaying workers do not see events the SDK does not know about.
+func (handler *decisionHandlerImpl) recordUpdateResults(
+	msBuilder mutableState,
+	decisionCompletedEventID int64,
+	queryResults map[string]*querypb.WorkflowQueryResult,
+	namespaceEntry *cache.NamespaceCacheEntry,
+) (map[string]*eventgenpb.WorkflowUpdateResult, error) {
+
+	updateRegistry := msBuilder.GetUpdateRegistry()
+	if !updateRegistry.hasDeliveredUpdate() {
+		return nil, nil
+	}
+
+	namespaceID := primitives.UUIDString(namespaceEntry.GetInfo().Id)
+	namespace := namespaceEntry.GetInfo().Name
+	workflowID := msBuilder.GetExecutionInfo().WorkflowID
+	runID := msBuilder.GetExecutionInfo().RunID
+
+	scope := handler.metricsClient.Scope(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		metrics.NamespaceTag(namespace),
+		metrics.DecisionTypeTag("Update"))
+
+	sizeLimitError := handler.config.BlobSizeLimitError(namespace)
+	sizeLimitWarn := handler.config.BlobSizeLimitWarn(namespace)
+
+	updateResults := make(map[string]*eventgenpb.WorkflowUpdateResult)
+	for _, id := range updateRegistry.getDeliveredIDs() {
+		queryResult, ok := queryResults[id]
+		if !ok {
+			continue
+		}
+
+		result := &eventgenpb.WorkflowUpdateResult{
+			UpdateId: id,
+		}
+		if queryResult.GetResultType() != querypb.QueryResultType_Answered {
+			result.FailureMessage = queryResult.GetErrorMessage()
+		} else if err := common.CheckEventBlobSizeLimit(
+			len(queryResult.GetAnswer()),
+			sizeLimitWarn,
+			sizeLimitError,
+			namespaceID,
+			workflowID,
+			runID,
+			scope,
+			handler.throttledLogger,
+			tag.BlobSizeViolationOperation("Update"),
+		); err != nil {
+			result.FailureMessage = err.Error()
+		} else {
+			result.Result = queryResult.GetAnswer()
+		}
+		if err := msBuilder.AddUpdateResult(decisionCompletedEventID, result); err != nil {
+			return nil, err
+		}
+		updateResults[id] = result
+	}
+	return updateResults, nil
+}
+
+// handleUpdates completes the updates with recorded results, the other delivered updates and optionally
+// the buffered ones are failed with the given failure if it is set
+func (handler *decisionHandlerImpl) handleUpdates(
+	updateRegistry updateRegistry,
+	updateResults map[string]*eventgenpb.WorkflowUpdateResult,
+	failure error,
+	failBuffered bool,
+	namespaceEntry *cache.NamespaceCacheEntry,
+	msBuilder mutableState,
+) {
+
+	namespace := namespaceEntry.GetInfo().Name
+	workflowID := msBuilder.GetExecutionInfo().WorkflowID
+	runID := msBuilder.GetExecutionInfo().RunID
+
+	scope := handler.metricsClient.Scope(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		metrics.NamespaceTag(namespace),
+		metrics.DecisionTypeTag("Update"))
+
+	setTerminationState := func(id string, terminationState *updateTerminationState) {
+		if err := updateRegistry.setTerminationState(id, terminationState); err != nil {
+			handler.logger.Error(
+				"failed to set update termination state",
+				tag.WorkflowNamespace(namespace),
+				tag.WorkflowID(workflowID),
+				tag.WorkflowRunID(runID),
+				tag.UpdateID(id),
+				tag.Error(err))
+			scope.IncCounter(metrics.UpdateRegistryInvalidStateCount)
+		}
+	}
+
+	for id, result := range updateResults {
+		setTerminationState(id, &updateTerminationState{
+			updateTerminationType: updateTerminationTypeCompleted,
+			updateResult:          result,
+		})
+	}
+	if failure == nil {
+		return
+	}
+
+	ids := updateRegistry.getDeliveredIDs()
+	if failBuffered {
+		ids = append(ids, updateRegistry.getBufferedIDs()...)
+	}
+	for _, id := range ids {
+		setTerminationState(id, &updateTerminationState{
+			updateTerminationType: updateTerminationTypeFailed,
+			failure:               failure,
+		})
+	}
+}
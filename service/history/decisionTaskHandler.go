@@ -558,6 +558,29 @@ func (handler *decisionTaskHandlerImpl) handleDecisionCancelWorkflow(
 		return nil
 	}
 
+	// a cron run canceled by the cancel_previous overlap policy continues with the next run
+	if handler.mutableState.GetExecutionInfo().CancelRequestID == cronOverlapCancelRequestID {
+		cronBackoff, err := handler.mutableState.GetCronBackoffDuration()
+		if err != nil {
+			handler.stopProcessing = true
+			return err
+		}
+		if cronBackoff != backoff.NoBackoff {
+			startEvent, err := handler.mutableState.GetStartEvent()
+			if err != nil {
+				return err
+			}
+			return handler.retryCronContinueAsNew(
+				startEvent.GetWorkflowExecutionStartedEventAttributes(),
+				int32(cronBackoff.Seconds()),
+				commonpb.ContinueAsNewInitiator_CronSchedule,
+				cronOverlapCancelCause,
+				attr.Details,
+				nil,
+			)
+		}
+	}
+
 	_, err := handler.mutableState.AddWorkflowExecutionCanceledEvent(handler.decisionTaskCompletedID, attr)
 	return err
 }
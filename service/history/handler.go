@@ -1763,6 +1763,340 @@ func (h *Handler) RefreshWorkflowTasks(ctx context.Context, request *historyserv
 	return &historyservice.RefreshWorkflowTasksResponse{}, nil
 }
 
+// PauseWorkflowExecution pauses a workflow execution
+func (h *Handler) PauseWorkflowExecution(ctx context.Context, request *historyservice.PauseWorkflowExecutionRequest) (_ *historyservice.PauseWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanicGRPC(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryPauseWorkflowExecutionScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	if namespaceID == "" {
+		return nil, h.error(errNamespaceNotSet, scope, namespaceID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, namespaceID, "")
+	}
+
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, namespaceID, workflowID)
+	}
+
+	err2 := engine.PauseWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, namespaceID, workflowID)
+	}
+
+	return &historyservice.PauseWorkflowExecutionResponse{}, nil
+}
+
+// UnpauseWorkflowExecution unpauses a workflow execution
+func (h *Handler) UnpauseWorkflowExecution(ctx context.Context, request *historyservice.UnpauseWorkflowExecutionRequest) (_ *historyservice.UnpauseWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanicGRPC(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryUnpauseWorkflowExecutionScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	if namespaceID == "" {
+		return nil, h.error(errNamespaceNotSet, scope, namespaceID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, namespaceID, "")
+	}
+
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, namespaceID, workflowID)
+	}
+
+	err2 := engine.UnpauseWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, namespaceID, workflowID)
+	}
+
+	return &historyservice.UnpauseWorkflowExecutionResponse{}, nil
+}
+
+// TriggerCronRun starts the pending run of a cron workflow right away
+func (h *Handler) TriggerCronRun(ctx context.Context, request *historyservice.TriggerCronRunRequest) (_ *historyservice.TriggerCronRunResponse, retError error) {
+	defer log.CapturePanicGRPC(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryTriggerCronRunScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	if namespaceID == "" {
+		return nil, h.error(errNamespaceNotSet, scope, namespaceID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, namespaceID, "")
+	}
+
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, namespaceID, workflowID)
+	}
+
+	err2 := engine.TriggerCronRun(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, namespaceID, workflowID)
+	}
+
+	return &historyservice.TriggerCronRunResponse{}, nil
+}
+
+// SkipCronRuns skips a number of upcoming runs of a cron workflow
+func (h *Handler) SkipCronRuns(ctx context.Context, request *historyservice.SkipCronRunsRequest) (_ *historyservice.SkipCronRunsResponse, retError error) {
+	defer log.CapturePanicGRPC(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistorySkipCronRunsScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	if namespaceID == "" {
+		return nil, h.error(errNamespaceNotSet, scope, namespaceID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, namespaceID, "")
+	}
+
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, namespaceID, workflowID)
+	}
+
+	resp, err2 := engine.SkipCronRuns(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, namespaceID, workflowID)
+	}
+
+	return resp, nil
+}
+
+// UpdateActivityOptions changes the timeouts, task list or retry policy of a pending activity
+func (h *Handler) UpdateActivityOptions(ctx context.Context, request *historyservice.UpdateActivityOptionsRequest) (_ *historyservice.UpdateActivityOptionsResponse, retError error) {
+	defer log.CapturePanicGRPC(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryUpdateActivityOptionsScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	if namespaceID == "" {
+		return nil, h.error(errNamespaceNotSet, scope, namespaceID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, namespaceID, "")
+	}
+
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, namespaceID, workflowID)
+	}
+
+	err2 := engine.UpdateActivityOptions(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, namespaceID, workflowID)
+	}
+
+	return &historyservice.UpdateActivityOptionsResponse{}, nil
+}
+
+// ResetActivity retries an activity waiting for its retry backoff immediately and optionally resets its attempts
+func (h *Handler) ResetActivity(ctx context.Context, request *historyservice.ResetActivityRequest) (_ *historyservice.ResetActivityResponse, retError error) {
+	defer log.CapturePanicGRPC(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryResetActivityScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	if namespaceID == "" {
+		return nil, h.error(errNamespaceNotSet, scope, namespaceID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, namespaceID, "")
+	}
+
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, namespaceID, workflowID)
+	}
+
+	err2 := engine.ResetActivity(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, namespaceID, workflowID)
+	}
+
+	return &historyservice.ResetActivityResponse{}, nil
+}
+
+// DeleteWorkflowExecution deletes a workflow execution along with all its data, terminating it first if it is running
+func (h *Handler) DeleteWorkflowExecution(ctx context.Context, request *historyservice.DeleteWorkflowExecutionRequest) (_ *historyservice.DeleteWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanicGRPC(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDeleteWorkflowExecutionScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	if namespaceID == "" {
+		return nil, h.error(errNamespaceNotSet, scope, namespaceID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, namespaceID, "")
+	}
+
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, namespaceID, workflowID)
+	}
+
+	err2 := engine.DeleteWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, namespaceID, workflowID)
+	}
+
+	return &historyservice.DeleteWorkflowExecutionResponse{}, nil
+}
+
+// UpsertWorkflowAttributes merges search attributes and memo into a workflow execution, or into the visibility record
+// of a closed one
+func (h *Handler) UpsertWorkflowAttributes(ctx context.Context, request *historyservice.UpsertWorkflowAttributesRequest) (_ *historyservice.UpsertWorkflowAttributesResponse, retError error) {
+	defer log.CapturePanicGRPC(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryUpsertWorkflowAttributesScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	if namespaceID == "" {
+		return nil, h.error(errNamespaceNotSet, scope, namespaceID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, namespaceID, "")
+	}
+
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, namespaceID, workflowID)
+	}
+
+	err2 := engine.UpsertWorkflowAttributes(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, namespaceID, workflowID)
+	}
+
+	return &historyservice.UpsertWorkflowAttributesResponse{}, nil
+}
+
+// UpdateWorkflowExecution delivers an update to a running workflow on a decision task and waits for its result
+func (h *Handler) UpdateWorkflowExecution(ctx context.Context, request *historyservice.UpdateWorkflowExecutionRequest) (_ *historyservice.UpdateWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanicGRPC(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryUpdateWorkflowExecutionScope
+	h.GetMetricsClient().IncCounter(scope, metrics.ServiceRequests)
+	sw := h.GetMetricsClient().StartTimer(scope, metrics.ServiceLatency)
+	defer sw.Stop()
+
+	if h.isShuttingDown() {
+		return nil, errShuttingDown
+	}
+
+	namespaceID := request.GetNamespaceId()
+	if namespaceID == "" {
+		return nil, h.error(errNamespaceNotSet, scope, namespaceID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, namespaceID, "")
+	}
+
+	workflowID := request.GetRequest().GetExecution().GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, namespaceID, workflowID)
+	}
+
+	resp, err2 := engine.UpdateWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return nil, h.error(err2, scope, namespaceID, workflowID)
+	}
+
+	return resp, nil
+}
+
 // convertError is a helper method to convert ShardOwnershipLostError from persistence layer returned by various
 // HistoryEngine API calls to ShardOwnershipLost error return by HistoryService for client to be redirected to the
 // correct shard.
@@ -36,6 +36,8 @@ import (
 
 	"github.com/gogo/protobuf/types"
 	"github.com/pborman/uuid"
+	"github.com/temporalio/temporal/.gen/proto/adminservice"
+	eventgenpb "github.com/temporalio/temporal/.gen/proto/event"
 	executiongenpb "github.com/temporalio/temporal/.gen/proto/execution"
 	"github.com/temporalio/temporal/.gen/proto/historyservice"
 	"github.com/temporalio/temporal/.gen/proto/matchingservice"
@@ -43,6 +45,7 @@ import (
 	"github.com/temporalio/temporal/client/history"
 	"github.com/temporalio/temporal/client/matching"
 	"github.com/temporalio/temporal/common"
+	"github.com/temporalio/temporal/common/backoff"
 	"github.com/temporalio/temporal/common/cache"
 	"github.com/temporalio/temporal/common/clock"
 	"github.com/temporalio/temporal/common/cluster"
@@ -61,6 +64,7 @@ import (
 	commonpb "go.temporal.io/temporal-proto/common"
 	eventpb "go.temporal.io/temporal-proto/event"
 	executionpb "go.temporal.io/temporal-proto/execution"
+	namespacepb "go.temporal.io/temporal-proto/namespace"
 	querypb "go.temporal.io/temporal-proto/query"
 	"go.temporal.io/temporal-proto/serviceerror"
 	tasklistpb "go.temporal.io/temporal-proto/tasklist"
@@ -117,6 +121,17 @@ type (
 		PurgeDLQMessages(ctx context.Context, messagesRequest *historyservice.PurgeDLQMessagesRequest) error
 		MergeDLQMessages(ctx context.Context, messagesRequest *historyservice.MergeDLQMessagesRequest) (*historyservice.MergeDLQMessagesResponse, error)
 		RefreshWorkflowTasks(ctx context.Context, namespaceUUID string, execution executionpb.WorkflowExecution) error
+		PauseWorkflowExecution(ctx context.Context, request *historyservice.PauseWorkflowExecutionRequest) error
+		UnpauseWorkflowExecution(ctx context.Context, request *historyservice.UnpauseWorkflowExecutionRequest) error
+		TriggerCronRun(ctx context.Context, request *historyservice.TriggerCronRunRequest) error
+		SkipCronRuns(ctx context.Context, request *historyservice.SkipCronRunsRequest) (*historyservice.SkipCronRunsResponse, error)
+		UpdateActivityOptions(ctx context.Context, request *historyservice.UpdateActivityOptionsRequest) error
+		ResetActivity(ctx context.Context, request *historyservice.ResetActivityRequest) error
+		DeleteWorkflowExecution(ctx context.Context, request *historyservice.DeleteWorkflowExecutionRequest) error
+		UpsertWorkflowAttributes(ctx context.Context, request *historyservice.UpsertWorkflowAttributesRequest) error
+		UpdateWorkflowExecution(ctx context.Context, request *historyservice.UpdateWorkflowExecutionRequest) (*historyservice.UpdateWorkflowExecutionResponse, error)
+		ReplicateDeleteWorkflowExecution(ctx context.Context, attributes *replicationgenpb.DeleteExecutionTaskAttributes) error
+		ReplicateWorkflowState(ctx context.Context, attributes *replicationgenpb.SyncWorkflowStateTaskAttributes) error
 
 		NotifyNewHistoryEvent(event *historyEventNotification)
 		NotifyNewTransferTasks(tasks []persistence.Task)
@@ -179,6 +194,22 @@ var (
 	ErrActivityTaskNotFound = serviceerror.NewNotFound("invalid activityID or activity already timed out or invoking workflow is completed")
 	// ErrWorkflowCompleted is the error to indicate workflow execution already completed
 	ErrWorkflowCompleted = serviceerror.NewNotFound("workflow execution already completed")
+	// ErrWorkflowPaused is error indicating that a task of a paused workflow execution is dropped until the workflow is unpaused
+	ErrWorkflowPaused = serviceerror.NewNotFound("workflow execution is paused")
+	// ErrWorkflowNotCompleted is the error to indicate the workflow execution is still running
+	ErrWorkflowNotCompleted = serviceerror.NewInvalidArgument("workflow execution is still running")
+	// ErrNamespaceDeleted is the error to indicate the namespace is being deleted and new workflow executions cannot be started
+	ErrNamespaceDeleted = serviceerror.NewInvalidArgument("namespace is being deleted")
+	// ErrActivityNotWaitingForRetry is the error to indicate the activity is not waiting for its retry backoff
+	ErrActivityNotWaitingForRetry = serviceerror.NewInvalidArgument("activity is not waiting for a retry")
+	// ErrActivityStarted is the error to indicate the attempts of a running activity cannot be reset
+	ErrActivityStarted = serviceerror.NewInvalidArgument("activity is running, its attempts cannot be reset")
+	// ErrNotCronWorkflow is the error to indicate the workflow execution does not have a cron schedule
+	ErrNotCronWorkflow = serviceerror.NewInvalidArgument("workflow execution is not a cron workflow")
+	// ErrCronRunStarted is the error to indicate the current run of a cron workflow has already started
+	ErrCronRunStarted = serviceerror.NewInvalidArgument("cron run has already started")
+	// ErrInvalidCronSkipCount is the error to indicate the number of cron runs to skip is not positive
+	ErrInvalidCronSkipCount = serviceerror.NewInvalidArgument("number of cron runs to skip must be positive")
 	// ErrWorkflowParent is the error to parent execution is given and mismatch
 	ErrWorkflowParent = serviceerror.NewNotFound("workflow parent does not match")
 	// ErrDeserializingToken is the error to indicate task token is invalid
@@ -199,6 +230,16 @@ var (
 	ErrConsistentQueryNotEnabled = serviceerror.NewInvalidArgument("cluster or namespace does not enable strongly consistent query but strongly consistent query was requested")
 	// ErrConsistentQueryBufferExceeded is error indicating that too many consistent queries have been buffered and until buffered queries are finished new consistent queries cannot be buffered
 	ErrConsistentQueryBufferExceeded = serviceerror.NewInternal("consistent query buffer is full, cannot accept new consistent queries")
+	// ErrUpdateBufferExceeded is error indicating that too many updates of the workflow execution are waiting to be handled
+	ErrUpdateBufferExceeded = serviceerror.NewResourceExhausted("update buffer is full, cannot accept new updates")
+	// ErrUpdateInProgress is error indicating that an update with the same id is already waiting to be handled
+	ErrUpdateInProgress = serviceerror.NewInvalidArgument("an update with the same id is in progress")
+	// ErrUpdateNotHandled is error indicating that the update was delivered on a decision task which did not return its result
+	ErrUpdateNotHandled = serviceerror.NewUnavailable("update was not handled by the decision task it was delivered on")
+	// ErrUpdateTimeout is error indicating that the workflow did not handle the update in time
+	ErrUpdateTimeout = serviceerror.NewDeadlineExceeded("timed out waiting for the workflow to handle the update")
+	// ErrUpdateEnteredInvalidState is error indicating update entered invalid state
+	ErrUpdateEnteredInvalidState = serviceerror.NewInternal("update entered invalid state, this should be impossible")
 
 	// FailedWorkflowStatuses is a set of failed workflow close states, used for start workflow policy
 	// for start workflow execution API
@@ -539,6 +580,29 @@ func (e *historyEngineImpl) generateFirstDecisionTask(
 	return nil
 }
 
+// isFirstDecisionTaskBackoff returns true when the first decision task has not been scheduled yet
+// because the workflow is waiting for its cron schedule or a delayed start.
+// Retry backoff is not included, a signal will start the next attempt right away.
+func isFirstDecisionTaskBackoff(
+	mutableState mutableState,
+) (bool, error) {
+
+	if mutableState.HasProcessedOrPendingDecision() {
+		return false, nil
+	}
+	if mutableState.GetExecutionInfo().CronSchedule != "" {
+		return true, nil
+	}
+
+	startEvent, err := mutableState.GetStartEvent()
+	if err != nil {
+		return false, err
+	}
+	startAttr := startEvent.GetWorkflowExecutionStartedEventAttributes()
+	return startAttr.GetFirstDecisionTaskBackoffSeconds() > 0 &&
+		startAttr.GetInitiator() != commonpb.ContinueAsNewInitiator_Retry, nil
+}
+
 // StartWorkflowExecution starts a workflow execution
 func (e *historyEngineImpl) StartWorkflowExecution(
 	ctx context.Context,
@@ -550,6 +614,9 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 		return nil, err
 	}
 	namespaceID := primitives.UUIDString(namespaceEntry.GetInfo().Id)
+	if namespaceEntry.GetInfo().Status == namespacepb.NamespaceStatus_Deleted {
+		return nil, ErrNamespaceDeleted
+	}
 
 	request := startRequest.StartRequest
 	err = validateStartWorkflowExecutionRequest(request, e.config.MaxIDLengthLimit())
@@ -1052,9 +1119,10 @@ func (e *historyEngineImpl) queryDirectlyThroughMatching(
 		tag.WorkflowNextEventID(msResp.GetNextEventId()))
 
 	nonStickyMatchingRequest := &matchingservice.QueryWorkflowRequest{
-		NamespaceId:  namespaceID,
-		QueryRequest: queryRequest,
-		TaskList:     msResp.TaskList,
+		NamespaceId:   namespaceID,
+		QueryRequest:  queryRequest,
+		TaskList:      msResp.TaskList,
+		WorkerBuildId: msResp.GetWorkerBuildId(),
 	}
 
 	nonStickyStopWatch := scope.StartTimer(metrics.DirectQueryDispatchNonStickyLatency)
@@ -1119,6 +1187,7 @@ func (e *historyEngineImpl) getMutableState(
 		WorkflowState:                        int32(workflowState),
 		WorkflowStatus:                       workflowStatus,
 		IsStickyTaskListEnabled:              mutableState.IsStickyTaskListEnabled(),
+		WorkerBuildId:                        executionInfo.WorkerBuildID,
 	}
 	replicationState := mutableState.GetReplicationState()
 	if replicationState != nil {
@@ -1385,6 +1454,10 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 			if !mutableState.IsWorkflowExecutionRunning() {
 				return ErrWorkflowCompleted
 			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				// the activity task is dispatched again when the workflow is unpaused
+				return ErrWorkflowPaused
+			}
 
 			scheduleID := request.GetScheduleId()
 			requestID := request.GetRequestId()
@@ -1410,7 +1483,7 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 			if err != nil {
 				return err
 			}
-			response.ScheduledEvent = scheduledEvent
+			response.ScheduledEvent = applyActivityOptions(scheduledEvent, ai)
 			response.ScheduledTimestampOfThisAttempt = ai.ScheduledTime.UnixNano()
 
 			if ai.StartedID != common.EmptyEventID {
@@ -1427,6 +1500,11 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				return serviceerror.NewEventAlreadyStarted("Activity task already started.")
 			}
 
+			// the activity was moved to another task list, drop the tasks dispatched to any previous one
+			if getRootTaskListName(request.PollRequest.GetTaskList().GetName()) != ai.TaskList {
+				return ErrActivityTaskNotFound
+			}
+
 			if _, err := mutableState.AddActivityTaskStartedEvent(
 				ai, scheduleID, requestID, request.PollRequest.GetIdentity(),
 			); err != nil {
@@ -1876,17 +1954,23 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 		execution,
 		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
 			executionInfo := mutableState.GetExecutionInfo()
-			createDecisionTask := true
-			// Do not create decision task when the workflow is cron and the cron has not been started yet
-			if mutableState.GetExecutionInfo().CronSchedule != "" && !mutableState.HasProcessedOrPendingDecision() {
-				createDecisionTask = false
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+
+			// Do not create decision task when the first decision task is still in backoff
+			firstDecisionBackoff, err := isFirstDecisionTaskBackoff(mutableState)
+			if err != nil {
+				return nil, err
 			}
 			postActions := &updateWorkflowAction{
-				createDecision: createDecisionTask,
+				createDecision: !firstDecisionBackoff,
 			}
 
-			if !mutableState.IsWorkflowExecutionRunning() {
-				return nil, ErrWorkflowCompleted
+			// deduplicate by request id, a retried signal is accepted without being recorded again
+			requestID := request.GetRequestId()
+			if requestID != "" && mutableState.IsSignalRequested(requestID) {
+				return &updateWorkflowAction{noop: true}, nil
 			}
 
 			maxAllowedSignals := e.config.MaximumSignalsPerExecution(namespaceEntry.GetInfo().Name)
@@ -1907,11 +1991,7 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 				}
 			}
 
-			// deduplicate by request id for signal decision
-			if requestID := request.GetRequestId(); requestID != "" {
-				if mutableState.IsSignalRequested(requestID) {
-					return postActions, nil
-				}
+			if requestID != "" {
 				mutableState.AddSignalRequested(requestID)
 			}
 
@@ -1965,6 +2045,12 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 				break
 			}
 
+			// deduplicate by request id, a retried signal with start is accepted without being recorded again
+			requestID := sRequest.GetRequestId()
+			if requestID != "" && mutableState.IsSignalRequested(requestID) {
+				return &historyservice.SignalWithStartWorkflowExecutionResponse{RunId: context.getExecution().RunId}, nil
+			}
+
 			executionInfo := mutableState.GetExecutionInfo()
 			maxAllowedSignals := e.config.MaximumSignalsPerExecution(namespaceEntry.GetInfo().Name)
 			if maxAllowedSignals > 0 && int(executionInfo.SignalCount) >= maxAllowedSignals {
@@ -1975,6 +2061,9 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 				return nil, ErrSignalsLimitExceeded
 			}
 
+			if requestID != "" {
+				mutableState.AddSignalRequested(requestID)
+			}
 			if _, err := mutableState.AddWorkflowExecutionSignaled(
 				sRequest.GetSignalName(),
 				sRequest.GetSignalInput(),
@@ -1982,8 +2071,12 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 				return nil, serviceerror.NewInternal("Unable to signal workflow execution.")
 			}
 
+			firstDecisionBackoff, err := isFirstDecisionTaskBackoff(mutableState)
+			if err != nil {
+				return nil, err
+			}
 			// Create a transfer task to schedule a decision task
-			if !mutableState.HasPendingDecision() {
+			if !mutableState.HasPendingDecision() && !firstDecisionBackoff {
 				_, err := mutableState.AddDecisionTaskScheduledEvent(false)
 				if err != nil {
 					return nil, serviceerror.NewInternal("Failed to add decision scheduled event.")
@@ -2010,8 +2103,13 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 		// workflow not exist, will create workflow then signal
 	}
 
+	if namespaceEntry.GetInfo().Status == namespacepb.NamespaceStatus_Deleted {
+		return nil, ErrNamespaceDeleted
+	}
+
 	// Start workflow and signal
-	startRequest := getStartRequest(namespaceID, sRequest)
+	startRequest := getStartRequest(namespaceID, sRequest, signalWithStartRequest.GetStartDelaySeconds())
+	startRequest.Priority = signalWithStartRequest.GetPriority()
 	request := startRequest.StartRequest
 	err = validateStartWorkflowExecutionRequest(request, e.config.MaxIDLengthLimit())
 	if err != nil {
@@ -2071,6 +2169,9 @@ func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	}
 
 	// Add signal event
+	if requestID := sRequest.GetRequestId(); requestID != "" {
+		mutableState.AddSignalRequested(requestID)
+	}
 	if _, err := mutableState.AddWorkflowExecutionSignaled(
 		sRequest.GetSignalName(),
 		sRequest.GetSignalInput(),
@@ -2420,6 +2521,7 @@ func (e *historyEngineImpl) ResetWorkflowExecution(
 		baseRebuildLastEventID,
 		baseRebuildLastEventVersion,
 		baseNextEventID,
+		baseMutableState,
 		resetRunID,
 		request.GetRequestId(),
 		newNDCWorkflow(
@@ -2762,9 +2864,43 @@ func getScheduleID(
 	return activityInfo.ScheduleID, nil
 }
 
+// applyActivityOptions returns the activity scheduled event with the options of the activity info,
+// which differ from the ones the activity was scheduled with after they are updated
+func applyActivityOptions(
+	scheduledEvent *eventpb.HistoryEvent,
+	ai *persistence.ActivityInfo,
+) *eventpb.HistoryEvent {
+
+	attributes := scheduledEvent.GetActivityTaskScheduledEventAttributes()
+	if attributes.GetScheduleToStartTimeoutSeconds() == ai.ScheduleToStartTimeout &&
+		attributes.GetScheduleToCloseTimeoutSeconds() == ai.ScheduleToCloseTimeout &&
+		attributes.GetStartToCloseTimeoutSeconds() == ai.StartToCloseTimeout &&
+		attributes.GetHeartbeatTimeoutSeconds() == ai.HeartbeatTimeout &&
+		attributes.GetTaskList().GetName() == ai.TaskList {
+		return scheduledEvent
+	}
+
+	// the scheduled event is shared through the events cache, update a copy of it
+	updatedAttributes := *attributes
+	updatedAttributes.ScheduleToStartTimeoutSeconds = ai.ScheduleToStartTimeout
+	updatedAttributes.ScheduleToCloseTimeoutSeconds = ai.ScheduleToCloseTimeout
+	updatedAttributes.StartToCloseTimeoutSeconds = ai.StartToCloseTimeout
+	updatedAttributes.HeartbeatTimeoutSeconds = ai.HeartbeatTimeout
+	updatedAttributes.TaskList = &tasklistpb.TaskList{
+		Name: ai.TaskList,
+		Kind: attributes.GetTaskList().GetKind(),
+	}
+	updatedEvent := *scheduledEvent
+	updatedEvent.Attributes = &eventpb.HistoryEvent_ActivityTaskScheduledEventAttributes{
+		ActivityTaskScheduledEventAttributes: &updatedAttributes,
+	}
+	return &updatedEvent
+}
+
 func getStartRequest(
 	namespaceID string,
 	request *workflowservice.SignalWithStartWorkflowExecutionRequest,
+	startDelaySeconds int32,
 ) *historyservice.StartWorkflowExecutionRequest {
 
 	req := &workflowservice.StartWorkflowExecutionRequest{
@@ -2785,7 +2921,7 @@ func getStartRequest(
 		Header:                              request.GetHeader(),
 	}
 
-	return common.CreateHistoryStartWorkflowRequest(namespaceID, req)
+	return common.CreateHistoryStartWorkflowRequest(namespaceID, req, startDelaySeconds)
 }
 
 func setTaskInfo(
@@ -3015,6 +3151,7 @@ func (e *historyEngineImpl) ReapplyEvents(
 					baseRebuildLastEventID,
 					baseRebuildLastEventVersion,
 					baseNextEventID,
+					mutableState,
 					resetRunID,
 					uuid.New(),
 					newNDCWorkflow(
@@ -3038,8 +3175,12 @@ func (e *historyEngineImpl) ReapplyEvents(
 			postActions := &updateWorkflowAction{
 				createDecision: true,
 			}
-			// Do not create decision task when the workflow is cron and the cron has not been started yet
-			if mutableState.GetExecutionInfo().CronSchedule != "" && !mutableState.HasProcessedOrPendingDecision() {
+			// Do not create decision task when the first decision task is still in backoff
+			firstDecisionBackoff, err := isFirstDecisionTaskBackoff(mutableState)
+			if err != nil {
+				return nil, err
+			}
+			if firstDecisionBackoff {
 				postActions.createDecision = false
 			}
 			reappliedEvents, err := e.eventsReapplier.reapplyEvents(
@@ -3162,6 +3303,729 @@ func (e *historyEngineImpl) RefreshWorkflowTasks(
 	return nil
 }
 
+func (e *historyEngineImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	pauseRequest *historyservice.PauseWorkflowExecutionRequest,
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(pauseRequest.GetNamespaceId())
+	if err != nil {
+		return err
+	}
+	namespaceID := primitives.UUIDString(namespaceEntry.GetInfo().Id)
+
+	request := pauseRequest.GetRequest()
+	execution := executionpb.WorkflowExecution{
+		WorkflowId: request.GetExecution().GetWorkflowId(),
+		RunId:      request.GetExecution().GetRunId(),
+	}
+
+	return e.updateWorkflow(
+		ctx,
+		namespaceID,
+		execution,
+		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				return &updateWorkflowAction{noop: true}, nil
+			}
+
+			// outstanding transfer and timer tasks are dropped by the task executors
+			// while the workflow is paused and regenerated on unpause
+			if err := mutableState.PauseWorkflowExecution(
+				request.GetReason(),
+				request.GetIdentity(),
+			); err != nil {
+				return nil, err
+			}
+
+			if e.config.AdvancedVisibilityWritingMode() != common.AdvancedVisibilityWritingModeOff {
+				taskGenerator := newMutableStateTaskGenerator(
+					e.shard.GetNamespaceCache(),
+					e.logger,
+					mutableState,
+				)
+				if err := taskGenerator.generateWorkflowSearchAttrTasks(e.timeSource.Now()); err != nil {
+					return nil, err
+				}
+			}
+			return updateWorkflowWithoutDecision, nil
+		})
+}
+
+func (e *historyEngineImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	unpauseRequest *historyservice.UnpauseWorkflowExecutionRequest,
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(unpauseRequest.GetNamespaceId())
+	if err != nil {
+		return err
+	}
+	namespaceID := primitives.UUIDString(namespaceEntry.GetInfo().Id)
+
+	request := unpauseRequest.GetRequest()
+	execution := executionpb.WorkflowExecution{
+		WorkflowId: request.GetExecution().GetWorkflowId(),
+		RunId:      request.GetExecution().GetRunId(),
+	}
+
+	return e.updateWorkflow(
+		ctx,
+		namespaceID,
+		execution,
+		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+			if !mutableState.IsWorkflowExecutionPaused() {
+				return &updateWorkflowAction{noop: true}, nil
+			}
+
+			if err := mutableState.UnpauseWorkflowExecution(); err != nil {
+				return nil, err
+			}
+
+			// release the tasks held back while the workflow was paused, this also
+			// generates the visibility task for the updated search attributes
+			mutableStateTaskRefresher := newMutableStateTaskRefresher(
+				e.shard.GetConfig(),
+				e.shard.GetNamespaceCache(),
+				e.shard.GetEventsCache(),
+				e.shard.GetLogger(),
+			)
+			if err := mutableStateTaskRefresher.refreshTasks(e.timeSource.Now(), mutableState); err != nil {
+				return nil, err
+			}
+			return updateWorkflowWithoutDecision, nil
+		})
+}
+
+func (e *historyEngineImpl) TriggerCronRun(
+	ctx context.Context,
+	triggerRequest *historyservice.TriggerCronRunRequest,
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(triggerRequest.GetNamespaceId())
+	if err != nil {
+		return err
+	}
+	namespaceID := primitives.UUIDString(namespaceEntry.GetInfo().Id)
+
+	request := triggerRequest.GetRequest()
+	execution := executionpb.WorkflowExecution{
+		WorkflowId: request.GetExecution().GetWorkflowId(),
+		RunId:      request.GetExecution().GetRunId(),
+	}
+
+	return e.updateWorkflow(
+		ctx,
+		namespaceID,
+		execution,
+		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+			executionInfo := mutableState.GetExecutionInfo()
+			if executionInfo.CronSchedule == "" {
+				return nil, ErrNotCronWorkflow
+			}
+			if mutableState.HasProcessedOrPendingDecision() {
+				return nil, ErrCronRunStarted
+			}
+
+			// the pending backoff timer finds the first decision already scheduled and does nothing
+			if err := mutableState.UpdateCronSkipUntil(time.Time{}); err != nil {
+				return nil, err
+			}
+			return updateWorkflowWithNewDecision, nil
+		})
+}
+
+func (e *historyEngineImpl) SkipCronRuns(
+	ctx context.Context,
+	skipRequest *historyservice.SkipCronRunsRequest,
+) (*historyservice.SkipCronRunsResponse, error) {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(skipRequest.GetNamespaceId())
+	if err != nil {
+		return nil, err
+	}
+	namespaceID := primitives.UUIDString(namespaceEntry.GetInfo().Id)
+
+	request := skipRequest.GetRequest()
+	if request.GetCount() <= 0 {
+		return nil, ErrInvalidCronSkipCount
+	}
+	execution := executionpb.WorkflowExecution{
+		WorkflowId: request.GetExecution().GetWorkflowId(),
+		RunId:      request.GetExecution().GetRunId(),
+	}
+
+	var nextRunTime time.Time
+	err = e.updateWorkflow(
+		ctx,
+		namespaceID,
+		execution,
+		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+			executionInfo := mutableState.GetExecutionInfo()
+			if executionInfo.CronSchedule == "" {
+				return nil, ErrNotCronWorkflow
+			}
+
+			startEvent, err := mutableState.GetStartEvent()
+			if err != nil {
+				return nil, err
+			}
+			now := e.timeSource.Now()
+
+			// the upcoming run is either this run waiting for its schedule or the run following this one
+			backoffDuration := time.Duration(startEvent.GetWorkflowExecutionStartedEventAttributes().GetFirstDecisionTaskBackoffSeconds()) * time.Second
+			upcomingRunTime := time.Unix(0, startEvent.GetTimestamp()).Add(backoffDuration)
+			if mutableState.HasProcessedOrPendingDecision() || upcomingRunTime.Before(now) {
+				upcomingRunTime = backoff.GetNextScheduleTime(executionInfo.CronSchedule, now, 0)
+			}
+			if executionInfo.CronSkipUntil.After(upcomingRunTime) {
+				upcomingRunTime = executionInfo.CronSkipUntil
+			}
+			nextRunTime = backoff.GetNextScheduleTime(executionInfo.CronSchedule, upcomingRunTime, int(request.GetCount())-1)
+			if err := mutableState.UpdateCronSkipUntil(nextRunTime); err != nil {
+				return nil, err
+			}
+
+			// timers scheduled before the skip time are ignored, create the ones for the new schedule
+			if !mutableState.HasProcessedOrPendingDecision() {
+				mutableState.AddTimerTasks(&persistence.WorkflowBackoffTimerTask{
+					// TaskID is set by shard
+					VisibilityTimestamp: nextRunTime,
+					TimeoutType:         persistence.WorkflowBackoffTimeoutTypeCron,
+					Version:             startEvent.GetVersion(),
+				})
+			} else if policy := backoff.GetCronOverlapPolicy(executionInfo.CronSchedule); policy == backoff.CronOverlapPolicyCancelPrevious ||
+				policy == backoff.CronOverlapPolicyTerminatePrevious {
+				mutableState.AddTimerTasks(&persistence.WorkflowBackoffTimerTask{
+					// TaskID is set by shard
+					VisibilityTimestamp: nextRunTime,
+					TimeoutType:         persistence.WorkflowBackoffTimeoutTypeCronOverlap,
+					Version:             startEvent.GetVersion(),
+				})
+			}
+			return updateWorkflowWithoutDecision, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &historyservice.SkipCronRunsResponse{NextRunTime: nextRunTime.UnixNano()}, nil
+}
+
+func (e *historyEngineImpl) UpdateActivityOptions(
+	ctx context.Context,
+	updateRequest *historyservice.UpdateActivityOptionsRequest,
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(updateRequest.GetNamespaceId())
+	if err != nil {
+		return err
+	}
+	namespaceID := primitives.UUIDString(namespaceEntry.GetInfo().Id)
+
+	request := updateRequest.GetRequest()
+	execution := executionpb.WorkflowExecution{
+		WorkflowId: request.GetExecution().GetWorkflowId(),
+		RunId:      request.GetExecution().GetRunId(),
+	}
+
+	return e.updateWorkflow(
+		ctx,
+		namespaceID,
+		execution,
+		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+
+			ai, ok := mutableState.GetActivityByActivityID(request.GetActivityId())
+			if !ok {
+				return nil, ErrActivityTaskNotFound
+			}
+			scheduleID := ai.ScheduleID
+
+			if err := mutableState.UpdateActivityOptions(ai, request); err != nil {
+				return nil, err
+			}
+			if err := newMutableStateTaskRefresher(
+				e.shard.GetConfig(),
+				e.shard.GetNamespaceCache(),
+				e.shard.GetEventsCache(),
+				e.shard.GetLogger(),
+			).refreshTasksForActivityInfo(e.timeSource.Now(), mutableState, scheduleID); err != nil {
+				return nil, err
+			}
+
+			// workers replaying the history do not expect an event for the update, the update is
+			// recorded in the activity info which is replicated by the sync activity task
+			return updateWorkflowWithoutDecision, nil
+		})
+}
+
+func (e *historyEngineImpl) ResetActivity(
+	ctx context.Context,
+	resetRequest *historyservice.ResetActivityRequest,
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(resetRequest.GetNamespaceId())
+	if err != nil {
+		return err
+	}
+	namespaceID := primitives.UUIDString(namespaceEntry.GetInfo().Id)
+
+	request := resetRequest.GetRequest()
+	execution := executionpb.WorkflowExecution{
+		WorkflowId: request.GetExecution().GetWorkflowId(),
+		RunId:      request.GetExecution().GetRunId(),
+	}
+
+	return e.updateWorkflow(
+		ctx,
+		namespaceID,
+		execution,
+		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+
+			ai, ok := mutableState.GetActivityByActivityID(request.GetActivityId())
+			if !ok {
+				return nil, ErrActivityTaskNotFound
+			}
+			waitingForRetry := ai.StartedID == common.EmptyEventID && ai.ScheduledTime.After(e.timeSource.Now())
+			if !waitingForRetry && !request.GetResetAttempts() {
+				return nil, ErrActivityNotWaitingForRetry
+			}
+			if ai.StartedID != common.EmptyEventID {
+				// the task token of the running attempt carries its attempt number,
+				// resetting the attempt would make the worker unable to complete the activity
+				return nil, ErrActivityStarted
+			}
+
+			if err := mutableState.ResetActivityRetry(ai, request.GetResetAttempts()); err != nil {
+				return nil, err
+			}
+			if waitingForRetry {
+				// dispatch the activity now, the pending retry timer task becomes a no-op
+				if err := newMutableStateTaskRefresher(
+					e.shard.GetConfig(),
+					e.shard.GetNamespaceCache(),
+					e.shard.GetEventsCache(),
+					e.shard.GetLogger(),
+				).refreshTasksForActivityInfo(e.timeSource.Now(), mutableState, ai.ScheduleID); err != nil {
+					return nil, err
+				}
+			}
+
+			e.logger.Info("Activity retry reset.",
+				tag.WorkflowNamespaceID(namespaceID),
+				tag.WorkflowID(execution.GetWorkflowId()),
+				tag.WorkflowRunID(mutableState.GetExecutionInfo().RunID),
+				tag.WorkflowActivityID(request.GetActivityId()),
+				tag.WorkflowScheduleID(ai.ScheduleID),
+				tag.Attempt(ai.Attempt),
+				tag.Identity(request.GetIdentity()),
+			)
+			return updateWorkflowWithoutDecision, nil
+		})
+}
+
+func (e *historyEngineImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	deleteRequest *historyservice.DeleteWorkflowExecutionRequest,
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(deleteRequest.GetNamespaceId())
+	if err != nil {
+		return err
+	}
+	namespaceID := primitives.UUIDString(namespaceEntry.GetInfo().Id)
+
+	request := deleteRequest.GetRequest()
+	execution := executionpb.WorkflowExecution{
+		WorkflowId: request.GetExecution().GetWorkflowId(),
+		RunId:      request.GetExecution().GetRunId(),
+	}
+
+	// a running workflow execution is terminated in its own transaction,
+	// so that the termination is replicated to standby clusters before the deletion
+	if err := e.updateWorkflow(
+		ctx,
+		namespaceID,
+		execution,
+		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
+			execution.RunId = mutableState.GetExecutionInfo().RunID
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return &updateWorkflowAction{noop: true}, nil
+			}
+
+			eventBatchFirstEventID := mutableState.GetNextEventID()
+			return updateWorkflowWithoutDecision, terminateWorkflow(
+				mutableState,
+				eventBatchFirstEventID,
+				request.GetReason(),
+				nil,
+				request.GetIdentity(),
+			)
+		}); err != nil {
+		return err
+	}
+
+	return e.updateWorkflow(
+		ctx,
+		namespaceID,
+		execution,
+		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
+			if mutableState.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowNotCompleted
+			}
+
+			// the data of the workflow execution is deleted asynchronously by the transfer task
+			now := e.timeSource.Now()
+			version := mutableState.GetCurrentVersion()
+			mutableState.AddTransferTasks(&persistence.DeleteExecutionTask{
+				// TaskID is set by shard
+				VisibilityTimestamp: now,
+				Version:             version,
+			})
+			if namespaceEntry.GetReplicationPolicy() == cache.ReplicationPolicyMultiCluster {
+				mutableState.AddReplicationTasks(&persistence.DeleteExecutionReplicationTask{
+					// TaskID is set by shard
+					VisibilityTimestamp: now,
+					Version:             version,
+				})
+			}
+
+			e.logger.Info("Workflow execution deletion requested.",
+				tag.WorkflowNamespaceID(namespaceID),
+				tag.WorkflowID(execution.GetWorkflowId()),
+				tag.WorkflowRunID(execution.GetRunId()),
+				tag.Identity(request.GetIdentity()),
+			)
+			return updateWorkflowWithoutDecision, nil
+		})
+}
+
+func (e *historyEngineImpl) UpsertWorkflowAttributes(
+	ctx context.Context,
+	upsertRequest *historyservice.UpsertWorkflowAttributesRequest,
+) error {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(upsertRequest.GetNamespaceId())
+	if err != nil {
+		return err
+	}
+	namespaceID := primitives.UUIDString(namespaceEntry.GetInfo().Id)
+
+	request := upsertRequest.GetRequest()
+	execution := executionpb.WorkflowExecution{
+		WorkflowId: request.GetExecution().GetWorkflowId(),
+		RunId:      request.GetExecution().GetRunId(),
+	}
+
+	return e.updateWorkflow(
+		ctx,
+		namespaceID,
+		execution,
+		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
+			if !mutableState.IsWorkflowExecutionRunning() {
+				if err := e.updateClosedWorkflowVisibility(
+					namespaceEntry,
+					mutableState,
+					request.GetSearchAttributes().GetIndexedFields(),
+					request.GetMemo().GetFields(),
+				); err != nil {
+					return nil, err
+				}
+
+				e.logger.Info("Closed workflow visibility attributes upserted.",
+					tag.WorkflowNamespaceID(namespaceID),
+					tag.WorkflowID(execution.GetWorkflowId()),
+					tag.WorkflowRunID(mutableState.GetExecutionInfo().RunID),
+					tag.Identity(request.GetIdentity()),
+				)
+				return &updateWorkflowAction{noop: true}, nil
+			}
+
+			if err := mutableState.UpdateWorkflowAttributes(
+				request.GetSearchAttributes().GetIndexedFields(),
+				request.GetMemo().GetFields(),
+			); err != nil {
+				return nil, err
+			}
+
+			e.logger.Info("Workflow attributes upserted.",
+				tag.WorkflowNamespaceID(namespaceID),
+				tag.WorkflowID(execution.GetWorkflowId()),
+				tag.WorkflowRunID(mutableState.GetExecutionInfo().RunID),
+				tag.Identity(request.GetIdentity()),
+			)
+			return updateWorkflowWithoutDecision, nil
+		})
+}
+
+// updateClosedWorkflowVisibility merges search attributes and memo into the visibility record of a closed workflow.
+// The mutable state of a closed workflow can not be changed, so the record of the current cluster is rewritten
+// directly and the change is neither replicated nor archived.
+func (e *historyEngineImpl) updateClosedWorkflowVisibility(
+	namespaceEntry *cache.NamespaceCacheEntry,
+	mutableState mutableState,
+	searchAttributes map[string][]byte,
+	memo map[string][]byte,
+) error {
+
+	executionInfo := mutableState.GetExecutionInfo()
+	if namespaceEntry.IsSampledForLongerRetentionEnabled(executionInfo.WorkflowID) &&
+		!namespaceEntry.IsSampledForLongerRetention(executionInfo.WorkflowID) {
+		// the close of the workflow was not recorded
+		return nil
+	}
+
+	startEvent, err := mutableState.GetStartEvent()
+	if err != nil {
+		return err
+	}
+	completionEvent, err := mutableState.GetCompletionEvent()
+	if err != nil {
+		return err
+	}
+	// elasticsearch uses the task id as the version of the record, a new one makes the rewrite win
+	taskID, err := e.shard.GenerateTransferTaskID()
+	if err != nil {
+		return err
+	}
+
+	return e.visibilityMgr.RecordWorkflowExecutionClosed(&persistence.RecordWorkflowExecutionClosedRequest{
+		NamespaceID: executionInfo.NamespaceID,
+		Namespace:   namespaceEntry.GetInfo().Name,
+		Execution: executionpb.WorkflowExecution{
+			WorkflowId: executionInfo.WorkflowID,
+			RunId:      executionInfo.RunID,
+		},
+		WorkflowTypeName:   executionInfo.WorkflowTypeName,
+		StartTimestamp:     startEvent.GetTimestamp(),
+		ExecutionTimestamp: getWorkflowExecutionTimestamp(mutableState, startEvent).UnixNano(),
+		CloseTimestamp:     completionEvent.GetTimestamp(),
+		Status:             executionInfo.Status,
+		HistoryLength:      mutableState.GetNextEventID() - 1,
+		RetentionSeconds:   int64(namespaceEntry.GetRetentionDays(executionInfo.WorkflowID)) * int64(secondsInDay),
+		TaskID:             taskID,
+		Memo:               getWorkflowMemo(mergeMapOfByteArray(mergeMapOfByteArray(nil, executionInfo.Memo), memo)),
+		TaskList:           executionInfo.TaskList,
+		SearchAttributes:   mergeMapOfByteArray(mergeMapOfByteArray(nil, executionInfo.SearchAttributes), searchAttributes),
+	})
+}
+
+func (e *historyEngineImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	updateRequest *historyservice.UpdateWorkflowExecutionRequest,
+) (retResp *historyservice.UpdateWorkflowExecutionResponse, retError error) {
+
+	namespaceEntry, err := e.getActiveNamespaceEntry(updateRequest.GetNamespaceId())
+	if err != nil {
+		return nil, err
+	}
+	namespaceID := primitives.UUIDString(namespaceEntry.GetInfo().Id)
+	namespace := namespaceEntry.GetInfo().Name
+	scope := e.metricsClient.Scope(metrics.HistoryUpdateWorkflowExecutionScope, metrics.NamespaceTag(namespace))
+
+	request := updateRequest.GetRequest()
+	workflowContext, err := e.loadWorkflowOnce(
+		ctx,
+		namespaceID,
+		request.GetExecution().GetWorkflowId(),
+		request.GetExecution().GetRunId(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	release := workflowContext.getReleaseFn()
+	defer func() { release(retError) }()
+
+	var recordedResult *eventgenpb.WorkflowUpdateResult
+	if err := e.updateWorkflowHelper(
+		workflowContext,
+		func(context workflowExecutionContext, mutableState mutableState) (*updateWorkflowAction, error) {
+			// the update is retried after it has been completed, e.g. the caller timed out or failed over
+			if result, ok := mutableState.GetUpdateResult(request.GetUpdateId()); ok {
+				recordedResult = result
+				return &updateWorkflowAction{noop: true}, nil
+			}
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+			if mutableState.IsWorkflowExecutionPaused() {
+				return nil, ErrWorkflowPaused
+			}
+			if mutableState.HasPendingDecision() {
+				// the update is delivered when the pending decision task is started, or on the decision task
+				// scheduled when the already started one completes
+				return &updateWorkflowAction{noop: true}, nil
+			}
+			return updateWorkflowWithNewDecision, nil
+		},
+	); err != nil {
+		return nil, err
+	}
+	if recordedResult != nil {
+		return &historyservice.UpdateWorkflowExecutionResponse{
+			Response: &adminservice.UpdateWorkflowExecutionResponse{
+				UpdateId:       request.GetUpdateId(),
+				Result:         recordedResult.GetResult(),
+				FailureMessage: recordedResult.GetFailureMessage(),
+			},
+		}, nil
+	}
+
+	// the update registry is part of the cached mutable state, errors must not clear it from here on
+	updateReg := workflowContext.getMutableState().GetUpdateRegistry()
+	var termCh <-chan struct{}
+	if len(updateReg.getBufferedIDs())+len(updateReg.getDeliveredIDs()) >= e.config.MaxBufferedUpdateCount() {
+		scope.IncCounter(metrics.UpdateBufferExceededCount)
+		err = ErrUpdateBufferExceeded
+	} else {
+		termCh, err = updateReg.bufferUpdate(&eventgenpb.WorkflowUpdate{
+			UpdateId: request.GetUpdateId(),
+			Name:     request.GetName(),
+			Input:    request.GetInput(),
+			Identity: request.GetIdentity(),
+		})
+	}
+	release(nil)
+	if err == errUpdateAlreadyExists {
+		return nil, ErrUpdateInProgress
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer updateReg.removeUpdate(request.GetUpdateId())
+
+	timer := time.NewTimer(e.config.UpdateWorkflowExecutionTimeout(namespace))
+	defer timer.Stop()
+	select {
+	case <-termCh:
+		state, err := updateReg.getTerminationState(request.GetUpdateId())
+		if err != nil {
+			scope.IncCounter(metrics.UpdateRegistryInvalidStateCount)
+			return nil, err
+		}
+		switch state.updateTerminationType {
+		case updateTerminationTypeCompleted:
+			return &historyservice.UpdateWorkflowExecutionResponse{
+				Response: &adminservice.UpdateWorkflowExecutionResponse{
+					UpdateId:       request.GetUpdateId(),
+					Result:         state.updateResult.GetResult(),
+					FailureMessage: state.updateResult.GetFailureMessage(),
+				},
+			}, nil
+		case updateTerminationTypeFailed:
+			return nil, state.failure
+		default:
+			scope.IncCounter(metrics.UpdateRegistryInvalidStateCount)
+			return nil, ErrUpdateEnteredInvalidState
+		}
+	case <-timer.C:
+		scope.IncCounter(metrics.UpdateTimeoutCount)
+		return nil, ErrUpdateTimeout
+	case <-ctx.Done():
+		scope.IncCounter(metrics.UpdateTimeoutCount)
+		return nil, ctx.Err()
+	}
+}
+
+func (e *historyEngineImpl) ReplicateDeleteWorkflowExecution(
+	ctx context.Context,
+	attributes *replicationgenpb.DeleteExecutionTaskAttributes,
+) (retError error) {
+
+	execution := executionpb.WorkflowExecution{
+		WorkflowId: attributes.GetWorkflowId(),
+		RunId:      attributes.GetRunId(),
+	}
+	context, release, err := e.historyCache.getOrCreateWorkflowExecution(ctx, attributes.GetNamespaceId(), execution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := context.loadWorkflowExecution()
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			// the workflow execution is never replicated or already deleted
+			return nil
+		}
+		return err
+	}
+
+	// the deletion is decided by the active cluster, the workflow execution is deleted
+	// even if its termination is not replicated, e.g. it was deleted before being replicated
+	now := e.timeSource.Now()
+	mutableState.AddTransferTasks(&persistence.DeleteExecutionTask{
+		// TaskID is set by shard
+		VisibilityTimestamp: now,
+		Version:             attributes.GetVersion(),
+	})
+	return context.updateWorkflowExecutionAsPassive(now)
+}
+
+func (e *historyEngineImpl) ReplicateWorkflowState(
+	ctx context.Context,
+	attributes *replicationgenpb.SyncWorkflowStateTaskAttributes,
+) (retError error) {
+
+	execution := executionpb.WorkflowExecution{
+		WorkflowId: attributes.GetWorkflowId(),
+		RunId:      attributes.GetRunId(),
+	}
+	context, release, err := e.historyCache.getOrCreateWorkflowExecution(ctx, attributes.GetNamespaceId(), execution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := context.loadWorkflowExecution()
+	if err != nil {
+		if _, ok := err.(*serviceerror.NotFound); ok {
+			// the workflow execution is not replicated yet, the state is sent again with its next change
+			return nil
+		}
+		return err
+	}
+
+	if attributes.GetVersion() < mutableState.GetExecutionInfo().SyncStateVersion {
+		// stale task, the state is already changed by a later failover version
+		return nil
+	}
+	if err := mutableState.ReplicateWorkflowState(attributes); err != nil {
+		return err
+	}
+
+	now := e.timeSource.Now()
+	if e.config.AdvancedVisibilityWritingMode() != common.AdvancedVisibilityWritingModeOff {
+		taskGenerator := newMutableStateTaskGenerator(
+			e.shard.GetNamespaceCache(),
+			e.logger,
+			mutableState,
+		)
+		if err := taskGenerator.generateWorkflowSearchAttrTasks(now); err != nil {
+			return err
+		}
+	}
+	return context.updateWorkflowExecutionAsPassive(now)
+}
+
 func (e *historyEngineImpl) loadWorkflowOnce(
 	ctx context.Context,
 	namespaceID string,
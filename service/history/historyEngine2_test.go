@@ -38,6 +38,7 @@ import (
 	"github.com/uber-go/tally"
 	commonpb "go.temporal.io/temporal-proto/common"
 	decisionpb "go.temporal.io/temporal-proto/decision"
+	eventpb "go.temporal.io/temporal-proto/event"
 	executionpb "go.temporal.io/temporal-proto/execution"
 	querypb "go.temporal.io/temporal-proto/query"
 	"go.temporal.io/temporal-proto/serviceerror"
@@ -755,6 +756,55 @@ func (s *engine2Suite) TestRecordActivityTaskStartedSuccess() {
 	s.Equal(scheduledEvent, response.ScheduledEvent)
 }
 
+func (s *engine2Suite) TestRecordActivityTaskStarted_TaskListChanged() {
+	namespaceID := testNamespaceID
+	workflowExecution := executionpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+
+	identity := "testIdentity"
+	tl := "testTaskList"
+
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, tl, identity, true)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, int64(2), int64(3), nil, identity)
+	scheduledEvent, ai := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.EventId, activityID,
+		activityType, tl, activityInput, 100, 10, 1, 5)
+	// the activity was moved to another task list after its task was dispatched
+	ai.TaskList = "otherTaskList"
+
+	ms1 := createMutableState(msBuilder)
+	gwmsResponse1 := &p.GetWorkflowExecutionResponse{State: ms1}
+
+	// the workflow is reloaded after each failed attempt
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse1, nil).Times(3)
+	s.mockEventsCache.EXPECT().getEvent(
+		namespaceID, workflowExecution.GetWorkflowId(), workflowExecution.GetRunId(),
+		decisionCompletedEvent.GetEventId(), scheduledEvent.GetEventId(), gomock.Any(),
+	).Return(scheduledEvent, nil).AnyTimes()
+
+	for _, pollTaskList := range []string{tl, "/__temporal_sys/" + tl + "/1", "thirdTaskList"} {
+		_, err := s.historyEngine.RecordActivityTaskStarted(context.Background(), &historyservice.RecordActivityTaskStartedRequest{
+			NamespaceId:       namespaceID,
+			WorkflowExecution: &workflowExecution,
+			ScheduleId:        5,
+			TaskId:            100,
+			RequestId:         "reqId",
+			PollRequest: &workflowservice.PollForActivityTaskRequest{
+				TaskList: &tasklistpb.TaskList{
+					Name: pollTaskList,
+				},
+				Identity: identity,
+			},
+		})
+		s.Equal(ErrActivityTaskNotFound, err)
+	}
+}
+
 func (s *engine2Suite) TestRequestCancelWorkflowExecutionSuccess() {
 	namespaceID := testNamespaceID
 	workflowExecution := executionpb.WorkflowExecution{
@@ -1180,9 +1230,17 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_JustSignal() {
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &p.GetWorkflowExecutionResponse{State: ms}
 	gceResponse := &p.GetCurrentExecutionResponse{RunID: runID}
+	startEvent := &eventpb.HistoryEvent{
+		EventId:    common.FirstEventID,
+		EventType:  eventpb.EventType_WorkflowExecutionStarted,
+		Attributes: &eventpb.HistoryEvent_WorkflowExecutionStartedEventAttributes{WorkflowExecutionStartedEventAttributes: &eventpb.WorkflowExecutionStartedEventAttributes{}},
+	}
 
 	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(gceResponse, nil).Once()
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockEventsCache.EXPECT().getEvent(
+		gomock.Any(), gomock.Any(), gomock.Any(), common.FirstEventID, common.FirstEventID, gomock.Any(),
+	).Return(startEvent, nil)
 	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
 	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{
 		MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{},
@@ -1234,6 +1292,57 @@ func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowNotExist() {
 	s.NotNil(resp.GetRunId())
 }
 
+func (s *engine2Suite) TestSignalWithStartWorkflowExecution_WorkflowNotExist_StartDelay() {
+	namespaceID := testNamespaceID
+	workflowID := "wId"
+	workflowType := "workflowType"
+	taskList := "testTaskList"
+	identity := "testIdentity"
+	signalName := "my signal name"
+	input := []byte("test input")
+	requestID := uuid.New()
+
+	sRequest := &historyservice.SignalWithStartWorkflowExecutionRequest{
+		NamespaceId: namespaceID,
+		SignalWithStartRequest: &workflowservice.SignalWithStartWorkflowExecutionRequest{
+			Namespace:                           namespaceID,
+			WorkflowId:                          workflowID,
+			WorkflowType:                        &commonpb.WorkflowType{Name: workflowType},
+			TaskList:                            &tasklistpb.TaskList{Name: taskList},
+			ExecutionStartToCloseTimeoutSeconds: 100,
+			TaskStartToCloseTimeoutSeconds:      2,
+			Identity:                            identity,
+			SignalName:                          signalName,
+			Input:                               input,
+			RequestId:                           requestID,
+		},
+		StartDelaySeconds: 10,
+	}
+
+	notExistErr := serviceerror.NewNotFound("Workflow not exist")
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(nil, notExistErr).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On(
+		"CreateWorkflowExecution",
+		mock.MatchedBy(func(request *p.CreateWorkflowExecutionRequest) bool {
+			if request.NewWorkflowSnapshot.ExecutionInfo.DecisionScheduleID != common.EmptyEventID {
+				return false
+			}
+			for _, task := range request.NewWorkflowSnapshot.TimerTasks {
+				if _, ok := task.(*p.WorkflowBackoffTimerTask); ok {
+					return true
+				}
+			}
+			return false
+		}),
+	).Return(&p.CreateWorkflowExecutionResponse{}, nil).Once()
+
+	resp, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
+	s.Nil(err)
+	s.NotNil(resp.GetRunId())
+}
+
 func (s *engine2Suite) TestSignalWithStartWorkflowExecution_CreateTimeout() {
 	sRequest := &historyservice.SignalWithStartWorkflowExecutionRequest{}
 	_, err := s.historyEngine.SignalWithStartWorkflowExecution(context.Background(), sRequest)
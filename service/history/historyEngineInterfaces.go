@@ -79,6 +79,7 @@ type (
 		GetWorkflowId() string
 		GetRunId() []byte
 		GetNamespaceId() []byte
+		GetPriority() int32
 	}
 
 	queueTask interface {
@@ -158,6 +158,20 @@ func (mr *MockqueueTaskInfoMockRecorder) GetNamespaceID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceId", reflect.TypeOf((*MockqueueTaskInfo)(nil).GetNamespaceId))
 }
 
+// GetPriority mocks base method
+func (m *MockqueueTaskInfo) GetPriority() int32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriority")
+	ret0, _ := ret[0].(int32)
+	return ret0
+}
+
+// GetPriority indicates an expected call of GetPriority
+func (mr *MockqueueTaskInfoMockRecorder) GetPriority() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriority", reflect.TypeOf((*MockqueueTaskInfo)(nil).GetPriority))
+}
+
 // MockqueueTask is a mock of queueTask interface
 type MockqueueTask struct {
 	ctrl     *gomock.Controller
@@ -385,6 +399,20 @@ func (mr *MockqueueTaskMockRecorder) GetNamespaceID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNamespaceId", reflect.TypeOf((*MockqueueTask)(nil).GetNamespaceId))
 }
 
+// GetPriority mocks base method
+func (m *MockqueueTask) GetPriority() int32 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPriority")
+	ret0, _ := ret[0].(int32)
+	return ret0
+}
+
+// GetPriority indicates an expected call of GetPriority
+func (mr *MockqueueTaskMockRecorder) GetPriority() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPriority", reflect.TypeOf((*MockqueueTask)(nil).GetPriority))
+}
+
 // GetQueueType mocks base method
 func (m *MockqueueTask) GetQueueType() queueType {
 	m.ctrl.T.Helper()
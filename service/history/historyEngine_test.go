@@ -47,6 +47,8 @@ import (
 	tasklistpb "go.temporal.io/temporal-proto/tasklist"
 	"go.temporal.io/temporal-proto/workflowservice"
 
+	"github.com/temporalio/temporal/.gen/proto/adminservice"
+	eventgenpb "github.com/temporalio/temporal/.gen/proto/event"
 	executiongenpb "github.com/temporalio/temporal/.gen/proto/execution"
 	"github.com/temporalio/temporal/.gen/proto/historyservice"
 	"github.com/temporalio/temporal/.gen/proto/historyservicemock"
@@ -59,6 +61,7 @@ import (
 	"github.com/temporalio/temporal/common/cache"
 	"github.com/temporalio/temporal/common/clock"
 	"github.com/temporalio/temporal/common/cluster"
+	"github.com/temporalio/temporal/common/definition"
 	"github.com/temporalio/temporal/common/headers"
 	"github.com/temporalio/temporal/common/log"
 	"github.com/temporalio/temporal/common/log/loggerimpl"
@@ -4780,6 +4783,45 @@ func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest() {
 	s.Nil(err)
 }
 
+// Test a retried signal within the deduplication window is accepted without being recorded again
+func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequestWithinWindow() {
+	we := executionpb.WorkflowExecution{
+		WorkflowId: "wId2",
+		RunId:      testRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	requestID := uuid.New()
+	signalRequest := &historyservice.SignalWorkflowExecutionRequest{
+		NamespaceId: testNamespaceID,
+		SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+			Namespace:         testNamespaceID,
+			WorkflowExecution: &we,
+			Identity:          identity,
+			SignalName:        "my signal name 2",
+			Input:             []byte("test input 2"),
+			RequestId:         requestID,
+		},
+	}
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.NamespaceID = testNamespaceID
+	ms.ExecutionInfo.SignalRequestIDTimestamps = map[string]time.Time{requestID: time.Now().Add(-time.Minute)}
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	// no UpdateWorkflowExecution is expected, the duplicate signal does not change the workflow
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+	executionBuilder := s.getBuilder(testNamespaceID, we)
+	s.Equal(int32(0), executionBuilder.GetExecutionInfo().SignalCount)
+}
+
 func (s *engineSuite) TestSignalWorkflowExecution_Failed() {
 	signalRequest := &historyservice.SignalWorkflowExecutionRequest{}
 	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
@@ -4851,6 +4893,921 @@ func (s *engineSuite) TestRemoveSignalMutableState() {
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestPauseWorkflowExecution() {
+	pauseRequest := &historyservice.PauseWorkflowExecutionRequest{}
+	err := s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), pauseRequest)
+	s.EqualError(err, "Missing namespace UUID.")
+
+	execution := executionpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	pauseRequest = &historyservice.PauseWorkflowExecutionRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.PauseWorkflowExecutionRequest{
+			Execution: &execution,
+			Reason:    "pause reason",
+			Identity:  identity,
+		},
+	}
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), testRunID)
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", tasklist, []byte("input"), 100, 200, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.NamespaceID = testNamespaceID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err = s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), pauseRequest)
+	s.Nil(err)
+
+	// the pause is kept in mutable state only, no event is recorded in history
+	executionBuilder := s.getBuilder(testNamespaceID, execution)
+	s.True(executionBuilder.IsWorkflowExecutionPaused())
+	s.Equal(int64(3), executionBuilder.GetNextEventID())
+	s.Equal(int32(0), executionBuilder.GetExecutionInfo().SignalCount)
+	s.Equal("pause reason", executionBuilder.GetExecutionInfo().PauseReason)
+	s.Equal(identity, executionBuilder.GetExecutionInfo().PauseIdentity)
+	s.Equal([]byte("true"), executionBuilder.GetExecutionInfo().SearchAttributes[definition.TemporalPaused])
+	s.Equal([]byte(`"pause reason"`), executionBuilder.GetExecutionInfo().SearchAttributes[definition.TemporalPauseReason])
+	s.Equal([]byte(`"testIdentity"`), executionBuilder.GetExecutionInfo().SearchAttributes[definition.TemporalPauseIdentity])
+
+	// pausing a paused workflow is a no-op
+	err = s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), pauseRequest)
+	s.Nil(err)
+
+	_, err = s.mockHistoryEngine.RecordDecisionTaskStarted(context.Background(), &historyservice.RecordDecisionTaskStartedRequest{
+		NamespaceId:       testNamespaceID,
+		WorkflowExecution: &execution,
+		ScheduleId:        2,
+		TaskId:            100,
+		RequestId:         "reqId",
+		PollRequest: &workflowservice.PollForDecisionTaskRequest{
+			TaskList: &tasklistpb.TaskList{
+				Name: tasklist,
+			},
+			Identity: identity,
+		},
+	})
+	s.Equal(ErrWorkflowPaused, err)
+}
+
+func (s *engineSuite) TestReplicateWorkflowState() {
+	execution := executionpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), testRunID)
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", "testTaskList", []byte("input"), 100, 200, identity)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.NamespaceID = testNamespaceID
+	ms.ExecutionInfo.SyncStateVersion = 10
+	cronSkipUntil := time.Now().Add(time.Hour)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	// a task older than the state is discarded
+	err := s.mockHistoryEngine.ReplicateWorkflowState(context.Background(), &replicationgenpb.SyncWorkflowStateTaskAttributes{
+		NamespaceId: testNamespaceID,
+		WorkflowId:  execution.GetWorkflowId(),
+		RunId:       execution.GetRunId(),
+		Version:     9,
+		Paused:      true,
+	})
+	s.Nil(err)
+	s.False(s.getBuilder(testNamespaceID, execution).IsWorkflowExecutionPaused())
+
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err = s.mockHistoryEngine.ReplicateWorkflowState(context.Background(), &replicationgenpb.SyncWorkflowStateTaskAttributes{
+		NamespaceId:   testNamespaceID,
+		WorkflowId:    execution.GetWorkflowId(),
+		RunId:         execution.GetRunId(),
+		Version:       11,
+		Paused:        true,
+		PauseReason:   "pause reason",
+		PauseIdentity: identity,
+		CronSkipUntil: cronSkipUntil.UnixNano(),
+		SearchAttributes: map[string][]byte{
+			"CustomKeywordField": []byte(`"updated"`),
+			"CustomIntField":     []byte("1"),
+		},
+		Memo:                    map[string][]byte{"memoKey": []byte(`"memoValue"`)},
+		UpdatedSearchAttributes: map[string][]byte{"CustomKeywordField": []byte(`"updated"`)},
+		UpdatedMemo:             map[string][]byte{"memoKey": []byte(`"memoValue"`)},
+		UpdateResults: map[string]*eventgenpb.WorkflowUpdateResult{
+			"update-id": {UpdateId: "update-id", Result: []byte("result"), AcceptedEventId: 4},
+		},
+	})
+	s.Nil(err)
+
+	executionBuilder := s.getBuilder(testNamespaceID, execution)
+	s.True(executionBuilder.IsWorkflowExecutionPaused())
+	s.Equal("pause reason", executionBuilder.GetExecutionInfo().PauseReason)
+	s.Equal(identity, executionBuilder.GetExecutionInfo().PauseIdentity)
+	s.Equal(int64(11), executionBuilder.GetExecutionInfo().SyncStateVersion)
+	s.True(cronSkipUntil.Equal(executionBuilder.GetExecutionInfo().CronSkipUntil))
+	s.Equal([]byte("1"), executionBuilder.GetExecutionInfo().SearchAttributes["CustomIntField"])
+	s.Equal([]byte(`"updated"`), executionBuilder.GetExecutionInfo().UpdatedSearchAttributes["CustomKeywordField"])
+	s.Equal([]byte(`"memoValue"`), executionBuilder.GetExecutionInfo().Memo["memoKey"])
+	s.Equal([]byte(`"memoValue"`), executionBuilder.GetExecutionInfo().UpdatedMemo["memoKey"])
+	updateResult, ok := executionBuilder.GetUpdateResult("update-id")
+	s.True(ok)
+	s.Equal([]byte("result"), updateResult.GetResult())
+}
+
+func (s *engineSuite) TestTriggerCronRun_NotCronWorkflow() {
+	execution := executionpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), testRunID)
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", "testTaskList", []byte("input"), 100, 200, identity)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.TriggerCronRun(context.Background(), &historyservice.TriggerCronRunRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.TriggerCronRunRequest{
+			Execution: &execution,
+			Identity:  identity,
+		},
+	})
+	s.Equal(ErrNotCronWorkflow, err)
+}
+
+func (s *engineSuite) TestTriggerCronRun() {
+	execution := executionpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), testRunID)
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", "testTaskList", []byte("input"), 100, 200, identity)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.CronSchedule = "CRON_TZ=UTC 0 10 * * *"
+	ms.ExecutionInfo.CronSkipUntil = time.Now().Add(48 * time.Hour)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	triggerRequest := &historyservice.TriggerCronRunRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.TriggerCronRunRequest{
+			Execution: &execution,
+			Identity:  identity,
+		},
+	}
+	err := s.mockHistoryEngine.TriggerCronRun(context.Background(), triggerRequest)
+	s.Nil(err)
+
+	executionBuilder := s.getBuilder(testNamespaceID, execution)
+	s.True(executionBuilder.HasPendingDecision())
+	s.True(executionBuilder.GetExecutionInfo().CronSkipUntil.IsZero())
+
+	// the run has started, it cannot be triggered again
+	err = s.mockHistoryEngine.TriggerCronRun(context.Background(), triggerRequest)
+	s.Equal(ErrCronRunStarted, err)
+}
+
+func (s *engineSuite) TestSkipCronRuns() {
+	execution := executionpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), testRunID)
+	addWorkflowExecutionStartedEvent(msBuilder, execution, "wType", "testTaskList", []byte("input"), 100, 200, identity)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.CronSchedule = "CRON_TZ=UTC 0 10 * * *"
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	now := time.Now()
+	resp, err := s.mockHistoryEngine.SkipCronRuns(context.Background(), &historyservice.SkipCronRunsRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.SkipCronRunsRequest{
+			Execution: &execution,
+			Count:     2,
+			Identity:  identity,
+		},
+	})
+	s.Nil(err)
+
+	nextRunTime := time.Unix(0, resp.GetNextRunTime())
+	s.True(nextRunTime.After(now.Add(24 * time.Hour)))
+	executionBuilder := s.getBuilder(testNamespaceID, execution)
+	s.Equal(nextRunTime.UnixNano(), executionBuilder.GetExecutionInfo().CronSkipUntil.UnixNano())
+	s.False(executionBuilder.HasPendingDecision())
+}
+
+func (s *engineSuite) TestUpdateActivityOptions() {
+	we := executionpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.EventId, activityID,
+		"activity_type1", tl, []byte("input1"), 100, 10, 1, 0)
+
+	ms := createMutableState(msBuilder)
+	ms.ActivityInfos[activityScheduledEvent.GetEventId()].ScheduledEvent = activityScheduledEvent
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.UpdateActivityOptions(context.Background(), &historyservice.UpdateActivityOptionsRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.UpdateActivityOptionsRequest{
+			Execution:                  &we,
+			ActivityId:                 activityID,
+			StartToCloseTimeoutSeconds: 60,
+			TaskList:                   "slowTaskList",
+			RetryPolicy: &commonpb.RetryPolicy{
+				InitialIntervalInSeconds:    1,
+				BackoffCoefficient:          2,
+				ExpirationIntervalInSeconds: 1000,
+			},
+			Identity: identity,
+		},
+	})
+	s.Nil(err)
+
+	executionBuilder := s.getBuilder(testNamespaceID, we)
+	ai, ok := executionBuilder.GetActivityByActivityID(activityID)
+	s.True(ok)
+	s.Equal(int32(10), ai.ScheduleToStartTimeout)
+	s.Equal(int32(60), ai.StartToCloseTimeout)
+	s.Equal("slowTaskList", ai.TaskList)
+	s.True(ai.HasRetryPolicy)
+	s.Equal(int32(1), ai.InitialInterval)
+	s.Equal(time.Unix(0, activityScheduledEvent.GetTimestamp()).Add(1000*time.Second).UnixNano(), ai.ExpirationTime.UnixNano())
+	// the update is recorded in the activity info, not in the history
+	s.Equal(identity, ai.OptionsUpdateIdentity)
+	s.False(ai.OptionsUpdateTime.IsZero())
+	s.Equal(int32(0), executionBuilder.GetExecutionInfo().SignalCount)
+	s.Equal(activityScheduledEvent.GetEventId()+1, executionBuilder.GetNextEventID())
+
+	// the options are reflected in the scheduled event sent to the worker, the original event is unchanged
+	scheduledEvent := applyActivityOptions(activityScheduledEvent, ai)
+	s.Equal(int32(60), scheduledEvent.GetActivityTaskScheduledEventAttributes().GetStartToCloseTimeoutSeconds())
+	s.Equal("slowTaskList", scheduledEvent.GetActivityTaskScheduledEventAttributes().GetTaskList().GetName())
+	s.Equal(int32(1), activityScheduledEvent.GetActivityTaskScheduledEventAttributes().GetStartToCloseTimeoutSeconds())
+	s.Equal(tl, activityScheduledEvent.GetActivityTaskScheduledEventAttributes().GetTaskList().GetName())
+}
+
+func (s *engineSuite) TestUpdateActivityOptions_ActivityNotFound() {
+	we := executionpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", "testTaskList", []byte("input"), 100, 100, identity)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.UpdateActivityOptions(context.Background(), &historyservice.UpdateActivityOptionsRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.UpdateActivityOptionsRequest{
+			Execution:               &we,
+			ActivityId:              "activity1_id",
+			HeartbeatTimeoutSeconds: 10,
+			Identity:                identity,
+		},
+	})
+	s.Equal(ErrActivityTaskNotFound, err)
+}
+
+func (s *engineSuite) TestResetActivity() {
+	we := executionpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.EventId, activityID,
+		"activity_type1", tl, []byte("input1"), 100, 10, 1, 0)
+
+	ms := createMutableState(msBuilder)
+	ai := ms.ActivityInfos[activityScheduledEvent.GetEventId()]
+	ai.ScheduledEvent = activityScheduledEvent
+	ai.HasRetryPolicy = true
+	ai.Attempt = 3
+	ai.ScheduledTime = time.Now().Add(time.Hour)
+	ai.ExpirationTime = time.Unix(0, activityScheduledEvent.GetTimestamp()).Add(2 * time.Hour)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.ResetActivity(context.Background(), &historyservice.ResetActivityRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.ResetActivityRequest{
+			Execution:     &we,
+			ActivityId:    activityID,
+			ResetAttempts: true,
+			Identity:      identity,
+		},
+	})
+	s.Nil(err)
+
+	executionBuilder := s.getBuilder(testNamespaceID, we)
+	ai, ok := executionBuilder.GetActivityByActivityID(activityID)
+	s.True(ok)
+	s.Equal(int32(0), ai.Attempt)
+	s.False(ai.ScheduledTime.After(time.Now()))
+	s.True(ai.ExpirationTime.After(time.Now().Add(time.Hour)))
+}
+
+func (s *engineSuite) TestResetActivity_NotWaitingForRetry() {
+	we := executionpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.EventId, activityID,
+		"activity_type1", tl, []byte("input1"), 100, 10, 1, 0)
+
+	ms := createMutableState(msBuilder)
+	ms.ActivityInfos[activityScheduledEvent.GetEventId()].ScheduledEvent = activityScheduledEvent
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.ResetActivity(context.Background(), &historyservice.ResetActivityRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.ResetActivityRequest{
+			Execution:  &we,
+			ActivityId: activityID,
+			Identity:   identity,
+		},
+	})
+	s.Equal(ErrActivityNotWaitingForRetry, err)
+}
+
+func (s *engineSuite) TestResetActivity_Started() {
+	we := executionpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.EventId, nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.EventId, activityID,
+		"activity_type1", tl, []byte("input1"), 100, 10, 1, 0)
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), identity)
+
+	ms := createMutableState(msBuilder)
+	ai := ms.ActivityInfos[activityScheduledEvent.GetEventId()]
+	ai.ScheduledEvent = activityScheduledEvent
+	ai.HasRetryPolicy = true
+	ai.Attempt = 3
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.ResetActivity(context.Background(), &historyservice.ResetActivityRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.ResetActivityRequest{
+			Execution:     &we,
+			ActivityId:    activityID,
+			ResetAttempts: true,
+			Identity:      identity,
+		},
+	})
+	s.Equal(ErrActivityStarted, err)
+}
+
+func (s *engineSuite) TestDeleteWorkflowExecution_Running() {
+	we := executionpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Twice()
+
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), &historyservice.DeleteWorkflowExecutionRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.DeleteWorkflowExecutionRequest{
+			Execution: &we,
+			Reason:    "cleanup",
+			Identity:  identity,
+		},
+	})
+	s.Nil(err)
+
+	executionBuilder := s.getBuilder(testNamespaceID, we)
+	s.False(executionBuilder.IsWorkflowExecutionRunning())
+	s.Equal(executionpb.WorkflowExecutionStatus_Terminated, executionBuilder.GetExecutionInfo().Status)
+}
+
+func (s *engineSuite) TestDeleteWorkflowExecution_Completed() {
+	we := executionpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.EventId, nil, identity)
+	addCompleteWorkflowEvent(msBuilder, decisionCompletedEvent.EventId, nil)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		transferTasks := request.UpdateWorkflowMutation.TransferTasks
+		return len(transferTasks) == 1 && transferTasks[0].GetType() == persistence.TransferTaskTypeDeleteExecution
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), &historyservice.DeleteWorkflowExecutionRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.DeleteWorkflowExecutionRequest{
+			Execution: &we,
+			Identity:  identity,
+		},
+	})
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestUpsertWorkflowAttributes() {
+	we := executionpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.SearchAttributes = map[string][]byte{"CustomKeywordField": []byte(`"old"`), "CustomIntField": []byte("1")}
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.UpsertWorkflowAttributes(context.Background(), &historyservice.UpsertWorkflowAttributesRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.UpsertWorkflowAttributesRequest{
+			Execution: &we,
+			SearchAttributes: &commonpb.SearchAttributes{
+				IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"new"`)},
+			},
+			Memo: &commonpb.Memo{
+				Fields: map[string][]byte{"memoKey": []byte(`"memoValue"`)},
+			},
+			Identity: identity,
+		},
+	})
+	s.Nil(err)
+
+	executionInfo := s.getBuilder(testNamespaceID, we).GetExecutionInfo()
+	s.Equal([]byte(`"new"`), executionInfo.SearchAttributes["CustomKeywordField"])
+	s.Equal([]byte("1"), executionInfo.SearchAttributes["CustomIntField"])
+	s.Equal([]byte(`"memoValue"`), executionInfo.Memo["memoKey"])
+	// only the updated attributes are kept apart, they are carried over when the workflow is rebuilt from history
+	s.Equal(map[string][]byte{"CustomKeywordField": []byte(`"new"`)}, executionInfo.UpdatedSearchAttributes)
+	s.Equal(map[string][]byte{"memoKey": []byte(`"memoValue"`)}, executionInfo.UpdatedMemo)
+	s.Equal(executionInfo.SyncStateVersion, s.getBuilder(testNamespaceID, we).GetCurrentVersion())
+}
+
+func (s *engineSuite) TestUpsertWorkflowAttributes_Completed() {
+	we := executionpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 100, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.EventId, nil, identity)
+	addCompleteWorkflowEvent(msBuilder, decisionCompletedEvent.EventId, nil)
+
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.SearchAttributes = map[string][]byte{"CustomIntField": []byte("1")}
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	// the mutable state of the closed workflow is not updated, its visibility record is rewritten
+	mockVisibilityMgr := s.mockShard.resource.VisibilityMgr
+	s.mockHistoryEngine.visibilityMgr = mockVisibilityMgr
+	mockVisibilityMgr.On("RecordWorkflowExecutionClosed", mock.MatchedBy(func(request *persistence.RecordWorkflowExecutionClosedRequest) bool {
+		return request.Execution.GetRunId() == we.GetRunId() &&
+			request.Status == executionpb.WorkflowExecutionStatus_Completed &&
+			string(request.Memo.GetFields()["memoKey"]) == `"memoValue"` &&
+			string(request.SearchAttributes["CustomIntField"]) == "1" &&
+			string(request.SearchAttributes["CustomKeywordField"]) == `"new"`
+	})).Return(nil).Once()
+
+	err := s.mockHistoryEngine.UpsertWorkflowAttributes(context.Background(), &historyservice.UpsertWorkflowAttributesRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.UpsertWorkflowAttributesRequest{
+			Execution: &we,
+			SearchAttributes: &commonpb.SearchAttributes{
+				IndexedFields: map[string][]byte{"CustomKeywordField": []byte(`"new"`)},
+			},
+			Memo: &commonpb.Memo{
+				Fields: map[string][]byte{"memoKey": []byte(`"memoValue"`)},
+			},
+			Identity: identity,
+		},
+	})
+	s.Nil(err)
+	mockVisibilityMgr.AssertExpectations(s.T())
+	s.Empty(s.getBuilder(testNamespaceID, we).GetExecutionInfo().Memo)
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_DecisionTaskDispatch_Complete() {
+	we := executionpb.WorkflowExecution{
+		WorkflowId: "TestUpdateWorkflowExecution_DecisionTaskDispatch_Complete",
+		RunId:      testRunID,
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	// the update waits for the started decision task, so no new decision task is persisted
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	waitGroup := &sync.WaitGroup{}
+	waitGroup.Add(1)
+	go func() {
+		defer waitGroup.Done()
+		<-time.After(time.Second)
+		ur := s.getBuilder(testNamespaceID, we).GetUpdateRegistry()
+		s.Equal([]string{"update-id"}, ur.getBufferedIDs())
+		s.NoError(ur.deliverUpdate("update-id"))
+		s.NoError(ur.setTerminationState("update-id", &updateTerminationState{
+			updateTerminationType: updateTerminationTypeCompleted,
+			updateResult: &eventgenpb.WorkflowUpdateResult{
+				UpdateId: "update-id",
+				Result:   []byte("result"),
+			},
+		}))
+	}()
+
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), &historyservice.UpdateWorkflowExecutionRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.UpdateWorkflowExecutionRequest{
+			Execution: &we,
+			UpdateId:  "update-id",
+			Name:      "update-name",
+			Input:     []byte("input"),
+			Identity:  identity,
+		},
+	})
+	waitGroup.Wait()
+	s.NoError(err)
+	s.Equal("update-id", resp.GetResponse().GetUpdateId())
+	s.Equal([]byte("result"), resp.GetResponse().GetResult())
+	s.Empty(resp.GetResponse().GetFailureMessage())
+
+	ur := s.getBuilder(testNamespaceID, we).GetUpdateRegistry()
+	s.False(ur.hasBufferedUpdate())
+	s.False(ur.hasDeliveredUpdate())
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_ScheduleDecisionTask_Timeout() {
+	we := executionpb.WorkflowExecution{
+		WorkflowId: "TestUpdateWorkflowExecution_ScheduleDecisionTask_Timeout",
+		RunId:      testRunID,
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, startedEvent.EventId, nil, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockHistoryEngine.config.UpdateWorkflowExecutionTimeout = dynamicconfig.GetDurationPropertyFnFilteredByNamespace(100 * time.Millisecond)
+
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), &historyservice.UpdateWorkflowExecutionRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.UpdateWorkflowExecutionRequest{
+			Execution: &we,
+			UpdateId:  "update-id",
+			Name:      "update-name",
+		},
+	})
+	s.Nil(resp)
+	s.Equal(ErrUpdateTimeout, err)
+
+	builder := s.getBuilder(testNamespaceID, we)
+	s.True(builder.HasPendingDecision())
+	s.False(builder.GetUpdateRegistry().hasBufferedUpdate())
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_BufferFull() {
+	we := executionpb.WorkflowExecution{
+		WorkflowId: "TestUpdateWorkflowExecution_BufferFull",
+		RunId:      testRunID,
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryEngine.config.MaxBufferedUpdateCount = dynamicconfig.GetIntPropertyFn(1)
+
+	// buffer an update so that the buffer is already full
+	ctx, release, err := s.mockHistoryEngine.historyCache.getOrCreateWorkflowExecutionForBackground(testNamespaceID, we)
+	s.NoError(err)
+	loadedMS, err := ctx.loadWorkflowExecution()
+	s.NoError(err)
+	_, err = loadedMS.GetUpdateRegistry().bufferUpdate(&eventgenpb.WorkflowUpdate{UpdateId: "buffered-update-id"})
+	s.NoError(err)
+	release(nil)
+
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), &historyservice.UpdateWorkflowExecutionRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.UpdateWorkflowExecutionRequest{
+			Execution: &we,
+			UpdateId:  "update-id",
+			Name:      "update-name",
+		},
+	})
+	s.Nil(resp)
+	s.Equal(ErrUpdateBufferExceeded, err)
+	// the buffered update survives the rejected one
+	s.Equal([]string{"buffered-update-id"}, s.getBuilder(testNamespaceID, we).GetUpdateRegistry().getBufferedIDs())
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_Completed() {
+	we := executionpb.WorkflowExecution{
+		WorkflowId: "TestUpdateWorkflowExecution_Completed",
+		RunId:      testRunID,
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	completedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, startedEvent.EventId, nil, identity)
+	addCompleteWorkflowEvent(msBuilder, completedEvent.EventId, nil)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), &historyservice.UpdateWorkflowExecutionRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.UpdateWorkflowExecutionRequest{
+			Execution: &we,
+			UpdateId:  "update-id",
+			Name:      "update-name",
+		},
+	})
+	s.Nil(resp)
+	s.Equal(ErrWorkflowCompleted, err)
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_Recorded() {
+	we := executionpb.WorkflowExecution{
+		WorkflowId: "TestUpdateWorkflowExecution_Recorded",
+		RunId:      testRunID,
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	completedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, startedEvent.EventId, nil, identity)
+	addCompleteWorkflowEvent(msBuilder, completedEvent.EventId, nil)
+
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.UpdateResults = map[string]*eventgenpb.WorkflowUpdateResult{
+		"update-id": {
+			UpdateId:        "update-id",
+			Result:          []byte("result"),
+			AcceptedEventId: completedEvent.EventId,
+		},
+	}
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	// the retry gets the recorded result, also after the workflow completed
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), &historyservice.UpdateWorkflowExecutionRequest{
+		NamespaceId: testNamespaceID,
+		Request: &adminservice.UpdateWorkflowExecutionRequest{
+			Execution: &we,
+			UpdateId:  "update-id",
+			Name:      "update-name",
+		},
+	})
+	s.NoError(err)
+	s.Equal("update-id", resp.GetResponse().GetUpdateId())
+	s.Equal([]byte("result"), resp.GetResponse().GetResult())
+	s.False(s.getBuilder(testNamespaceID, we).GetUpdateRegistry().hasBufferedUpdate())
+}
+
+func (s *engineSuite) TestRespondDecisionTaskCompleted_UpdateResults() {
+	we := executionpb.WorkflowExecution{
+		WorkflowId: "wId",
+		RunId:      testRunID,
+	}
+	tl := "testTaskList"
+	tt := &tokengenpb.Task{
+		WorkflowId: we.WorkflowId,
+		RunId:      primitives.MustParseUUID(we.RunId),
+		ScheduleId: 2,
+	}
+	taskToken, _ := tt.Marshal()
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&persistence.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	// the results are persisted with the decision task completion
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		updateResults := request.UpdateWorkflowMutation.ExecutionInfo.UpdateResults
+		return len(updateResults) == 2 && updateResults["accepted"] != nil && updateResults["rejected"] != nil
+	})).Return(&persistence.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &persistence.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	// three updates were delivered on the started decision task and one more arrived while it was running
+	ctx, release, err := s.mockHistoryEngine.historyCache.getOrCreateWorkflowExecutionForBackground(testNamespaceID, we)
+	s.NoError(err)
+	loadedMS, err := ctx.loadWorkflowExecution()
+	s.NoError(err)
+	ur := loadedMS.GetUpdateRegistry()
+	termChs := make(map[string]<-chan struct{})
+	for _, id := range []string{"accepted", "rejected", "unhandled", "buffered"} {
+		termCh, err := ur.bufferUpdate(&eventgenpb.WorkflowUpdate{UpdateId: id, Name: "update-name"})
+		s.NoError(err)
+		termChs[id] = termCh
+		if id != "buffered" {
+			s.NoError(ur.deliverUpdate(id))
+		}
+	}
+	release(nil)
+
+	_, err = s.mockHistoryEngine.RespondDecisionTaskCompleted(context.Background(), &historyservice.RespondDecisionTaskCompletedRequest{
+		NamespaceId: testNamespaceID,
+		CompleteRequest: &workflowservice.RespondDecisionTaskCompletedRequest{
+			TaskToken: taskToken,
+			Identity:  identity,
+			QueryResults: map[string]*querypb.WorkflowQueryResult{
+				"accepted": {
+					ResultType: querypb.QueryResultType_Answered,
+					Answer:     []byte("result"),
+				},
+				"rejected": {
+					ResultType:   querypb.QueryResultType_Failed,
+					ErrorMessage: "rejected",
+				},
+			},
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+
+	// the results are recorded in the mutable state instead of history and replicated with the workflow state,
+	// the buffered update is delivered on a new decision task
+	executionBuilder := s.getBuilder(testNamespaceID, we)
+	executionInfo := executionBuilder.GetExecutionInfo()
+	s.Equal(int64(6), executionInfo.NextEventID)
+	s.True(executionBuilder.HasPendingDecision())
+	s.Len(executionInfo.UpdateResults, 2)
+	s.Equal([]byte("result"), executionInfo.UpdateResults["accepted"].GetResult())
+	s.Equal(int64(4), executionInfo.UpdateResults["accepted"].GetAcceptedEventId())
+	s.NotZero(executionInfo.UpdateResults["accepted"].GetCompletedTimestamp())
+	s.Equal("rejected", executionInfo.UpdateResults["rejected"].GetFailureMessage())
+	s.Equal(executionBuilder.GetCurrentVersion(), executionInfo.SyncStateVersion)
+
+	<-termChs["accepted"]
+	state, err := ur.getTerminationState("accepted")
+	s.NoError(err)
+	s.Equal(updateTerminationTypeCompleted, state.updateTerminationType)
+	s.Equal([]byte("result"), state.updateResult.GetResult())
+
+	<-termChs["rejected"]
+	state, err = ur.getTerminationState("rejected")
+	s.NoError(err)
+	s.Equal(updateTerminationTypeCompleted, state.updateTerminationType)
+	s.Equal("rejected", state.updateResult.GetFailureMessage())
+
+	<-termChs["unhandled"]
+	state, err = ur.getTerminationState("unhandled")
+	s.NoError(err)
+	s.Equal(updateTerminationTypeFailed, state.updateTerminationType)
+	s.Equal(ErrUpdateNotHandled, state.failure)
+
+	s.Equal([]string{"buffered"}, ur.getBufferedIDs())
+}
+
 func (s *engineSuite) getBuilder(testNamespaceID string, we executionpb.WorkflowExecution) mutableState {
 	context, release, err := s.mockHistoryEngine.historyCache.getOrCreateWorkflowExecutionForBackground(testNamespaceID, we)
 	if err != nil {
@@ -35,8 +35,11 @@ import (
 	executionpb "go.temporal.io/temporal-proto/execution"
 	"go.temporal.io/temporal-proto/workflowservice"
 
+	"github.com/temporalio/temporal/.gen/proto/adminservice"
+	eventgenpb "github.com/temporalio/temporal/.gen/proto/event"
 	"github.com/temporalio/temporal/.gen/proto/historyservice"
 	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
+	replicationgenpb "github.com/temporalio/temporal/.gen/proto/replication"
 	"github.com/temporalio/temporal/common/cache"
 	"github.com/temporalio/temporal/common/definition"
 	"github.com/temporalio/temporal/common/persistence"
@@ -105,6 +108,7 @@ type (
 		AddTimerCanceledEvent(int64, *decisionpb.CancelTimerDecisionAttributes, string) (*eventpb.HistoryEvent, error)
 		AddTimerFiredEvent(string) (*eventpb.HistoryEvent, error)
 		AddTimerStartedEvent(int64, *decisionpb.StartTimerDecisionAttributes) (*eventpb.HistoryEvent, *persistenceblobs.TimerInfo, error)
+		AddUpdateResult(int64, *eventgenpb.WorkflowUpdateResult) error
 		AddUpsertWorkflowSearchAttributesEvent(int64, *decisionpb.UpsertWorkflowSearchAttributesDecisionAttributes) (*eventpb.HistoryEvent, error)
 		AddWorkflowExecutionCancelRequestedEvent(string, *historyservice.RequestCancelWorkflowExecutionRequest) (*eventpb.HistoryEvent, error)
 		AddWorkflowExecutionCanceledEvent(int64, *decisionpb.CancelWorkflowExecutionDecisionAttributes) (*eventpb.HistoryEvent, error)
@@ -158,16 +162,22 @@ type (
 		GetWorkflowType() *commonpb.WorkflowType
 		GetWorkflowStateStatus() (int, executionpb.WorkflowExecutionStatus)
 		GetQueryRegistry() queryRegistry
+		GetUpdateRegistry() updateRegistry
+		GetUpdateResult(updateID string) (*eventgenpb.WorkflowUpdateResult, bool)
 		HasBufferedEvents() bool
 		HasInFlightDecision() bool
 		HasParentExecution() bool
 		HasPendingDecision() bool
 		HasProcessedOrPendingDecision() bool
+		InheritUpdatedWorkflowAttributes(baseMutableState mutableState)
 		IsCancelRequested() (bool, string)
 		IsCurrentWorkflowGuaranteed() bool
 		IsSignalRequested(requestID string) bool
 		IsStickyTaskListEnabled() bool
 		IsWorkflowExecutionRunning() bool
+		IsWorkflowExecutionPaused() bool
+		PauseWorkflowExecution(reason string, identity string) error
+		UnpauseWorkflowExecution() error
 		IsResourceDuplicated(resourceDedupKey definition.DeduplicationID) bool
 		UpdateDuplicatedResource(resourceDedupKey definition.DeduplicationID)
 		Load(*persistence.WorkflowMutableState)
@@ -212,21 +222,28 @@ type (
 		ReplicateWorkflowExecutionStartedEvent(string, executionpb.WorkflowExecution, string, *eventpb.HistoryEvent) error
 		ReplicateWorkflowExecutionTerminatedEvent(int64, *eventpb.HistoryEvent) error
 		ReplicateWorkflowExecutionTimedoutEvent(int64, *eventpb.HistoryEvent) error
+		ReplicateWorkflowState(*replicationgenpb.SyncWorkflowStateTaskAttributes) error
 		SetCurrentBranchToken(branchToken []byte) error
 		SetHistoryBuilder(hBuilder *historyBuilder)
 		SetHistoryTree(treeID []byte) error
 		SetVersionHistories(*persistence.VersionHistories) error
+		StartNewRunAfterTermination(parentNamespace string, attributes *decisionpb.ContinueAsNewWorkflowExecutionDecisionAttributes) (mutableState, error)
+		ResetActivityRetry(ai *persistence.ActivityInfo, resetAttempts bool) error
 		UpdateActivity(*persistence.ActivityInfo) error
+		UpdateActivityOptions(ai *persistence.ActivityInfo, request *adminservice.UpdateActivityOptionsRequest) error
 		UpdateActivityProgress(ai *persistence.ActivityInfo, request *workflowservice.RecordActivityTaskHeartbeatRequest)
 		UpdateDecision(*decisionInfo)
 		UpdateReplicationStateVersion(int64, bool)
 		UpdateReplicationStateLastEventID(int64, int64)
 		UpdateUserTimer(*persistenceblobs.TimerInfo) error
+		UpdateWorkflowAttributes(searchAttributes map[string][]byte, memo map[string][]byte) error
 		UpdateCurrentVersion(version int64, forceUpdate bool) error
+		UpdateCronSkipUntil(skipUntil time.Time) error
 		UpdateWorkflowStateStatus(state int, status executionpb.WorkflowExecutionStatus) error
 
 		AddTransferTasks(transferTasks ...persistence.Task)
 		AddTimerTasks(timerTasks ...persistence.Task)
+		AddReplicationTasks(replicationTasks ...persistence.Task)
 		SetUpdateCondition(int64)
 		GetUpdateCondition() int64
 
@@ -28,6 +28,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"sort"
 	"time"
 
 	"github.com/gogo/protobuf/types"
@@ -40,6 +41,8 @@ import (
 	tasklistpb "go.temporal.io/temporal-proto/tasklist"
 	"go.temporal.io/temporal-proto/workflowservice"
 
+	"github.com/temporalio/temporal/.gen/proto/adminservice"
+	eventgenpb "github.com/temporalio/temporal/.gen/proto/event"
 	executiongenpb "github.com/temporalio/temporal/.gen/proto/execution"
 	"github.com/temporalio/temporal/.gen/proto/historyservice"
 	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
@@ -50,6 +53,7 @@ import (
 	"github.com/temporalio/temporal/common/checksum"
 	"github.com/temporalio/temporal/common/clock"
 	"github.com/temporalio/temporal/common/cluster"
+	"github.com/temporalio/temporal/common/convert"
 	"github.com/temporalio/temporal/common/definition"
 	"github.com/temporalio/temporal/common/log"
 	"github.com/temporalio/temporal/common/log/tag"
@@ -95,6 +99,7 @@ type (
 		updateActivityInfos        map[*persistence.ActivityInfo]struct{} // Modified activities from last update.
 		deleteActivityInfos        map[int64]struct{}                     // Deleted activities from last update.
 		syncActivityTasks          map[int64]struct{}                     // Activity to be sync to remote
+		syncWorkflowState          bool                                   // Workflow state to be sync to remote
 
 		pendingTimerInfoIDs     map[string]*persistenceblobs.TimerInfo   // User Timer ID -> Timer Info.
 		pendingTimerEventIDToID map[int64]string                         // User Timer Start Event ID -> User Timer ID.
@@ -156,6 +161,7 @@ type (
 		taskGenerator       mutableStateTaskGenerator
 		decisionTaskManager mutableStateDecisionTaskManager
 		queryRegistry       queryRegistry
+		updateRegistry      updateRegistry
 
 		shard           ShardContext
 		clusterMetadata cluster.Metadata
@@ -210,7 +216,8 @@ func newMutableStateBuilder(
 		namespaceEntry:        namespaceEntry,
 		appliedEvents:         make(map[string]struct{}),
 
-		queryRegistry: newQueryRegistry(),
+		queryRegistry:  newQueryRegistry(),
+		updateRegistry: newUpdateRegistry(),
 
 		shard:           shard,
 		clusterMetadata: shard.GetClusterMetadata(),
@@ -948,6 +955,71 @@ func (e *mutableStateBuilder) GetQueryRegistry() queryRegistry {
 	return e.queryRegistry
 }
 
+func (e *mutableStateBuilder) GetUpdateRegistry() updateRegistry {
+	return e.updateRegistry
+}
+
+// GetUpdateResult returns the recorded result of an update
+func (e *mutableStateBuilder) GetUpdateResult(
+	updateID string,
+) (*eventgenpb.WorkflowUpdateResult, bool) {
+
+	result, ok := e.executionInfo.UpdateResults[updateID]
+	return result, ok
+}
+
+// AddUpdateResult records the result of an update answered by the decision task completed with the given event,
+// the result is replicated with the workflow state and returned to the retries of the update
+func (e *mutableStateBuilder) AddUpdateResult(
+	decisionCompletedEventID int64,
+	result *eventgenpb.WorkflowUpdateResult,
+) error {
+
+	opTag := tag.WorkflowActionUpdateResultRecorded
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	result.AcceptedEventId = decisionCompletedEventID
+	result.CompletedTimestamp = e.timeSource.Now().UnixNano()
+	if e.executionInfo.UpdateResults == nil {
+		e.executionInfo.UpdateResults = make(map[string]*eventgenpb.WorkflowUpdateResult)
+	}
+	e.executionInfo.UpdateResults[result.GetUpdateId()] = result
+	e.trimUpdateResults()
+	e.updateSyncStateVersion()
+	return nil
+}
+
+// trimUpdateResults drops the update results older than the retention, then the oldest ones until the number of
+// results is within the limit
+func (e *mutableStateBuilder) trimUpdateResults() {
+
+	namespace := e.namespaceEntry.GetInfo().Name
+	expiration := e.timeSource.Now().Add(-e.config.UpdateResultRetention(namespace)).UnixNano()
+	for updateID, result := range e.executionInfo.UpdateResults {
+		if result.GetCompletedTimestamp() <= expiration {
+			delete(e.executionInfo.UpdateResults, updateID)
+		}
+	}
+
+	maxResults := e.config.MaxUpdateResultCount(namespace)
+	if len(e.executionInfo.UpdateResults) <= maxResults {
+		return
+	}
+	updateIDs := make([]string, 0, len(e.executionInfo.UpdateResults))
+	for updateID := range e.executionInfo.UpdateResults {
+		updateIDs = append(updateIDs, updateID)
+	}
+	sort.Slice(updateIDs, func(i, j int) bool {
+		return e.executionInfo.UpdateResults[updateIDs[i]].GetCompletedTimestamp() <
+			e.executionInfo.UpdateResults[updateIDs[j]].GetCompletedTimestamp()
+	})
+	for _, updateID := range updateIDs[:len(updateIDs)-maxResults] {
+		delete(e.executionInfo.UpdateResults, updateID)
+	}
+}
+
 func (e *mutableStateBuilder) GetActivityScheduledEvent(
 	scheduleEventID int64,
 ) (*eventpb.HistoryEvent, error) {
@@ -1097,7 +1169,12 @@ func (e *mutableStateBuilder) GetCronBackoffDuration() (time.Duration, error) {
 	firstDecisionTaskBackoff :=
 		time.Duration(workflowStartEvent.GetWorkflowExecutionStartedEventAttributes().GetFirstDecisionTaskBackoffSeconds()) * time.Second
 	executionTime = executionTime.Add(firstDecisionTaskBackoff)
-	return backoff.GetBackoffForNextSchedule(info.CronSchedule, executionTime, e.timeSource.Now()), nil
+	now := e.timeSource.Now()
+	if info.CronSkipUntil.After(now) {
+		// upcoming runs were skipped, the skip time is the schedule of the next run
+		return time.Duration(convert.Int64Ceil(info.CronSkipUntil.Sub(now).Seconds())) * time.Second, nil
+	}
+	return backoff.GetBackoffForNextSchedule(info.CronSchedule, executionTime, now), nil
 }
 
 // GetSignalInfo get details about a signal request that is currently in progress.
@@ -1297,6 +1374,29 @@ func (e *mutableStateBuilder) ReplicateActivityInfo(
 	ai.LastWorkerIdentity = request.GetLastWorkerIdentity()
 	ai.LastFailureDetails = request.GetLastFailureDetails()
 
+	// activity options are only sent by clusters which support updating them
+	if request.GetTaskList() != "" {
+		ai.ScheduleToStartTimeout = request.GetScheduleToStartTimeoutSeconds()
+		ai.ScheduleToCloseTimeout = request.GetScheduleToCloseTimeoutSeconds()
+		ai.StartToCloseTimeout = request.GetStartToCloseTimeoutSeconds()
+		ai.HeartbeatTimeout = request.GetHeartbeatTimeoutSeconds()
+		ai.TaskList = request.GetTaskList()
+		ai.HasRetryPolicy = request.GetRetryPolicy() != nil
+		if ai.HasRetryPolicy {
+			ai.InitialInterval = request.GetRetryPolicy().GetInitialIntervalInSeconds()
+			ai.BackoffCoefficient = request.GetRetryPolicy().GetBackoffCoefficient()
+			ai.MaximumInterval = request.GetRetryPolicy().GetMaximumIntervalInSeconds()
+			ai.MaximumAttempts = request.GetRetryPolicy().GetMaximumAttempts()
+			ai.NonRetriableErrors = request.GetRetryPolicy().GetNonRetriableErrorReasons()
+		}
+		ai.ExpirationTime = time.Unix(0, request.GetExpirationTime())
+		ai.OptionsUpdateIdentity = request.GetOptionsUpdateIdentity()
+		ai.OptionsUpdateTime = time.Time{}
+		if request.GetOptionsUpdateTime() != 0 {
+			ai.OptionsUpdateTime = time.Unix(0, request.GetOptionsUpdateTime())
+		}
+	}
+
 	if resetActivityTimerTaskStatus {
 		ai.TimerTaskStatus = timerTaskStatusNone
 	}
@@ -1323,6 +1423,104 @@ func (e *mutableStateBuilder) UpdateActivity(
 	return nil
 }
 
+// UpdateActivityOptions updates the timeouts, task list and retry policy of a pending activity,
+// options which are not set on the request keep their current values
+func (e *mutableStateBuilder) UpdateActivityOptions(
+	ai *persistence.ActivityInfo,
+	request *adminservice.UpdateActivityOptionsRequest,
+) error {
+
+	if _, ok := e.pendingActivityInfoIDs[ai.ScheduleID]; !ok {
+		e.logError(
+			fmt.Sprintf("unable to find activity ID: %v in mutable state", ai.ActivityID),
+			tag.ErrorTypeInvalidMutableStateAction,
+		)
+		return ErrMissingActivityInfo
+	}
+
+	// the retry expiration covers all attempts, it is counted from the time the activity was first scheduled
+	scheduledEvent, err := e.GetActivityScheduledEvent(ai.ScheduleID)
+	if err != nil {
+		return err
+	}
+	firstScheduledTime := time.Unix(0, scheduledEvent.GetTimestamp())
+	expirationInterval := ai.ExpirationTime.Sub(firstScheduledTime)
+
+	if request.GetScheduleToStartTimeoutSeconds() > 0 {
+		ai.ScheduleToStartTimeout = request.GetScheduleToStartTimeoutSeconds()
+	}
+	if request.GetScheduleToCloseTimeoutSeconds() > 0 {
+		ai.ScheduleToCloseTimeout = request.GetScheduleToCloseTimeoutSeconds()
+	}
+	if request.GetStartToCloseTimeoutSeconds() > 0 {
+		ai.StartToCloseTimeout = request.GetStartToCloseTimeoutSeconds()
+	}
+	if request.GetHeartbeatTimeoutSeconds() > 0 {
+		ai.HeartbeatTimeout = request.GetHeartbeatTimeoutSeconds()
+	}
+	if request.GetTaskList() != "" {
+		ai.TaskList = request.GetTaskList()
+	}
+	if retryPolicy := request.GetRetryPolicy(); retryPolicy != nil {
+		ai.HasRetryPolicy = true
+		ai.InitialInterval = retryPolicy.GetInitialIntervalInSeconds()
+		ai.BackoffCoefficient = retryPolicy.GetBackoffCoefficient()
+		ai.MaximumInterval = retryPolicy.GetMaximumIntervalInSeconds()
+		ai.MaximumAttempts = retryPolicy.GetMaximumAttempts()
+		ai.NonRetriableErrors = retryPolicy.GetNonRetriableErrorReasons()
+		expirationInterval = time.Duration(retryPolicy.GetExpirationIntervalInSeconds()) * time.Second
+	}
+
+	scheduleToCloseTimeout := time.Duration(ai.ScheduleToCloseTimeout) * time.Second
+	if !ai.HasRetryPolicy || expirationInterval < scheduleToCloseTimeout {
+		expirationInterval = scheduleToCloseTimeout
+	}
+	ai.ExpirationTime = firstScheduledTime.Add(expirationInterval)
+	ai.OptionsUpdateIdentity = request.GetIdentity()
+	ai.OptionsUpdateTime = e.timeSource.Now()
+
+	ai.Version = e.GetCurrentVersion()
+	e.updateActivityInfos[ai] = struct{}{}
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return nil
+}
+
+// ResetActivityRetry moves the retry of an activity waiting for its retry backoff to now,
+// and resets the attempt count and the retry expiration of the activity if requested
+func (e *mutableStateBuilder) ResetActivityRetry(
+	ai *persistence.ActivityInfo,
+	resetAttempts bool,
+) error {
+
+	if _, ok := e.pendingActivityInfoIDs[ai.ScheduleID]; !ok {
+		e.logError(
+			fmt.Sprintf("unable to find activity ID: %v in mutable state", ai.ActivityID),
+			tag.ErrorTypeInvalidMutableStateAction,
+		)
+		return ErrMissingActivityInfo
+	}
+
+	now := e.timeSource.Now()
+	if resetAttempts {
+		// the retry expiration window restarts along with the attempts
+		scheduledEvent, err := e.GetActivityScheduledEvent(ai.ScheduleID)
+		if err != nil {
+			return err
+		}
+		ai.ExpirationTime = now.Add(ai.ExpirationTime.Sub(time.Unix(0, scheduledEvent.GetTimestamp())))
+		ai.Attempt = 0
+	}
+	if ai.StartedID == common.EmptyEventID && ai.ScheduledTime.After(now) {
+		// the pending activity retry timer is superseded by the new scheduled time
+		ai.ScheduledTime = now
+	}
+
+	ai.Version = e.GetCurrentVersion()
+	e.updateActivityInfos[ai] = struct{}{}
+	e.syncActivityTasks[ai.ScheduleID] = struct{}{}
+	return nil
+}
+
 // DeleteActivity deletes details about an activity.
 func (e *mutableStateBuilder) DeleteActivity(
 	scheduleEventID int64,
@@ -1574,6 +1772,84 @@ func (e *mutableStateBuilder) IsWorkflowExecutionRunning() bool {
 	}
 }
 
+func (e *mutableStateBuilder) IsWorkflowExecutionPaused() bool {
+	return e.executionInfo.Paused
+}
+
+// PauseWorkflowExecution marks the workflow execution as paused, the pause is not recorded in history
+// and is sent to the remote clusters with a sync workflow state replication task
+func (e *mutableStateBuilder) PauseWorkflowExecution(
+	reason string,
+	identity string,
+) error {
+
+	opTag := tag.WorkflowActionWorkflowPaused
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	setWorkflowExecutionPaused(e.executionInfo, reason, identity)
+	e.updateSyncStateVersion()
+	return nil
+}
+
+func (e *mutableStateBuilder) UnpauseWorkflowExecution() error {
+
+	opTag := tag.WorkflowActionWorkflowUnpaused
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	clearWorkflowExecutionPaused(e.executionInfo)
+	e.updateSyncStateVersion()
+	return nil
+}
+
+// ReplicateWorkflowState applies the workflow state sent by the active cluster with a sync workflow state
+// replication task, the caller is responsible for discarding stale tasks
+func (e *mutableStateBuilder) ReplicateWorkflowState(
+	attributes *replicationgenpb.SyncWorkflowStateTaskAttributes,
+) error {
+
+	if attributes.GetPaused() {
+		setWorkflowExecutionPaused(e.executionInfo, attributes.GetPauseReason(), attributes.GetPauseIdentity())
+	} else {
+		clearWorkflowExecutionPaused(e.executionInfo)
+	}
+	e.executionInfo.CronSkipUntil = time.Time{}
+	if attributes.GetCronSkipUntil() != 0 {
+		e.executionInfo.CronSkipUntil = time.Unix(0, attributes.GetCronSkipUntil())
+	}
+	e.executionInfo.SearchAttributes = attributes.GetSearchAttributes()
+	e.executionInfo.Memo = attributes.GetMemo()
+	e.executionInfo.UpdatedSearchAttributes = attributes.GetUpdatedSearchAttributes()
+	e.executionInfo.UpdatedMemo = attributes.GetUpdatedMemo()
+	e.executionInfo.UpdateResults = attributes.GetUpdateResults()
+	e.executionInfo.SyncStateVersion = attributes.GetVersion()
+	return nil
+}
+
+// UpdateCronSkipUntil sets the time before which the cron schedules are skipped, a zero time
+// clears it
+func (e *mutableStateBuilder) UpdateCronSkipUntil(
+	skipUntil time.Time,
+) error {
+
+	opTag := tag.WorkflowActionWorkflowCronSkipUpdated
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	e.executionInfo.CronSkipUntil = skipUntil
+	e.updateSyncStateVersion()
+	return nil
+}
+
+func (e *mutableStateBuilder) updateSyncStateVersion() {
+	e.executionInfo.SyncStateVersion = e.GetCurrentVersion()
+	e.syncWorkflowState = true
+}
+
 func (e *mutableStateBuilder) IsCancelRequested() (bool, string) {
 	if e.executionInfo.CancelRequested {
 		return e.executionInfo.CancelRequested, e.executionInfo.CancelRequestID
@@ -1586,32 +1862,67 @@ func (e *mutableStateBuilder) IsSignalRequested(
 	requestID string,
 ) bool {
 
+	// request ids recorded in the signal requested set before the deduplication window was introduced
 	if _, ok := e.pendingSignalRequestedIDs[requestID]; ok {
 		return true
 	}
-	return false
+	timestamp, ok := e.executionInfo.SignalRequestIDTimestamps[requestID]
+	if !ok {
+		return false
+	}
+	window := e.config.SignalDeduplicationWindow(e.namespaceEntry.GetInfo().Name)
+	return e.timeSource.Now().Before(timestamp.Add(window))
 }
 
 func (e *mutableStateBuilder) AddSignalRequested(
 	requestID string,
 ) {
 
-	if e.pendingSignalRequestedIDs == nil {
-		e.pendingSignalRequestedIDs = make(map[string]struct{})
+	if e.executionInfo.SignalRequestIDTimestamps == nil {
+		e.executionInfo.SignalRequestIDTimestamps = make(map[string]time.Time)
 	}
-	if e.updateSignalRequestedIDs == nil {
-		e.updateSignalRequestedIDs = make(map[string]struct{})
-	}
-	e.pendingSignalRequestedIDs[requestID] = struct{}{} // add requestID to set
-	e.updateSignalRequestedIDs[requestID] = struct{}{}
+	e.executionInfo.SignalRequestIDTimestamps[requestID] = e.timeSource.Now()
+	e.trimSignalRequested()
 }
 
+// DeleteSignalRequested only removes request ids recorded in the signal requested set, the ones with
+// a timestamp keep deduplicating retries of the signal until they fall out of the deduplication window
 func (e *mutableStateBuilder) DeleteSignalRequested(
 	requestID string,
 ) {
 
-	delete(e.pendingSignalRequestedIDs, requestID)
-	e.deleteSignalRequestedID = requestID
+	if _, ok := e.pendingSignalRequestedIDs[requestID]; ok {
+		delete(e.pendingSignalRequestedIDs, requestID)
+		e.deleteSignalRequestedID = requestID
+	}
+}
+
+// trimSignalRequested drops the signal request ids older than the deduplication window,
+// then the oldest ones until the number of remembered ids is within the limit
+func (e *mutableStateBuilder) trimSignalRequested() {
+
+	namespace := e.namespaceEntry.GetInfo().Name
+	expiration := e.timeSource.Now().Add(-e.config.SignalDeduplicationWindow(namespace))
+	for requestID, timestamp := range e.executionInfo.SignalRequestIDTimestamps {
+		if !timestamp.After(expiration) {
+			delete(e.executionInfo.SignalRequestIDTimestamps, requestID)
+		}
+	}
+
+	maxRequestIDs := e.config.MaximumSignalDeduplicationIDs(namespace)
+	if len(e.executionInfo.SignalRequestIDTimestamps) <= maxRequestIDs {
+		return
+	}
+	requestIDs := make([]string, 0, len(e.executionInfo.SignalRequestIDTimestamps))
+	for requestID := range e.executionInfo.SignalRequestIDTimestamps {
+		requestIDs = append(requestIDs, requestID)
+	}
+	sort.Slice(requestIDs, func(i, j int) bool {
+		return e.executionInfo.SignalRequestIDTimestamps[requestIDs[i]].Before(e.executionInfo.SignalRequestIDTimestamps[requestIDs[j]])
+	})
+	for _, requestID := range requestIDs[:len(requestIDs)-maxRequestIDs] {
+		delete(e.executionInfo.SignalRequestIDTimestamps, requestID)
+	}
 }
 
 func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
@@ -1700,6 +2011,8 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 	); err != nil {
 		return nil, err
 	}
+	// the new run keeps the priority of the workflow
+	e.executionInfo.Priority = previousExecutionInfo.Priority
 
 	if err := e.SetHistoryTree(primitives.MustParseUUID(e.GetExecutionInfo().RunID)); err != nil {
 		return nil, err
@@ -1760,6 +2073,7 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEvent(
 		event); err != nil {
 		return nil, err
 	}
+	e.executionInfo.Priority = startRequest.GetPriority()
 	// TODO merge active & passive task generation
 	if err := e.taskGenerator.generateWorkflowStartTasks(
 		e.unixNanoToTime(event.GetTimestamp()),
@@ -2170,6 +2484,7 @@ func (e *mutableStateBuilder) ReplicateActivityTaskScheduledEvent(
 		TimerTaskStatus:          timerTaskStatusNone,
 		TaskList:                 attributes.TaskList.GetName(),
 		HasRetryPolicy:           attributes.RetryPolicy != nil,
+		Priority:                 getActivityTaskPriority(attributes.GetHeader()),
 	}
 	ai.ExpirationTime = ai.ScheduledTime.Add(time.Duration(scheduleToCloseTimeout) * time.Second)
 	if ai.HasRetryPolicy {
@@ -2929,6 +3244,48 @@ func (e *mutableStateBuilder) ReplicateUpsertWorkflowSearchAttributesEvent(
 	e.executionInfo.SearchAttributes = mergeMapOfByteArray(currentSearchAttr, upsertSearchAttr)
 }
 
+// UpdateWorkflowAttributes merges search attributes and memo into the workflow execution without a history event,
+// the change is replicated by a sync workflow state task and the visibility record is updated accordingly
+func (e *mutableStateBuilder) UpdateWorkflowAttributes(
+	searchAttributes map[string][]byte,
+	memo map[string][]byte,
+) error {
+
+	opTag := tag.WorkflowActionUpsertWorkflowSearchAttributes
+	if err := e.checkMutability(opTag); err != nil {
+		return err
+	}
+
+	e.mergeUpdatedWorkflowAttributes(searchAttributes, memo)
+	e.updateSyncStateVersion()
+	return e.taskGenerator.generateWorkflowSearchAttrTasks(e.timeSource.Now())
+}
+
+// InheritUpdatedWorkflowAttributes merges the search attributes and memo set by UpdateWorkflowAttributes on the
+// base workflow, they are not in the history so they are lost when the workflow is rebuilt from it on reset
+func (e *mutableStateBuilder) InheritUpdatedWorkflowAttributes(
+	baseMutableState mutableState,
+) {
+
+	baseExecutionInfo := baseMutableState.GetExecutionInfo()
+	e.mergeUpdatedWorkflowAttributes(baseExecutionInfo.UpdatedSearchAttributes, baseExecutionInfo.UpdatedMemo)
+}
+
+func (e *mutableStateBuilder) mergeUpdatedWorkflowAttributes(
+	searchAttributes map[string][]byte,
+	memo map[string][]byte,
+) {
+
+	if len(searchAttributes) > 0 {
+		e.executionInfo.SearchAttributes = mergeMapOfByteArray(e.executionInfo.SearchAttributes, searchAttributes)
+		e.executionInfo.UpdatedSearchAttributes = mergeMapOfByteArray(e.executionInfo.UpdatedSearchAttributes, searchAttributes)
+	}
+	if len(memo) > 0 {
+		e.executionInfo.Memo = mergeMapOfByteArray(e.executionInfo.Memo, memo)
+		e.executionInfo.UpdatedMemo = mergeMapOfByteArray(e.executionInfo.UpdatedMemo, memo)
+	}
+}
+
 func mergeMapOfByteArray(
 	current map[string][]byte,
 	upsert map[string][]byte,
@@ -3279,8 +3636,54 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(
 		return nil, nil, err
 	}
 
-	var err error
 	newRunID := uuid.New()
+	continueAsNewEvent := e.hBuilder.AddContinuedAsNewEvent(decisionCompletedEventID, newRunID, attributes)
+	newStateBuilder, err := e.newRunStateBuilder(newRunID, parentNamespace, attributes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	namespaceID := primitives.UUIDString(e.namespaceEntry.GetInfo().Id)
+	if err = e.ReplicateWorkflowExecutionContinuedAsNewEvent(
+		firstEventID,
+		namespaceID,
+		continueAsNewEvent,
+	); err != nil {
+		return nil, nil, err
+	}
+	// TODO merge active & passive task generation
+	if err := e.taskGenerator.generateWorkflowCloseTasks(
+		e.unixNanoToTime(continueAsNewEvent.GetTimestamp()),
+	); err != nil {
+		return nil, nil, err
+	}
+
+	return continueAsNewEvent, newStateBuilder, nil
+}
+
+// StartNewRunAfterTermination starts the next run of a workflow execution terminated in the same transaction,
+// unlike continue as new the runs are not linked by a history event of the terminated run
+func (e *mutableStateBuilder) StartNewRunAfterTermination(
+	parentNamespace string,
+	attributes *decisionpb.ContinueAsNewWorkflowExecutionDecisionAttributes,
+) (mutableState, error) {
+
+	if e.executionInfo.Status != executionpb.WorkflowExecutionStatus_Terminated {
+		return nil, serviceerror.NewInternal("unable to start a new run of a workflow execution which is not terminated")
+	}
+	newStateBuilder, err := e.newRunStateBuilder(uuid.New(), parentNamespace, attributes)
+	if err != nil {
+		return nil, err
+	}
+	return newStateBuilder, nil
+}
+
+func (e *mutableStateBuilder) newRunStateBuilder(
+	newRunID string,
+	parentNamespace string,
+	attributes *decisionpb.ContinueAsNewWorkflowExecutionDecisionAttributes,
+) (*mutableStateBuilder, error) {
+
 	newExecution := executionpb.WorkflowExecution{
 		WorkflowId: e.executionInfo.WorkflowID,
 		RunId:      newRunID,
@@ -3300,15 +3703,13 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(
 		}
 	}
 
-	continueAsNewEvent := e.hBuilder.AddContinuedAsNewEvent(decisionCompletedEventID, newRunID, attributes)
 	currentStartEvent, err := e.GetStartEvent()
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	firstRunID := currentStartEvent.GetWorkflowExecutionStartedEventAttributes().GetFirstExecutionRunId()
 
 	namespace := e.namespaceEntry.GetInfo().Name
-	namespaceID := primitives.UUIDString(e.namespaceEntry.GetInfo().Id)
 	var newStateBuilder *mutableStateBuilder
 	// If a workflow is ndc enabled, the continue as new should be ndc enabled.
 	if e.config.EnableNDC(namespace) || e.GetVersionHistories() != nil {
@@ -3334,31 +3735,34 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(
 		}
 	}
 
-	if _, err = newStateBuilder.addWorkflowExecutionStartedEventForContinueAsNew(
+	if _, err := newStateBuilder.addWorkflowExecutionStartedEventForContinueAsNew(
 		parentInfo,
 		newExecution,
 		e,
 		attributes,
 		firstRunID,
 	); err != nil {
-		return nil, nil, serviceerror.NewInternal("Failed to add workflow execution started event.")
+		return nil, serviceerror.NewInternal("Failed to add workflow execution started event.")
 	}
 
-	if err = e.ReplicateWorkflowExecutionContinuedAsNewEvent(
-		firstEventID,
-		namespaceID,
-		continueAsNewEvent,
-	); err != nil {
-		return nil, nil, err
+	// carry the signal request ids over so that signals retried across continue as new are deduplicated
+	if len(e.executionInfo.SignalRequestIDTimestamps) > 0 {
+		newStateBuilder.executionInfo.SignalRequestIDTimestamps = make(map[string]time.Time, len(e.executionInfo.SignalRequestIDTimestamps))
+		for requestID, timestamp := range e.executionInfo.SignalRequestIDTimestamps {
+			newStateBuilder.executionInfo.SignalRequestIDTimestamps[requestID] = timestamp
+		}
+		newStateBuilder.trimSignalRequested()
 	}
-	// TODO merge active & passive task generation
-	if err := e.taskGenerator.generateWorkflowCloseTasks(
-		e.unixNanoToTime(continueAsNewEvent.GetTimestamp()),
-	); err != nil {
-		return nil, nil, err
+	// and the update results so that retried updates get the result recorded by the previous run
+	if len(e.executionInfo.UpdateResults) > 0 {
+		newStateBuilder.executionInfo.UpdateResults = make(map[string]*eventgenpb.WorkflowUpdateResult, len(e.executionInfo.UpdateResults))
+		for updateID, result := range e.executionInfo.UpdateResults {
+			newStateBuilder.executionInfo.UpdateResults[updateID] = result
+		}
+		newStateBuilder.trimUpdateResults()
 	}
 
-	return continueAsNewEvent, newStateBuilder, nil
+	return newStateBuilder, nil
 }
 
 func rolloverAutoResetPointsWithExpiringTime(
@@ -3830,6 +4234,13 @@ func (e *mutableStateBuilder) AddTimerTasks(
 	e.insertTimerTasks = append(e.insertTimerTasks, timerTasks...)
 }
 
+func (e *mutableStateBuilder) AddReplicationTasks(
+	replicationTasks ...persistence.Task,
+) {
+
+	e.insertReplicationTasks = append(e.insertReplicationTasks, replicationTasks...)
+}
+
 func (e *mutableStateBuilder) SetUpdateCondition(
 	nextEventIDInDB int64,
 ) {
@@ -4106,6 +4517,7 @@ func (e *mutableStateBuilder) cleanupTransaction(
 	e.updateActivityInfos = make(map[*persistence.ActivityInfo]struct{})
 	e.deleteActivityInfos = make(map[int64]struct{})
 	e.syncActivityTasks = make(map[int64]struct{})
+	e.syncWorkflowState = false
 
 	e.updateTimerInfos = make(map[*persistenceblobs.TimerInfo]struct{})
 	e.deleteTimerInfos = make(map[string]struct{})
@@ -4189,6 +4601,10 @@ func (e *mutableStateBuilder) prepareEventsAndReplicationTasks(
 		e.insertReplicationTasks,
 		e.syncActivityToReplicationTask(transactionPolicy)...,
 	)
+	e.insertReplicationTasks = append(
+		e.insertReplicationTasks,
+		e.syncWorkflowStateToReplicationTask(transactionPolicy)...,
+	)
 
 	if transactionPolicy == transactionPolicyPassive && len(e.insertReplicationTasks) > 0 {
 		return nil, serviceerror.NewInternal("should not generate replication task when close transaction as passive")
@@ -4259,6 +4675,21 @@ func (e *mutableStateBuilder) syncActivityToReplicationTask(
 	)
 }
 
+func (e *mutableStateBuilder) syncWorkflowStateToReplicationTask(
+	transactionPolicy transactionPolicy,
+) []persistence.Task {
+
+	if transactionPolicy == transactionPolicyPassive ||
+		!e.canReplicateEvents() ||
+		!e.syncWorkflowState {
+		return emptyTasks
+	}
+
+	return []persistence.Task{&persistence.SyncWorkflowStateTask{
+		Version: e.executionInfo.SyncStateVersion,
+	}}
+}
+
 func (e *mutableStateBuilder) updateWithLastWriteEvent(
 	lastEvent *eventpb.HistoryEvent,
 	transactionPolicy transactionPolicy,
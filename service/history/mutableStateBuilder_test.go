@@ -40,6 +40,7 @@ import (
 	executionpb "go.temporal.io/temporal-proto/execution"
 	tasklistpb "go.temporal.io/temporal-proto/tasklist"
 
+	eventgenpb "github.com/temporalio/temporal/.gen/proto/event"
 	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
 	replicationgenpb "github.com/temporalio/temporal/.gen/proto/replication"
 	"github.com/temporalio/temporal/common"
@@ -540,6 +541,28 @@ func (s *mutableStateSuite) TestMergeMapOfByteArray() {
 	s.Equal(2, len(resultMap))
 }
 
+func (s *mutableStateSuite) TestInheritUpdatedWorkflowAttributes() {
+	baseMutableState := newMutableStateBuilder(s.mockShard, s.mockEventsCache, s.logger, testLocalNamespaceEntry)
+	baseMutableState.executionInfo.SearchAttributes = map[string][]byte{
+		"CustomKeywordField": []byte(`"updated"`),
+		"CustomIntField":     []byte("2"),
+	}
+	baseMutableState.executionInfo.UpdatedSearchAttributes = map[string][]byte{"CustomKeywordField": []byte(`"updated"`)}
+	baseMutableState.executionInfo.UpdatedMemo = map[string][]byte{"memoKey": []byte(`"memoValue"`)}
+
+	// the rebuilt state only has the attributes from the history
+	s.msBuilder.executionInfo.SearchAttributes = map[string][]byte{"CustomIntField": []byte("1")}
+	s.msBuilder.InheritUpdatedWorkflowAttributes(baseMutableState)
+
+	s.Equal(map[string][]byte{
+		"CustomKeywordField": []byte(`"updated"`),
+		"CustomIntField":     []byte("1"),
+	}, s.msBuilder.executionInfo.SearchAttributes)
+	s.Equal(map[string][]byte{"memoKey": []byte(`"memoValue"`)}, s.msBuilder.executionInfo.Memo)
+	s.Equal(baseMutableState.executionInfo.UpdatedSearchAttributes, s.msBuilder.executionInfo.UpdatedSearchAttributes)
+	s.Equal(baseMutableState.executionInfo.UpdatedMemo, s.msBuilder.executionInfo.UpdatedMemo)
+}
+
 func (s *mutableStateSuite) TestEventReapplied() {
 	runID := uuid.New()
 	eventID := int64(1)
@@ -552,6 +575,84 @@ func (s *mutableStateSuite) TestEventReapplied() {
 	s.True(isReapplied)
 }
 
+func (s *mutableStateSuite) TestSignalRequested() {
+	s.mockShard.config.SignalDeduplicationWindow = func(namespace string) time.Duration { return time.Hour }
+	s.mockShard.config.MaximumSignalDeduplicationIDs = func(namespace string) int { return 2 }
+	now := time.Now()
+
+	requestID := uuid.New()
+	s.False(s.msBuilder.IsSignalRequested(requestID))
+	s.msBuilder.AddSignalRequested(requestID)
+	s.True(s.msBuilder.IsSignalRequested(requestID))
+	// the request id is kept until it falls out of the window
+	s.msBuilder.DeleteSignalRequested(requestID)
+	s.True(s.msBuilder.IsSignalRequested(requestID))
+
+	// request ids outside of the window are not deduplicated
+	s.msBuilder.GetExecutionInfo().SignalRequestIDTimestamps[requestID] = now.Add(-2 * time.Hour)
+	s.False(s.msBuilder.IsSignalRequested(requestID))
+
+	// expired request ids are dropped first, then the oldest ones above the limit
+	oldestRequestID := uuid.New()
+	olderRequestID := uuid.New()
+	s.msBuilder.GetExecutionInfo().SignalRequestIDTimestamps[oldestRequestID] = now.Add(-2 * time.Minute)
+	s.msBuilder.GetExecutionInfo().SignalRequestIDTimestamps[olderRequestID] = now.Add(-time.Minute)
+	newRequestID := uuid.New()
+	s.msBuilder.AddSignalRequested(newRequestID)
+	s.Len(s.msBuilder.GetExecutionInfo().SignalRequestIDTimestamps, 2)
+	s.False(s.msBuilder.IsSignalRequested(requestID))
+	s.False(s.msBuilder.IsSignalRequested(oldestRequestID))
+	s.True(s.msBuilder.IsSignalRequested(olderRequestID))
+	s.True(s.msBuilder.IsSignalRequested(newRequestID))
+
+	// request ids recorded in the signal requested set are still deduplicated
+	legacyRequestID := uuid.New()
+	s.msBuilder.pendingSignalRequestedIDs[legacyRequestID] = struct{}{}
+	s.True(s.msBuilder.IsSignalRequested(legacyRequestID))
+	s.msBuilder.DeleteSignalRequested(legacyRequestID)
+	s.False(s.msBuilder.IsSignalRequested(legacyRequestID))
+	s.Equal(legacyRequestID, s.msBuilder.deleteSignalRequestedID)
+}
+
+func (s *mutableStateSuite) TestUpdateResult() {
+	s.mockShard.config.UpdateResultRetention = func(namespace string) time.Duration { return time.Hour }
+	s.mockShard.config.MaxUpdateResultCount = func(namespace string) int { return 2 }
+	now := time.Now()
+
+	_, ok := s.msBuilder.GetUpdateResult("update-id")
+	s.False(ok)
+	err := s.msBuilder.AddUpdateResult(4, &eventgenpb.WorkflowUpdateResult{UpdateId: "update-id", Result: []byte("result")})
+	s.NoError(err)
+	result, ok := s.msBuilder.GetUpdateResult("update-id")
+	s.True(ok)
+	s.Equal([]byte("result"), result.GetResult())
+	s.Equal(int64(4), result.GetAcceptedEventId())
+	s.NotZero(result.GetCompletedTimestamp())
+	s.Equal(s.msBuilder.GetCurrentVersion(), s.msBuilder.GetExecutionInfo().SyncStateVersion)
+
+	// expired results are dropped first, then the oldest ones above the limit
+	s.msBuilder.GetExecutionInfo().UpdateResults["update-id"].CompletedTimestamp = now.Add(-2 * time.Hour).UnixNano()
+	s.msBuilder.GetExecutionInfo().UpdateResults["oldest-update-id"] = &eventgenpb.WorkflowUpdateResult{
+		UpdateId:           "oldest-update-id",
+		CompletedTimestamp: now.Add(-2 * time.Minute).UnixNano(),
+	}
+	s.msBuilder.GetExecutionInfo().UpdateResults["older-update-id"] = &eventgenpb.WorkflowUpdateResult{
+		UpdateId:           "older-update-id",
+		CompletedTimestamp: now.Add(-time.Minute).UnixNano(),
+	}
+	err = s.msBuilder.AddUpdateResult(5, &eventgenpb.WorkflowUpdateResult{UpdateId: "new-update-id"})
+	s.NoError(err)
+	s.Len(s.msBuilder.GetExecutionInfo().UpdateResults, 2)
+	_, ok = s.msBuilder.GetUpdateResult("update-id")
+	s.False(ok)
+	_, ok = s.msBuilder.GetUpdateResult("oldest-update-id")
+	s.False(ok)
+	_, ok = s.msBuilder.GetUpdateResult("older-update-id")
+	s.True(ok)
+	_, ok = s.msBuilder.GetUpdateResult("new-update-id")
+	s.True(ok)
+}
+
 func (s *mutableStateSuite) prepareTransientDecisionCompletionFirstBatchReplicated(version int64, runID string) (*eventpb.HistoryEvent, *eventpb.HistoryEvent) {
 	namespaceID := testNamespaceID
 	execution := executionpb.WorkflowExecution{
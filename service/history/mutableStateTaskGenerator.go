@@ -34,6 +34,7 @@ import (
 	eventpb "go.temporal.io/temporal-proto/event"
 	"go.temporal.io/temporal-proto/serviceerror"
 
+	"github.com/temporalio/temporal/common/backoff"
 	"github.com/temporalio/temporal/common/cache"
 	"github.com/temporalio/temporal/common/clock"
 	"github.com/temporalio/temporal/common/log"
@@ -150,6 +151,19 @@ func (r *mutableStateTaskGeneratorImpl) generateWorkflowStartTasks(
 		Version:             startVersion,
 	})
 
+	switch backoff.GetCronOverlapPolicy(executionInfo.CronSchedule) {
+	case backoff.CronOverlapPolicyCancelPrevious,
+		backoff.CronOverlapPolicyTerminatePrevious:
+		// the overlap policy is enforced at the next schedule following the start of this run
+		executionTimestamp := time.Unix(0, startEvent.GetTimestamp()).Add(firstDecisionDelayDuration)
+		r.mutableState.AddTimerTasks(&persistence.WorkflowBackoffTimerTask{
+			// TaskID is set by shard
+			VisibilityTimestamp: backoff.GetNextScheduleTime(executionInfo.CronSchedule, executionTimestamp, 0),
+			TimeoutType:         persistence.WorkflowBackoffTimeoutTypeCronOverlap,
+			Version:             startVersion,
+		})
+	}
+
 	return nil
 }
 
@@ -197,6 +211,9 @@ func (r *mutableStateTaskGeneratorImpl) generateDelayedDecisionTasks(
 	startAttr := startEvent.GetWorkflowExecutionStartedEventAttributes()
 	decisionBackoffDuration := time.Duration(startAttr.GetFirstDecisionTaskBackoffSeconds()) * time.Second
 	executionTimestamp := now.Add(decisionBackoffDuration)
+	if cronSkipUntil := r.mutableState.GetExecutionInfo().CronSkipUntil; cronSkipUntil.After(executionTimestamp) {
+		executionTimestamp = cronSkipUntil
+	}
 
 	var firstDecisionDelayType int
 	switch startAttr.GetInitiator() {
@@ -341,6 +358,7 @@ func (r *mutableStateTaskGeneratorImpl) generateActivityTransferTasks(
 		TaskList:            activityInfo.TaskList,
 		ScheduleID:          activityInfo.ScheduleID,
 		Version:             activityInfo.Version,
+		Priority:            activityInfo.Priority,
 	})
 
 	return nil
@@ -361,6 +379,7 @@ func (r *mutableStateTaskGeneratorImpl) generateActivityRetryTasks(
 		VisibilityTimestamp: ai.ScheduledTime,
 		EventID:             ai.ScheduleID,
 		Attempt:             ai.Attempt,
+		ScheduledTime:       ai.ScheduledTime,
 	})
 	return nil
 }
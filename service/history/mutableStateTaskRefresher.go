@@ -27,6 +27,7 @@
 package history
 
 import (
+	"fmt"
 	"time"
 
 	executionpb "go.temporal.io/temporal-proto/execution"
@@ -44,6 +45,7 @@ var emptyTasks = []persistence.Task{}
 type (
 	mutableStateTaskRefresher interface {
 		refreshTasks(now time.Time, mutableState mutableState) error
+		refreshTasksForActivityInfo(now time.Time, mutableState mutableState, scheduleID int64) error
 	}
 
 	mutableStateTaskRefresherImpl struct {
@@ -327,6 +329,55 @@ Loop:
 	return nil
 }
 
+// refreshTasksForActivityInfo regenerates the tasks of a single pending activity whose activity info changed
+func (r *mutableStateTaskRefresherImpl) refreshTasksForActivityInfo(
+	now time.Time,
+	mutableState mutableState,
+	scheduleID int64,
+) error {
+
+	activityInfo, ok := mutableState.GetActivityInfo(scheduleID)
+	if !ok {
+		return serviceerror.NewInternal(fmt.Sprintf("it could be a bug, cannot get pending activity: %v", scheduleID))
+	}
+
+	// clear the activity timer task mask for later activity timer task re-generation
+	activityInfo.TimerTaskStatus = timerTaskStatusNone
+	if err := mutableState.UpdateActivity(
+		activityInfo,
+	); err != nil {
+		return err
+	}
+
+	// an activity waiting for its retry is dispatched by the activity retry timer
+	if activityInfo.StartedID == common.EmptyEventID && !activityInfo.ScheduledTime.After(now) {
+		scheduleEvent, err := mutableState.GetActivityScheduledEvent(scheduleID)
+		if err != nil {
+			return err
+		}
+
+		if err := newMutableStateTaskGenerator(
+			r.namespaceCache,
+			r.logger,
+			mutableState,
+		).generateActivityTransferTasks(
+			now,
+			scheduleEvent,
+		); err != nil {
+			return err
+		}
+	}
+
+	if _, err := newTimerSequence(
+		r.getTimeSource(now),
+		mutableState,
+	).createNextActivityTimer(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (r *mutableStateTaskRefresherImpl) refreshTasksForTimer(
 	now time.Time,
 	mutableState mutableState,
@@ -71,3 +71,17 @@ func (mr *MockmutableStateTaskRefresherMockRecorder) refreshTasks(now, mutableSt
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "refreshTasks", reflect.TypeOf((*MockmutableStateTaskRefresher)(nil).refreshTasks), now, mutableState)
 }
+
+// refreshTasksForActivityInfo mocks base method.
+func (m *MockmutableStateTaskRefresher) refreshTasksForActivityInfo(now time.Time, mutableState mutableState, scheduleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "refreshTasksForActivityInfo", now, mutableState, scheduleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// refreshTasksForActivityInfo indicates an expected call of refreshTasksForActivityInfo.
+func (mr *MockmutableStateTaskRefresherMockRecorder) refreshTasksForActivityInfo(now, mutableState, scheduleID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "refreshTasksForActivityInfo", reflect.TypeOf((*MockmutableStateTaskRefresher)(nil).refreshTasksForActivityInfo), now, mutableState, scheduleID)
+}
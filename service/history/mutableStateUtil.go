@@ -25,7 +25,14 @@
 package history
 
 import (
+	"encoding/json"
+	"strings"
+
+	commonpb "go.temporal.io/temporal-proto/common"
+
 	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
+	"github.com/temporalio/temporal/common"
+	"github.com/temporalio/temporal/common/definition"
 	"github.com/temporalio/temporal/common/persistence"
 )
 
@@ -203,3 +210,76 @@ func convertSignalRequestedIDs(
 	}
 	return outputs
 }
+
+// getActivityTaskPriority returns the priority requested by the activity header,
+// or unspecified if the header does not carry a valid priority
+func getActivityTaskPriority(
+	header *commonpb.Header,
+) int32 {
+
+	value, ok := header.GetFields()[common.ActivityPriorityHeaderField]
+	if !ok {
+		return common.TaskPriorityUnspecified
+	}
+	priority, err := common.ParseTaskPriority(strings.Trim(string(value), `"`))
+	if err != nil {
+		return common.TaskPriorityUnspecified
+	}
+	return priority
+}
+
+// setWorkflowExecutionPaused marks a workflow execution as paused, the task executors hold
+// back its tasks until it is unpaused
+func setWorkflowExecutionPaused(
+	executionInfo *persistence.WorkflowExecutionInfo,
+	reason string,
+	identity string,
+) {
+
+	executionInfo.Paused = true
+	executionInfo.PauseReason = reason
+	executionInfo.PauseIdentity = identity
+	if executionInfo.SearchAttributes == nil {
+		executionInfo.SearchAttributes = make(map[string][]byte)
+	}
+	executionInfo.SearchAttributes[definition.TemporalPaused] = []byte("true")
+	executionInfo.SearchAttributes[definition.TemporalPauseReason] = encodeStringSearchAttribute(reason)
+	executionInfo.SearchAttributes[definition.TemporalPauseIdentity] = encodeStringSearchAttribute(identity)
+}
+
+func clearWorkflowExecutionPaused(
+	executionInfo *persistence.WorkflowExecutionInfo,
+) {
+
+	executionInfo.Paused = false
+	executionInfo.PauseReason = ""
+	executionInfo.PauseIdentity = ""
+	delete(executionInfo.SearchAttributes, definition.TemporalPaused)
+	delete(executionInfo.SearchAttributes, definition.TemporalPauseReason)
+	delete(executionInfo.SearchAttributes, definition.TemporalPauseIdentity)
+}
+
+func encodeStringSearchAttribute(
+	value string,
+) []byte {
+
+	// marshaling a string can not fail
+	encoded, _ := json.Marshal(value)
+	return encoded
+}
+
+// getRootTaskListName returns the name of the task list a task list partition belongs to,
+// partitions other than the root one are named /__temporal_sys/[original-name]/[partitionID]
+func getRootTaskListName(
+	name string,
+) string {
+
+	if !strings.HasPrefix(name, reservedTaskListPrefix) {
+		return name
+	}
+	suffixOff := strings.LastIndex(name, "/")
+	if suffixOff <= len(reservedTaskListPrefix) {
+		return name
+	}
+	return name[len(reservedTaskListPrefix):suffixOff]
+}
@@ -33,8 +33,11 @@ import (
 	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	adminservice "github.com/temporalio/temporal/.gen/proto/adminservice"
+	event0 "github.com/temporalio/temporal/.gen/proto/event"
 	historyservice "github.com/temporalio/temporal/.gen/proto/historyservice"
 	persistenceblobs "github.com/temporalio/temporal/.gen/proto/persistenceblobs"
+	replication "github.com/temporalio/temporal/.gen/proto/replication"
 	cache "github.com/temporalio/temporal/common/cache"
 	definition "github.com/temporalio/temporal/common/definition"
 	persistence "github.com/temporalio/temporal/common/persistence"
@@ -672,6 +675,20 @@ func (mr *MockmutableStateMockRecorder) AddTimerStartedEvent(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTimerStartedEvent", reflect.TypeOf((*MockmutableState)(nil).AddTimerStartedEvent), arg0, arg1)
 }
 
+// AddUpdateResult mocks base method.
+func (m *MockmutableState) AddUpdateResult(arg0 int64, arg1 *event0.WorkflowUpdateResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUpdateResult", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUpdateResult indicates an expected call of AddUpdateResult.
+func (mr *MockmutableStateMockRecorder) AddUpdateResult(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUpdateResult", reflect.TypeOf((*MockmutableState)(nil).AddUpdateResult), arg0, arg1)
+}
+
 // AddUpsertWorkflowSearchAttributesEvent mocks base method.
 func (m *MockmutableState) AddUpsertWorkflowSearchAttributesEvent(arg0 int64, arg1 *decision.UpsertWorkflowSearchAttributesDecisionAttributes) (*event.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -1433,6 +1450,35 @@ func (mr *MockmutableStateMockRecorder) GetQueryRegistry() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueryRegistry", reflect.TypeOf((*MockmutableState)(nil).GetQueryRegistry))
 }
 
+// GetUpdateRegistry mocks base method.
+func (m *MockmutableState) GetUpdateRegistry() updateRegistry {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpdateRegistry")
+	ret0, _ := ret[0].(updateRegistry)
+	return ret0
+}
+
+// GetUpdateRegistry indicates an expected call of GetUpdateRegistry.
+func (mr *MockmutableStateMockRecorder) GetUpdateRegistry() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdateRegistry", reflect.TypeOf((*MockmutableState)(nil).GetUpdateRegistry))
+}
+
+// GetUpdateResult mocks base method.
+func (m *MockmutableState) GetUpdateResult(updateID string) (*event0.WorkflowUpdateResult, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpdateResult", updateID)
+	ret0, _ := ret[0].(*event0.WorkflowUpdateResult)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetUpdateResult indicates an expected call of GetUpdateResult.
+func (mr *MockmutableStateMockRecorder) GetUpdateResult(updateID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdateResult", reflect.TypeOf((*MockmutableState)(nil).GetUpdateResult), updateID)
+}
+
 // HasBufferedEvents mocks base method.
 func (m *MockmutableState) HasBufferedEvents() bool {
 	m.ctrl.T.Helper()
@@ -1503,6 +1549,18 @@ func (mr *MockmutableStateMockRecorder) HasProcessedOrPendingDecision() *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasProcessedOrPendingDecision", reflect.TypeOf((*MockmutableState)(nil).HasProcessedOrPendingDecision))
 }
 
+// InheritUpdatedWorkflowAttributes mocks base method.
+func (m *MockmutableState) InheritUpdatedWorkflowAttributes(baseMutableState mutableState) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "InheritUpdatedWorkflowAttributes", baseMutableState)
+}
+
+// InheritUpdatedWorkflowAttributes indicates an expected call of InheritUpdatedWorkflowAttributes.
+func (mr *MockmutableStateMockRecorder) InheritUpdatedWorkflowAttributes(baseMutableState interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InheritUpdatedWorkflowAttributes", reflect.TypeOf((*MockmutableState)(nil).InheritUpdatedWorkflowAttributes), baseMutableState)
+}
+
 // IsCancelRequested mocks base method.
 func (m *MockmutableState) IsCancelRequested() (bool, string) {
 	m.ctrl.T.Helper()
@@ -1574,6 +1632,48 @@ func (mr *MockmutableStateMockRecorder) IsWorkflowExecutionRunning() *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowExecutionRunning", reflect.TypeOf((*MockmutableState)(nil).IsWorkflowExecutionRunning))
 }
 
+// IsWorkflowExecutionPaused mocks base method.
+func (m *MockmutableState) IsWorkflowExecutionPaused() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsWorkflowExecutionPaused")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsWorkflowExecutionPaused indicates an expected call of IsWorkflowExecutionPaused.
+func (mr *MockmutableStateMockRecorder) IsWorkflowExecutionPaused() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsWorkflowExecutionPaused", reflect.TypeOf((*MockmutableState)(nil).IsWorkflowExecutionPaused))
+}
+
+// PauseWorkflowExecution mocks base method.
+func (m *MockmutableState) PauseWorkflowExecution(reason, identity string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseWorkflowExecution", reason, identity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseWorkflowExecution indicates an expected call of PauseWorkflowExecution.
+func (mr *MockmutableStateMockRecorder) PauseWorkflowExecution(reason, identity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseWorkflowExecution", reflect.TypeOf((*MockmutableState)(nil).PauseWorkflowExecution), reason, identity)
+}
+
+// UnpauseWorkflowExecution mocks base method.
+func (m *MockmutableState) UnpauseWorkflowExecution() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpauseWorkflowExecution")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpauseWorkflowExecution indicates an expected call of UnpauseWorkflowExecution.
+func (mr *MockmutableStateMockRecorder) UnpauseWorkflowExecution() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpauseWorkflowExecution", reflect.TypeOf((*MockmutableState)(nil).UnpauseWorkflowExecution))
+}
+
 // IsResourceDuplicated mocks base method.
 func (m *MockmutableState) IsResourceDuplicated(resourceDedupKey definition.DeduplicationID) bool {
 	m.ctrl.T.Helper()
@@ -2192,6 +2292,20 @@ func (mr *MockmutableStateMockRecorder) ReplicateWorkflowExecutionTimedoutEvent(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowExecutionTimedoutEvent", reflect.TypeOf((*MockmutableState)(nil).ReplicateWorkflowExecutionTimedoutEvent), arg0, arg1)
 }
 
+// ReplicateWorkflowState mocks base method.
+func (m *MockmutableState) ReplicateWorkflowState(arg0 *replication.SyncWorkflowStateTaskAttributes) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplicateWorkflowState", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplicateWorkflowState indicates an expected call of ReplicateWorkflowState.
+func (mr *MockmutableStateMockRecorder) ReplicateWorkflowState(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplicateWorkflowState", reflect.TypeOf((*MockmutableState)(nil).ReplicateWorkflowState), arg0)
+}
+
 // SetCurrentBranchToken mocks base method.
 func (m *MockmutableState) SetCurrentBranchToken(branchToken []byte) error {
 	m.ctrl.T.Helper()
@@ -2246,6 +2360,35 @@ func (mr *MockmutableStateMockRecorder) SetVersionHistories(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVersionHistories", reflect.TypeOf((*MockmutableState)(nil).SetVersionHistories), arg0)
 }
 
+// StartNewRunAfterTermination mocks base method.
+func (m *MockmutableState) StartNewRunAfterTermination(parentNamespace string, attributes *decision.ContinueAsNewWorkflowExecutionDecisionAttributes) (mutableState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartNewRunAfterTermination", parentNamespace, attributes)
+	ret0, _ := ret[0].(mutableState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartNewRunAfterTermination indicates an expected call of StartNewRunAfterTermination.
+func (mr *MockmutableStateMockRecorder) StartNewRunAfterTermination(parentNamespace, attributes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartNewRunAfterTermination", reflect.TypeOf((*MockmutableState)(nil).StartNewRunAfterTermination), parentNamespace, attributes)
+}
+
+// ResetActivityRetry mocks base method.
+func (m *MockmutableState) ResetActivityRetry(ai *persistence.ActivityInfo, resetAttempts bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetActivityRetry", ai, resetAttempts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetActivityRetry indicates an expected call of ResetActivityRetry.
+func (mr *MockmutableStateMockRecorder) ResetActivityRetry(ai, resetAttempts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetActivityRetry", reflect.TypeOf((*MockmutableState)(nil).ResetActivityRetry), ai, resetAttempts)
+}
+
 // UpdateActivity mocks base method.
 func (m *MockmutableState) UpdateActivity(arg0 *persistence.ActivityInfo) error {
 	m.ctrl.T.Helper()
@@ -2260,6 +2403,20 @@ func (mr *MockmutableStateMockRecorder) UpdateActivity(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivity", reflect.TypeOf((*MockmutableState)(nil).UpdateActivity), arg0)
 }
 
+// UpdateActivityOptions mocks base method.
+func (m *MockmutableState) UpdateActivityOptions(ai *persistence.ActivityInfo, request *adminservice.UpdateActivityOptionsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActivityOptions", ai, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateActivityOptions indicates an expected call of UpdateActivityOptions.
+func (mr *MockmutableStateMockRecorder) UpdateActivityOptions(ai, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActivityOptions", reflect.TypeOf((*MockmutableState)(nil).UpdateActivityOptions), ai, request)
+}
+
 // UpdateActivityProgress mocks base method.
 func (m *MockmutableState) UpdateActivityProgress(ai *persistence.ActivityInfo, request *workflowservice.RecordActivityTaskHeartbeatRequest) {
 	m.ctrl.T.Helper()
@@ -2322,6 +2479,34 @@ func (mr *MockmutableStateMockRecorder) UpdateUserTimer(arg0 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTimer", reflect.TypeOf((*MockmutableState)(nil).UpdateUserTimer), arg0)
 }
 
+// UpdateWorkflowAttributes mocks base method.
+func (m *MockmutableState) UpdateWorkflowAttributes(searchAttributes, memo map[string][]byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkflowAttributes", searchAttributes, memo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorkflowAttributes indicates an expected call of UpdateWorkflowAttributes.
+func (mr *MockmutableStateMockRecorder) UpdateWorkflowAttributes(searchAttributes, memo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkflowAttributes", reflect.TypeOf((*MockmutableState)(nil).UpdateWorkflowAttributes), searchAttributes, memo)
+}
+
+// UpdateCronSkipUntil mocks base method.
+func (m *MockmutableState) UpdateCronSkipUntil(skipUntil time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCronSkipUntil", skipUntil)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCronSkipUntil indicates an expected call of UpdateCronSkipUntil.
+func (mr *MockmutableStateMockRecorder) UpdateCronSkipUntil(skipUntil interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCronSkipUntil", reflect.TypeOf((*MockmutableState)(nil).UpdateCronSkipUntil), skipUntil)
+}
+
 // UpdateCurrentVersion mocks base method.
 func (m *MockmutableState) UpdateCurrentVersion(version int64, forceUpdate bool) error {
 	m.ctrl.T.Helper()
@@ -2382,6 +2567,22 @@ func (mr *MockmutableStateMockRecorder) AddTimerTasks(timerTasks ...interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTimerTasks", reflect.TypeOf((*MockmutableState)(nil).AddTimerTasks), timerTasks...)
 }
 
+// AddReplicationTasks mocks base method.
+func (m *MockmutableState) AddReplicationTasks(replicationTasks ...persistence.Task) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range replicationTasks {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "AddReplicationTasks", varargs...)
+}
+
+// AddReplicationTasks indicates an expected call of AddReplicationTasks.
+func (mr *MockmutableStateMockRecorder) AddReplicationTasks(replicationTasks ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReplicationTasks", reflect.TypeOf((*MockmutableState)(nil).AddReplicationTasks), replicationTasks...)
+}
+
 // SetUpdateCondition mocks base method.
 func (m *MockmutableState) SetUpdateCondition(arg0 int64) {
 	m.ctrl.T.Helper()
@@ -165,11 +165,14 @@ func (r *nDCActivityReplicatorImpl) SyncActivity(
 	// reset timer task status bits if
 	// 1. same source cluster & attempt changes
 	// 2. different source cluster
+	// 3. activity timeouts are updated
 	resetActivityTimerTaskStatus := false
 	if !r.clusterMetadata.IsVersionFromSameCluster(request.GetVersion(), ai.Version) {
 		resetActivityTimerTaskStatus = true
 	} else if ai.Attempt < request.GetAttempt() {
 		resetActivityTimerTaskStatus = true
+	} else if r.isActivityTimeoutUpdated(ai, request) {
+		resetActivityTimerTaskStatus = true
 	}
 	err = mutableState.ReplicateActivityInfo(request, resetActivityTimerTaskStatus)
 	if err != nil {
@@ -209,6 +212,21 @@ func (r *nDCActivityReplicatorImpl) SyncActivity(
 	)
 }
 
+func (r *nDCActivityReplicatorImpl) isActivityTimeoutUpdated(
+	ai *persistence.ActivityInfo,
+	request *historyservice.SyncActivityRequest,
+) bool {
+
+	// activity options are only sent by clusters which support updating them
+	if request.GetTaskList() == "" {
+		return false
+	}
+	return ai.ScheduleToStartTimeout != request.GetScheduleToStartTimeoutSeconds() ||
+		ai.ScheduleToCloseTimeout != request.GetScheduleToCloseTimeoutSeconds() ||
+		ai.StartToCloseTimeout != request.GetStartToCloseTimeoutSeconds() ||
+		ai.HeartbeatTimeout != request.GetHeartbeatTimeoutSeconds()
+}
+
 func (r *nDCActivityReplicatorImpl) shouldApplySyncActivity(
 	namespaceID string,
 	workflowID string,
@@ -178,6 +178,8 @@ func (r *nDCConflictResolverImpl) rebuild(
 	}
 	// set the update condition from original mutable state
 	rebuildMutableState.SetUpdateCondition(r.mutableState.GetUpdateCondition())
+	// the attributes updated by operators are not in the history
+	rebuildMutableState.InheritUpdatedWorkflowAttributes(r.mutableState)
 
 	r.context.clear()
 	r.context.setHistorySize(rebuiltHistorySize)
@@ -150,6 +150,7 @@ func (s *nDCConflictResolverSuite) TestRebuild() {
 	).Times(1)
 	mockRebuildMutableState.EXPECT().SetVersionHistories(versionHistories).Return(nil).Times(1)
 	mockRebuildMutableState.EXPECT().SetUpdateCondition(updateCondition).Times(1)
+	mockRebuildMutableState.EXPECT().InheritUpdatedWorkflowAttributes(s.mockMutableState).Times(1)
 
 	s.mockStateBuilder.EXPECT().rebuild(
 		ctx,
@@ -243,6 +244,7 @@ func (s *nDCConflictResolverSuite) TestPrepareMutableState_Rebuild() {
 	).Times(1)
 	mockRebuildMutableState.EXPECT().SetVersionHistories(versionHistories).Return(nil).Times(1)
 	mockRebuildMutableState.EXPECT().SetUpdateCondition(updateCondition).Times(1)
+	mockRebuildMutableState.EXPECT().InheritUpdatedWorkflowAttributes(s.mockMutableState).Times(1)
 
 	s.mockStateBuilder.EXPECT().rebuild(
 		ctx,
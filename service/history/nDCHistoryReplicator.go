@@ -47,6 +47,7 @@ var (
 	workflowTerminationReason   = "Terminate Workflow Due To Version Conflict."
 	workflowTerminationIdentity = "worker-service"
 	workflowResetReason         = "Reset Workflow Due To Events Re-application."
+	replicatedPausedReason      = "Workflow Created Paused By Replication."
 )
 
 const (
@@ -325,6 +326,12 @@ func (r *nDCHistoryReplicatorImpl) applyStartEvents(
 		return err
 	}
 
+	if task.isCreatePaused() {
+		// the task executors hold back the tasks generated for the new workflow
+		// until the workflow is unpaused
+		setWorkflowExecutionPaused(mutableState.GetExecutionInfo(), replicatedPausedReason, "")
+	}
+
 	err = r.transactionMgr.createWorkflow(
 		ctx,
 		task.getEventTime(),
@@ -59,6 +59,7 @@ type (
 		getLogger() log.Logger
 		getVersionHistory() *persistence.VersionHistory
 		isWorkflowReset() bool
+		isCreatePaused() bool
 
 		splitTask(taskStartTime time.Time) (nDCReplicationTask, nDCReplicationTask, error)
 	}
@@ -74,6 +75,7 @@ type (
 		events         []*eventpb.HistoryEvent
 		newEvents      []*eventpb.HistoryEvent
 		versionHistory *persistence.VersionHistory
+		createPaused   bool
 
 		startTime time.Time
 		logger    log.Logger
@@ -158,6 +160,7 @@ func newNDCReplicationTask(
 		events:         events,
 		newEvents:      newEvents,
 		versionHistory: persistence.NewVersionHistoryFromProto(versionHistory),
+		createPaused:   request.GetCreatePaused(),
 
 		startTime: taskStartTime,
 		logger:    logger,
@@ -232,6 +235,10 @@ func (t *nDCReplicationTaskImpl) isWorkflowReset() bool {
 	}
 }
 
+func (t *nDCReplicationTaskImpl) isCreatePaused() bool {
+	return t.createPaused
+}
+
 func (t *nDCReplicationTaskImpl) splitTask(
 	taskStartTime time.Time,
 ) (nDCReplicationTask, nDCReplicationTask, error) {
@@ -288,6 +295,7 @@ func (t *nDCReplicationTaskImpl) splitTask(
 		events:         newHistoryEvents,
 		newEvents:      []*eventpb.HistoryEvent{},
 		versionHistory: newVersionHistory,
+		createPaused:   t.createPaused,
 
 		startTime: taskStartTime,
 		logger:    logger,
@@ -122,6 +122,7 @@ type (
 	pushDecisionToMatchingInfo struct {
 		decisionScheduleToStartTimeout int32
 		tasklist                       tasklistpb.TaskList
+		workerBuildID                  string
 	}
 )
 
@@ -158,11 +159,13 @@ func newPushActivityToMatchingInfo(
 func newPushDecisionToMatchingInfo(
 	decisionScheduleToStartTimeout int32,
 	tasklist tasklistpb.TaskList,
+	workerBuildID string,
 ) *pushDecisionToMatchingInfo {
 
 	return &pushDecisionToMatchingInfo{
 		decisionScheduleToStartTimeout: decisionScheduleToStartTimeout,
 		tasklist:                       tasklist,
+		workerBuildID:                  workerBuildID,
 	}
 }
 
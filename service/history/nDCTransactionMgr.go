@@ -349,6 +349,7 @@ func (r *nDCTransactionMgrImpl) backfillWorkflowEventsReapply(
 			baseRebuildLastEventID,
 			baseRebuildLastEventVersion,
 			baseNextEventID,
+			baseMutableState,
 			resetRunID,
 			uuid.New(),
 			targetWorkflow,
@@ -226,6 +226,7 @@ func (s *nDCTransactionMgrSuite) TestBackfillWorkflow_CurrentWorkflow_Active_Clo
 		lastDecisionTaskStartedEventID,
 		lastDecisionTaskStartedVersion,
 		nextEventID,
+		mutableState,
 		gomock.Any(),
 		gomock.Any(),
 		workflow,
@@ -356,3 +356,75 @@ func (h *NilCheckHandler) RefreshWorkflowTasks(ctx context.Context, request *his
 	}
 	return resp, err
 }
+
+func (h *NilCheckHandler) PauseWorkflowExecution(ctx context.Context, request *historyservice.PauseWorkflowExecutionRequest) (*historyservice.PauseWorkflowExecutionResponse, error) {
+	resp, err := h.parentHandler.PauseWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.PauseWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+func (h *NilCheckHandler) UnpauseWorkflowExecution(ctx context.Context, request *historyservice.UnpauseWorkflowExecutionRequest) (*historyservice.UnpauseWorkflowExecutionResponse, error) {
+	resp, err := h.parentHandler.UnpauseWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.UnpauseWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+func (h *NilCheckHandler) TriggerCronRun(ctx context.Context, request *historyservice.TriggerCronRunRequest) (*historyservice.TriggerCronRunResponse, error) {
+	resp, err := h.parentHandler.TriggerCronRun(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.TriggerCronRunResponse{}
+	}
+	return resp, err
+}
+
+func (h *NilCheckHandler) SkipCronRuns(ctx context.Context, request *historyservice.SkipCronRunsRequest) (*historyservice.SkipCronRunsResponse, error) {
+	resp, err := h.parentHandler.SkipCronRuns(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.SkipCronRunsResponse{}
+	}
+	return resp, err
+}
+
+func (h *NilCheckHandler) UpdateActivityOptions(ctx context.Context, request *historyservice.UpdateActivityOptionsRequest) (*historyservice.UpdateActivityOptionsResponse, error) {
+	resp, err := h.parentHandler.UpdateActivityOptions(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.UpdateActivityOptionsResponse{}
+	}
+	return resp, err
+}
+
+func (h *NilCheckHandler) ResetActivity(ctx context.Context, request *historyservice.ResetActivityRequest) (*historyservice.ResetActivityResponse, error) {
+	resp, err := h.parentHandler.ResetActivity(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.ResetActivityResponse{}
+	}
+	return resp, err
+}
+
+func (h *NilCheckHandler) DeleteWorkflowExecution(ctx context.Context, request *historyservice.DeleteWorkflowExecutionRequest) (*historyservice.DeleteWorkflowExecutionResponse, error) {
+	resp, err := h.parentHandler.DeleteWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.DeleteWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
+
+func (h *NilCheckHandler) UpsertWorkflowAttributes(ctx context.Context, request *historyservice.UpsertWorkflowAttributesRequest) (*historyservice.UpsertWorkflowAttributesResponse, error) {
+	resp, err := h.parentHandler.UpsertWorkflowAttributes(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.UpsertWorkflowAttributesResponse{}
+	}
+	return resp, err
+}
+
+func (h *NilCheckHandler) UpdateWorkflowExecution(ctx context.Context, request *historyservice.UpdateWorkflowExecutionRequest) (*historyservice.UpdateWorkflowExecutionResponse, error) {
+	resp, err := h.parentHandler.UpdateWorkflowExecution(ctx, request)
+	if resp == nil && err == nil {
+		resp = &historyservice.UpdateWorkflowExecutionResponse{}
+	}
+	return resp, err
+}
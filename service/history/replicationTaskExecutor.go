@@ -104,6 +104,12 @@ func (e *replicationTaskExecutorImpl) execute(
 	case replicationgenpb.ReplicationTaskType_HistoryV2Task:
 		scope = metrics.HistoryReplicationV2TaskScope
 		err = e.handleHistoryReplicationTaskV2(replicationTask, forceApply)
+	case replicationgenpb.ReplicationTaskType_DeleteExecutionTask:
+		scope = metrics.DeleteExecutionReplicationTaskScope
+		err = e.handleDeleteExecutionTask(replicationTask, forceApply)
+	case replicationgenpb.ReplicationTaskType_SyncWorkflowStateTask:
+		scope = metrics.SyncWorkflowStateTaskScope
+		err = e.handleSyncWorkflowStateTask(replicationTask, forceApply)
 	default:
 		e.logger.Error("Unknown task type.")
 		scope = metrics.ReplicatorScope
@@ -125,20 +131,29 @@ func (e *replicationTaskExecutorImpl) handleActivityTask(
 	}
 
 	request := &historyservice.SyncActivityRequest{
-		NamespaceId:        attr.NamespaceId,
-		WorkflowId:         attr.WorkflowId,
-		RunId:              attr.RunId,
-		Version:            attr.Version,
-		ScheduledId:        attr.ScheduledId,
-		ScheduledTime:      attr.ScheduledTime,
-		StartedId:          attr.StartedId,
-		StartedTime:        attr.StartedTime,
-		LastHeartbeatTime:  attr.LastHeartbeatTime,
-		Details:            attr.Details,
-		Attempt:            attr.Attempt,
-		LastFailureReason:  attr.LastFailureReason,
-		LastWorkerIdentity: attr.LastWorkerIdentity,
-		VersionHistory:     attr.GetVersionHistory(),
+		NamespaceId:                   attr.NamespaceId,
+		WorkflowId:                    attr.WorkflowId,
+		RunId:                         attr.RunId,
+		Version:                       attr.Version,
+		ScheduledId:                   attr.ScheduledId,
+		ScheduledTime:                 attr.ScheduledTime,
+		StartedId:                     attr.StartedId,
+		StartedTime:                   attr.StartedTime,
+		LastHeartbeatTime:             attr.LastHeartbeatTime,
+		Details:                       attr.Details,
+		Attempt:                       attr.Attempt,
+		LastFailureReason:             attr.LastFailureReason,
+		LastWorkerIdentity:            attr.LastWorkerIdentity,
+		VersionHistory:                attr.GetVersionHistory(),
+		ScheduleToStartTimeoutSeconds: attr.ScheduleToStartTimeoutSeconds,
+		ScheduleToCloseTimeoutSeconds: attr.ScheduleToCloseTimeoutSeconds,
+		StartToCloseTimeoutSeconds:    attr.StartToCloseTimeoutSeconds,
+		HeartbeatTimeoutSeconds:       attr.HeartbeatTimeoutSeconds,
+		TaskList:                      attr.TaskList,
+		RetryPolicy:                   attr.RetryPolicy,
+		ExpirationTime:                attr.ExpirationTime,
+		OptionsUpdateIdentity:         attr.OptionsUpdateIdentity,
+		OptionsUpdateTime:             attr.OptionsUpdateTime,
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
 	defer cancel()
@@ -305,6 +320,40 @@ func (e *replicationTaskExecutorImpl) handleHistoryReplicationTaskV2(
 	return e.historyEngine.ReplicateEventsV2(ctx, request)
 }
 
+func (e *replicationTaskExecutorImpl) handleDeleteExecutionTask(
+	task *replicationgenpb.ReplicationTask,
+	forceApply bool,
+) error {
+
+	attr := task.GetDeleteExecutionTaskAttributes()
+	doContinue, err := e.filterTask(attr.GetNamespaceId(), forceApply)
+	if err != nil || !doContinue {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
+	defer cancel()
+
+	return e.historyEngine.ReplicateDeleteWorkflowExecution(ctx, attr)
+}
+
+func (e *replicationTaskExecutorImpl) handleSyncWorkflowStateTask(
+	task *replicationgenpb.ReplicationTask,
+	forceApply bool,
+) error {
+
+	attr := task.GetSyncWorkflowStateTaskAttributes()
+	doContinue, err := e.filterTask(attr.GetNamespaceId(), forceApply)
+	if err != nil || !doContinue {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), replicationTimeout)
+	defer cancel()
+
+	return e.historyEngine.ReplicateWorkflowState(ctx, attr)
+}
+
 func (e *replicationTaskExecutorImpl) filterTask(
 	namespaceID string,
 	forceApply bool,
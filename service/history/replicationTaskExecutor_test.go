@@ -300,3 +300,50 @@ func (s *replicationTaskExecutorSuite) TestProcess_HistoryV2ReplicationTask() {
 	_, err := s.replicationTaskHandler.execute(s.currentCluster, task, true)
 	s.NoError(err)
 }
+
+func (s *replicationTaskExecutorSuite) TestProcess_DeleteExecutionReplicationTask() {
+	namespaceID := uuid.New()
+	workflowID := uuid.New()
+	runID := uuid.New()
+	attributes := &replicationgenpb.DeleteExecutionTaskAttributes{
+		NamespaceId: namespaceID,
+		WorkflowId:  workflowID,
+		RunId:       runID,
+		Version:     1,
+	}
+	task := &replicationgenpb.ReplicationTask{
+		TaskType: replicationgenpb.ReplicationTaskType_DeleteExecutionTask,
+		Attributes: &replicationgenpb.ReplicationTask_DeleteExecutionTaskAttributes{
+			DeleteExecutionTaskAttributes: attributes,
+		},
+	}
+
+	s.mockEngine.EXPECT().ReplicateDeleteWorkflowExecution(gomock.Any(), attributes).Return(nil).Times(1)
+	_, err := s.replicationTaskHandler.execute(s.currentCluster, task, true)
+	s.NoError(err)
+}
+
+func (s *replicationTaskExecutorSuite) TestProcess_SyncWorkflowStateReplicationTask() {
+	namespaceID := uuid.New()
+	workflowID := uuid.New()
+	runID := uuid.New()
+	attributes := &replicationgenpb.SyncWorkflowStateTaskAttributes{
+		NamespaceId:   namespaceID,
+		WorkflowId:    workflowID,
+		RunId:         runID,
+		Version:       1,
+		Paused:        true,
+		PauseReason:   "pause reason",
+		PauseIdentity: "pause identity",
+	}
+	task := &replicationgenpb.ReplicationTask{
+		TaskType: replicationgenpb.ReplicationTaskType_SyncWorkflowStateTask,
+		Attributes: &replicationgenpb.ReplicationTask_SyncWorkflowStateTaskAttributes{
+			SyncWorkflowStateTaskAttributes: attributes,
+		},
+	}
+
+	s.mockEngine.EXPECT().ReplicateWorkflowState(gomock.Any(), attributes).Return(nil).Times(1)
+	_, err := s.replicationTaskHandler.execute(s.currentCluster, task, true)
+	s.NoError(err)
+}
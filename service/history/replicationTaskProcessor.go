@@ -479,6 +479,32 @@ func (p *ReplicationTaskProcessorImpl) generateDLQRequest(
 				Version:      events[0].GetVersion(),
 			},
 		}, nil
+	case replicationgenpb.ReplicationTaskType_DeleteExecutionTask:
+		taskAttributes := replicationTask.GetDeleteExecutionTaskAttributes()
+		return &persistence.PutReplicationTaskToDLQRequest{
+			SourceClusterName: p.sourceCluster,
+			TaskInfo: &persistenceblobs.ReplicationTaskInfo{
+				NamespaceId: primitives.MustParseUUID(taskAttributes.GetNamespaceId()),
+				WorkflowId:  taskAttributes.GetWorkflowId(),
+				RunId:       primitives.MustParseUUID(taskAttributes.GetRunId()),
+				TaskId:      replicationTask.GetSourceTaskId(),
+				TaskType:    persistence.ReplicationTaskTypeDeleteExecution,
+				Version:     taskAttributes.GetVersion(),
+			},
+		}, nil
+	case replicationgenpb.ReplicationTaskType_SyncWorkflowStateTask:
+		taskAttributes := replicationTask.GetSyncWorkflowStateTaskAttributes()
+		return &persistence.PutReplicationTaskToDLQRequest{
+			SourceClusterName: p.sourceCluster,
+			TaskInfo: &persistenceblobs.ReplicationTaskInfo{
+				NamespaceId: primitives.MustParseUUID(taskAttributes.GetNamespaceId()),
+				WorkflowId:  taskAttributes.GetWorkflowId(),
+				RunId:       primitives.MustParseUUID(taskAttributes.GetRunId()),
+				TaskId:      replicationTask.GetSourceTaskId(),
+				TaskType:    persistence.ReplicationTaskTypeSyncWorkflowState,
+				Version:     taskAttributes.GetVersion(),
+			},
+		}, nil
 	default:
 		return nil, fmt.Errorf("unknown replication task type")
 	}
@@ -187,6 +187,14 @@ func (p *replicatorQueueProcessorImpl) process(
 			err = p.executionMgr.CompleteReplicationTask(&persistence.CompleteReplicationTaskRequest{TaskID: task.GetTaskId()})
 		}
 		return metrics.ReplicatorTaskHistoryScope, err
+	case persistence.ReplicationTaskTypeDeleteExecution:
+		// deletion is only replicated by the RPC based replication, which reads the task with toReplicationTask
+		err := p.executionMgr.CompleteReplicationTask(&persistence.CompleteReplicationTaskRequest{TaskID: task.GetTaskId()})
+		return metrics.ReplicatorTaskDeleteExecutionScope, err
+	case persistence.ReplicationTaskTypeSyncWorkflowState:
+		// the workflow state is only replicated by the RPC based replication, which reads the task with toReplicationTask
+		err := p.executionMgr.CompleteReplicationTask(&persistence.CompleteReplicationTaskRequest{TaskID: task.GetTaskId()})
+		return metrics.ReplicatorTaskSyncWorkflowStateScope, err
 	default:
 		return metrics.ReplicatorQueueProcessorScope, errUnknownReplicationTask
 	}
@@ -586,11 +594,82 @@ func (p *replicatorQueueProcessorImpl) toReplicationTask(
 			task.SourceTaskId = qTask.GetTaskId()
 		}
 		return task, err
+	case persistence.ReplicationTaskTypeDeleteExecution:
+		task := p.generateDeleteExecutionTask(task)
+		task.SourceTaskId = qTask.GetTaskId()
+		return task, nil
+	case persistence.ReplicationTaskTypeSyncWorkflowState:
+		task, err := p.generateSyncWorkflowStateTask(ctx, task)
+		if task != nil {
+			task.SourceTaskId = qTask.GetTaskId()
+		}
+		return task, err
 	default:
 		return nil, errUnknownReplicationTask
 	}
 }
 
+func (p *replicatorQueueProcessorImpl) generateDeleteExecutionTask(
+	taskInfo *persistenceblobs.ReplicationTaskInfo,
+) *replicationgenpb.ReplicationTask {
+
+	// the mutable state may already be deleted, so the task is built from the task info only
+	return &replicationgenpb.ReplicationTask{
+		TaskType: replicationgenpb.ReplicationTaskType_DeleteExecutionTask,
+		Attributes: &replicationgenpb.ReplicationTask_DeleteExecutionTaskAttributes{
+			DeleteExecutionTaskAttributes: &replicationgenpb.DeleteExecutionTaskAttributes{
+				NamespaceId: primitives.UUID(taskInfo.GetNamespaceId()).String(),
+				WorkflowId:  taskInfo.GetWorkflowId(),
+				RunId:       primitives.UUID(taskInfo.GetRunId()).String(),
+				Version:     taskInfo.GetVersion(),
+			},
+		},
+	}
+}
+
+func (p *replicatorQueueProcessorImpl) generateSyncWorkflowStateTask(
+	ctx context.Context,
+	taskInfo *persistenceblobs.ReplicationTaskInfo,
+) (*replicationgenpb.ReplicationTask, error) {
+	namespaceID := primitives.UUID(taskInfo.GetNamespaceId()).String()
+	runID := primitives.UUID(taskInfo.GetRunId()).String()
+	return p.processReplication(
+		ctx,
+		true, // the state of closed workflows is still visible
+		namespaceID,
+		taskInfo.GetWorkflowId(),
+		runID,
+		func(mutableState mutableState) (*replicationgenpb.ReplicationTask, error) {
+			// the latest state is sent, which also covers the changes of earlier tasks
+			executionInfo := mutableState.GetExecutionInfo()
+			var cronSkipUntil int64
+			if !executionInfo.CronSkipUntil.IsZero() {
+				cronSkipUntil = executionInfo.CronSkipUntil.UnixNano()
+			}
+			return &replicationgenpb.ReplicationTask{
+				TaskType: replicationgenpb.ReplicationTaskType_SyncWorkflowStateTask,
+				Attributes: &replicationgenpb.ReplicationTask_SyncWorkflowStateTaskAttributes{
+					SyncWorkflowStateTaskAttributes: &replicationgenpb.SyncWorkflowStateTaskAttributes{
+						NamespaceId:             namespaceID,
+						WorkflowId:              taskInfo.GetWorkflowId(),
+						RunId:                   runID,
+						Version:                 executionInfo.SyncStateVersion,
+						Paused:                  executionInfo.Paused,
+						PauseReason:             executionInfo.PauseReason,
+						PauseIdentity:           executionInfo.PauseIdentity,
+						CronSkipUntil:           cronSkipUntil,
+						SearchAttributes:        executionInfo.SearchAttributes,
+						Memo:                    executionInfo.Memo,
+						UpdatedSearchAttributes: executionInfo.UpdatedSearchAttributes,
+						UpdatedMemo:             executionInfo.UpdatedMemo,
+						UpdateResults:           executionInfo.UpdateResults,
+					},
+				},
+			}, nil
+		},
+	)
+}
+
 func (p *replicatorQueueProcessorImpl) generateSyncActivityTask(
 	ctx context.Context,
 	taskInfo *persistenceblobs.ReplicationTaskInfo,
@@ -629,25 +708,50 @@ func (p *replicatorQueueProcessorImpl) generateSyncActivityTask(
 				versionHistory = rawVersionHistory.ToProto()
 			}
 
+			var optionsUpdateTime int64
+			if !activityInfo.OptionsUpdateTime.IsZero() {
+				optionsUpdateTime = activityInfo.OptionsUpdateTime.UnixNano()
+			}
+
+			var retryPolicy *commonpb.RetryPolicy
+			if activityInfo.HasRetryPolicy {
+				retryPolicy = &commonpb.RetryPolicy{
+					InitialIntervalInSeconds: activityInfo.InitialInterval,
+					BackoffCoefficient:       activityInfo.BackoffCoefficient,
+					MaximumIntervalInSeconds: activityInfo.MaximumInterval,
+					MaximumAttempts:          activityInfo.MaximumAttempts,
+					NonRetriableErrorReasons: activityInfo.NonRetriableErrors,
+				}
+			}
+
 			return &replicationgenpb.ReplicationTask{
 				TaskType: replicationgenpb.ReplicationTaskType_SyncActivityTask,
 				Attributes: &replicationgenpb.ReplicationTask_SyncActivityTaskAttributes{
 					SyncActivityTaskAttributes: &replicationgenpb.SyncActivityTaskAttributes{
-						NamespaceId:        namespaceID,
-						WorkflowId:         taskInfo.GetWorkflowId(),
-						RunId:              runID,
-						Version:            activityInfo.Version,
-						ScheduledId:        activityInfo.ScheduleID,
-						ScheduledTime:      scheduledTime,
-						StartedId:          activityInfo.StartedID,
-						StartedTime:        startedTime,
-						LastHeartbeatTime:  heartbeatTime,
-						Details:            activityInfo.Details,
-						Attempt:            activityInfo.Attempt,
-						LastFailureReason:  activityInfo.LastFailureReason,
-						LastWorkerIdentity: activityInfo.LastWorkerIdentity,
-						LastFailureDetails: activityInfo.LastFailureDetails,
-						VersionHistory:     versionHistory,
+						NamespaceId:                   namespaceID,
+						WorkflowId:                    taskInfo.GetWorkflowId(),
+						RunId:                         runID,
+						Version:                       activityInfo.Version,
+						ScheduledId:                   activityInfo.ScheduleID,
+						ScheduledTime:                 scheduledTime,
+						StartedId:                     activityInfo.StartedID,
+						StartedTime:                   startedTime,
+						LastHeartbeatTime:             heartbeatTime,
+						Details:                       activityInfo.Details,
+						Attempt:                       activityInfo.Attempt,
+						LastFailureReason:             activityInfo.LastFailureReason,
+						LastWorkerIdentity:            activityInfo.LastWorkerIdentity,
+						LastFailureDetails:            activityInfo.LastFailureDetails,
+						VersionHistory:                versionHistory,
+						ScheduleToStartTimeoutSeconds: activityInfo.ScheduleToStartTimeout,
+						ScheduleToCloseTimeoutSeconds: activityInfo.ScheduleToCloseTimeout,
+						StartToCloseTimeoutSeconds:    activityInfo.StartToCloseTimeout,
+						HeartbeatTimeoutSeconds:       activityInfo.HeartbeatTimeout,
+						TaskList:                      activityInfo.TaskList,
+						RetryPolicy:                   retryPolicy,
+						ExpirationTime:                activityInfo.ExpirationTime.UnixNano(),
+						OptionsUpdateIdentity:         activityInfo.OptionsUpdateIdentity,
+						OptionsUpdateTime:             optionsUpdateTime,
 					},
 				},
 			}, nil
@@ -25,6 +25,7 @@
 package history
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -36,6 +37,7 @@ import (
 	executionpb "go.temporal.io/temporal-proto/execution"
 	"go.temporal.io/temporal-proto/serviceerror"
 
+	eventgenpb "github.com/temporalio/temporal/.gen/proto/event"
 	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
 	replicationgenpb "github.com/temporalio/temporal/.gen/proto/replication"
 	"github.com/temporalio/temporal/common"
@@ -485,6 +487,70 @@ func (s *replicatorQueueProcessorSuite) TestSyncActivity_ActivityRunning() {
 	s.Nil(err)
 }
 
+func (s *replicatorQueueProcessorSuite) TestSyncWorkflowState_WorkflowCompleted() {
+	namespaceID := testNamespaceID
+	workflowID := "some random workflow ID"
+	runID := uuid.New()
+	taskID := int64(1444)
+	version := int64(2333)
+	task := &persistenceblobs.ReplicationTaskInfo{
+		TaskType:    persistence.ReplicationTaskTypeSyncWorkflowState,
+		TaskId:      taskID,
+		NamespaceId: primitives.MustParseUUID(namespaceID),
+		WorkflowId:  workflowID,
+		RunId:       primitives.MustParseUUID(runID),
+		Version:     version,
+	}
+
+	weContext, release, _ := s.replicatorQueueProcessor.historyCache.getOrCreateWorkflowExecutionForBackground(
+		namespaceID,
+		executionpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+	)
+	weContext.(*workflowExecutionContextImpl).mutableState = s.mockMutableState
+	release(nil)
+	s.mockMutableState.EXPECT().StartTransaction(gomock.Any()).Return(false, nil).Times(1)
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(false).AnyTimes()
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistence.WorkflowExecutionInfo{
+		NamespaceID:      namespaceID,
+		WorkflowID:       workflowID,
+		RunID:            runID,
+		SyncStateVersion: version,
+		Paused:           true,
+		PauseReason:      "pause reason",
+		PauseIdentity:    "pause identity",
+		UpdateResults: map[string]*eventgenpb.WorkflowUpdateResult{
+			"update-id": {UpdateId: "update-id", Result: []byte("result")},
+		},
+	}).AnyTimes()
+
+	replicationTask, err := s.replicatorQueueProcessor.toReplicationTask(
+		context.Background(),
+		&persistence.ReplicationTaskInfoWrapper{ReplicationTaskInfo: task},
+	)
+	s.NoError(err)
+	s.Equal(&replicationgenpb.ReplicationTask{
+		TaskType:     replicationgenpb.ReplicationTaskType_SyncWorkflowStateTask,
+		SourceTaskId: taskID,
+		Attributes: &replicationgenpb.ReplicationTask_SyncWorkflowStateTaskAttributes{
+			SyncWorkflowStateTaskAttributes: &replicationgenpb.SyncWorkflowStateTaskAttributes{
+				NamespaceId:   namespaceID,
+				WorkflowId:    workflowID,
+				RunId:         runID,
+				Version:       version,
+				Paused:        true,
+				PauseReason:   "pause reason",
+				PauseIdentity: "pause identity",
+				UpdateResults: map[string]*eventgenpb.WorkflowUpdateResult{
+					"update-id": {UpdateId: "update-id", Result: []byte("result")},
+				},
+			},
+		},
+	}, replicationTask)
+}
+
 func (s *replicatorQueueProcessorSuite) TestPaginateHistoryWithShardID() {
 	firstEventID := int64(133)
 	nextEventID := int64(134)
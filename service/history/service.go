@@ -159,6 +159,11 @@ type Config struct {
 	MaximumBufferedEventsBatch dynamicconfig.IntPropertyFn
 	MaximumSignalsPerExecution dynamicconfig.IntPropertyFnWithNamespaceFilter
 
+	// SignalDeduplicationWindow is how long the request id of a signal is remembered to deduplicate retried signals
+	SignalDeduplicationWindow dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	// MaximumSignalDeduplicationIDs is max number of signal request ids remembered by single execution
+	MaximumSignalDeduplicationIDs dynamicconfig.IntPropertyFnWithNamespaceFilter
+
 	// ShardUpdateMinInterval the minimal time interval which the shard info can be updated
 	ShardUpdateMinInterval dynamicconfig.DurationPropertyFn
 	// ShardSyncMinInterval the minimal time interval which the shard info should be sync to remote
@@ -171,6 +176,8 @@ type Config struct {
 
 	// encoding the history events
 	EventEncodingType dynamicconfig.StringPropertyFnWithNamespaceFilter
+	// compressing the persisted history events
+	EventCompressionType dynamicconfig.StringPropertyFnWithNamespaceFilter
 	// whether or not using ParentClosePolicy
 	EnableParentClosePolicy dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	// whether or not enable system workers for processing parent close policy task
@@ -231,6 +238,12 @@ type Config struct {
 	EnableConsistentQueryByNamespace dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	MaxBufferedQueryCount            dynamicconfig.IntPropertyFn
 
+	// The following are used by workflow update
+	MaxBufferedUpdateCount         dynamicconfig.IntPropertyFn
+	UpdateWorkflowExecutionTimeout dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	UpdateResultRetention          dynamicconfig.DurationPropertyFnWithNamespaceFilter
+	MaxUpdateResultCount           dynamicconfig.IntPropertyFnWithNamespaceFilter
+
 	// Data integrity check related config knobs
 	MutableStateChecksumGenProbability    dynamicconfig.IntPropertyFnWithNamespaceFilter
 	MutableStateChecksumVerifyProbability dynamicconfig.IntPropertyFnWithNamespaceFilter
@@ -338,6 +351,8 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, storeType strin
 		HistoryMgrNumConns:              dc.GetIntProperty(dynamicconfig.HistoryMgrNumConns, 50),
 		MaximumBufferedEventsBatch:      dc.GetIntProperty(dynamicconfig.MaximumBufferedEventsBatch, 100),
 		MaximumSignalsPerExecution:      dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MaximumSignalsPerExecution, 0),
+		SignalDeduplicationWindow:       dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.SignalDeduplicationWindow, 24*time.Hour),
+		MaximumSignalDeduplicationIDs:   dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MaximumSignalDeduplicationIDs, 1000),
 		ShardUpdateMinInterval:          dc.GetDurationProperty(dynamicconfig.ShardUpdateMinInterval, 5*time.Minute),
 		ShardSyncMinInterval:            dc.GetDurationProperty(dynamicconfig.ShardSyncMinInterval, 5*time.Minute),
 		ShardSyncTimerJitterCoefficient: dc.GetFloat64Property(dynamicconfig.TransferProcessorMaxPollIntervalJitterCoefficient, 0.15),
@@ -346,6 +361,7 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, storeType strin
 		// TODO: Return this value to the client: github.com/temporalio/temporal/issues/294
 		LongPollExpirationInterval:          dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.HistoryLongPollExpirationInterval, time.Second*20),
 		EventEncodingType:                   dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.DefaultEventEncoding, string(common.EncodingTypeProto3)),
+		EventCompressionType:                dc.GetStringPropertyFnWithNamespaceFilter(dynamicconfig.EventCompression, string(persistence.CompressionTypeNone)),
 		EnableParentClosePolicy:             dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableParentClosePolicy, true),
 		NumParentClosePolicySystemWorkflows: dc.GetIntProperty(dynamicconfig.NumParentClosePolicySystemWorkflows, 10),
 		EnableParentClosePolicyWorker:       dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
@@ -385,6 +401,10 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, storeType strin
 		EnableConsistentQuery:                 dc.GetBoolProperty(dynamicconfig.EnableConsistentQuery, true),
 		EnableConsistentQueryByNamespace:      dc.GetBoolPropertyFnWithNamespaceFilter(dynamicconfig.EnableConsistentQueryByNamespace, false),
 		MaxBufferedQueryCount:                 dc.GetIntProperty(dynamicconfig.MaxBufferedQueryCount, 1),
+		MaxBufferedUpdateCount:                dc.GetIntProperty(dynamicconfig.MaxBufferedUpdateCount, 10),
+		UpdateWorkflowExecutionTimeout:        dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.UpdateWorkflowExecutionTimeout, 20*time.Second),
+		UpdateResultRetention:                 dc.GetDurationPropertyFilteredByNamespace(dynamicconfig.UpdateResultRetention, 24*time.Hour),
+		MaxUpdateResultCount:                  dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MaxUpdateResultCount, 100),
 		MutableStateChecksumGenProbability:    dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MutableStateChecksumGenProbability, 0),
 		MutableStateChecksumVerifyProbability: dc.GetIntPropertyFilteredByNamespace(dynamicconfig.MutableStateChecksumVerifyProbability, 0),
 		MutableStateChecksumInvalidateBefore:  dc.GetFloat64Property(dynamicconfig.MutableStateChecksumInvalidateBefore, 0),
@@ -441,8 +461,12 @@ func NewService(
 			if err != nil {
 				logger.Fatal("Creating visibility producer failed", tag.Error(err))
 			}
+			serializer, err := persistence.NewPayloadSerializerWithEncryption(params.PersistenceConfig.Encryption)
+			if err != nil {
+				logger.Fatal("Creating payload serializer failed", tag.Error(err))
+			}
 			visibilityFromES = espersistence.NewESVisibilityManager("", nil, nil, visibilityProducer,
-				params.MetricsClient, logger)
+				serializer, params.MetricsClient, logger)
 		}
 		return persistence.NewVisibilityManagerWrapper(
 			visibilityFromDB,
@@ -488,7 +512,8 @@ func (s *Service) Start() {
 	s.Resource.Start()
 	s.handler.Start()
 
-	s.server = grpc.NewServer(grpc.UnaryInterceptor(interceptor))
+	opts := append(s.GetGRPCServerOptions(), grpc.UnaryInterceptor(interceptor))
+	s.server = grpc.NewServer(opts...)
 	nilCheckHandler := NewNilCheckHandler(s.handler)
 	historyservice.RegisterHistoryServiceServer(s.server, nilCheckHandler)
 	healthpb.RegisterHealthServer(s.server, s.handler)
@@ -545,6 +545,19 @@ func (s *shardContextImpl) getDefaultEncoding(namespaceEntry *cache.NamespaceCac
 	return common.EncodingType(s.config.EventEncodingType(namespaceEntry.GetInfo().Name))
 }
 
+func (s *shardContextImpl) getEventCompressionType(namespaceEntry *cache.NamespaceCacheEntry) persistence.CompressionType {
+	value := s.config.EventCompressionType(namespaceEntry.GetInfo().Name)
+	compression, err := persistence.ParseCompressionType(value)
+	if err != nil {
+		// a bad dynamic config value must not fail every history append, store the events uncompressed
+		s.logger.Warn("Invalid history event compression type, events are not compressed",
+			tag.WorkflowNamespace(namespaceEntry.GetInfo().Name),
+			tag.Value(value),
+			tag.Error(err))
+	}
+	return compression
+}
+
 func (s *shardContextImpl) UpdateWorkflowExecution(
 	request *persistence.UpdateWorkflowExecutionRequest,
 ) (*persistence.UpdateWorkflowExecutionResponse, error) {
@@ -836,10 +849,13 @@ func (s *shardContextImpl) AppendHistoryV2Events(
 	}
 
 	request.Encoding = s.getDefaultEncoding(namespaceEntry)
+	request.Compression = s.getEventCompressionType(namespaceEntry)
 	request.ShardID = convert.IntPtr(s.shardID)
 	request.TransactionID = transactionID
+	request.NamespaceID = namespaceID
 
 	size := 0
+	compressedSize := 0
 	defer func() {
 		// N.B. - Dual emit here makes sense so that we can see aggregate timer stats across all
 		// namespaces along with the individual namespaces stats
@@ -847,6 +863,11 @@ func (s *shardContextImpl) AppendHistoryV2Events(
 		if entry, err := s.GetNamespaceCache().GetNamespaceByID(namespaceID); err == nil && entry != nil && entry.GetInfo() != nil {
 			s.GetMetricsClient().Scope(metrics.SessionSizeStatsScope, metrics.NamespaceTag(entry.GetInfo().Name)).RecordTimer(metrics.HistorySize, time.Duration(size))
 		}
+		if request.Compression != persistence.CompressionTypeNone && size > 0 {
+			scope := s.GetMetricsClient().Scope(metrics.SessionSizeStatsScope, metrics.NamespaceTag(namespaceEntry.GetInfo().Name))
+			scope.RecordTimer(metrics.HistoryCompressedSize, time.Duration(compressedSize))
+			scope.UpdateGauge(metrics.HistoryCompressionRatio, float64(compressedSize)/float64(size))
+		}
 		if size >= historySizeLogThreshold {
 			s.throttledLogger.Warn("history size threshold breached",
 				tag.WorkflowID(execution.GetWorkflowId()),
@@ -858,6 +879,7 @@ func (s *shardContextImpl) AppendHistoryV2Events(
 	resp, err0 := s.GetHistoryManager().AppendHistoryNodes(request)
 	if resp != nil {
 		size = resp.Size
+		compressedSize = resp.CompressedSize
 	}
 	return size, err0
 }
@@ -32,6 +32,7 @@ import (
 
 	"go.temporal.io/temporal-proto/serviceerror"
 
+	"github.com/temporalio/temporal/common"
 	"github.com/temporalio/temporal/common/cache"
 	"github.com/temporalio/temporal/common/log"
 	"github.com/temporalio/temporal/common/log/tag"
@@ -76,8 +77,12 @@ const (
 )
 
 var defaultTaskPriorityWeight = map[int]int{
+	getTaskPriority(taskHighPriorityClass, taskHighPrioritySubclass):       300,
 	getTaskPriority(taskHighPriorityClass, taskDefaultPrioritySubclass):    200,
+	getTaskPriority(taskHighPriorityClass, taskLowPrioritySubclass):        100,
+	getTaskPriority(taskDefaultPriorityClass, taskHighPrioritySubclass):    150,
 	getTaskPriority(taskDefaultPriorityClass, taskDefaultPrioritySubclass): 100,
+	getTaskPriority(taskDefaultPriorityClass, taskLowPrioritySubclass):     50,
 	getTaskPriority(taskLowPriorityClass, taskDefaultPrioritySubclass):     50,
 }
 
@@ -117,8 +122,10 @@ func (a *taskPriorityAssignerImpl) Assign(
 		return nil
 	}
 
+	// active tasks are further ordered by the priority of the workflow or activity
+	subClass := getTaskPrioritySubclass(task.GetPriority())
 	if !a.getRateLimiter(namespace).Allow() {
-		task.SetPriority(getTaskPriority(taskDefaultPriorityClass, taskDefaultPrioritySubclass))
+		task.SetPriority(a.getWeightedTaskPriority(taskDefaultPriorityClass, subClass))
 		taggedScope := a.scope.Tagged(metrics.NamespaceTag(namespace))
 		if task.GetQueueType() == transferQueueType {
 			taggedScope.IncCounter(metrics.TransferTaskThrottledCounter)
@@ -128,10 +135,25 @@ func (a *taskPriorityAssignerImpl) Assign(
 		return nil
 	}
 
-	task.SetPriority(getTaskPriority(taskHighPriorityClass, taskDefaultPrioritySubclass))
+	task.SetPriority(a.getWeightedTaskPriority(taskHighPriorityClass, subClass))
 	return nil
 }
 
+// getWeightedTaskPriority returns the priority of the subclass if the task scheduler has a weight for it,
+// round robin weights configured before task priorities were introduced only cover the default subclasses
+func (a *taskPriorityAssignerImpl) getWeightedTaskPriority(
+	class, subClass int,
+) int {
+	priority := getTaskPriority(class, subClass)
+	if subClass == taskDefaultPrioritySubclass {
+		return priority
+	}
+	if _, ok := a.config.TaskSchedulerRoundRobinWeights()[strconv.Itoa(priority)]; !ok {
+		return getTaskPriority(class, taskDefaultPrioritySubclass)
+	}
+	return priority
+}
+
 // getNamespaceInfo returns three pieces of information:
 //  1. namespace name
 //  2. if namespace is active
@@ -189,6 +211,19 @@ func getTaskPriority(
 	return class | subClass
 }
 
+func getTaskPrioritySubclass(
+	priority int32,
+) int {
+	switch common.NormalizeTaskPriority(priority) {
+	case common.TaskPriorityHigh:
+		return taskHighPrioritySubclass
+	case common.TaskPriorityLow:
+		return taskLowPrioritySubclass
+	default:
+		return taskDefaultPrioritySubclass
+	}
+}
+
 func convertWeightsToDynamicConfigValue(
 	weights map[int]int,
 ) map[string]interface{} {
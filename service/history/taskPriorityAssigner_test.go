@@ -34,6 +34,7 @@ import (
 	"github.com/uber-go/tally"
 	"go.temporal.io/temporal-proto/serviceerror"
 
+	"github.com/temporalio/temporal/common"
 	"github.com/temporalio/temporal/common/cache"
 	"github.com/temporalio/temporal/common/cluster"
 	"github.com/temporalio/temporal/common/log"
@@ -170,6 +171,7 @@ func (s *taskPriorityAssignerSuite) TestAssign_TransferTask() {
 	mockTask := NewMockqueueTask(s.controller)
 	mockTask.EXPECT().GetQueueType().Return(transferQueueType).AnyTimes()
 	mockTask.EXPECT().GetNamespaceID().Return(primitives.MustParseUUID(testNamespaceID)).Times(1)
+	mockTask.EXPECT().GetPriority().Return(common.TaskPriorityUnspecified).AnyTimes()
 	mockTask.EXPECT().SetPriority(getTaskPriority(taskHighPriorityClass, taskDefaultPrioritySubclass)).Times(1)
 
 	err := s.priorityAssigner.Assign(mockTask)
@@ -182,6 +184,7 @@ func (s *taskPriorityAssignerSuite) TestAssign_TimerTask() {
 	mockTask := NewMockqueueTask(s.controller)
 	mockTask.EXPECT().GetQueueType().Return(timerQueueType).AnyTimes()
 	mockTask.EXPECT().GetNamespaceID().Return(primitives.MustParseUUID(testNamespaceID)).Times(1)
+	mockTask.EXPECT().GetPriority().Return(common.TaskPriorityUnspecified).AnyTimes()
 	mockTask.EXPECT().SetPriority(getTaskPriority(taskHighPriorityClass, taskDefaultPrioritySubclass)).Times(1)
 
 	err := s.priorityAssigner.Assign(mockTask)
@@ -195,6 +198,7 @@ func (s *taskPriorityAssignerSuite) TestAssign_ThrottledTask() {
 		mockTask := NewMockqueueTask(s.controller)
 		mockTask.EXPECT().GetQueueType().Return(timerQueueType).AnyTimes()
 		mockTask.EXPECT().GetNamespaceID().Return(primitives.MustParseUUID(testNamespaceID)).Times(1)
+		mockTask.EXPECT().GetPriority().Return(common.TaskPriorityUnspecified).AnyTimes()
 		if i < s.testTaskProcessRPS {
 			mockTask.EXPECT().SetPriority(getTaskPriority(taskHighPriorityClass, taskDefaultPrioritySubclass)).Times(1)
 		} else {
@@ -206,6 +210,61 @@ func (s *taskPriorityAssignerSuite) TestAssign_ThrottledTask() {
 	}
 }
 
+func (s *taskPriorityAssignerSuite) TestAssign_PrioritizedTask() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(testNamespaceID).Return(testGlobalNamespaceEntry, nil).AnyTimes()
+
+	testCases := []struct {
+		taskPriority     int32
+		expectedSubclass int
+	}{
+		{
+			taskPriority:     common.TaskPriorityHigh,
+			expectedSubclass: taskHighPrioritySubclass,
+		},
+		{
+			taskPriority:     common.TaskPriorityNormal,
+			expectedSubclass: taskDefaultPrioritySubclass,
+		},
+		{
+			taskPriority:     common.TaskPriorityLow,
+			expectedSubclass: taskLowPrioritySubclass,
+		},
+	}
+
+	for _, tc := range testCases {
+		mockTask := NewMockqueueTask(s.controller)
+		mockTask.EXPECT().GetQueueType().Return(transferQueueType).AnyTimes()
+		mockTask.EXPECT().GetNamespaceID().Return(primitives.MustParseUUID(testNamespaceID)).Times(1)
+		mockTask.EXPECT().GetPriority().Return(tc.taskPriority).AnyTimes()
+		mockTask.EXPECT().SetPriority(getTaskPriority(taskHighPriorityClass, tc.expectedSubclass)).Times(1)
+
+		err := s.priorityAssigner.Assign(mockTask)
+		s.NoError(err)
+	}
+}
+
+func (s *taskPriorityAssignerSuite) TestAssign_PrioritizedTask_MissingWeights() {
+	s.mockNamespaceCache.EXPECT().GetNamespaceByID(testNamespaceID).Return(testGlobalNamespaceEntry, nil).AnyTimes()
+
+	// weights overridden before task priorities were introduced
+	s.priorityAssigner.config.TaskSchedulerRoundRobinWeights = dynamicconfig.GetMapPropertyFn(convertWeightsToDynamicConfigValue(map[int]int{
+		getTaskPriority(taskHighPriorityClass, taskDefaultPrioritySubclass):    500,
+		getTaskPriority(taskDefaultPriorityClass, taskDefaultPrioritySubclass): 20,
+		getTaskPriority(taskLowPriorityClass, taskDefaultPrioritySubclass):     10,
+	}))
+
+	for _, taskPriority := range []int32{common.TaskPriorityHigh, common.TaskPriorityNormal, common.TaskPriorityLow} {
+		mockTask := NewMockqueueTask(s.controller)
+		mockTask.EXPECT().GetQueueType().Return(transferQueueType).AnyTimes()
+		mockTask.EXPECT().GetNamespaceID().Return(primitives.MustParseUUID(testNamespaceID)).Times(1)
+		mockTask.EXPECT().GetPriority().Return(taskPriority).AnyTimes()
+		mockTask.EXPECT().SetPriority(getTaskPriority(taskHighPriorityClass, taskDefaultPrioritySubclass)).Times(1)
+
+		err := s.priorityAssigner.Assign(mockTask)
+		s.NoError(err)
+	}
+}
+
 func (s *taskPriorityAssignerSuite) TestGetTaskPriority() {
 	testCases := []struct {
 		class            int
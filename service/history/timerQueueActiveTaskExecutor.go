@@ -27,6 +27,7 @@ package history
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	commonpb "go.temporal.io/temporal-proto/common"
@@ -35,7 +36,9 @@ import (
 	executionpb "go.temporal.io/temporal-proto/execution"
 	"go.temporal.io/temporal-proto/serviceerror"
 	tasklistpb "go.temporal.io/temporal-proto/tasklist"
+	"go.temporal.io/temporal-proto/workflowservice"
 
+	"github.com/temporalio/temporal/.gen/proto/historyservice"
 	"github.com/temporalio/temporal/.gen/proto/matchingservice"
 	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
 	"github.com/temporalio/temporal/common"
@@ -47,6 +50,13 @@ import (
 	"github.com/temporalio/temporal/common/primitives"
 )
 
+const (
+	// cronOverlapCancelRequestID is the cancel request ID of a cron run canceled by the cancel_previous overlap policy
+	cronOverlapCancelRequestID = "cron-overlap-cancel"
+	cronOverlapCancelCause     = "cron schedule overlap"
+	cronOverlapTerminateReason = "cronScheduleOverlap"
+)
+
 type (
 	timerQueueActiveTaskExecutor struct {
 		*timerQueueTaskExecutorBase
@@ -124,7 +134,7 @@ func (t *timerQueueActiveTaskExecutor) executeUserTimerTimeoutTask(
 	if err != nil {
 		return err
 	}
-	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
+	if !isTimerProcessable(mutableState) {
 		return nil
 	}
 
@@ -176,7 +186,7 @@ func (t *timerQueueActiveTaskExecutor) executeActivityTimeoutTask(
 	if err != nil {
 		return err
 	}
-	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
+	if !isTimerProcessable(mutableState) {
 		return nil
 	}
 
@@ -274,7 +284,7 @@ func (t *timerQueueActiveTaskExecutor) executeDecisionTimeoutTask(
 	if err != nil {
 		return err
 	}
-	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
+	if !isTimerProcessable(mutableState) {
 		return nil
 	}
 
@@ -346,13 +356,17 @@ func (t *timerQueueActiveTaskExecutor) executeWorkflowBackoffTimerTask(
 	if err != nil {
 		return err
 	}
-	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
+	if !isTimerProcessable(mutableState) {
 		return nil
 	}
 
-	if task.TimeoutType == persistence.WorkflowBackoffTimeoutTypeRetry {
+	switch task.TimeoutType {
+	case persistence.WorkflowBackoffTimeoutTypeRetry:
 		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowRetryBackoffTimerCount)
-	} else {
+	case persistence.WorkflowBackoffTimeoutTypeCronOverlap:
+		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowCronOverlapTimerCount)
+		return t.enforceCronOverlapPolicy(task, weContext, mutableState)
+	default:
 		t.metricsClient.IncCounter(metrics.TimerActiveTaskWorkflowBackoffTimerScope, metrics.WorkflowCronBackoffTimerCount)
 	}
 
@@ -361,6 +375,12 @@ func (t *timerQueueActiveTaskExecutor) executeWorkflowBackoffTimerTask(
 		return nil
 	}
 
+	visibilityTimestamp, _ := types.TimestampFromProto(task.VisibilityTimestamp)
+	if visibilityTimestamp.Before(mutableState.GetExecutionInfo().CronSkipUntil) {
+		// upcoming cron runs were skipped, another backoff timer fires at the skip time
+		return nil
+	}
+
 	// schedule first decision task
 	return t.updateWorkflowExecution(weContext, mutableState, true)
 }
@@ -381,14 +401,15 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 	if err != nil {
 		return err
 	}
-	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
+	if !isTimerProcessable(mutableState) {
 		return nil
 	}
 
 	// generate activity task
 	scheduledID := task.GetEventId()
 	activityInfo, ok := mutableState.GetActivityInfo(scheduledID)
-	if !ok || task.ScheduleAttempt < int64(activityInfo.Attempt) || activityInfo.StartedID != common.EmptyEventID {
+	if !ok || task.ScheduleAttempt < int64(activityInfo.Attempt) || activityInfo.StartedID != common.EmptyEventID ||
+		isActivityRetryTimerSuperseded(task, activityInfo) {
 		if ok {
 			t.logger.Info("Duplicate activity retry timer task",
 				tag.WorkflowID(mutableState.GetExecutionInfo().WorkflowID),
@@ -436,6 +457,10 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 		Name: activityInfo.TaskList,
 	}
 	scheduleToStartTimeout := activityInfo.ScheduleToStartTimeout
+	priority := activityInfo.Priority
+	if priority == common.TaskPriorityUnspecified {
+		priority = mutableState.GetExecutionInfo().Priority
+	}
 
 	release(nil) // release earlier as we don't need the lock anymore
 
@@ -446,6 +471,7 @@ func (t *timerQueueActiveTaskExecutor) executeActivityRetryTimerTask(
 		TaskList:                      taskList,
 		ScheduleId:                    scheduledID,
 		ScheduleToStartTimeoutSeconds: scheduleToStartTimeout,
+		Priority:                      priority,
 	})
 
 	return retError
@@ -467,7 +493,7 @@ func (t *timerQueueActiveTaskExecutor) executeWorkflowTimeoutTask(
 	if err != nil {
 		return err
 	}
-	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
+	if !isTimerProcessable(mutableState) {
 		return nil
 	}
 
@@ -504,10 +530,145 @@ func (t *timerQueueActiveTaskExecutor) executeWorkflowTimeoutTask(
 	}
 
 	// workflow timeout, but a retry or cron is needed, so we do continue as new to retry or cron
-	startEvent, err := mutableState.GetStartEvent()
+	return t.continueAsNewWorkflow(
+		weContext,
+		mutableState,
+		eventBatchFirstEventID,
+		backoffInterval,
+		continueAsNewInitiator,
+		timeoutReason,
+	)
+}
+
+func (t *timerQueueActiveTaskExecutor) enforceCronOverlapPolicy(
+	task *persistenceblobs.TimerTaskInfo,
+	weContext workflowExecutionContext,
+	mutableState mutableState,
+) error {
+
+	executionInfo := mutableState.GetExecutionInfo()
+	visibilityTimestamp, _ := types.TimestampFromProto(task.VisibilityTimestamp)
+	if !mutableState.HasProcessedOrPendingDecision() || visibilityTimestamp.Before(executionInfo.CronSkipUntil) {
+		// the run has not started yet, or the overlapping schedule was skipped
+		return nil
+	}
+
+	startVersion, err := mutableState.GetStartVersion()
+	if err != nil {
+		return err
+	}
+	ok, err := verifyTaskVersion(t.shard, t.logger, task.GetNamespaceId(), startVersion, task.Version, task)
+	if err != nil || !ok {
+		return err
+	}
+
+	switch backoff.GetCronOverlapPolicy(executionInfo.CronSchedule) {
+	case backoff.CronOverlapPolicyCancelPrevious:
+		if executionInfo.CancelRequested {
+			return nil
+		}
+		if _, err := mutableState.AddWorkflowExecutionCancelRequestedEvent(
+			cronOverlapCancelCause,
+			&historyservice.RequestCancelWorkflowExecutionRequest{
+				NamespaceId: executionInfo.NamespaceID,
+				CancelRequest: &workflowservice.RequestCancelWorkflowExecutionRequest{
+					WorkflowExecution: &executionpb.WorkflowExecution{
+						WorkflowId: executionInfo.WorkflowID,
+						RunId:      executionInfo.RunID,
+					},
+					Identity:  identityHistoryService,
+					RequestId: cronOverlapCancelRequestID,
+				},
+			},
+		); err != nil {
+			return err
+		}
+		return t.updateWorkflowExecution(weContext, mutableState, true)
+
+	case backoff.CronOverlapPolicyTerminatePrevious:
+		// the run is terminated and the next cron run starts right away
+		return t.terminateAndStartCronRun(weContext, mutableState)
+
+	default:
+		return nil
+	}
+}
+
+func (t *timerQueueActiveTaskExecutor) continueAsNewWorkflow(
+	weContext workflowExecutionContext,
+	mutableState mutableState,
+	eventBatchFirstEventID int64,
+	backoffInterval time.Duration,
+	continueAsNewInitiator commonpb.ContinueAsNewInitiator,
+	failureReason string,
+) error {
+
+	startAttributes, continueAsnewAttributes, err := getNewRunAttributes(
+		mutableState,
+		backoffInterval,
+		continueAsNewInitiator,
+		failureReason,
+	)
+	if err != nil {
+		return err
+	}
+	newMutableState, err := retryWorkflow(
+		mutableState,
+		eventBatchFirstEventID,
+		startAttributes.GetParentWorkflowNamespace(),
+		continueAsnewAttributes,
+	)
+	if err != nil {
+		return err
+	}
+	return t.updateWorkflowExecutionWithNew(weContext, newMutableState)
+}
+
+func (t *timerQueueActiveTaskExecutor) terminateAndStartCronRun(
+	weContext workflowExecutionContext,
+	mutableState mutableState,
+) error {
+
+	startAttributes, newRunAttributes, err := getNewRunAttributes(
+		mutableState,
+		0,
+		commonpb.ContinueAsNewInitiator_CronSchedule,
+		cronOverlapTerminateReason,
+	)
 	if err != nil {
 		return err
 	}
+	if err := terminateWorkflow(
+		mutableState,
+		mutableState.GetNextEventID(),
+		cronOverlapTerminateReason,
+		nil,
+		identityHistoryService,
+	); err != nil {
+		return err
+	}
+	newMutableState, err := mutableState.StartNewRunAfterTermination(
+		startAttributes.GetParentWorkflowNamespace(),
+		newRunAttributes,
+	)
+	if err != nil {
+		return err
+	}
+	return t.updateWorkflowExecutionWithNew(weContext, newMutableState)
+}
+
+// getNewRunAttributes returns the start attributes of a workflow run and the attributes of its next run
+func getNewRunAttributes(
+	mutableState mutableState,
+	backoffInterval time.Duration,
+	continueAsNewInitiator commonpb.ContinueAsNewInitiator,
+	failureReason string,
+) (*eventpb.WorkflowExecutionStartedEventAttributes, *decisionpb.ContinueAsNewWorkflowExecutionDecisionAttributes, error) {
+
+	startEvent, err := mutableState.GetStartEvent()
+	if err != nil {
+		return nil, nil, err
+	}
 
 	startAttributes := startEvent.GetWorkflowExecutionStartedEventAttributes()
 	continueAsnewAttributes := &decisionpb.ContinueAsNewWorkflowExecutionDecisionAttributes{
@@ -519,21 +680,19 @@ func (t *timerQueueActiveTaskExecutor) executeWorkflowTimeoutTask(
 		BackoffStartIntervalInSeconds:       int32(backoffInterval.Seconds()),
 		RetryPolicy:                         startAttributes.RetryPolicy,
 		Initiator:                           continueAsNewInitiator,
-		FailureReason:                       timeoutReason,
+		FailureReason:                       failureReason,
 		CronSchedule:                        mutableState.GetExecutionInfo().CronSchedule,
 		Header:                              startAttributes.Header,
 		Memo:                                startAttributes.Memo,
 		SearchAttributes:                    startAttributes.SearchAttributes,
 	}
-	newMutableState, err := retryWorkflow(
-		mutableState,
-		eventBatchFirstEventID,
-		startAttributes.GetParentWorkflowNamespace(),
-		continueAsnewAttributes,
-	)
-	if err != nil {
-		return err
-	}
+	return startAttributes, continueAsnewAttributes, nil
+}
+
+func (t *timerQueueActiveTaskExecutor) updateWorkflowExecutionWithNew(
+	weContext workflowExecutionContext,
+	newMutableState mutableState,
+) error {
 
 	newExecutionInfo := newMutableState.GetExecutionInfo()
 	return weContext.updateWorkflowExecutionWithNewAsActive(
@@ -611,3 +770,26 @@ func (t *timerQueueActiveTaskExecutor) emitTimeoutMetricScopeWithNamespaceTag(
 		metricsScope.IncCounter(metrics.HeartbeatTimeoutCounter)
 	}
 }
+
+// isActivityRetryTimerSuperseded tells whether the retry of the activity was moved,
+// e.g. retried immediately, after the retry timer task was generated
+func isActivityRetryTimerSuperseded(
+	task *persistenceblobs.TimerTaskInfo,
+	activityInfo *persistence.ActivityInfo,
+) bool {
+
+	// retry timer tasks generated by older versions do not record the scheduled time
+	return task.GetActivityScheduledTimeNanos() != 0 &&
+		task.GetActivityScheduledTimeNanos() != activityInfo.ScheduledTime.UnixNano()
+}
+
+// isTimerProcessable returns whether the timers of a workflow can fire, timers of a paused
+// workflow are left pending and are regenerated on unpause
+func isTimerProcessable(
+	mutableState mutableState,
+) bool {
+
+	return mutableState != nil &&
+		mutableState.IsWorkflowExecutionRunning() &&
+		!mutableState.IsWorkflowExecutionPaused()
+}
@@ -312,12 +312,23 @@ func (t *timerQueueStandbyTaskExecutor) executeWorkflowBackoffTimerTask(
 
 	actionFn := func(context workflowExecutionContext, mutableState mutableState) (interface{}, error) {
 
+		if timerTask.TimeoutType == persistence.WorkflowBackoffTimeoutTypeCronOverlap {
+			// cron overlap policy is enforced by the active cluster, the outcome is replicated as events
+			return nil, nil
+		}
+
 		if mutableState.HasProcessedOrPendingDecision() {
 			// if there is one decision already been processed
 			// or has pending decision, meaning workflow has already running
 			return nil, nil
 		}
 
+		visibilityTimestamp, _ := types.TimestampFromProto(timerTask.VisibilityTimestamp)
+		if visibilityTimestamp.Before(mutableState.GetExecutionInfo().CronSkipUntil) {
+			// upcoming cron runs were skipped, another backoff timer fires at the skip time
+			return nil, nil
+		}
+
 		// Note: do not need to verify task version here
 		// logic can only go here if mutable state build's next event ID is 2
 		// meaning history only contains workflow started event.
@@ -119,6 +119,8 @@ func (t *transferQueueActiveTaskExecutor) execute(
 		return t.processResetWorkflow(task)
 	case persistence.TransferTaskTypeUpsertWorkflowSearchAttributes:
 		return t.processUpsertWorkflowSearchAttributes(task)
+	case persistence.TransferTaskTypeDeleteExecution:
+		return t.processDeleteExecution(task)
 	default:
 		return errUnknownTransferTask
 	}
@@ -143,6 +145,10 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// tasks of a paused workflow are not dispatched, they are regenerated on unpause
+		return nil
+	}
 
 	ai, ok := mutableState.GetActivityInfo(task.GetScheduleId())
 	if !ok {
@@ -180,6 +186,10 @@ func (t *transferQueueActiveTaskExecutor) processDecisionTask(
 	if mutableState == nil || !mutableState.IsWorkflowExecutionRunning() {
 		return nil
 	}
+	if mutableState.IsWorkflowExecutionPaused() {
+		// tasks of a paused workflow are not dispatched, they are regenerated on unpause
+		return nil
+	}
 
 	decision, found := mutableState.GetDecisionInfo(task.GetScheduleId())
 	if !found {
@@ -210,10 +220,12 @@ func (t *transferQueueActiveTaskExecutor) processDecisionTask(
 		decisionTimeout = executionInfo.StickyScheduleToStartTimeout
 	}
 
+	workerBuildID := executionInfo.WorkerBuildID
+
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushDecision(task, taskList, decisionTimeout)
+	return t.pushDecision(task, taskList, decisionTimeout, workerBuildID)
 }
 
 func (t *transferQueueActiveTaskExecutor) processCloseExecution(
@@ -1221,6 +1233,8 @@ func (t *transferQueueActiveTaskExecutor) startWorkflowWithRetry(
 			},
 			InitiatedId: task.GetScheduleId(),
 		},
+		// child workflow inherits the priority of its parent
+		Priority: task.GetPriority(),
 		FirstDecisionTaskBackoffSeconds: backoff.GetBackoffForNextScheduleInSeconds(
 			attributes.GetCronSchedule(),
 			now,
@@ -1308,6 +1322,7 @@ func (t *transferQueueActiveTaskExecutor) resetWorkflow(
 			baseRebuildLastEventID,
 			baseRebuildLastEventVersion,
 			baseNextEventID,
+			baseMutableState,
 			resetRunID,
 			uuid.New(),
 			newNDCWorkflow(
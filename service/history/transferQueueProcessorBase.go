@@ -158,6 +158,11 @@ func getTransferTaskMetricsScope(
 			return metrics.TransferActiveTaskUpsertWorkflowSearchAttributesScope
 		}
 		return metrics.TransferStandbyTaskUpsertWorkflowSearchAttributesScope
+	case persistence.TransferTaskTypeDeleteExecution:
+		if isActive {
+			return metrics.TransferActiveTaskDeleteExecutionScope
+		}
+		return metrics.TransferStandbyTaskDeleteExecutionScope
 	default:
 		if isActive {
 			return metrics.TransferActiveQueueProcessorScope
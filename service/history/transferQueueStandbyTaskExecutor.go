@@ -111,6 +111,9 @@ func (t *transferQueueStandbyTaskExecutor) execute(
 		return nil
 	case persistence.TransferTaskTypeUpsertWorkflowSearchAttributes:
 		return t.processUpsertWorkflowSearchAttributes(transferTask)
+	case persistence.TransferTaskTypeDeleteExecution:
+		// the deletion is replicated from the active cluster, no need to wait for the active side
+		return t.processDeleteExecution(transferTask)
 	default:
 		return errUnknownTransferTask
 	}
@@ -182,6 +185,7 @@ func (t *transferQueueStandbyTaskExecutor) processDecisionTask(
 			return newPushDecisionToMatchingInfo(
 				decisionTimeout,
 				tasklistpb.TaskList{Name: transferTask.TaskList},
+				executionInfo.WorkerBuildID,
 			), nil
 		}
 
@@ -547,6 +551,7 @@ func (t *transferQueueStandbyTaskExecutor) pushDecision(
 		task.(*persistenceblobs.TransferTaskInfo),
 		&pushDecisionInfo.tasklist,
 		timeout,
+		pushDecisionInfo.workerBuildID,
 	)
 }
 
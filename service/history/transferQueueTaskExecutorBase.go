@@ -40,6 +40,8 @@ import (
 	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
 	"github.com/temporalio/temporal/client/matching"
 	"github.com/temporalio/temporal/common"
+	"github.com/temporalio/temporal/common/backoff"
+	"github.com/temporalio/temporal/common/convert"
 	"github.com/temporalio/temporal/common/log"
 	"github.com/temporalio/temporal/common/log/tag"
 	"github.com/temporalio/temporal/common/metrics"
@@ -116,6 +118,7 @@ func (t *transferQueueTaskExecutorBase) pushActivity(
 		TaskList:                      &tasklistpb.TaskList{Name: task.TaskList},
 		ScheduleId:                    task.GetScheduleId(),
 		ScheduleToStartTimeoutSeconds: activityScheduleToStartTimeout,
+		Priority:                      task.GetPriority(),
 	})
 
 	return err
@@ -125,6 +128,7 @@ func (t *transferQueueTaskExecutorBase) pushDecision(
 	task *persistenceblobs.TransferTaskInfo,
 	tasklist *tasklistpb.TaskList,
 	decisionScheduleToStartTimeout int32,
+	workerBuildID string,
 ) error {
 
 	ctx, cancel := context.WithTimeout(context.Background(), transferActiveTaskDefaultTimeout)
@@ -143,6 +147,8 @@ func (t *transferQueueTaskExecutorBase) pushDecision(
 		TaskList:                      tasklist,
 		ScheduleId:                    task.GetScheduleId(),
 		ScheduleToStartTimeoutSeconds: decisionScheduleToStartTimeout,
+		Priority:                      task.GetPriority(),
+		WorkerBuildId:                 workerBuildID,
 	})
 	return err
 }
@@ -335,6 +341,104 @@ func (t *transferQueueTaskExecutorBase) recordWorkflowClosed(
 	return nil
 }
 
+// processDeleteExecution removes all data of a workflow execution, the mutable state row is deleted last
+// so that the task is retried as long as any of the data remains
+func (t *transferQueueTaskExecutorBase) processDeleteExecution(
+	task *persistenceblobs.TransferTaskInfo,
+) (retError error) {
+
+	context, release, err := t.cache.getOrCreateWorkflowExecutionForBackground(
+		t.getNamespaceIDAndWorkflowExecution(task),
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	mutableState, err := loadMutableStateForTransferTask(context, task, t.metricsClient, t.logger)
+	if err != nil || mutableState == nil {
+		return err
+	}
+
+	namespaceID := primitives.UUIDString(task.GetNamespaceId())
+	workflowID := task.GetWorkflowId()
+	runID := primitives.UUIDString(task.GetRunId())
+
+	if err := backoff.Retry(func() error {
+		return t.visibilityMgr.DeleteWorkflowExecution(&persistence.VisibilityDeleteWorkflowExecutionRequest{
+			NamespaceID: namespaceID,
+			WorkflowID:  workflowID,
+			RunID:       runID,
+			TaskID:      task.GetTaskId(),
+		})
+	}, persistenceOperationRetryPolicy, common.IsPersistenceTransientError); err != nil {
+		return err
+	}
+
+	branchTokens, err := getHistoryBranchTokens(mutableState)
+	if err != nil {
+		return err
+	}
+	for _, branchToken := range branchTokens {
+		branchToken := branchToken
+		if err := backoff.Retry(func() error {
+			return t.shard.GetHistoryManager().DeleteHistoryBranch(&persistence.DeleteHistoryBranchRequest{
+				BranchToken: branchToken,
+				ShardID:     convert.IntPtr(t.shard.GetShardID()),
+			})
+		}, persistenceOperationRetryPolicy, common.IsPersistenceTransientError); err != nil {
+			return err
+		}
+	}
+
+	// the current execution row is only deleted if it still points to this run
+	if err := backoff.Retry(func() error {
+		return t.shard.GetExecutionManager().DeleteCurrentWorkflowExecution(&persistence.DeleteCurrentWorkflowExecutionRequest{
+			NamespaceID: namespaceID,
+			WorkflowID:  workflowID,
+			RunID:       runID,
+		})
+	}, persistenceOperationRetryPolicy, common.IsPersistenceTransientError); err != nil {
+		return err
+	}
+
+	if err := backoff.Retry(func() error {
+		return t.shard.GetExecutionManager().DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionRequest{
+			NamespaceID: namespaceID,
+			WorkflowID:  workflowID,
+			RunID:       runID,
+		})
+	}, persistenceOperationRetryPolicy, common.IsPersistenceTransientError); err != nil {
+		return err
+	}
+
+	// pending transfer and timer tasks of the execution are dropped once they find the mutable state gone,
+	// calling clear here to force accesses of mutable state to read database
+	context.clear()
+	return nil
+}
+
+// getHistoryBranchTokens returns the tokens of all history branches of a workflow execution
+func getHistoryBranchTokens(
+	mutableState mutableState,
+) ([][]byte, error) {
+
+	versionHistories := mutableState.GetVersionHistories()
+	if versionHistories == nil {
+		branchToken, err := mutableState.GetCurrentBranchToken()
+		if err != nil {
+			return nil, err
+		}
+		return [][]byte{branchToken}, nil
+	}
+
+	branchTokens := make([][]byte, 0, len(versionHistories.Histories))
+	for _, versionHistory := range versionHistories.Histories {
+		branchTokens = append(branchTokens, versionHistory.GetBranchToken())
+	}
+	return branchTokens, nil
+}
+
 // Argument startEvent is to save additional call of msBuilder.GetStartEvent
 func getWorkflowExecutionTimestamp(
 	msBuilder mutableState,
@@ -0,0 +1,131 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync/atomic"
+
+	"go.temporal.io/temporal-proto/serviceerror"
+
+	eventgenpb "github.com/temporalio/temporal/.gen/proto/event"
+)
+
+const (
+	updateTerminationTypeCompleted updateTerminationType = iota
+	updateTerminationTypeFailed
+)
+
+var (
+	errUpdateTerminationStateInvalid = serviceerror.NewInternal("update termination state invalid")
+	errUpdateAlreadyInTerminalState  = serviceerror.NewInternal("update already in terminal state")
+	errUpdateNotInTerminalState      = serviceerror.NewInternal("update not in terminal state")
+)
+
+type (
+	updateTerminationType int
+
+	update interface {
+		getUpdateID() string
+		getUpdateTermCh() <-chan struct{}
+		getUpdateInput() *eventgenpb.WorkflowUpdate
+		getTerminationState() (*updateTerminationState, error)
+		setTerminationState(*updateTerminationState) error
+	}
+
+	updateImpl struct {
+		updateInput *eventgenpb.WorkflowUpdate
+		termCh      chan struct{}
+
+		terminationState atomic.Value
+	}
+
+	updateTerminationState struct {
+		updateTerminationType updateTerminationType
+		updateResult          *eventgenpb.WorkflowUpdateResult
+		failure               error
+	}
+)
+
+func newUpdate(updateInput *eventgenpb.WorkflowUpdate) update {
+	return &updateImpl{
+		updateInput: updateInput,
+		termCh:      make(chan struct{}),
+	}
+}
+
+func (u *updateImpl) getUpdateID() string {
+	return u.updateInput.GetUpdateId()
+}
+
+func (u *updateImpl) getUpdateTermCh() <-chan struct{} {
+	return u.termCh
+}
+
+func (u *updateImpl) getUpdateInput() *eventgenpb.WorkflowUpdate {
+	return u.updateInput
+}
+
+func (u *updateImpl) getTerminationState() (*updateTerminationState, error) {
+	ts := u.terminationState.Load()
+	if ts == nil {
+		return nil, errUpdateNotInTerminalState
+	}
+	return ts.(*updateTerminationState), nil
+}
+
+func (u *updateImpl) setTerminationState(terminationState *updateTerminationState) error {
+	if err := u.validateTerminationState(terminationState); err != nil {
+		return err
+	}
+	currTerminationState, _ := u.getTerminationState()
+	if currTerminationState != nil {
+		return errUpdateAlreadyInTerminalState
+	}
+	u.terminationState.Store(terminationState)
+	close(u.termCh)
+	return nil
+}
+
+func (u *updateImpl) validateTerminationState(
+	terminationState *updateTerminationState,
+) error {
+	if terminationState == nil {
+		return errUpdateTerminationStateInvalid
+	}
+	switch terminationState.updateTerminationType {
+	case updateTerminationTypeCompleted:
+		if terminationState.updateResult == nil || terminationState.failure != nil {
+			return errUpdateTerminationStateInvalid
+		}
+		return nil
+	case updateTerminationTypeFailed:
+		if terminationState.updateResult != nil || terminationState.failure == nil {
+			return errUpdateTerminationStateInvalid
+		}
+		return nil
+	default:
+		return errUpdateTerminationStateInvalid
+	}
+}
@@ -0,0 +1,204 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+
+	"go.temporal.io/temporal-proto/serviceerror"
+
+	eventgenpb "github.com/temporalio/temporal/.gen/proto/event"
+)
+
+var (
+	errUpdateNotExists     = serviceerror.NewInternal("update does not exist")
+	errUpdateNotBuffered   = serviceerror.NewInternal("update is not buffered")
+	errUpdateAlreadyExists = serviceerror.NewInternal("update already exists")
+)
+
+type (
+	// updateRegistry tracks the updates of a workflow until they are handled by a decision task,
+	// an update is buffered until it is delivered on a started decision task
+	// and delivered until the completion of a decision task terminates it
+	updateRegistry interface {
+		hasBufferedUpdate() bool
+		getBufferedIDs() []string
+		hasDeliveredUpdate() bool
+		getDeliveredIDs() []string
+
+		getUpdateTermCh(string) (<-chan struct{}, error)
+		getUpdateInput(string) (*eventgenpb.WorkflowUpdate, error)
+		getTerminationState(string) (*updateTerminationState, error)
+
+		bufferUpdate(updateInput *eventgenpb.WorkflowUpdate) (<-chan struct{}, error)
+		deliverUpdate(id string) error
+		setTerminationState(string, *updateTerminationState) error
+		removeUpdate(id string)
+	}
+
+	updateRegistryImpl struct {
+		sync.RWMutex
+
+		buffered   map[string]update
+		delivered  map[string]update
+		terminated map[string]update
+	}
+)
+
+func newUpdateRegistry() updateRegistry {
+	return &updateRegistryImpl{
+		buffered:   make(map[string]update),
+		delivered:  make(map[string]update),
+		terminated: make(map[string]update),
+	}
+}
+
+func (r *updateRegistryImpl) hasBufferedUpdate() bool {
+	r.RLock()
+	defer r.RUnlock()
+	return len(r.buffered) > 0
+}
+
+func (r *updateRegistryImpl) getBufferedIDs() []string {
+	r.RLock()
+	defer r.RUnlock()
+	return r.getIDs(r.buffered)
+}
+
+func (r *updateRegistryImpl) hasDeliveredUpdate() bool {
+	r.RLock()
+	defer r.RUnlock()
+	return len(r.delivered) > 0
+}
+
+func (r *updateRegistryImpl) getDeliveredIDs() []string {
+	r.RLock()
+	defer r.RUnlock()
+	return r.getIDs(r.delivered)
+}
+
+func (r *updateRegistryImpl) getUpdateTermCh(id string) (<-chan struct{}, error) {
+	r.RLock()
+	defer r.RUnlock()
+	u, err := r.getUpdateNoLock(id)
+	if err != nil {
+		return nil, err
+	}
+	return u.getUpdateTermCh(), nil
+}
+
+func (r *updateRegistryImpl) getUpdateInput(id string) (*eventgenpb.WorkflowUpdate, error) {
+	r.RLock()
+	defer r.RUnlock()
+	u, err := r.getUpdateNoLock(id)
+	if err != nil {
+		return nil, err
+	}
+	return u.getUpdateInput(), nil
+}
+
+func (r *updateRegistryImpl) getTerminationState(id string) (*updateTerminationState, error) {
+	r.RLock()
+	defer r.RUnlock()
+	u, err := r.getUpdateNoLock(id)
+	if err != nil {
+		return nil, err
+	}
+	return u.getTerminationState()
+}
+
+func (r *updateRegistryImpl) bufferUpdate(updateInput *eventgenpb.WorkflowUpdate) (<-chan struct{}, error) {
+	r.Lock()
+	defer r.Unlock()
+	id := updateInput.GetUpdateId()
+	if _, err := r.getUpdateNoLock(id); err == nil {
+		return nil, errUpdateAlreadyExists
+	}
+	u := newUpdate(updateInput)
+	r.buffered[id] = u
+	return u.getUpdateTermCh(), nil
+}
+
+func (r *updateRegistryImpl) deliverUpdate(id string) error {
+	r.Lock()
+	defer r.Unlock()
+	u, ok := r.buffered[id]
+	if !ok {
+		return errUpdateNotBuffered
+	}
+	delete(r.buffered, id)
+	r.delivered[id] = u
+	return nil
+}
+
+func (r *updateRegistryImpl) setTerminationState(id string, terminationState *updateTerminationState) error {
+	r.Lock()
+	defer r.Unlock()
+	u, ok := r.buffered[id]
+	if !ok {
+		u, ok = r.delivered[id]
+	}
+	if !ok {
+		return errUpdateNotExists
+	}
+	if err := u.setTerminationState(terminationState); err != nil {
+		return err
+	}
+	delete(r.buffered, id)
+	delete(r.delivered, id)
+	r.terminated[id] = u
+	return nil
+}
+
+func (r *updateRegistryImpl) removeUpdate(id string) {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.buffered, id)
+	delete(r.delivered, id)
+	delete(r.terminated, id)
+}
+
+func (r *updateRegistryImpl) getUpdateNoLock(id string) (update, error) {
+	if u, ok := r.buffered[id]; ok {
+		return u, nil
+	}
+	if u, ok := r.delivered[id]; ok {
+		return u, nil
+	}
+	if u, ok := r.terminated[id]; ok {
+		return u, nil
+	}
+	return nil, errUpdateNotExists
+}
+
+func (r *updateRegistryImpl) getIDs(m map[string]update) []string {
+	result := make([]string, len(m), len(m))
+	index := 0
+	for id := range m {
+		result[index] = id
+		index++
+	}
+	return result
+}
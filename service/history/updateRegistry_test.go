@@ -0,0 +1,151 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	eventgenpb "github.com/temporalio/temporal/.gen/proto/event"
+)
+
+type UpdateRegistrySuite struct {
+	suite.Suite
+	*require.Assertions
+}
+
+func TestUpdateRegistrySuite(t *testing.T) {
+	suite.Run(t, new(UpdateRegistrySuite))
+}
+
+func (s *UpdateRegistrySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *UpdateRegistrySuite) TestUpdateRegistry() {
+	ur := newUpdateRegistry()
+	ids := make([]string, 30, 30)
+	termChans := make([]<-chan struct{}, 30, 30)
+	for i := 0; i < 30; i++ {
+		ids[i] = fmt.Sprintf("update-%v", i)
+		termCh, err := ur.bufferUpdate(&eventgenpb.WorkflowUpdate{UpdateId: ids[i]})
+		s.NoError(err)
+		termChans[i] = termCh
+	}
+	s.assertPendingState(ur, ids...)
+	s.ElementsMatch(ids, ur.getBufferedIDs())
+	s.False(ur.hasDeliveredUpdate())
+	s.assertChanState(false, termChans...)
+
+	_, err := ur.bufferUpdate(&eventgenpb.WorkflowUpdate{UpdateId: ids[0]})
+	s.Equal(errUpdateAlreadyExists, err)
+
+	for i := 0; i < 20; i++ {
+		s.NoError(ur.deliverUpdate(ids[i]))
+	}
+	s.Equal(errUpdateNotBuffered, ur.deliverUpdate(ids[0]))
+	s.assertPendingState(ur, ids...)
+	s.ElementsMatch(ids[0:20], ur.getDeliveredIDs())
+	s.ElementsMatch(ids[20:], ur.getBufferedIDs())
+	s.assertChanState(false, termChans...)
+
+	for i := 0; i < 10; i++ {
+		err := ur.setTerminationState(ids[i], &updateTerminationState{
+			updateTerminationType: updateTerminationTypeCompleted,
+			updateResult: &eventgenpb.WorkflowUpdateResult{
+				UpdateId: ids[i],
+				Result:   []byte{1, 2, 3},
+			},
+		})
+		s.NoError(err)
+	}
+	// buffered updates can be failed without being delivered
+	for i := 10; i < 30; i += 10 {
+		err := ur.setTerminationState(ids[i], &updateTerminationState{
+			updateTerminationType: updateTerminationTypeFailed,
+			failure:               errors.New("err"),
+		})
+		s.NoError(err)
+	}
+	s.assertTerminatedState(ur, updateTerminationTypeCompleted, ids[0:10]...)
+	s.assertTerminatedState(ur, updateTerminationTypeFailed, ids[10], ids[20])
+	s.ElementsMatch(ids[11:20], ur.getDeliveredIDs())
+	s.ElementsMatch(ids[21:], ur.getBufferedIDs())
+	s.assertChanState(true, termChans[0:11]...)
+	s.assertChanState(false, termChans[11:20]...)
+	s.assertChanState(true, termChans[20])
+
+	s.Equal(errUpdateNotExists, ur.setTerminationState(ids[0], &updateTerminationState{
+		updateTerminationType: updateTerminationTypeFailed,
+		failure:               errors.New("err"),
+	}))
+	s.Equal(errUpdateTerminationStateInvalid, ur.setTerminationState(ids[11], &updateTerminationState{
+		updateTerminationType: updateTerminationTypeCompleted,
+	}))
+	s.assertPendingState(ur, ids[11])
+
+	for _, id := range ids {
+		ur.removeUpdate(id)
+		_, err := ur.getUpdateInput(id)
+		s.Equal(errUpdateNotExists, err)
+	}
+	s.False(ur.hasBufferedUpdate())
+	s.False(ur.hasDeliveredUpdate())
+}
+
+func (s *UpdateRegistrySuite) assertPendingState(ur updateRegistry, ids ...string) {
+	for _, id := range ids {
+		termCh, err := ur.getUpdateTermCh(id)
+		s.NoError(err)
+		s.False(closed(termCh))
+		input, err := ur.getUpdateInput(id)
+		s.NoError(err)
+		s.Equal(id, input.GetUpdateId())
+		termState, err := ur.getTerminationState(id)
+		s.Equal(errUpdateNotInTerminalState, err)
+		s.Nil(termState)
+	}
+}
+
+func (s *UpdateRegistrySuite) assertTerminatedState(ur updateRegistry, terminationType updateTerminationType, ids ...string) {
+	for _, id := range ids {
+		termCh, err := ur.getUpdateTermCh(id)
+		s.NoError(err)
+		s.True(closed(termCh))
+		termState, err := ur.getTerminationState(id)
+		s.NoError(err)
+		s.Equal(terminationType, termState.updateTerminationType)
+	}
+}
+
+func (s *UpdateRegistrySuite) assertChanState(expectedClosed bool, chans ...<-chan struct{}) {
+	for _, ch := range chans {
+		s.Equal(expectedClosed, closed(ch))
+	}
+}
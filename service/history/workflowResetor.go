@@ -224,6 +224,7 @@ func (w *workflowResetorImpl) scheduleUnstartedActivities(msBuilder mutableState
 			NamespaceID: exeInfo.NamespaceID,
 			TaskList:    exeInfo.TaskList,
 			ScheduleID:  ai.ScheduleID,
+			Priority:    ai.Priority,
 		}
 		tasks = append(tasks, t)
 	}
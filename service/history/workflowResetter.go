@@ -56,6 +56,7 @@ type (
 			baseRebuildLastEventID int64,
 			baseRebuildLastEventVersion int64,
 			baseNextEventID int64,
+			baseMutableState mutableState,
 			resetRunID string,
 			resetRequestID string,
 			currentWorkflow nDCWorkflow,
@@ -106,6 +107,7 @@ func (r *workflowResetterImpl) resetWorkflow(
 	baseRebuildLastEventID int64,
 	baseRebuildLastEventVersion int64,
 	baseNextEventID int64,
+	baseMutableState mutableState,
 	resetRunID string,
 	resetRequestID string,
 	currentWorkflow nDCWorkflow,
@@ -152,6 +154,9 @@ func (r *workflowResetterImpl) resetWorkflow(
 	}
 	defer resetWorkflow.getReleaseFn()(retError)
 
+	// the attributes updated by operators are not in the history replayed up to the reset point
+	resetWorkflow.getMutableState().InheritUpdatedWorkflowAttributes(baseMutableState)
+
 	return r.persistToDB(
 		currentWorkflowTerminated,
 		currentWorkflow,
@@ -44,12 +44,15 @@ type (
 		taskType     int32
 		rangeID      int64
 		ackLevel     int64
-		store        persistence.TaskManager
-		logger       log.Logger
+		// maxTasksPerSecond is the dispatch rate limit set through the admin API, 0 if not set
+		maxTasksPerSecond float64
+		store             persistence.TaskManager
+		logger            log.Logger
 	}
 	taskListState struct {
-		rangeID  int64
-		ackLevel int64
+		rangeID           int64
+		ackLevel          int64
+		maxTasksPerSecond float64
 	}
 )
 
@@ -98,7 +101,15 @@ func (db *taskListDB) RenewLease() (taskListState, error) {
 	}
 	db.ackLevel = resp.TaskListInfo.Data.AckLevel
 	db.rangeID = resp.TaskListInfo.RangeID
-	return taskListState{rangeID: db.rangeID, ackLevel: db.ackLevel}, nil
+	db.maxTasksPerSecond = resp.TaskListInfo.Data.MaxTasksPerSecond
+	return taskListState{rangeID: db.rangeID, ackLevel: db.ackLevel, maxTasksPerSecond: db.maxTasksPerSecond}, nil
+}
+
+// MaxTasksPerSecond returns the current persistence view of the dispatch rate limit
+func (db *taskListDB) MaxTasksPerSecond() float64 {
+	db.Lock()
+	defer db.Unlock()
+	return db.maxTasksPerSecond
 }
 
 // UpdateState updates the taskList state with the given value
@@ -107,11 +118,12 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 	defer db.Unlock()
 	_, err := db.store.UpdateTaskList(&persistence.UpdateTaskListRequest{
 		TaskListInfo: &persistenceblobs.TaskListInfo{
-			NamespaceId: db.namespaceID,
-			Name:        db.taskListName,
-			TaskType:    db.taskType,
-			AckLevel:    ackLevel,
-			Kind:        db.taskListKind,
+			NamespaceId:       db.namespaceID,
+			Name:              db.taskListName,
+			TaskType:          db.taskType,
+			AckLevel:          ackLevel,
+			Kind:              db.taskListKind,
+			MaxTasksPerSecond: db.maxTasksPerSecond,
 		},
 		RangeID: db.rangeID,
 	})
@@ -121,6 +133,27 @@ func (db *taskListDB) UpdateState(ackLevel int64) error {
 	return err
 }
 
+// UpdateMaxTasksPerSecond updates the dispatch rate limit of the taskList
+func (db *taskListDB) UpdateMaxTasksPerSecond(maxTasksPerSecond float64) error {
+	db.Lock()
+	defer db.Unlock()
+	_, err := db.store.UpdateTaskList(&persistence.UpdateTaskListRequest{
+		TaskListInfo: &persistenceblobs.TaskListInfo{
+			NamespaceId:       db.namespaceID,
+			Name:              db.taskListName,
+			TaskType:          db.taskType,
+			AckLevel:          db.ackLevel,
+			Kind:              db.taskListKind,
+			MaxTasksPerSecond: maxTasksPerSecond,
+		},
+		RangeID: db.rangeID,
+	})
+	if err == nil {
+		db.maxTasksPerSecond = maxTasksPerSecond
+	}
+	return err
+}
+
 // CreateTasks creates a batch of given tasks for this task list
 func (db *taskListDB) CreateTasks(tasks []*persistenceblobs.AllocatedTaskInfo) (*persistence.CreateTasksResponse, error) {
 	db.Lock()
@@ -129,11 +162,12 @@ func (db *taskListDB) CreateTasks(tasks []*persistenceblobs.AllocatedTaskInfo) (
 		&persistence.CreateTasksRequest{
 			TaskListInfo: &persistence.PersistedTaskListInfo{
 				Data: &persistenceblobs.TaskListInfo{
-					NamespaceId: db.namespaceID,
-					Name:        db.taskListName,
-					TaskType:    db.taskType,
-					AckLevel:    db.ackLevel,
-					Kind:        db.taskListKind,
+					NamespaceId:       db.namespaceID,
+					Name:              db.taskListName,
+					TaskType:          db.taskType,
+					AckLevel:          db.ackLevel,
+					Kind:              db.taskListKind,
+					MaxTasksPerSecond: db.maxTasksPerSecond,
 				},
 				RangeID: db.rangeID,
 			},
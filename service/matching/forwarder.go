@@ -161,6 +161,9 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			Source:                        task.source,
 			ScheduleToStartTimeoutSeconds: newScheduleToStartTimeout,
 			ForwardedFrom:                 fwdr.taskListID.name,
+			Priority:                      task.event.Data.GetPriority(),
+			// forwarded tasks carry the id of their worker version set
+			WorkerBuildId: fwdr.taskListID.versionSet,
 		})
 	case persistence.TaskListTypeActivity:
 		_, err = fwdr.client.AddActivityTask(ctx, &matchingservice.AddActivityTaskRequest{
@@ -175,6 +178,7 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			Source:                        task.source,
 			ScheduleToStartTimeoutSeconds: newScheduleToStartTimeout,
 			ForwardedFrom:                 fwdr.taskListID.name,
+			Priority:                      task.event.Data.GetPriority(),
 		})
 	default:
 		return errInvalidTaskListType
@@ -206,6 +210,7 @@ func (fwdr *Forwarder) ForwardQueryTask(
 		},
 		QueryRequest:  task.query.request.QueryRequest,
 		ForwardedFrom: fwdr.taskListID.name,
+		WorkerBuildId: fwdr.taskListID.versionSet,
 	})
 
 	return resp, fwdr.handleErr(err)
@@ -224,6 +229,7 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*internalTask, error) {
 
 	pollerID, _ := ctx.Value(pollerIDKey).(string)
 	identity, _ := ctx.Value(identityKey).(string)
+	buildID, _ := ctx.Value(workerBuildIDKey).(string)
 
 	switch fwdr.taskListID.taskType {
 	case persistence.TaskListTypeDecision:
@@ -238,6 +244,7 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*internalTask, error) {
 				Identity: identity,
 			},
 			ForwardedFrom: fwdr.taskListID.name,
+			WorkerBuildId: buildID,
 		})
 		if err != nil {
 			return nil, fwdr.handleErr(err)
@@ -255,6 +262,7 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*internalTask, error) {
 				Identity: identity,
 			},
 			ForwardedFrom: fwdr.taskListID.name,
+			WorkerBuildId: buildID,
 		})
 		if err != nil {
 			return nil, fwdr.handleErr(err)
@@ -388,6 +388,30 @@ func (h *Handler) ListTaskListPartitions(
 	return response, hCtx.handleErr(err)
 }
 
+// UpdateTaskListRateLimit persists the dispatch rate limit of a task list
+func (h *Handler) UpdateTaskListRateLimit(
+	ctx context.Context,
+	request *matchingservice.UpdateTaskListRateLimitRequest,
+) (_ *matchingservice.UpdateTaskListRateLimitResponse, retError error) {
+	defer log.CapturePanicGRPC(h.GetLogger(), &retError)
+	hCtx := h.newHandlerContext(
+		ctx,
+		request.GetNamespaceId(),
+		request.GetTaskList(),
+		metrics.MatchingUpdateTaskListRateLimitScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	err := h.engine.UpdateTaskListRateLimit(hCtx, request)
+	return &matchingservice.UpdateTaskListRateLimitResponse{}, hCtx.handleErr(err)
+}
+
 func (h *Handler) namespaceName(id string) string {
 	entry, err := h.GetNamespaceCache().GetNamespaceByID(id)
 	if err != nil {
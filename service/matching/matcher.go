@@ -27,12 +27,14 @@ package matching
 import (
 	"context"
 	"errors"
+	"sync"
 	"time"
 
 	"golang.org/x/time/rate"
 
 	commongenpb "github.com/temporalio/temporal/.gen/proto/common"
 	"github.com/temporalio/temporal/.gen/proto/matchingservice"
+	"github.com/temporalio/temporal/common"
 	"github.com/temporalio/temporal/common/metrics"
 	"github.com/temporalio/temporal/common/quotas"
 )
@@ -41,8 +43,9 @@ import (
 // Producers are usually rpc calls from history or taskReader
 // that drains backlog from db. Consumers are the task list pollers
 type TaskMatcher struct {
-	// synchronous task channel to match producer/consumer
-	taskC chan *internalTask
+	// synchronous task channels to match producer/consumer, one per task priority.
+	// pollers always prefer a more urgent task when several are offered
+	taskC map[int32]chan *internalTask
 	// synchronous task channel to match query task - the reason to have
 	// separate channel for this is because there are cases when consumers
 	// are interested in queryTasks but not others. Example is when namespace is
@@ -50,6 +53,9 @@ type TaskMatcher struct {
 	queryTaskC chan *internalTask
 	// ratelimiter that limits the rate at which tasks can be dispatched to consumers
 	limiter *quotas.RateLimiter
+	// dispatch rate of the whole task list the limiter applies, as last set by pollers or the admin API
+	rpsLock sync.Mutex
+	rps     float64
 
 	fwdr          *Forwarder
 	scope         func() metrics.Scope // namespace metric scope
@@ -69,11 +75,16 @@ var errTasklistThrottled = errors.New("cannot add to tasklist, limit exceeded")
 func newTaskMatcher(config *taskListConfig, fwdr *Forwarder, scopeFunc func() metrics.Scope) *TaskMatcher {
 	dPtr := _defaultTaskDispatchRPS
 	limiter := quotas.NewRateLimiter(&dPtr, _defaultTaskDispatchRPSTTL, config.MinTaskThrottlingBurstSize())
+	taskC := make(map[int32]chan *internalTask, len(taskPriorities))
+	for _, priority := range taskPriorities {
+		taskC[priority] = make(chan *internalTask)
+	}
 	return &TaskMatcher{
 		limiter:       limiter,
+		rps:           dPtr,
 		scope:         scopeFunc,
 		fwdr:          fwdr,
-		taskC:         make(chan *internalTask),
+		taskC:         taskC,
 		queryTaskC:    make(chan *internalTask),
 		numPartitions: config.NumReadPartitions,
 	}
@@ -120,7 +131,7 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *internalTask) (bool, err
 	}
 
 	select {
-	case tm.taskC <- task: // poller picked up the task
+	case tm.taskC[task.priority()] <- task: // poller picked up the task
 		if task.responseC != nil {
 			// if there is a response channel, block until resp is received
 			// and return error if the response contains error
@@ -160,7 +171,7 @@ func (tm *TaskMatcher) Offer(ctx context.Context, task *internalTask) (bool, err
 
 func (tm *TaskMatcher) offerOrTimeout(ctx context.Context, task *internalTask) (bool, error) {
 	select {
-	case tm.taskC <- task: // poller picked up the task
+	case tm.taskC[task.priority()] <- task: // poller picked up the task
 		if task.responseC != nil {
 			select {
 			case err := <-task.responseC:
@@ -220,10 +231,12 @@ func (tm *TaskMatcher) MustOffer(ctx context.Context, task *internalTask) error
 		return err
 	}
 
+	taskC := tm.taskC[task.priority()]
+
 	// attempt a match with local poller first. When that
 	// doesn't succeed, try both local match and remote match
 	select {
-	case tm.taskC <- task:
+	case taskC <- task:
 		return nil
 	case <-ctx.Done():
 		return ctx.Err()
@@ -233,7 +246,7 @@ func (tm *TaskMatcher) MustOffer(ctx context.Context, task *internalTask) error
 forLoop:
 	for {
 		select {
-		case tm.taskC <- task:
+		case taskC <- task:
 			return nil
 		case token := <-tm.fwdrAddReqTokenC():
 			childCtx, cancel := context.WithDeadline(ctx, time.Now().Add(time.Second*2))
@@ -245,7 +258,7 @@ forLoop:
 				// the next forwarded call after this childCtx expires. Till then, we block
 				// hoping for a local poller match
 				select {
-				case tm.taskC <- task:
+				case taskC <- task:
 					return nil
 				case <-childCtx.Done():
 				case <-ctx.Done():
@@ -270,26 +283,20 @@ forLoop:
 // On success, the returned task could be a query task or a regular task
 // Returns ErrNoTasks when context deadline is exceeded
 func (tm *TaskMatcher) Poll(ctx context.Context) (*internalTask, error) {
-	// try local match first without blocking until context timeout
-	if task, err := tm.pollNonBlocking(ctx, tm.taskC, tm.queryTaskC); err == nil {
-		return task, nil
-	}
-	// there is no local poller available to pickup this task. Now block waiting
-	// either for a local poller or a forwarding token to be available. When a
-	// forwarding token becomes available, send this poll to a parent partition
+	// try local match first without blocking until context timeout. When there
+	// is no local task available, block waiting either for a local task or a
+	// forwarding token to be available. When a forwarding token becomes
+	// available, send this poll to a parent partition
 	return tm.pollOrForward(ctx, tm.taskC, tm.queryTaskC)
 }
 
 // PollForQuery blocks until a *query* task is found or context deadline is exceeded
 // Returns ErrNoTasks when context deadline is exceeded
 func (tm *TaskMatcher) PollForQuery(ctx context.Context) (*internalTask, error) {
-	// try local match first without blocking until context timeout
-	if task, err := tm.pollNonBlocking(ctx, nil, tm.queryTaskC); err == nil {
-		return task, nil
-	}
-	// there is no local poller available to pickup this task. Now block waiting
-	// either for a local poller or a forwarding token to be available. When a
-	// forwarding token becomes available, send this poll to a parent partition
+	// try local match first without blocking until context timeout. When there
+	// is no local task available, block waiting either for a local task or a
+	// forwarding token to be available. When a forwarding token becomes
+	// available, send this poll to a parent partition
 	return tm.pollOrForward(ctx, nil, tm.queryTaskC)
 }
 
@@ -302,32 +309,54 @@ func (tm *TaskMatcher) UpdateRatelimit(rps *float64) {
 	nPartitions := tm.numPartitions()
 	if rate > float64(nPartitions) {
 		// divide the rate equally across all partitions
-		rate = rate / float64(tm.numPartitions())
+		rate = rate / float64(nPartitions)
+	}
+	tm.rpsLock.Lock()
+	defer tm.rpsLock.Unlock()
+	// the limiter keeps the previous rate if it is lower and has not expired yet
+	if tm.limiter.UpdateMaxDispatch(&rate) {
+		tm.rps = *rps
 	}
-	tm.limiter.UpdateMaxDispatch(&rate)
 }
 
-// Rate returns the current rate at which tasks are dispatched
+// SetRatelimit sets the task dispatch rate of the whole task list right away, while
+// UpdateRatelimit only lowers the rate until the rate of the previous update expires.
+// The rate is always divided equally across all partitions, so that the task list
+// never dispatches more than the limit
+func (tm *TaskMatcher) SetRatelimit(rps float64) {
+	rate := rps / float64(tm.numPartitions())
+	tm.rpsLock.Lock()
+	defer tm.rpsLock.Unlock()
+	tm.limiter.SetMaxDispatch(&rate)
+	tm.rps = rps
+}
+
+// Rate returns the rate at which tasks are dispatched across all partitions of the task list
 func (tm *TaskMatcher) Rate() float64 {
-	return tm.limiter.Limit()
+	tm.rpsLock.Lock()
+	defer tm.rpsLock.Unlock()
+	return tm.rps
 }
 
 func (tm *TaskMatcher) pollOrForward(
 	ctx context.Context,
-	taskC <-chan *internalTask,
+	taskC map[int32]chan *internalTask,
 	queryTaskC <-chan *internalTask,
 ) (*internalTask, error) {
-	select {
-	case task := <-taskC:
-		if task.responseC != nil {
-			tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
-		}
-		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
+	// a select picks randomly among the ready channels, so the tasks already
+	// offered are taken in priority order before blocking
+	if task, err := tm.pollNonBlocking(ctx, taskC, queryTaskC); err == nil {
 		return task, nil
+	}
+	select {
+	case task := <-taskC[common.TaskPriorityHigh]:
+		return tm.taskPolled(task), nil
+	case task := <-taskC[common.TaskPriorityNormal]:
+		return tm.taskPolled(task), nil
+	case task := <-taskC[common.TaskPriorityLow]:
+		return tm.taskPolled(task), nil
 	case task := <-queryTaskC:
-		tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
-		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
-		return task, nil
+		return tm.taskPolled(task), nil
 	case <-ctx.Done():
 		tm.scope().IncCounter(metrics.PollTimeoutPerTaskListCounter)
 		return nil, ErrNoTasks
@@ -343,45 +372,57 @@ func (tm *TaskMatcher) pollOrForward(
 
 func (tm *TaskMatcher) poll(
 	ctx context.Context,
-	taskC <-chan *internalTask,
+	taskC map[int32]chan *internalTask,
 	queryTaskC <-chan *internalTask,
 ) (*internalTask, error) {
-	select {
-	case task := <-taskC:
-		if task.responseC != nil {
-			tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
-		}
-		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
+	// tasks may have been offered while the poll was forwarded, take them in priority order first
+	if task, err := tm.pollNonBlocking(ctx, taskC, queryTaskC); err == nil {
 		return task, nil
+	}
+	select {
+	case task := <-taskC[common.TaskPriorityHigh]:
+		return tm.taskPolled(task), nil
+	case task := <-taskC[common.TaskPriorityNormal]:
+		return tm.taskPolled(task), nil
+	case task := <-taskC[common.TaskPriorityLow]:
+		return tm.taskPolled(task), nil
 	case task := <-queryTaskC:
-		tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
-		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
-		return task, nil
+		return tm.taskPolled(task), nil
 	case <-ctx.Done():
 		tm.scope().IncCounter(metrics.PollTimeoutPerTaskListCounter)
 		return nil, ErrNoTasks
 	}
 }
 
+// pollNonBlocking checks the query channel and then the task channels from
+// the most to the least urgent priority, so the most urgent offered task wins
 func (tm *TaskMatcher) pollNonBlocking(
 	ctx context.Context,
-	taskC <-chan *internalTask,
+	taskC map[int32]chan *internalTask,
 	queryTaskC <-chan *internalTask,
 ) (*internalTask, error) {
 	select {
-	case task := <-taskC:
-		if task.responseC != nil {
-			tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
-		}
-		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
-		return task, nil
 	case task := <-queryTaskC:
-		tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
-		tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
-		return task, nil
+		return tm.taskPolled(task), nil
 	default:
-		return nil, ErrNoTasks
 	}
+	for _, priority := range taskPriorities {
+		select {
+		case task := <-taskC[priority]:
+			return tm.taskPolled(task), nil
+		default:
+		}
+	}
+	return nil, ErrNoTasks
+}
+
+// taskPolled emits the poll success metrics for a task handed to a poller
+func (tm *TaskMatcher) taskPolled(task *internalTask) *internalTask {
+	if task.responseC != nil {
+		tm.scope().IncCounter(metrics.PollSuccessWithSyncPerTaskListCounter)
+	}
+	tm.scope().IncCounter(metrics.PollSuccessPerTaskListCounter)
+	return task
 }
 
 func (tm *TaskMatcher) fwdrPollReqTokenC() <-chan *ForwarderReqToken {
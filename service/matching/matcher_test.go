@@ -41,6 +41,7 @@ import (
 	"github.com/temporalio/temporal/.gen/proto/matchingservice"
 	"github.com/temporalio/temporal/.gen/proto/matchingservicemock"
 	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
+	"github.com/temporalio/temporal/common"
 	"github.com/temporalio/temporal/common/cache"
 	"github.com/temporalio/temporal/common/metrics"
 	"github.com/temporalio/temporal/common/persistence"
@@ -355,6 +356,62 @@ func (t *MatcherTestSuite) TestMustOfferLocalMatch() {
 	t.NoError(err)
 }
 
+func (t *MatcherTestSuite) TestPollPrefersHigherPriority() {
+	offer := func(priority int32) *internalTask {
+		taskInfo := randomTaskInfo()
+		taskInfo.Data.Priority = priority
+		task := newInternalTask(taskInfo, nil, commongenpb.TaskSource_DbBacklog, "", false)
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			_ = t.rootMatcher.MustOffer(ctx, task)
+		}()
+		return task
+	}
+
+	lowTask := offer(common.TaskPriorityLow)
+	normalTask := offer(common.TaskPriorityNormal)
+	highTask := offer(common.TaskPriorityHigh)
+	time.Sleep(10 * time.Millisecond) // let all offers block waiting for a poller
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	task, err := t.rootMatcher.Poll(ctx)
+	t.NoError(err)
+	t.Equal(highTask, task)
+	task, err = t.rootMatcher.Poll(ctx)
+	t.NoError(err)
+	t.Equal(normalTask, task)
+	task, err = t.rootMatcher.Poll(ctx)
+	t.NoError(err)
+	t.Equal(lowTask, task)
+}
+
+func (t *MatcherTestSuite) TestPollAfterForwardPrefersHigherPriority() {
+	tasks := make(map[int32]*internalTask)
+	for _, priority := range []int32{common.TaskPriorityLow, common.TaskPriorityNormal, common.TaskPriorityHigh} {
+		taskInfo := randomTaskInfo()
+		taskInfo.Data.Priority = priority
+		task := newInternalTask(taskInfo, nil, commongenpb.TaskSource_DbBacklog, "", false)
+		tasks[priority] = task
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			_ = t.rootMatcher.MustOffer(ctx, task)
+		}()
+	}
+	time.Sleep(10 * time.Millisecond) // let all offers block waiting for a poller
+
+	// the local poll a poller falls back to when forwarding its poll failed
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for _, priority := range taskPriorities {
+		task, err := t.rootMatcher.poll(ctx, t.rootMatcher.taskC, t.rootMatcher.queryTaskC)
+		t.NoError(err)
+		t.Equal(tasks[priority], task)
+	}
+}
+
 func (t *MatcherTestSuite) TestMustOfferRemoteMatch() {
 	pollSigC := make(chan struct{})
 
@@ -461,6 +518,35 @@ func (t *MatcherTestSuite) TestRemotePollForQuery() {
 	t.True(task.isStarted())
 }
 
+func (t *MatcherTestSuite) TestSetRatelimit() {
+	t.matcher.numPartitions = func() int { return 4 }
+
+	t.matcher.SetRatelimit(100)
+	t.Equal(100.0, t.matcher.Rate())
+	t.Equal(25.0, t.matcher.limiter.Limit())
+
+	// a rate below the number of partitions is still divided across them
+	t.matcher.SetRatelimit(2)
+	t.Equal(2.0, t.matcher.Rate())
+	t.Equal(0.5, t.matcher.limiter.Limit())
+}
+
+func (t *MatcherTestSuite) TestUpdateRatelimit() {
+	t.matcher.numPartitions = func() int { return 4 }
+	t.matcher.SetRatelimit(100)
+
+	// a higher rate is ignored until the current one expires, the reported rate is the applied one
+	rps := 200.0
+	t.matcher.UpdateRatelimit(&rps)
+	t.Equal(100.0, t.matcher.Rate())
+	t.Equal(25.0, t.matcher.limiter.Limit())
+
+	rps = 40.0
+	t.matcher.UpdateRatelimit(&rps)
+	t.Equal(40.0, t.matcher.Rate())
+	t.Equal(10.0, t.matcher.limiter.Limit())
+}
+
 func (t *MatcherTestSuite) newNamespaceCache() cache.NamespaceCache {
 	entry := cache.NewLocalNamespaceCacheEntryForTest(
 		&persistenceblobs.NamespaceInfo{Name: "test-namespace"},
@@ -62,8 +62,9 @@ import (
 // TODO: Switch implementation from lock/channel based to a partitioned agent
 // to simplify code and reduce possibility of synchronization errors.
 type (
-	pollerIDCtxKey string
-	identityCtxKey string
+	pollerIDCtxKey      string
+	identityCtxKey      string
+	workerBuildIDCtxKey string
 
 	// lockableQueryTaskMap maps query TaskID (which is a UUID generated in QueryWorkflow() call) to a channel
 	// that QueryWorkflow() will block on. The channel is unblocked either by worker sending response through
@@ -103,8 +104,9 @@ var (
 	ErrNoTasks    = errors.New("No tasks")
 	errPumpClosed = errors.New("Task list pump closed its channel")
 
-	pollerIDKey pollerIDCtxKey = "pollerID"
-	identityKey identityCtxKey = "identity"
+	pollerIDKey      pollerIDCtxKey      = "pollerID"
+	identityKey      identityCtxKey      = "identity"
+	workerBuildIDKey workerBuildIDCtxKey = "workerBuildID"
 )
 
 var _ Engine = (*matchingEngineImpl)(nil) // Asserts that interface is indeed implemented
@@ -203,9 +205,33 @@ func (e *matchingEngineImpl) getTaskListManager(taskList *taskListID, taskListKi
 		return nil, err
 	}
 	e.logger.Info("", tag.LifeCycleStarted, tag.WorkflowTaskListName(taskList.name), tag.WorkflowTaskListType(taskList.taskType))
+	if taskList.versionSet != "" {
+		if err := e.inheritRateLimit(taskList, taskListKind, mgr); err != nil {
+			return nil, err
+		}
+	}
 	return mgr, nil
 }
 
+// inheritRateLimit applies the rate limit of a task list to the task list of one of its worker version sets,
+// which may have been loaded after the limit was last updated
+func (e *matchingEngineImpl) inheritRateLimit(
+	versionedTaskList *taskListID,
+	taskListKind tasklistpb.TaskListKind,
+	versionedMgr taskListManager,
+) error {
+	taskList := *versionedTaskList
+	taskList.versionSet = ""
+	tlMgr, err := e.getTaskListManager(&taskList, taskListKind)
+	if err != nil {
+		return err
+	}
+	if tlMgr.RateLimit() == versionedMgr.RateLimit() {
+		return nil
+	}
+	return versionedMgr.UpdateRateLimit(tlMgr.RateLimit())
+}
+
 // For use in tests
 func (e *matchingEngineImpl) updateTaskList(taskList *taskListID, mgr taskListManager) {
 	e.taskListsLock.Lock()
@@ -240,6 +266,10 @@ func (e *matchingEngineImpl) AddDecisionTask(
 	if err != nil {
 		return false, err
 	}
+	taskList, err = e.getVersionedTaskListID(taskList, taskListKind, addRequest.GetWorkerBuildId(), addRequest.GetForwardedFrom() != "", false)
+	if err != nil {
+		return false, err
+	}
 
 	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
 	if err != nil {
@@ -257,6 +287,7 @@ func (e *matchingEngineImpl) AddDecisionTask(
 		ScheduleId:  addRequest.GetScheduleId(),
 		Expiry:      expiry,
 		CreatedTime: now,
+		Priority:    addRequest.GetPriority(),
 	}
 
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
@@ -304,6 +335,7 @@ func (e *matchingEngineImpl) AddActivityTask(
 		ScheduleId:  addRequest.GetScheduleId(),
 		CreatedTime: now,
 		Expiry:      expiry,
+		Priority:    addRequest.GetPriority(),
 	}
 
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
@@ -334,11 +366,16 @@ pollLoop:
 		// long-poll when frontend calls CancelOutstandingPoll API
 		pollerCtx := context.WithValue(hCtx.Context, pollerIDKey, pollerID)
 		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
+		pollerCtx = context.WithValue(pollerCtx, workerBuildIDKey, req.GetWorkerBuildId())
 		taskList, err := newTaskListID(namespaceID, taskListName, persistence.TaskListTypeDecision)
 		if err != nil {
 			return nil, err
 		}
 		taskListKind := request.TaskList.GetKind()
+		taskList, err = e.getVersionedTaskListID(taskList, taskListKind, req.GetWorkerBuildId(), false, true)
+		if err != nil {
+			return nil, err
+		}
 		task, err := e.getTask(pollerCtx, taskList, nil, taskListKind)
 		if err != nil {
 			// TODO: Is empty poll the best reply for errPumpClosed?
@@ -436,6 +473,7 @@ pollLoop:
 		// long-poll when frontend calls CancelOutstandingPoll API
 		pollerCtx := context.WithValue(hCtx.Context, pollerIDKey, pollerID)
 		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
+		pollerCtx = context.WithValue(pollerCtx, workerBuildIDKey, req.GetWorkerBuildId())
 		taskListKind := request.TaskList.GetKind()
 		task, err := e.getTask(pollerCtx, taskList, maxDispatch, taskListKind)
 		if err != nil {
@@ -488,6 +526,10 @@ func (e *matchingEngineImpl) QueryWorkflow(
 	if err != nil {
 		return nil, err
 	}
+	taskList, err = e.getVersionedTaskListID(taskList, taskListKind, queryRequest.GetWorkerBuildId(), queryRequest.GetForwardedFrom() != "", false)
+	if err != nil {
+		return nil, err
+	}
 
 	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
 	if err != nil {
@@ -562,6 +604,10 @@ func (e *matchingEngineImpl) CancelOutstandingPoll(
 		return err
 	}
 	taskListKind := request.TaskList.GetKind()
+	taskList, err = e.getVersionedTaskListID(taskList, taskListKind, request.GetWorkerBuildId(), false, true)
+	if err != nil {
+		return err
+	}
 	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
 	if err != nil {
 		return err
@@ -591,7 +637,73 @@ func (e *matchingEngineImpl) DescribeTaskList(
 		return nil, err
 	}
 
-	return tlMgr.DescribeTaskList(request.DescRequest.GetIncludeTaskListStatus()), nil
+	response := tlMgr.DescribeTaskList(request.DescRequest.GetIncludeTaskListStatus())
+	// pollers of a compatible build poll the task lists of their worker version set
+	for _, versionedMgr := range e.getVersionedTaskListManagers(taskList) {
+		versionedResponse := versionedMgr.DescribeTaskList(false)
+		response.Pollers = append(response.Pollers, versionedResponse.GetPollers()...)
+		if len(versionedResponse.GetPollerBuildIds()) > 0 && response.PollerBuildIds == nil {
+			response.PollerBuildIds = make(map[string]string)
+		}
+		for identity, buildID := range versionedResponse.GetPollerBuildIds() {
+			response.PollerBuildIds[identity] = buildID
+		}
+	}
+	return response, nil
+}
+
+// UpdateTaskListRateLimit persists the dispatch rate limit of a task list partition and of the loaded task lists
+// of its worker version sets. The root partition passes the limit on to the other partitions, each of them
+// dispatching its share of the rate.
+func (e *matchingEngineImpl) UpdateTaskListRateLimit(
+	hCtx *handlerContext,
+	request *matchingservice.UpdateTaskListRateLimitRequest,
+) error {
+	namespaceID := request.GetNamespaceId()
+	taskListType := persistence.TaskListTypeDecision
+	if request.GetTaskListType() == tasklistpb.TaskListType_Activity {
+		taskListType = persistence.TaskListTypeActivity
+	}
+	taskListName := request.TaskList.GetName()
+	taskList, err := newTaskListID(namespaceID, taskListName, taskListType)
+	if err != nil {
+		return err
+	}
+	tlMgr, err := e.getTaskListManager(taskList, tasklistpb.TaskListKind_Normal)
+	if err != nil {
+		return err
+	}
+	if err := tlMgr.UpdateRateLimit(request.GetMaxTasksPerSecond()); err != nil {
+		return err
+	}
+	// the task lists of worker version sets loaded later inherit the limit when they start
+	for _, versionedMgr := range e.getVersionedTaskListManagers(taskList) {
+		if err := versionedMgr.UpdateRateLimit(request.GetMaxTasksPerSecond()); err != nil {
+			return err
+		}
+	}
+	if request.GetForwardedFrom() != "" || !taskList.IsRoot() {
+		return nil
+	}
+
+	namespaceEntry, err := e.namespaceCache.GetNamespaceByID(namespaceID)
+	if err != nil {
+		return err
+	}
+	nPartitions := e.config.NumTasklistReadPartitions(namespaceEntry.GetInfo().Name, taskList.name, taskListType)
+	for partition := 1; partition < nPartitions; partition++ {
+		_, err := e.matchingClient.UpdateTaskListRateLimit(hCtx.Context, &matchingservice.UpdateTaskListRateLimitRequest{
+			NamespaceId:       namespaceID,
+			TaskList:          &tasklistpb.TaskList{Name: taskList.mkName(partition), Kind: tasklistpb.TaskListKind_Normal},
+			TaskListType:      request.GetTaskListType(),
+			MaxTasksPerSecond: request.GetMaxTasksPerSecond(),
+			ForwardedFrom:     taskListName,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (e *matchingEngineImpl) ListTaskListPartitions(
@@ -686,6 +798,55 @@ func (e *matchingEngineImpl) getTask(
 	return tlMgr.GetTask(ctx, maxDispatchPerSecond)
 }
 
+// getVersionedTaskListID returns the task list the decision tasks of a worker build ID are dispatched through.
+// When the namespace declares worker version sets, every set gets a task list of its own, so that tasks
+// are only matched with pollers of a compatible build.
+func (e *matchingEngineImpl) getVersionedTaskListID(
+	taskList *taskListID,
+	taskListKind tasklistpb.TaskListKind,
+	buildID string,
+	isForwarded bool,
+	isPoller bool,
+) (*taskListID, error) {
+	// sticky task lists belong to a single worker, which is compatible by definition
+	if taskList.taskType != persistence.TaskListTypeDecision || taskListKind == tasklistpb.TaskListKind_Sticky {
+		return taskList, nil
+	}
+	// a forwarded task was already assigned to a worker version set by the child partition
+	if isForwarded {
+		if buildID == "" {
+			return taskList, nil
+		}
+		versionedTaskList := *taskList
+		versionedTaskList.versionSet = buildID
+		return &versionedTaskList, nil
+	}
+	namespaceEntry, err := e.namespaceCache.GetNamespaceByID(taskList.namespaceID)
+	if err != nil {
+		return nil, err
+	}
+	versionSet := getWorkerVersionSet(namespaceEntry.GetConfig().GetWorkerVersionSets(), buildID, isPoller)
+	if versionSet == "" {
+		return taskList, nil
+	}
+	versionedTaskList := *taskList
+	versionedTaskList.versionSet = versionSet
+	return &versionedTaskList, nil
+}
+
+// getVersionedTaskListManagers returns the loaded managers of the worker version sets of a task list
+func (e *matchingEngineImpl) getVersionedTaskListManagers(taskList *taskListID) []taskListManager {
+	e.taskListsLock.RLock()
+	defer e.taskListsLock.RUnlock()
+	var result []taskListManager
+	for id, tlMgr := range e.taskLists {
+		if id.versionSet != "" && id.namespaceID == taskList.namespaceID && id.name == taskList.name && id.taskType == taskList.taskType {
+			result = append(result, tlMgr)
+		}
+	}
+	return result
+}
+
 func (e *matchingEngineImpl) unloadTaskList(id *taskListID) {
 	e.taskListsLock.Lock()
 	tlMgr, ok := e.taskLists[*id]
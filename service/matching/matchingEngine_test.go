@@ -52,6 +52,8 @@ import (
 	"github.com/temporalio/temporal/.gen/proto/historyservice"
 	"github.com/temporalio/temporal/.gen/proto/historyservicemock"
 	"github.com/temporalio/temporal/.gen/proto/matchingservice"
+	"github.com/temporalio/temporal/.gen/proto/matchingservicemock"
+	namespacegenpb "github.com/temporalio/temporal/.gen/proto/namespace"
 	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
 	tokengenpb "github.com/temporalio/temporal/.gen/proto/token"
 	"github.com/temporalio/temporal/client/history"
@@ -320,6 +322,151 @@ func (s *matchingEngineSuite) PollForDecisionTasksResultTest() {
 	s.Equal(expectedResp, resp)
 }
 
+func (s *matchingEngineSuite) TestPollForDecisionTasks_WorkerVersionSets() {
+	namespaceID := primitives.UUID(uuid.NewRandom())
+	tl := "makeToast"
+	taskList := &tasklistpb.TaskList{Name: tl, Kind: tasklistpb.TaskListKind_Normal}
+
+	namespaceCache := cache.NewMockNamespaceCache(s.controller)
+	namespaceCache.EXPECT().GetNamespaceByID(gomock.Any()).Return(cache.NewLocalNamespaceCacheEntryForTest(
+		&persistenceblobs.NamespaceInfo{Name: matchingTestNamespace},
+		&persistenceblobs.NamespaceConfig{WorkerVersionSets: []*namespacegenpb.WorkerVersionSet{
+			{BuildIds: []string{"1.0", "1.1"}},
+			{BuildIds: []string{"2.0"}},
+		}},
+		"",
+		nil,
+	), nil).AnyTimes()
+	engine := newMatchingEngine(defaultTestConfig(), s.taskManager, s.mockHistoryClient, s.logger, namespaceCache)
+	engine.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(10 * time.Millisecond)
+	defer engine.Stop()
+
+	execution1 := &executionpb.WorkflowExecution{RunId: uuid.New(), WorkflowId: "workflow1"}
+	execution2 := &executionpb.WorkflowExecution{RunId: uuid.New(), WorkflowId: "workflow2"}
+	s.mockHistoryClient.EXPECT().RecordDecisionTaskStarted(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, taskRequest *historyservice.RecordDecisionTaskStartedRequest) (*historyservice.RecordDecisionTaskStartedResponse, error) {
+			return &historyservice.RecordDecisionTaskStartedResponse{
+				WorkflowType:              &commonpb.WorkflowType{Name: "workflow"},
+				ScheduledEventId:          1,
+				WorkflowExecutionTaskList: taskList,
+			}, nil
+		}).AnyTimes()
+
+	// the task of a 1.1 worker belongs to the {1.0, 1.1} set, a task without a build ID to the newest set
+	_, err := engine.AddDecisionTask(s.handlerContext, &matchingservice.AddDecisionTaskRequest{
+		NamespaceId:                   namespaceID.String(),
+		Execution:                     execution1,
+		TaskList:                      taskList,
+		ScheduleToStartTimeoutSeconds: 100,
+		WorkerBuildId:                 "1.1",
+	})
+	s.NoError(err)
+	_, err = engine.AddDecisionTask(s.handlerContext, &matchingservice.AddDecisionTaskRequest{
+		NamespaceId:                   namespaceID.String(),
+		Execution:                     execution2,
+		TaskList:                      taskList,
+		ScheduleToStartTimeoutSeconds: 100,
+	})
+	s.NoError(err)
+	s.EqualValues(0, s.taskManager.getTaskCount(newTestTaskListID(namespaceID.String(), tl, persistence.TaskListTypeDecision)))
+	s.EqualValues(1, s.taskManager.getTaskCount(newTestTaskListID(namespaceID.String(), tl+taskListVersionSetInfix+"1.0", persistence.TaskListTypeDecision)))
+	s.EqualValues(1, s.taskManager.getTaskCount(newTestTaskListID(namespaceID.String(), tl+taskListVersionSetInfix+"2.0", persistence.TaskListTypeDecision)))
+
+	// an incompatible poller does not get any of the tasks
+	resp, err := engine.PollForDecisionTask(s.handlerContext, &matchingservice.PollForDecisionTaskRequest{
+		NamespaceId:   namespaceID.String(),
+		PollRequest:   &workflowservice.PollForDecisionTaskRequest{TaskList: taskList, Identity: "worker-3.0"},
+		WorkerBuildId: "3.0",
+	})
+	s.NoError(err)
+	s.Equal(emptyPollForDecisionTaskResponse, resp)
+
+	resp, err = engine.PollForDecisionTask(s.handlerContext, &matchingservice.PollForDecisionTaskRequest{
+		NamespaceId:   namespaceID.String(),
+		PollRequest:   &workflowservice.PollForDecisionTaskRequest{TaskList: taskList, Identity: "worker-1.0"},
+		WorkerBuildId: "1.0",
+	})
+	s.NoError(err)
+	s.Equal(execution1, resp.WorkflowExecution)
+
+	descResp, err := engine.DescribeTaskList(s.handlerContext, &matchingservice.DescribeTaskListRequest{
+		NamespaceId: namespaceID.String(),
+		DescRequest: &workflowservice.DescribeTaskListRequest{
+			TaskList:     taskList,
+			TaskListType: tasklistpb.TaskListType_Decision,
+		},
+	})
+	s.NoError(err)
+	s.Equal(2, len(descResp.Pollers))
+	s.Equal(map[string]string{"worker-1.0": "1.0", "worker-3.0": "3.0"}, descResp.PollerBuildIds)
+}
+
+func (s *matchingEngineSuite) TestUpdateTaskListRateLimit() {
+	namespaceID := primitives.UUID(uuid.NewRandom())
+	tl := "makeToast"
+	tlID := newTestTaskListID(namespaceID.String(), tl, persistence.TaskListTypeActivity)
+
+	matchingClient := matchingservicemock.NewMockMatchingServiceClient(s.controller)
+	s.matchingEngine.matchingClient = matchingClient
+	s.matchingEngine.config.NumTasklistReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(3)
+
+	// the root partition passes the limit on to the other partitions
+	for _, partition := range []string{tlID.mkName(1), tlID.mkName(2)} {
+		matchingClient.EXPECT().UpdateTaskListRateLimit(gomock.Any(), &matchingservice.UpdateTaskListRateLimitRequest{
+			NamespaceId:       namespaceID.String(),
+			TaskList:          &tasklistpb.TaskList{Name: partition, Kind: tasklistpb.TaskListKind_Normal},
+			TaskListType:      tasklistpb.TaskListType_Activity,
+			MaxTasksPerSecond: 30,
+			ForwardedFrom:     tl,
+		}).Return(&matchingservice.UpdateTaskListRateLimitResponse{}, nil)
+	}
+	err := s.matchingEngine.UpdateTaskListRateLimit(s.handlerContext, &matchingservice.UpdateTaskListRateLimitRequest{
+		NamespaceId:       namespaceID.String(),
+		TaskList:          &tasklistpb.TaskList{Name: tl},
+		TaskListType:      tasklistpb.TaskListType_Activity,
+		MaxTasksPerSecond: 30,
+	})
+	s.NoError(err)
+	s.EqualValues(30, s.taskManager.getTaskListManager(tlID).maxTasksPerSecond)
+
+	tlMgr, err := s.matchingEngine.getTaskListManager(tlID, tasklistpb.TaskListKind_Normal)
+	s.NoError(err)
+	s.EqualValues(30, tlMgr.DescribeTaskList(true).GetTaskListStatus().GetRatePerSecond())
+	// each partition dispatches its share of the rate
+	s.EqualValues(10, tlMgr.(*taskListManagerImpl).matcher.limiter.Limit())
+}
+
+func (s *matchingEngineSuite) TestUpdateTaskListRateLimit_VersionSets() {
+	namespaceID := primitives.UUID(uuid.NewRandom())
+	tl := "makeToast"
+	s.matchingEngine.config.NumTasklistReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(1)
+
+	versionedTaskListID := func(versionSet string) *taskListID {
+		id := newTestTaskListID(namespaceID.String(), tl, persistence.TaskListTypeDecision)
+		id.versionSet = versionSet
+		return id
+	}
+	_, err := s.matchingEngine.getTaskListManager(versionedTaskListID("1.0"), tasklistpb.TaskListKind_Normal)
+	s.NoError(err)
+
+	// the limit applies to the loaded task lists of the worker version sets
+	err = s.matchingEngine.UpdateTaskListRateLimit(s.handlerContext, &matchingservice.UpdateTaskListRateLimitRequest{
+		NamespaceId:       namespaceID.String(),
+		TaskList:          &tasklistpb.TaskList{Name: tl},
+		TaskListType:      tasklistpb.TaskListType_Decision,
+		MaxTasksPerSecond: 30,
+	})
+	s.NoError(err)
+	s.EqualValues(30, s.taskManager.getTaskListManager(newTestTaskListID(namespaceID.String(), tl, persistence.TaskListTypeDecision)).maxTasksPerSecond)
+	s.EqualValues(30, s.taskManager.getTaskListManager(newTestTaskListID(namespaceID.String(), tl+taskListVersionSetInfix+"1.0", persistence.TaskListTypeDecision)).maxTasksPerSecond)
+
+	// and to the ones loaded later
+	tlMgr, err := s.matchingEngine.getTaskListManager(versionedTaskListID("2.0"), tasklistpb.TaskListKind_Normal)
+	s.NoError(err)
+	s.EqualValues(30, tlMgr.RateLimit())
+	s.EqualValues(30, tlMgr.(*taskListManagerImpl).matcher.Rate())
+}
+
 func (s *matchingEngineSuite) PollForTasksEmptyResultTest(callContext context.Context, taskType int32) {
 	s.matchingEngine.config.RangeSize = 2 // to test that range is not updated without tasks
 	if _, ok := callContext.Deadline(); !ok {
@@ -1472,8 +1619,8 @@ func (s *matchingEngineSuite) TestTaskListManagerGetTaskBatch() {
 
 	// wait until all tasks are read by the task pump and enqeued into the in-memory buffer
 	// at the end of this step, ackManager readLevel will also be equal to the buffer size
-	expectedBufSize := common.MinInt(cap(tlMgr.taskReader.taskBuffer), taskCount)
-	s.True(s.awaitCondition(func() bool { return len(tlMgr.taskReader.taskBuffer) == expectedBufSize }, time.Second))
+	expectedBufSize := common.MinInt(cap(tlMgr.taskReader.taskBuffers[common.TaskPriorityNormal]), taskCount)
+	s.True(s.awaitCondition(func() bool { return len(tlMgr.taskReader.taskBuffers[common.TaskPriorityNormal]) == expectedBufSize }, time.Second))
 
 	// stop all goroutines that read / write tasks in the background
 	// remainder of this test works with the in-memory buffer
@@ -1616,7 +1763,7 @@ func (s *matchingEngineSuite) TestTaskExpiryAndCompletion() {
 
 		// wait until all tasks are loaded by into in-memory buffers by task list manager
 		// the buffer size should be one less than expected because dispatcher will dequeue the head
-		s.True(s.awaitCondition(func() bool { return len(tlMgr.taskReader.taskBuffer) >= (taskCount/2 - 1) }, time.Second))
+		s.True(s.awaitCondition(func() bool { return len(tlMgr.taskReader.taskBuffers[common.TaskPriorityNormal]) >= (taskCount/2 - 1) }, time.Second))
 
 		maxTimeBetweenTaskDeletes = tc.maxTimeBtwnDeletes
 		s.matchingEngine.config.MaxTaskDeleteBatchSize = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(tc.batchSize)
@@ -1744,10 +1891,11 @@ func (m *testTaskManager) getTaskListManager(id *taskListID) *testTaskListManage
 
 type testTaskListManager struct {
 	sync.Mutex
-	rangeID         int64
-	ackLevel        int64
-	createTaskCount int
-	tasks           *treemap.Map
+	rangeID           int64
+	ackLevel          int64
+	maxTasksPerSecond float64
+	createTaskCount   int
+	tasks             *treemap.Map
 }
 
 func Int64Comparator(a, b interface{}) int {
@@ -1786,11 +1934,12 @@ func (m *testTaskManager) LeaseTaskList(request *persistence.LeaseTaskListReques
 	return &persistence.LeaseTaskListResponse{
 		TaskListInfo: &persistence.PersistedTaskListInfo{
 			Data: &persistenceblobs.TaskListInfo{
-				AckLevel:    tlm.ackLevel,
-				NamespaceId: request.NamespaceID,
-				Name:        request.TaskList,
-				TaskType:    request.TaskType,
-				Kind:        request.TaskListKind,
+				AckLevel:          tlm.ackLevel,
+				NamespaceId:       request.NamespaceID,
+				Name:              request.TaskList,
+				TaskType:          request.TaskType,
+				Kind:              request.TaskListKind,
+				MaxTasksPerSecond: tlm.maxTasksPerSecond,
 			},
 			RangeID: tlm.rangeID,
 		},
@@ -1812,6 +1961,7 @@ func (m *testTaskManager) UpdateTaskList(request *persistence.UpdateTaskListRequ
 		}
 	}
 	tlm.ackLevel = tli.AckLevel
+	tlm.maxTasksPerSecond = tli.MaxTasksPerSecond
 	return &persistence.UpdateTaskListResponse{}, nil
 }
 
@@ -124,3 +124,11 @@ func (h *NilCheckHandler) ListTaskListPartitions(ctx context.Context, request *m
 	}
 	return resp, err
 }
+
+func (h *NilCheckHandler) UpdateTaskListRateLimit(ctx context.Context, request *matchingservice.UpdateTaskListRateLimitRequest) (*matchingservice.UpdateTaskListRateLimitResponse, error) {
+	resp, err := h.parentHandler.UpdateTaskListRateLimit(ctx, request)
+	if resp == nil && err == nil {
+		resp = &matchingservice.UpdateTaskListRateLimitResponse{}
+	}
+	return resp, err
+}
@@ -43,6 +43,7 @@ type (
 
 	pollerInfo struct {
 		ratePerSecond float64
+		buildID       string
 	}
 )
 
@@ -64,12 +65,12 @@ func newPollerHistory() *pollerHistory {
 	}
 }
 
-func (pollers *pollerHistory) updatePollerInfo(id pollerIdentity, ratePerSecond *float64) {
+func (pollers *pollerHistory) updatePollerInfo(id pollerIdentity, ratePerSecond *float64, buildID string) {
 	rps := _defaultTaskDispatchRPS
 	if ratePerSecond != nil {
 		rps = *ratePerSecond
 	}
-	pollers.history.Put(id, &pollerInfo{ratePerSecond: rps})
+	pollers.history.Put(id, &pollerInfo{ratePerSecond: rps, buildID: buildID})
 }
 
 func (pollers *pollerHistory) getAllPollerInfo() []*tasklistpb.PollerInfo {
@@ -92,3 +93,20 @@ func (pollers *pollerHistory) getAllPollerInfo() []*tasklistpb.PollerInfo {
 
 	return result
 }
+
+// getPollerBuildIDs returns the worker build IDs reported by the pollers, keyed by poller identity
+func (pollers *pollerHistory) getPollerBuildIDs() map[string]string {
+	result := make(map[string]string)
+
+	ite := pollers.history.Iterator()
+	defer ite.Close()
+	for ite.HasNext() {
+		entry := ite.Next()
+		value := entry.Value().(*pollerInfo)
+		if value.buildID != "" {
+			result[string(entry.Key().(pollerIdentity))] = value.buildID
+		}
+	}
+
+	return result
+}
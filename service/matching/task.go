@@ -30,6 +30,7 @@ import (
 	commongenpb "github.com/temporalio/temporal/.gen/proto/common"
 	"github.com/temporalio/temporal/.gen/proto/matchingservice"
 	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
+	"github.com/temporalio/temporal/common"
 	"github.com/temporalio/temporal/common/primitives"
 )
 
@@ -65,6 +66,9 @@ type (
 	}
 )
 
+// taskPriorities lists the task priorities from the most to the least urgent
+var taskPriorities = []int32{common.TaskPriorityHigh, common.TaskPriorityNormal, common.TaskPriorityLow}
+
 func newInternalTask(
 	info *persistenceblobs.AllocatedTaskInfo,
 	completionFunc func(*persistenceblobs.AllocatedTaskInfo, error),
@@ -120,6 +124,14 @@ func (task *internalTask) isForwarded() bool {
 	return task.forwardedFrom != ""
 }
 
+// priority returns the priority of the underlying task, query and started tasks have normal priority
+func (task *internalTask) priority() int32 {
+	if task.event != nil {
+		return common.NormalizeTaskPriority(task.event.Data.GetPriority())
+	}
+	return common.TaskPriorityNormal
+}
+
 func (task *internalTask) workflowExecution() *executionpb.WorkflowExecution {
 	switch {
 	case task.event != nil:
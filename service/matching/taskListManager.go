@@ -86,6 +86,11 @@ type (
 		DispatchQueryTask(ctx context.Context, taskID string, request *matchingservice.QueryWorkflowRequest) (*matchingservice.QueryWorkflowResponse, error)
 		CancelPoller(pollerID string)
 		GetAllPollerInfo() []*tasklistpb.PollerInfo
+		// UpdateRateLimit persists the dispatch rate of the whole task list, which takes precedence
+		// over the rate set by pollers. A rate of 0 lets the pollers set the rate again
+		UpdateRateLimit(maxTasksPerSecond float64) error
+		// RateLimit returns the dispatch rate persisted through UpdateRateLimit, 0 if the pollers set the rate
+		RateLimit() float64
 		// DescribeTaskList returns information about the target task list
 		DescribeTaskList(includeTaskListStatus bool) *matchingservice.DescribeTaskListResponse
 		String() string
@@ -118,7 +123,7 @@ type (
 		outstandingPollsLock sync.Mutex
 		outstandingPollsMap  map[string]context.CancelFunc
 
-		shutdownCh chan struct{}  // Delivers stop to the pump that populates taskBuffer
+		shutdownCh chan struct{}  // Delivers stop to the pump that populates task buffers
 		startWG    sync.WaitGroup // ensures that background processes do not start until setup is ready
 		stopped    int32
 	}
@@ -145,7 +150,7 @@ func newTaskListManager(
 		return nil, err
 	}
 
-	db := newTaskListDB(e.taskManager, primitives.MustParseUUID(taskList.namespaceID), taskList.name, taskList.taskType, int32(taskListKind), e.logger)
+	db := newTaskListDB(e.taskManager, primitives.MustParseUUID(taskList.namespaceID), taskList.persistenceName(), taskList.taskType, int32(taskListKind), e.logger)
 
 	tlMgr := &taskListManagerImpl{
 		namespaceCache: e.namespaceCache,
@@ -188,7 +193,7 @@ func newTaskListManager(
 }
 
 // Starts reading pump for the given task list.
-// The pump fills up taskBuffer from persistence.
+// The pump fills up task buffers from persistence.
 func (c *taskListManagerImpl) Start() error {
 	defer c.startWG.Done()
 
@@ -200,13 +205,16 @@ func (c *taskListManagerImpl) Start() error {
 	}
 
 	c.taskAckManager.setAckLevel(state.ackLevel)
+	if state.maxTasksPerSecond > 0 {
+		c.matcher.SetRatelimit(state.maxTasksPerSecond)
+	}
 	c.taskWriter.Start(c.rangeIDToTaskIDBlock(state.rangeID))
 	c.taskReader.Start()
 
 	return nil
 }
 
-// Stops pump that fills up taskBuffer from persistence.
+// Stops pump that fills up task buffers from persistence.
 func (c *taskListManagerImpl) Stop() {
 	if !atomic.CompareAndSwapInt32(&c.stopped, 0, 1) {
 		return
@@ -239,9 +247,13 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 			return r, err
 		}
 
-		syncMatch, err = c.trySyncMatch(ctx, params)
-		if syncMatch {
-			return &persistence.CreateTasksResponse{}, err
+		// more urgent tasks waiting in the backlog must be dispatched first,
+		// so do not let this task jump ahead of them through sync match
+		if !c.taskReader.hasHigherPriorityBacklog(td.GetPriority()) {
+			syncMatch, err = c.trySyncMatch(ctx, params)
+			if syncMatch {
+				return &persistence.CreateTasksResponse{}, err
+			}
 		}
 
 		if params.forwardedFrom != "" {
@@ -318,7 +330,8 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 
 	identity, ok := ctx.Value(identityKey).(string)
 	if ok && identity != "" {
-		c.pollerHistory.updatePollerInfo(pollerIdentity(identity), maxDispatchPerSecond)
+		buildID, _ := ctx.Value(workerBuildIDKey).(string)
+		c.pollerHistory.updatePollerInfo(pollerIdentity(identity), maxDispatchPerSecond, buildID)
 	}
 
 	namespaceEntry, err := c.namespaceCache.GetNamespaceByID(c.taskListID.namespaceID)
@@ -330,8 +343,11 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 	// poller, which lives inside the client side worker. There is
 	// one rateLimiter for this entire task list and as we get polls,
 	// we update the ratelimiter rps if it has changed from the last
-	// value. Last poller wins if different pollers provide different values
-	c.matcher.UpdateRatelimit(maxDispatchPerSecond)
+	// value. Last poller wins if different pollers provide different values.
+	// A rate limit persisted through the admin API overrides the pollers
+	if c.db.MaxTasksPerSecond() == 0 {
+		c.matcher.UpdateRatelimit(maxDispatchPerSecond)
+	}
 
 	if namespaceEntry.GetNamespaceNotActiveErr() != nil {
 		return c.matcher.PollForQuery(childCtx)
@@ -355,11 +371,37 @@ func (c *taskListManagerImpl) CancelPoller(pollerID string) {
 	}
 }
 
+// UpdateRateLimit persists the dispatch rate of the whole task list and applies the share of this partition
+func (c *taskListManagerImpl) UpdateRateLimit(maxTasksPerSecond float64) error {
+	c.startWG.Wait()
+	_, err := c.executeWithRetry(func() (interface{}, error) {
+		return nil, c.db.UpdateMaxTasksPerSecond(maxTasksPerSecond)
+	})
+	if err != nil {
+		return err
+	}
+	if maxTasksPerSecond == 0 {
+		// back to the default until the next poller sets its rate
+		maxTasksPerSecond = _defaultTaskDispatchRPS
+	}
+	c.matcher.SetRatelimit(maxTasksPerSecond)
+	return nil
+}
+
+// RateLimit returns the dispatch rate persisted through UpdateRateLimit, 0 if the pollers set the rate
+func (c *taskListManagerImpl) RateLimit() float64 {
+	c.startWG.Wait()
+	return c.db.MaxTasksPerSecond()
+}
+
 // DescribeTaskList returns information about the target tasklist, right now this API returns the
 // pollers which polled this tasklist in last few minutes and status of tasklist's ackManager
 // (readLevel, ackLevel, backlogCountHint and taskIDBlock).
 func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *matchingservice.DescribeTaskListResponse {
-	response := &matchingservice.DescribeTaskListResponse{Pollers: c.GetAllPollerInfo()}
+	response := &matchingservice.DescribeTaskListResponse{
+		Pollers:        c.GetAllPollerInfo(),
+		PollerBuildIds: c.pollerHistory.getPollerBuildIDs(),
+	}
 	if !includeTaskListStatus {
 		return response
 	}
@@ -38,6 +38,7 @@ import (
 
 	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
 
+	"github.com/temporalio/temporal/common"
 	"github.com/temporalio/temporal/common/cache"
 	"github.com/temporalio/temporal/common/log/loggerimpl"
 	"github.com/temporalio/temporal/common/log/tag"
@@ -51,12 +52,12 @@ func TestDeliverBufferTasks(t *testing.T) {
 	defer controller.Finish()
 
 	tests := []func(tlm *taskListManagerImpl){
-		func(tlm *taskListManagerImpl) { close(tlm.taskReader.taskBuffer) },
+		func(tlm *taskListManagerImpl) { close(tlm.taskReader.taskBuffers[common.TaskPriorityNormal]) },
 		func(tlm *taskListManagerImpl) { close(tlm.taskReader.dispatcherShutdownC) },
 		func(tlm *taskListManagerImpl) {
 			rps := 0.1
 			tlm.matcher.UpdateRatelimit(&rps)
-			tlm.taskReader.taskBuffer <- &persistenceblobs.AllocatedTaskInfo{}
+			tlm.taskReader.taskBuffers[common.TaskPriorityNormal] <- &persistenceblobs.AllocatedTaskInfo{}
 			_, err := tlm.matcher.ratelimit(context.Background()) // consume the token
 			assert.NoError(t, err)
 			tlm.taskReader.cancelFunc()
@@ -81,7 +82,7 @@ func TestDeliverBufferTasks_NoPollers(t *testing.T) {
 	defer controller.Finish()
 
 	tlm := createTestTaskListManager(controller)
-	tlm.taskReader.taskBuffer <- &persistenceblobs.AllocatedTaskInfo{}
+	tlm.taskReader.taskBuffers[common.TaskPriorityNormal] <- &persistenceblobs.AllocatedTaskInfo{}
 	var wg sync.WaitGroup
 	wg.Add(1)
 	go func() {
@@ -93,6 +94,55 @@ func TestDeliverBufferTasks_NoPollers(t *testing.T) {
 	wg.Wait()
 }
 
+func TestNextBufferedTask_WeightedByPriority(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	for _, priority := range taskPriorities {
+		for i := 0; i < 10; i++ {
+			tlm.taskReader.taskBuffers[priority] <- &persistenceblobs.AllocatedTaskInfo{
+				Data: &persistenceblobs.TaskInfo{Priority: priority},
+			}
+		}
+	}
+
+	dispatched := make(map[int32]int)
+	for range tlm.taskReader.dispatchOrder {
+		taskInfo, ok := tlm.taskReader.nextBufferedTask()
+		require.True(t, ok)
+		dispatched[taskInfo.GetData().GetPriority()]++
+	}
+	for _, priority := range taskPriorities {
+		require.Equal(t, taskPriorityDispatchWeights[priority], dispatched[priority])
+	}
+
+	// low priority tasks are drained once the other buffers are empty
+	for i := 0; i < 5; i++ {
+		<-tlm.taskReader.taskBuffers[common.TaskPriorityHigh]
+	}
+	for i := 0; i < 7; i++ {
+		<-tlm.taskReader.taskBuffers[common.TaskPriorityNormal]
+	}
+	taskInfo, ok := tlm.taskReader.nextBufferedTask()
+	require.True(t, ok)
+	require.Equal(t, common.TaskPriorityLow, taskInfo.GetData().GetPriority())
+}
+
+func TestHasHigherPriorityBacklog(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	require.False(t, tlm.taskReader.hasHigherPriorityBacklog(common.TaskPriorityLow))
+
+	tlm.taskReader.taskBuffers[common.TaskPriorityNormal] <- &persistenceblobs.AllocatedTaskInfo{}
+	require.True(t, tlm.taskReader.hasHigherPriorityBacklog(common.TaskPriorityLow))
+	require.False(t, tlm.taskReader.hasHigherPriorityBacklog(common.TaskPriorityNormal))
+	require.False(t, tlm.taskReader.hasHigherPriorityBacklog(common.TaskPriorityUnspecified))
+	require.False(t, tlm.taskReader.hasHigherPriorityBacklog(common.TaskPriorityHigh))
+}
+
 func TestReadLevelForAllExpiredTasksInBatch(t *testing.T) {
 	controller := gomock.NewController(t)
 	defer controller.Finish()
@@ -221,7 +271,7 @@ func TestDescribeTaskList(t *testing.T) {
 	require.Equal(t, tlm.config.RangeSize, taskIDBlock.GetEndId())
 
 	// Add a poller and complete all tasks
-	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), nil)
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), nil, "")
 	for i := int64(0); i < taskCount; i++ {
 		tlm.taskAckManager.completeTask(startTaskID + i)
 	}
@@ -231,13 +281,15 @@ func TestDescribeTaskList(t *testing.T) {
 	require.Equal(t, PollerIdentity, descResp.Pollers[0].GetIdentity())
 	require.NotEmpty(t, descResp.Pollers[0].GetLastAccessTime())
 	require.True(t, descResp.Pollers[0].GetRatePerSecond() > (_defaultTaskDispatchRPS-1))
+	require.Empty(t, descResp.GetPollerBuildIds())
 
 	rps := 5.0
-	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), &rps)
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), &rps, "1.0")
 	descResp = tlm.DescribeTaskList(includeTaskStatus)
 	require.Equal(t, 1, len(descResp.GetPollers()))
 	require.Equal(t, PollerIdentity, descResp.Pollers[0].GetIdentity())
 	require.True(t, descResp.Pollers[0].GetRatePerSecond() > 4.0 && descResp.Pollers[0].GetRatePerSecond() < 6.0)
+	require.Equal(t, map[string]string{PollerIdentity: "1.0"}, descResp.GetPollerBuildIds())
 
 	taskListStatus = descResp.GetTaskListStatus()
 	require.NotNil(t, taskListStatus)
@@ -245,6 +297,36 @@ func TestDescribeTaskList(t *testing.T) {
 	require.Zero(t, taskListStatus.GetBacklogCountHint())
 }
 
+func TestUpdateRateLimit(t *testing.T) {
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	tlm := createTestTaskListManager(controller)
+	require.NoError(t, tlm.Start())
+	require.NoError(t, tlm.UpdateRateLimit(10))
+	require.Equal(t, 10.0, tlm.DescribeTaskList(true).GetTaskListStatus().GetRatePerSecond())
+
+	// the persisted limit takes precedence over the rate of pollers
+	ctx, cancel := context.WithTimeout(context.Background(), returnEmptyTaskTimeBudget+10*time.Millisecond)
+	defer cancel()
+	rps := 5.0
+	_, err := tlm.GetTask(ctx, &rps)
+	require.Equal(t, ErrNoTasks, err)
+	require.Equal(t, 10.0, tlm.DescribeTaskList(true).GetTaskListStatus().GetRatePerSecond())
+	tlm.Stop()
+
+	// and is loaded with the task list
+	tlMgr, err := newTaskListManager(tlm.engine, tlm.taskListID, tasklistpb.TaskListKind_Normal, tlm.engine.config)
+	require.NoError(t, err)
+	tlm = tlMgr.(*taskListManagerImpl)
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+	require.Equal(t, 10.0, tlm.DescribeTaskList(true).GetTaskListStatus().GetRatePerSecond())
+
+	require.NoError(t, tlm.UpdateRateLimit(0))
+	require.Equal(t, _defaultTaskDispatchRPS, tlm.DescribeTaskList(true).GetTaskListStatus().GetRatePerSecond())
+}
+
 func tlMgrStartWithoutNotifyEvent(tlm *taskListManagerImpl) {
 	// mimic tlm.Start() but avoid calling notifyEvent
 	tlm.startWG.Done()
@@ -267,7 +349,7 @@ func TestCheckIdleTaskList(t *testing.T) {
 
 	// Active poll-er
 	tlm = createTestTaskListManagerWithConfig(controller, cfg)
-	tlm.pollerHistory.updatePollerInfo(pollerIdentity("test-poll"), nil)
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity("test-poll"), nil, "")
 	require.Equal(t, 1, len(tlm.GetAllPollerInfo()))
 	tlMgrStartWithoutNotifyEvent(tlm)
 	time.Sleep(20 * time.Millisecond)
@@ -31,6 +31,7 @@ import (
 
 	commongenpb "github.com/temporalio/temporal/.gen/proto/common"
 	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
+	"github.com/temporalio/temporal/common"
 	"github.com/temporalio/temporal/common/log"
 	"github.com/temporalio/temporal/common/log/tag"
 	"github.com/temporalio/temporal/common/metrics"
@@ -40,9 +41,13 @@ import (
 
 type (
 	taskReader struct {
-		taskBuffer chan *persistenceblobs.AllocatedTaskInfo // tasks loaded from persistence
-		notifyC    chan struct{}                            // Used as signal to notify pump of new tasks
-		tlMgr      *taskListManagerImpl
+		taskBuffers map[int32]chan *persistenceblobs.AllocatedTaskInfo // tasks loaded from persistence, by priority
+		notifyC     chan struct{}                                      // Used as signal to notify pump of new tasks
+		tlMgr       *taskListManagerImpl
+		// dispatchOrder is the weighted round robin order in which the task buffers are drained,
+		// higher priorities get more turns but lower priorities are never starved
+		dispatchOrder []int32
+		dispatchIndex int
 		// The cancel objects are to cancel the ratelimiter Wait in dispatchBufferedTasks. The ideal
 		// approach is to use request-scoped contexts and use a unique one for each call to Wait. However
 		// in order to cancel it on shutdown, we need a new goroutine for each call that would wait on
@@ -56,17 +61,34 @@ type (
 	}
 )
 
+// taskPriorityDispatchWeights is the number of buffered tasks dispatched for each priority
+// during one round of the dispatcher
+var taskPriorityDispatchWeights = map[int32]int{
+	common.TaskPriorityHigh:   5,
+	common.TaskPriorityNormal: 3,
+	common.TaskPriorityLow:    1,
+}
+
 func newTaskReader(tlMgr *taskListManagerImpl) *taskReader {
 	ctx, cancel := context.WithCancel(context.Background())
+	taskBuffers := make(map[int32]chan *persistenceblobs.AllocatedTaskInfo, len(taskPriorities))
+	var dispatchOrder []int32
+	for _, priority := range taskPriorities {
+		// we always dequeue the head of the buffer and try to dispatch it to a poller
+		// so allocate one less than desired target buffer size
+		taskBuffers[priority] = make(chan *persistenceblobs.AllocatedTaskInfo, tlMgr.config.GetTasksBatchSize()-1)
+		for i := 0; i < taskPriorityDispatchWeights[priority]; i++ {
+			dispatchOrder = append(dispatchOrder, priority)
+		}
+	}
 	return &taskReader{
 		tlMgr:               tlMgr,
 		cancelCtx:           ctx,
 		cancelFunc:          cancel,
 		notifyC:             make(chan struct{}, 1),
 		dispatcherShutdownC: make(chan struct{}),
-		// we always dequeue the head of the buffer and try to dispatch it to a poller
-		// so allocate one less than desired target buffer size
-		taskBuffer: make(chan *persistenceblobs.AllocatedTaskInfo, tlMgr.config.GetTasksBatchSize()-1),
+		taskBuffers:         taskBuffers,
+		dispatchOrder:       dispatchOrder,
 	}
 }
 
@@ -92,35 +114,78 @@ func (tr *taskReader) Signal() {
 func (tr *taskReader) dispatchBufferedTasks() {
 dispatchLoop:
 	for {
-		select {
-		case taskInfo, ok := <-tr.taskBuffer:
-			if !ok { // Task list getTasks pump is shutdown
-				break dispatchLoop
+		taskInfo, ok := tr.nextBufferedTask()
+		if !ok { // Task list getTasks pump or dispatcher is shutdown
+			break dispatchLoop
+		}
+		tr.emitBacklogGauge(taskInfo.GetData().GetPriority())
+		task := newInternalTask(taskInfo, tr.tlMgr.completeTask, commongenpb.TaskSource_DbBacklog, "", false)
+		for {
+			err := tr.tlMgr.DispatchTask(tr.cancelCtx, task)
+			if err == nil {
+				break
 			}
-			task := newInternalTask(taskInfo, tr.tlMgr.completeTask, commongenpb.TaskSource_DbBacklog, "", false)
-			for {
-				err := tr.tlMgr.DispatchTask(tr.cancelCtx, task)
-				if err == nil {
-					break
-				}
-				if err == context.Canceled {
-					tr.tlMgr.logger.Info("Tasklist manager context is cancelled, shutting down")
-					break dispatchLoop
-				}
-				// this should never happen unless there is a bug - don't drop the task
-				tr.scope().IncCounter(metrics.BufferThrottlePerTaskListCounter)
-				tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
-				runtime.Gosched()
+			if err == context.Canceled {
+				tr.tlMgr.logger.Info("Tasklist manager context is cancelled, shutting down")
+				break dispatchLoop
 			}
-		case <-tr.dispatcherShutdownC:
-			break dispatchLoop
+			// this should never happen unless there is a bug - don't drop the task
+			tr.scope().IncCounter(metrics.BufferThrottlePerTaskListCounter)
+			tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
+			runtime.Gosched()
 		}
 	}
 }
 
+// nextBufferedTask returns the next buffered task to dispatch. Buffers are visited in
+// the weighted round robin dispatch order and the first buffered task is returned. When
+// all buffers are empty, it blocks until a task is buffered. Returns false on shutdown.
+func (tr *taskReader) nextBufferedTask() (*persistenceblobs.AllocatedTaskInfo, bool) {
+	select {
+	case <-tr.dispatcherShutdownC:
+		return nil, false
+	default:
+	}
+
+	for range tr.dispatchOrder {
+		priority := tr.dispatchOrder[tr.dispatchIndex]
+		tr.dispatchIndex = (tr.dispatchIndex + 1) % len(tr.dispatchOrder)
+		select {
+		case taskInfo, ok := <-tr.taskBuffers[priority]:
+			return taskInfo, ok
+		default:
+		}
+	}
+
+	select {
+	case taskInfo, ok := <-tr.taskBuffers[common.TaskPriorityHigh]:
+		return taskInfo, ok
+	case taskInfo, ok := <-tr.taskBuffers[common.TaskPriorityNormal]:
+		return taskInfo, ok
+	case taskInfo, ok := <-tr.taskBuffers[common.TaskPriorityLow]:
+		return taskInfo, ok
+	case <-tr.dispatcherShutdownC:
+		return nil, false
+	}
+}
+
+// hasHigherPriorityBacklog returns true if tasks more urgent than the given priority are buffered
+func (tr *taskReader) hasHigherPriorityBacklog(priority int32) bool {
+	priority = common.NormalizeTaskPriority(priority)
+	for _, p := range taskPriorities {
+		if p >= priority {
+			return false
+		}
+		if len(tr.taskBuffers[p]) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func (tr *taskReader) getTasksPump() {
 	tr.tlMgr.startWG.Wait()
-	defer close(tr.taskBuffer)
+	defer tr.closeTaskBuffers()
 
 	updateAckTimer := time.NewTimer(tr.tlMgr.config.UpdateAckInterval())
 	checkIdleTaskListTimer := time.NewTimer(tr.tlMgr.config.IdleTasklistCheckInterval())
@@ -253,9 +318,11 @@ func (tr *taskReader) addTasksToBuffer(tasks []*persistenceblobs.AllocatedTaskIn
 func (tr *taskReader) addSingleTaskToBuffer(
 	task *persistenceblobs.AllocatedTaskInfo, lastWriteTime time.Time, idleTimer *time.Timer) bool {
 	tr.tlMgr.taskAckManager.addTask(task.GetTaskId())
+	priority := common.NormalizeTaskPriority(task.GetData().GetPriority())
 	for {
 		select {
-		case tr.taskBuffer <- task:
+		case tr.taskBuffers[priority] <- task:
+			tr.emitBacklogGauge(priority)
 			return true
 		case <-idleTimer.C:
 			if tr.isIdle(lastWriteTime) {
@@ -268,6 +335,18 @@ func (tr *taskReader) addSingleTaskToBuffer(
 	}
 }
 
+func (tr *taskReader) closeTaskBuffers() {
+	for _, taskBuffer := range tr.taskBuffers {
+		close(taskBuffer)
+	}
+}
+
+func (tr *taskReader) emitBacklogGauge(priority int32) {
+	priority = common.NormalizeTaskPriority(priority)
+	tr.scope().Tagged(metrics.TaskPriorityTag(common.TaskPriorityName(priority))).
+		UpdateGauge(metrics.BufferedBacklogPerTaskListGauge, float64(len(tr.taskBuffers[priority])))
+}
+
 func (tr *taskReader) persistAckLevel() error {
 	return tr.tlMgr.db.UpdateState(tr.tlMgr.taskAckManager.getAckLevel())
 }
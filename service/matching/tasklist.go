@@ -30,6 +30,8 @@ import (
 	"strconv"
 	"strings"
 
+	namespacegenpb "github.com/temporalio/temporal/.gen/proto/namespace"
+	"github.com/temporalio/temporal/common"
 	"github.com/temporalio/temporal/common/persistence"
 )
 
@@ -39,6 +41,7 @@ type (
 		qualifiedTaskListName
 		namespaceID string
 		taskType    int32
+		versionSet  string // key of the worker version set the task list dispatches to, empty if not versioned
 	}
 	// qualifiedTaskListName refers to the fully qualified task list name
 	qualifiedTaskListName struct {
@@ -51,6 +54,8 @@ type (
 const (
 	// taskListPartitionPrefix is the required naming prefix for any task list partition other than partition 0
 	taskListPartitionPrefix = "/__temporal_sys/"
+	// taskListVersionSetInfix separates the name of a task list from the worker version set its tasks are persisted for
+	taskListVersionSetInfix = "/__temporal_version/"
 )
 
 // newTaskListName returns a fully qualified task list name.
@@ -143,11 +148,53 @@ func newTaskListID(namespaceID string, taskListName string, taskType int32) (*ta
 	}, nil
 }
 
+// persistenceName returns the name the tasks of the task list are persisted under. The tasks of
+// every worker version set are kept apart, so that a backlog of one set does not block the others.
+func (tid *taskListID) persistenceName() string {
+	if tid.versionSet == "" {
+		return tid.name
+	}
+	return tid.name + taskListVersionSetInfix + tid.versionSet
+}
+
+// getWorkerVersionSet returns the id of the worker version set of a build ID, empty when the namespace
+// declares no sets. A build ID outside of the declared sets is a set of its own, which is keyed apart from
+// the ids of the declared sets, since a set keeps its id after its first build ID is removed. Tasks without a build ID
+// belong to the last, newest set. Pollers without a build ID only get the tasks persisted before the
+// namespace declared version sets.
+func getWorkerVersionSet(
+	versionSets []*namespacegenpb.WorkerVersionSet,
+	buildID string,
+	isPoller bool,
+) string {
+	if len(versionSets) == 0 {
+		return ""
+	}
+	if buildID == "" {
+		if isPoller {
+			return ""
+		}
+		return common.GetWorkerVersionSetID(versionSets[len(versionSets)-1])
+	}
+	for _, versionSet := range versionSets {
+		for _, id := range versionSet.GetBuildIds() {
+			if id == buildID {
+				return common.GetWorkerVersionSetID(versionSet)
+			}
+		}
+	}
+	return common.WorkerBuildIDVersionSetPrefix + buildID
+}
+
 func (tid *taskListID) String() string {
 	var b bytes.Buffer
 	b.WriteString("[")
 	b.WriteString("name=")
 	b.WriteString(tid.name)
+	if tid.versionSet != "" {
+		b.WriteString("versionSet=")
+		b.WriteString(tid.versionSet)
+	}
 	b.WriteString("type=")
 	if tid.taskType == persistence.TaskListTypeActivity {
 		b.WriteString("activity")
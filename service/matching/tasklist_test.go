@@ -29,6 +29,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	namespacegenpb "github.com/temporalio/temporal/.gen/proto/namespace"
+	"github.com/temporalio/temporal/common"
 )
 
 func TestValidTaskListNames(t *testing.T) {
@@ -119,3 +122,47 @@ func TestInvalidTasklistNames(t *testing.T) {
 		})
 	}
 }
+
+func TestWorkerVersionSet(t *testing.T) {
+	versionSets := []*namespacegenpb.WorkerVersionSet{
+		{BuildIds: []string{"1.0", "1.1"}},
+		{BuildIds: []string{"2.0"}},
+	}
+	versionSetsWithIDs := []*namespacegenpb.WorkerVersionSet{
+		{BuildIds: []string{"1.1", "1.2"}, Id: "1.0"},
+		{BuildIds: []string{"2.0"}, Id: "set2"},
+	}
+	testCases := []struct {
+		name        string
+		versionSets []*namespacegenpb.WorkerVersionSet
+		buildID     string
+		isPoller    bool
+		output      string
+	}{
+		{"no sets", nil, "1.0", false, ""},
+		{"no sets poller", nil, "1.0", true, ""},
+		{"first of set", versionSets, "1.0", false, "1.0"},
+		{"second of set", versionSets, "1.1", true, "1.0"},
+		{"last set", versionSets, "2.0", false, "2.0"},
+		{"unknown build", versionSets, "3.0", true, common.WorkerBuildIDVersionSetPrefix + "3.0"},
+		{"removed build keeping set id", versionSetsWithIDs, "1.0", true, common.WorkerBuildIDVersionSetPrefix + "1.0"},
+		{"no build task", versionSets, "", false, "2.0"},
+		{"no build poller", versionSets, "", true, ""},
+		{"set id", versionSetsWithIDs, "1.2", true, "1.0"},
+		{"last set id", versionSetsWithIDs, "", false, "set2"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.output, getWorkerVersionSet(tc.versionSets, tc.buildID, tc.isPoller))
+		})
+	}
+}
+
+func TestTaskListPersistenceName(t *testing.T) {
+	id, err := newTaskListID("nsID", "list0", 0)
+	require.NoError(t, err)
+	require.Equal(t, "list0", id.persistenceName())
+	id.versionSet = "1.0"
+	require.Equal(t, "list0"+taskListVersionSetInfix+"1.0", id.persistenceName())
+}
@@ -0,0 +1,199 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/temporalio/temporal/client/frontend"
+	"github.com/temporalio/temporal/common/clock"
+	"github.com/temporalio/temporal/service/history"
+	eventpb "go.temporal.io/temporal-proto/event"
+	executionpb "go.temporal.io/temporal-proto/execution"
+	namespacepb "go.temporal.io/temporal-proto/namespace"
+	"go.temporal.io/temporal-proto/serviceerror"
+	"go.temporal.io/temporal-proto/workflowservice"
+)
+
+const (
+	// ResetTypeFirstDecisionCompleted resets to the first DecisionTaskCompleted event of the workflow
+	ResetTypeFirstDecisionCompleted = "FirstDecisionCompleted"
+	// ResetTypeLastDecisionCompleted resets to the last DecisionTaskCompleted event of the workflow
+	ResetTypeLastDecisionCompleted = "LastDecisionCompleted"
+	// ResetTypeLastContinuedAsNew resets to the last DecisionTaskCompleted event of the run that continued as new into the workflow
+	ResetTypeLastContinuedAsNew = "LastContinuedAsNew"
+	// ResetTypeBadBinary resets to the first DecisionTaskCompleted event completed by a bad binary
+	ResetTypeBadBinary = "BadBinary"
+)
+
+// AllResetTypes is the reset types we supported
+var AllResetTypes = []string{ResetTypeFirstDecisionCompleted, ResetTypeLastDecisionCompleted, ResetTypeLastContinuedAsNew, ResetTypeBadBinary}
+
+// errNoResetPoint is an invalid argument so that the workflow is not retried
+var errNoResetPoint = serviceerror.NewInvalidArgument("no reset point found for the workflow")
+
+func validateResetParams(params ResetParams) error {
+	switch params.ResetType {
+	case ResetTypeBadBinary:
+		if params.BadBinaryChecksum == "" {
+			return fmt.Errorf("must provide bad binary checksum")
+		}
+		return nil
+	case ResetTypeFirstDecisionCompleted, ResetTypeLastDecisionCompleted, ResetTypeLastContinuedAsNew:
+		return nil
+	default:
+		return fmt.Errorf("not supported reset type: %v", params.ResetType)
+	}
+}
+
+func resetWorkflow(
+	ctx context.Context,
+	client frontend.Client,
+	batchParams BatchParams,
+	workflowID string,
+	runID string,
+	requestID string,
+) error {
+	baseRunID, decisionFinishID, err := getResetPoint(ctx, client, batchParams, workflowID, runID)
+	if err != nil {
+		return err
+	}
+	_, err = client.ResetWorkflowExecution(ctx, &workflowservice.ResetWorkflowExecutionRequest{
+		Namespace: batchParams.Namespace,
+		WorkflowExecution: &executionpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      baseRunID,
+		},
+		Reason:                batchParams.Reason,
+		DecisionFinishEventId: decisionFinishID,
+		RequestId:             requestID,
+	})
+	return err
+}
+
+func getResetPoint(
+	ctx context.Context,
+	client frontend.Client,
+	batchParams BatchParams,
+	workflowID string,
+	runID string,
+) (string, int64, error) {
+	namespace := batchParams.Namespace
+	switch batchParams.ResetParams.ResetType {
+	case ResetTypeFirstDecisionCompleted:
+		decisionFinishID, err := getDecisionCompletedID(ctx, client, namespace, workflowID, runID, true)
+		return runID, decisionFinishID, err
+	case ResetTypeLastDecisionCompleted:
+		decisionFinishID, err := getDecisionCompletedID(ctx, client, namespace, workflowID, runID, false)
+		return runID, decisionFinishID, err
+	case ResetTypeLastContinuedAsNew:
+		resp, err := client.GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+			Namespace: namespace,
+			Execution: &executionpb.WorkflowExecution{
+				WorkflowId: workflowID,
+				RunId:      runID,
+			},
+			MaximumPageSize: 1,
+		})
+		if err != nil {
+			return "", 0, err
+		}
+		events := resp.GetHistory().GetEvents()
+		if len(events) == 0 {
+			return "", 0, errNoResetPoint
+		}
+		baseRunID := events[0].GetWorkflowExecutionStartedEventAttributes().GetContinuedExecutionRunId()
+		if baseRunID == "" {
+			return "", 0, errNoResetPoint
+		}
+		decisionFinishID, err := getDecisionCompletedID(ctx, client, namespace, workflowID, baseRunID, false)
+		return baseRunID, decisionFinishID, err
+	case ResetTypeBadBinary:
+		resp, err := client.DescribeWorkflowExecution(ctx, &workflowservice.DescribeWorkflowExecutionRequest{
+			Namespace: namespace,
+			Execution: &executionpb.WorkflowExecution{
+				WorkflowId: workflowID,
+				RunId:      runID,
+			},
+		})
+		if err != nil {
+			return "", 0, err
+		}
+		_, p := history.FindAutoResetPoint(clock.NewRealTimeSource(), &namespacepb.BadBinaries{
+			Binaries: map[string]*namespacepb.BadBinaryInfo{
+				batchParams.ResetParams.BadBinaryChecksum: {},
+			},
+		}, resp.GetWorkflowExecutionInfo().GetAutoResetPoints())
+		if p == nil {
+			return "", 0, errNoResetPoint
+		}
+		return runID, p.GetFirstDecisionCompletedId(), nil
+	default:
+		return "", 0, fmt.Errorf("not supported reset type: %v", batchParams.ResetParams.ResetType)
+	}
+}
+
+func getDecisionCompletedID(
+	ctx context.Context,
+	client frontend.Client,
+	namespace string,
+	workflowID string,
+	runID string,
+	first bool,
+) (int64, error) {
+	req := &workflowservice.GetWorkflowExecutionHistoryRequest{
+		Namespace: namespace,
+		Execution: &executionpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      runID,
+		},
+		MaximumPageSize: int32(pageSize),
+	}
+
+	var decisionFinishID int64
+	for {
+		resp, err := client.GetWorkflowExecutionHistory(ctx, req)
+		if err != nil {
+			return 0, err
+		}
+		for _, e := range resp.GetHistory().GetEvents() {
+			if e.GetEventType() == eventpb.EventType_DecisionTaskCompleted {
+				decisionFinishID = e.GetEventId()
+				if first {
+					return decisionFinishID, nil
+				}
+			}
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		req.NextPageToken = resp.NextPageToken
+	}
+	if decisionFinishID == 0 {
+		return 0, errNoResetPoint
+	}
+	return decisionFinishID, nil
+}
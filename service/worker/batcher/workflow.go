@@ -30,12 +30,15 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/temporalio/temporal/.gen/proto/adminservice"
+	"github.com/temporalio/temporal/client/admin"
 	"github.com/temporalio/temporal/client/frontend"
 	"github.com/temporalio/temporal/common/convert"
 	"github.com/temporalio/temporal/common/log"
 	"github.com/temporalio/temporal/common/log/tag"
 	"github.com/temporalio/temporal/common/metrics"
 	"go.temporal.io/temporal"
+	commonpb "go.temporal.io/temporal-proto/common"
 	executionpb "go.temporal.io/temporal-proto/execution"
 	"go.temporal.io/temporal-proto/serviceerror"
 	"go.temporal.io/temporal-proto/workflowservice"
@@ -72,10 +75,34 @@ const (
 	BatchTypeCancel = "cancel"
 	// BatchTypeSignal is batch type for signaling workflows
 	BatchTypeSignal = "signal"
+	// BatchTypePause is batch type for pausing workflows
+	BatchTypePause = "pause"
+	// BatchTypeUnpause is batch type for unpausing workflows
+	BatchTypeUnpause = "unpause"
+	// BatchTypeReset is batch type for resetting workflows
+	BatchTypeReset = "reset"
+	// BatchTypeDelete is batch type for deleting workflows, running workflows are terminated first
+	BatchTypeDelete = "delete"
+	// BatchTypeUpsertAttributes is batch type for upserting search attributes and memo of workflows
+	BatchTypeUpsertAttributes = "upsert_attributes"
 )
 
 // AllBatchTypes is the batch types we supported
-var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal}
+var AllBatchTypes = []string{BatchTypeTerminate, BatchTypeCancel, BatchTypeSignal, BatchTypePause, BatchTypeUnpause,
+	BatchTypeReset, BatchTypeDelete, BatchTypeUpsertAttributes}
+
+const (
+	// NamespaceSearchAttribute is the search attribute of a batch job recording its target namespace
+	NamespaceSearchAttribute = "CustomNamespace"
+	// OperatorSearchAttribute is the search attribute of a batch job recording who started it
+	OperatorSearchAttribute = "Operator"
+	// ReasonMemo is the memo of a batch job recording its reason
+	ReasonMemo = "Reason"
+	// BatchTypeMemo is the memo of a batch job recording its batch type
+	BatchTypeMemo = "BatchType"
+	// QueryMemo is the memo of a batch job recording its query
+	QueryMemo = "Query"
+)
 
 type (
 	// TerminateParams is the parameters for terminating workflow
@@ -100,6 +127,22 @@ type (
 		Input      string
 	}
 
+	// ResetParams is the parameters for resetting workflow
+	ResetParams struct {
+		// ResetType is one of AllResetTypes and decides the reset point of each workflow
+		ResetType string
+		// BadBinaryChecksum is required only for ResetTypeBadBinary
+		BadBinaryChecksum string
+	}
+
+	// UpsertAttributesParams is the parameters for upserting search attributes and memo of workflow
+	UpsertAttributesParams struct {
+		// SearchAttributes are json encoded search attribute values keyed by name
+		SearchAttributes map[string]string
+		// Memo are json encoded memo values keyed by name
+		Memo map[string]string
+	}
+
 	// BatchParams is the parameters for batch operation workflow
 	BatchParams struct {
 		// Target namespace to execute batch operation
@@ -118,6 +161,10 @@ type (
 		CancelParams CancelParams
 		// SignalParams is params only for BatchTypeSignal
 		SignalParams SignalParams
+		// ResetParams is params only for BatchTypeReset
+		ResetParams ResetParams
+		// UpsertAttributesParams is params only for BatchTypeUpsertAttributes
+		UpsertAttributesParams UpsertAttributesParams
 		// RPS of processing. Default to DefaultRPS
 		// TODO we will implement smarter way than this static rate limiter: https://github.com/temporalio/temporal/issues/2138
 		RPS int
@@ -195,9 +242,14 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide signal name")
 		}
 		return nil
-	case BatchTypeCancel:
-		fallthrough
-	case BatchTypeTerminate:
+	case BatchTypeReset:
+		return validateResetParams(params.ResetParams)
+	case BatchTypeUpsertAttributes:
+		if len(params.UpsertAttributesParams.SearchAttributes) == 0 && len(params.UpsertAttributesParams.Memo) == 0 {
+			return fmt.Errorf("must provide search attributes or memo")
+		}
+		return nil
+	case BatchTypeCancel, BatchTypeTerminate, BatchTypePause, BatchTypeUnpause, BatchTypeDelete:
 		return nil
 	default:
 		return fmt.Errorf("not supported batch type: %v", params.BatchType)
@@ -233,6 +285,7 @@ func setDefaultParams(params BatchParams) BatchParams {
 func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetails, error) {
 	batcher := ctx.Value(batcherContextKey).(*Batcher)
 	client := batcher.clientBean.GetFrontendClient()
+	adminClient := batcher.clientBean.GetRemoteAdminClient(batcher.cfg.ClusterMetadata.GetCurrentClusterName())
 
 	hbd := HeartBeatDetails{}
 	startOver := true
@@ -260,7 +313,7 @@ func BatchActivity(ctx context.Context, batchParams BatchParams) (HeartBeatDetai
 	taskCh := make(chan taskDetail, pageSize)
 	respCh := make(chan error, pageSize)
 	for i := 0; i < batchParams.Concurrency; i++ {
-		go startTaskProcessor(ctx, batchParams, taskCh, respCh, rateLimiter, client)
+		go startTaskProcessor(ctx, batchParams, taskCh, respCh, rateLimiter, client, adminClient)
 	}
 
 	for {
@@ -331,6 +384,7 @@ func startTaskProcessor(
 	respCh chan error,
 	limiter *rate.Limiter,
 	client frontend.Client,
+	adminClient admin.Client,
 ) {
 	batcher := ctx.Value(batcherContextKey).(*Batcher)
 	for {
@@ -391,13 +445,77 @@ func startTaskProcessor(
 						})
 						return err
 					})
+			case BatchTypePause:
+				err = processTask(ctx, limiter, task, batchParams, client, convert.BoolPtr(false),
+					func(workflowID, runID string) error {
+						_, err := adminClient.PauseWorkflowExecution(ctx, &adminservice.PauseWorkflowExecutionRequest{
+							Namespace: batchParams.Namespace,
+							Execution: &executionpb.WorkflowExecution{
+								WorkflowId: workflowID,
+								RunId:      runID,
+							},
+							Reason:   batchParams.Reason,
+							Identity: BatchWFTypeName,
+						})
+						return err
+					})
+			case BatchTypeUnpause:
+				err = processTask(ctx, limiter, task, batchParams, client, convert.BoolPtr(false),
+					func(workflowID, runID string) error {
+						_, err := adminClient.UnpauseWorkflowExecution(ctx, &adminservice.UnpauseWorkflowExecutionRequest{
+							Namespace: batchParams.Namespace,
+							Execution: &executionpb.WorkflowExecution{
+								WorkflowId: workflowID,
+								RunId:      runID,
+							},
+							Reason:   batchParams.Reason,
+							Identity: BatchWFTypeName,
+						})
+						return err
+					})
+			case BatchTypeReset:
+				err = processTask(ctx, limiter, task, batchParams, client, convert.BoolPtr(false),
+					func(workflowID, runID string) error {
+						return resetWorkflow(ctx, client, batchParams, workflowID, runID, requestID)
+					})
+			case BatchTypeDelete:
+				err = processTask(ctx, limiter, task, batchParams, client, convert.BoolPtr(false),
+					func(workflowID, runID string) error {
+						_, err := adminClient.DeleteWorkflowExecution(ctx, &adminservice.DeleteWorkflowExecutionRequest{
+							Namespace: batchParams.Namespace,
+							Execution: &executionpb.WorkflowExecution{
+								WorkflowId: workflowID,
+								RunId:      runID,
+							},
+							Reason:   batchParams.Reason,
+							Identity: BatchWFTypeName,
+						})
+						return err
+					})
+			case BatchTypeUpsertAttributes:
+				err = processTask(ctx, limiter, task, batchParams, client, convert.BoolPtr(false),
+					func(workflowID, runID string) error {
+						_, err := adminClient.UpsertWorkflowAttributes(ctx, &adminservice.UpsertWorkflowAttributesRequest{
+							Namespace: batchParams.Namespace,
+							Execution: &executionpb.WorkflowExecution{
+								WorkflowId: workflowID,
+								RunId:      runID,
+							},
+							SearchAttributes: toSearchAttributes(batchParams.UpsertAttributesParams.SearchAttributes),
+							Memo:             toMemo(batchParams.UpsertAttributesParams.Memo),
+							Identity:         BatchWFTypeName,
+						})
+						return err
+					})
 			}
 			if err != nil {
 				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
 				getActivityLogger(ctx).Error("Failed to process batch operation task", tag.Error(err))
 
 				_, ok := batchParams._nonRetryableErrors[err.Error()]
-				if ok || task.attempts >= batchParams.AttemptsOnRetryableError {
+				// retrying an invalid argument, e.g. resetting a workflow without reset point, never succeeds
+				_, invalidArgument := err.(*serviceerror.InvalidArgument)
+				if ok || invalidArgument || task.attempts >= batchParams.AttemptsOnRetryableError {
 					respCh <- err
 				} else {
 					// put back to the channel if less than attemptsOnError
@@ -470,6 +588,28 @@ func processTask(
 	return nil
 }
 
+func toSearchAttributes(fields map[string]string) *commonpb.SearchAttributes {
+	if len(fields) == 0 {
+		return nil
+	}
+	indexedFields := make(map[string][]byte, len(fields))
+	for k, v := range fields {
+		indexedFields[k] = []byte(v)
+	}
+	return &commonpb.SearchAttributes{IndexedFields: indexedFields}
+}
+
+func toMemo(fields map[string]string) *commonpb.Memo {
+	if len(fields) == 0 {
+		return nil
+	}
+	memoFields := make(map[string][]byte, len(fields))
+	for k, v := range fields {
+		memoFields[k] = []byte(v)
+	}
+	return &commonpb.Memo{Fields: memoFields}
+}
+
 func isDone(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
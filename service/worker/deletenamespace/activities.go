@@ -0,0 +1,383 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	"context"
+	"math"
+	"time"
+
+	executionpb "go.temporal.io/temporal-proto/execution"
+	namespacepb "go.temporal.io/temporal-proto/namespace"
+	"go.temporal.io/temporal-proto/serviceerror"
+	"go.temporal.io/temporal-proto/workflowservice"
+	"go.temporal.io/temporal/activity"
+	"golang.org/x/time/rate"
+
+	"github.com/temporalio/temporal/.gen/proto/adminservice"
+	"github.com/temporalio/temporal/.gen/proto/historyservice"
+	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
+	"github.com/temporalio/temporal/common/log"
+	"github.com/temporalio/temporal/common/log/tag"
+	"github.com/temporalio/temporal/common/persistence"
+	"github.com/temporalio/temporal/common/primitives"
+	"github.com/temporalio/temporal/common/service/config"
+)
+
+const (
+	pageSize      = 1000
+	taskBatchSize = 1000
+	// latestStartTime makes the listing of visibility records include every workflow execution
+	latestStartTime = math.MaxInt64
+)
+
+var (
+	// passInterval is the wait between passes over the visibility records, which are updated asynchronously
+	passInterval = 10 * time.Second
+	// openExecutionsCheckInterval is the interval of checking whether the open workflow executions are closed
+	openExecutionsCheckInterval = time.Minute
+)
+
+type (
+	// HeartbeatDetails is the heartbeat details of the activities, it lets a retried activity resume where it stopped
+	HeartbeatDetails struct {
+		Progress Progress
+		// PageToken is the page of visibility records or task lists the activity is at
+		PageToken []byte
+		// Pass is the number of passes over the visibility records
+		Pass int
+		// PassCount is the number of workflow executions processed in the current pass
+		PassCount int64
+	}
+
+	listExecutionsFn func(pageToken []byte) (*persistence.ListWorkflowExecutionsResponse, error)
+	// executionOperationFn returns false if there was nothing to do for the workflow execution
+	executionOperationFn func(execution *executionpb.WorkflowExecution, pass int) (bool, error)
+)
+
+// MarkNamespaceDeletedActivity sets the status of the namespace to deleted, which stops new workflow executions
+func MarkNamespaceDeletedActivity(ctx context.Context, params Params) error {
+	dc := ctx.Value(deleterContextKey).(deleterContext)
+	metadataMgr := dc.GetMetadataManager()
+
+	// must get the metadata (notificationVersion) first
+	// this version can be regarded as the lock on the v2 namespace table
+	metadata, err := metadataMgr.GetMetadata()
+	if err != nil {
+		return err
+	}
+	resp, err := metadataMgr.GetNamespace(&persistence.GetNamespaceRequest{Name: params.Namespace})
+	if err != nil {
+		return err
+	}
+	if resp.Namespace.Info.Status == namespacepb.NamespaceStatus_Deleted {
+		return nil
+	}
+
+	resp.Namespace.Info.Status = namespacepb.NamespaceStatus_Deleted
+	return metadataMgr.UpdateNamespace(&persistence.UpdateNamespaceRequest{
+		Namespace: &persistenceblobs.NamespaceDetail{
+			Info:                        resp.Namespace.Info,
+			Config:                      resp.Namespace.Config,
+			ReplicationConfig:           resp.Namespace.ReplicationConfig,
+			ConfigVersion:               resp.Namespace.ConfigVersion + 1,
+			FailoverVersion:             resp.Namespace.FailoverVersion,
+			FailoverNotificationVersion: resp.Namespace.FailoverNotificationVersion,
+		},
+		NotificationVersion: metadata.NotificationVersion,
+	})
+}
+
+// TerminateExecutionsActivity terminates the open workflow executions of the namespace
+func TerminateExecutionsActivity(ctx context.Context, params Params, progress Progress) (Progress, error) {
+	dc := ctx.Value(deleterContextKey).(deleterContext)
+	hbd := getHeartbeatDetails(ctx, progress)
+
+	err := processExecutions(ctx, params, &hbd,
+		func(pageToken []byte) (*persistence.ListWorkflowExecutionsResponse, error) {
+			return dc.GetVisibilityManager().ListOpenWorkflowExecutions(newListRequest(params, pageSize, pageToken))
+		},
+		func(execution *executionpb.WorkflowExecution, _ int) (bool, error) {
+			_, err := dc.GetHistoryClient().TerminateWorkflowExecution(ctx, &historyservice.TerminateWorkflowExecutionRequest{
+				NamespaceId: params.NamespaceID,
+				TerminateRequest: &workflowservice.TerminateWorkflowExecutionRequest{
+					Namespace:         params.Namespace,
+					WorkflowExecution: execution,
+					Reason:            params.Reason,
+					Identity:          params.Identity,
+				},
+			})
+			switch err.(type) {
+			case nil:
+				hbd.Progress.TerminatedExecutionCount++
+				return true, nil
+			case *serviceerror.NotFound:
+				// the workflow execution is closed, its visibility record is not updated yet
+				return false, nil
+			default:
+				return false, err
+			}
+		},
+	)
+	return hbd.Progress, err
+}
+
+// WaitExecutionsActivity waits for the open workflow executions of the namespace to close
+func WaitExecutionsActivity(ctx context.Context, params Params) error {
+	dc := ctx.Value(deleterContextKey).(deleterContext)
+
+	for {
+		resp, err := dc.GetVisibilityManager().ListOpenWorkflowExecutions(newListRequest(params, 1, nil))
+		if err != nil {
+			return err
+		}
+		if len(resp.Executions) == 0 {
+			return nil
+		}
+
+		activity.RecordHeartbeat(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(openExecutionsCheckInterval):
+		}
+	}
+}
+
+// DeleteExecutionsActivity deletes the closed workflow executions of the namespace along with their history and visibility records
+func DeleteExecutionsActivity(ctx context.Context, params Params, progress Progress) (Progress, error) {
+	dc := ctx.Value(deleterContextKey).(deleterContext)
+	hbd := getHeartbeatDetails(ctx, progress)
+
+	err := processExecutions(ctx, params, &hbd,
+		func(pageToken []byte) (*persistence.ListWorkflowExecutionsResponse, error) {
+			return dc.GetVisibilityManager().ListClosedWorkflowExecutions(newListRequest(params, pageSize, pageToken))
+		},
+		func(execution *executionpb.WorkflowExecution, pass int) (bool, error) {
+			_, err := dc.GetHistoryClient().DeleteWorkflowExecution(ctx, &historyservice.DeleteWorkflowExecutionRequest{
+				NamespaceId: params.NamespaceID,
+				Request: &adminservice.DeleteWorkflowExecutionRequest{
+					Namespace: params.Namespace,
+					Execution: execution,
+					Reason:    params.Reason,
+					Identity:  params.Identity,
+				},
+			})
+			switch err.(type) {
+			case nil:
+				// the workflow execution is deleted asynchronously, later passes see it again until its visibility record is deleted
+				if pass == 0 {
+					hbd.Progress.DeletedExecutionCount++
+				}
+				return true, nil
+			case *serviceerror.NotFound:
+				// the workflow execution is already deleted, e.g. by retention, only its visibility record is left
+				return false, dc.GetVisibilityManager().DeleteWorkflowExecution(&persistence.VisibilityDeleteWorkflowExecutionRequest{
+					NamespaceID: params.NamespaceID,
+					WorkflowID:  execution.GetWorkflowId(),
+					RunID:       execution.GetRunId(),
+				})
+			default:
+				return false, err
+			}
+		},
+	)
+	return hbd.Progress, err
+}
+
+// DeleteTaskListsActivity deletes the task lists of the namespace along with their tasks
+func DeleteTaskListsActivity(ctx context.Context, params Params, progress Progress) (Progress, error) {
+	dc := ctx.Value(deleterContextKey).(deleterContext)
+	hbd := getHeartbeatDetails(ctx, progress)
+	logger := getActivityLogger(ctx)
+
+	if dc.cfg.Persistence.DefaultStoreType() != config.StoreTypeSQL {
+		logger.Warn("Task lists are not deleted, listing task lists is only supported by sql persistence.")
+		return hbd.Progress, nil
+	}
+
+	taskMgr := dc.GetTaskManager()
+	limiter := rate.NewLimiter(rate.Limit(params.RPS), params.RPS)
+	for {
+		resp, err := taskMgr.ListTaskList(&persistence.ListTaskListRequest{
+			PageSize:  pageSize,
+			PageToken: hbd.PageToken,
+		})
+		if err != nil {
+			return hbd.Progress, err
+		}
+
+		for _, item := range resp.Items {
+			if primitives.UUIDString(item.Data.GetNamespaceId()) != params.NamespaceID {
+				continue
+			}
+			if err := limiter.Wait(ctx); err != nil {
+				return hbd.Progress, err
+			}
+			deleted, err := deleteTaskList(taskMgr, item, logger)
+			if err != nil {
+				return hbd.Progress, err
+			}
+			if deleted {
+				hbd.Progress.DeletedTaskListCount++
+			}
+		}
+
+		hbd.PageToken = resp.NextPageToken
+		activity.RecordHeartbeat(ctx, hbd)
+		if len(hbd.PageToken) == 0 {
+			return hbd.Progress, nil
+		}
+	}
+}
+
+// DeleteNamespaceActivity removes the namespace
+func DeleteNamespaceActivity(ctx context.Context, params Params) error {
+	dc := ctx.Value(deleterContextKey).(deleterContext)
+	return dc.GetMetadataManager().DeleteNamespace(&persistence.DeleteNamespaceRequest{
+		ID: primitives.MustParseUUID(params.NamespaceID),
+	})
+}
+
+// processExecutions applies the operation to the listed workflow executions in rate limited pages.
+// The passes over the executions are repeated until one of them has nothing to do,
+// because the visibility records are updated asynchronously by the operations.
+func processExecutions(
+	ctx context.Context,
+	params Params,
+	hbd *HeartbeatDetails,
+	list listExecutionsFn,
+	operation executionOperationFn,
+) error {
+
+	limiter := rate.NewLimiter(rate.Limit(params.RPS), params.RPS)
+	for {
+		resp, err := list(hbd.PageToken)
+		if err != nil {
+			return err
+		}
+
+		for _, info := range resp.Executions {
+			if err := limiter.Wait(ctx); err != nil {
+				return err
+			}
+			processed, err := operation(info.GetExecution(), hbd.Pass)
+			if err != nil {
+				return err
+			}
+			if processed {
+				hbd.PassCount++
+			}
+		}
+
+		hbd.PageToken = resp.NextPageToken
+		if len(hbd.PageToken) == 0 {
+			if hbd.PassCount == 0 {
+				return nil
+			}
+			hbd.Pass++
+			hbd.PassCount = 0
+		}
+		activity.RecordHeartbeat(ctx, *hbd)
+
+		if len(hbd.PageToken) == 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(passInterval):
+			}
+		}
+	}
+}
+
+func deleteTaskList(
+	taskMgr persistence.TaskManager,
+	item *persistence.PersistedTaskListInfo,
+	logger log.Logger,
+) (bool, error) {
+
+	key := &persistence.TaskListKey{
+		NamespaceID: item.Data.GetNamespaceId(),
+		Name:        item.Data.GetName(),
+		TaskType:    item.Data.GetTaskType(),
+	}
+	for {
+		n, err := taskMgr.CompleteTasksLessThan(&persistence.CompleteTasksLessThanRequest{
+			NamespaceID:  key.NamespaceID,
+			TaskListName: key.Name,
+			TaskType:     key.TaskType,
+			TaskID:       math.MaxInt64,
+			Limit:        taskBatchSize,
+		})
+		if err != nil {
+			return false, err
+		}
+		if n < taskBatchSize {
+			break
+		}
+	}
+
+	// the delete is conditional on the range id, it fails if matching service still owns the task list
+	if err := taskMgr.DeleteTaskList(&persistence.DeleteTaskListRequest{
+		TaskList: key,
+		RangeID:  item.RangeID,
+	}); err != nil {
+		logger.Warn("Failed to delete task list.", tag.WorkflowTaskListName(key.Name), tag.TaskType(key.TaskType), tag.Error(err))
+		return false, nil
+	}
+	return true, nil
+}
+
+func newListRequest(params Params, pageSize int, pageToken []byte) *persistence.ListWorkflowExecutionsRequest {
+	return &persistence.ListWorkflowExecutionsRequest{
+		NamespaceID:       params.NamespaceID,
+		Namespace:         params.Namespace,
+		EarliestStartTime: 0,
+		LatestStartTime:   latestStartTime,
+		PageSize:          pageSize,
+		NextPageToken:     pageToken,
+	}
+}
+
+func getHeartbeatDetails(ctx context.Context, progress Progress) HeartbeatDetails {
+	hbd := HeartbeatDetails{Progress: progress}
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &hbd); err != nil {
+			getActivityLogger(ctx).Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+			hbd = HeartbeatDetails{Progress: progress}
+		}
+	}
+	return hbd
+}
+
+func getActivityLogger(ctx context.Context) log.Logger {
+	dc := ctx.Value(deleterContextKey).(deleterContext)
+	wfInfo := activity.GetInfo(ctx)
+	return dc.logger.WithTags(
+		tag.WorkflowID(wfInfo.WorkflowExecution.ID),
+		tag.WorkflowRunID(wfInfo.WorkflowExecution.RunID),
+		tag.WorkflowNamespace(wfInfo.WorkflowNamespace),
+	)
+}
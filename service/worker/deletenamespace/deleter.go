@@ -0,0 +1,98 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	"context"
+
+	"go.temporal.io/temporal/activity"
+	"go.temporal.io/temporal/worker"
+	"go.temporal.io/temporal/workflow"
+
+	"github.com/temporalio/temporal/common/log"
+	"github.com/temporalio/temporal/common/log/tag"
+	"github.com/temporalio/temporal/common/resource"
+	"github.com/temporalio/temporal/common/service/config"
+)
+
+type (
+	// Config defines the configuration for namespace deleter
+	Config struct {
+		// Persistence contains the persistence configuration
+		Persistence *config.Persistence
+	}
+
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the namespace deleter sub-system
+	BootstrapParams struct {
+		// Config contains the configuration for namespace deleter
+		Config Config
+	}
+
+	// deleterContext is the context object that gets
+	// passed around within the namespace deletion workflow / activities
+	deleterContext struct {
+		resource.Resource
+		cfg    Config
+		logger log.Logger
+	}
+
+	// Deleter is the background sub-system that executes the workflows deleting namespaces
+	Deleter struct {
+		context deleterContext
+	}
+)
+
+// New returns a new instance of namespace deleter
+func New(
+	resource resource.Resource,
+	params *BootstrapParams,
+) *Deleter {
+
+	return &Deleter{
+		context: deleterContext{
+			Resource: resource,
+			cfg:      params.Config,
+			logger:   resource.GetLogger().WithTags(tag.ComponentNamespaceDeleter),
+		},
+	}
+}
+
+// Start starts the worker of the namespace deletion workflow
+func (d *Deleter) Start() error {
+	workerOpts := worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), deleterContextKey, d.context),
+	}
+	deleterWorker := worker.New(d.context.GetSDKClient(), TaskListName, workerOpts)
+	deleterWorker.RegisterWorkflowWithOptions(DeleteNamespaceWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	deleterWorker.RegisterActivityWithOptions(MarkNamespaceDeletedActivity, activity.RegisterOptions{Name: markNamespaceDeletedActivityName})
+	deleterWorker.RegisterActivityWithOptions(TerminateExecutionsActivity, activity.RegisterOptions{Name: terminateExecutionsActivityName})
+	deleterWorker.RegisterActivityWithOptions(WaitExecutionsActivity, activity.RegisterOptions{Name: waitExecutionsActivityName})
+	deleterWorker.RegisterActivityWithOptions(DeleteExecutionsActivity, activity.RegisterOptions{Name: deleteExecutionsActivityName})
+	deleterWorker.RegisterActivityWithOptions(DeleteTaskListsActivity, activity.RegisterOptions{Name: deleteTaskListsActivityName})
+	deleterWorker.RegisterActivityWithOptions(DeleteNamespaceActivity, activity.RegisterOptions{Name: deleteNamespaceActivityName})
+
+	return deleterWorker.Start()
+}
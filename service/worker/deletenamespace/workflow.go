@@ -0,0 +1,186 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	"fmt"
+	"time"
+
+	"go.temporal.io/temporal"
+	"go.temporal.io/temporal/workflow"
+)
+
+type (
+	contextKey int
+)
+
+const (
+	deleterContextKey = contextKey(0)
+
+	// TaskListName is the task list of the namespace deletion workflow
+	TaskListName = "temporal-sys-delete-namespace-tasklist"
+	// WorkflowTypeName is the workflow type of the namespace deletion workflow
+	WorkflowTypeName = "temporal-sys-delete-namespace-workflow"
+	// ProgressQueryType is the query type returning the Progress of a namespace deletion
+	ProgressQueryType = "progress"
+
+	workflowIDPrefix                 = "temporal-sys-delete-namespace-"
+	markNamespaceDeletedActivityName = "temporal-sys-delete-namespace-mark-deleted-activity"
+	terminateExecutionsActivityName  = "temporal-sys-delete-namespace-terminate-executions-activity"
+	waitExecutionsActivityName       = "temporal-sys-delete-namespace-wait-executions-activity"
+	deleteExecutionsActivityName     = "temporal-sys-delete-namespace-delete-executions-activity"
+	deleteTaskListsActivityName      = "temporal-sys-delete-namespace-delete-task-lists-activity"
+	deleteNamespaceActivityName      = "temporal-sys-delete-namespace-delete-namespace-activity"
+
+	// InfiniteDuration is a long duration(20 yrs) we used for infinite workflow running
+	InfiniteDuration = 20 * 365 * 24 * time.Hour
+	// DefaultRPS is the default rate of the operations on workflow executions and task lists
+	DefaultRPS = 50
+)
+
+const (
+	// StageMarkDeleted is the stage marking the namespace as deleted, which stops new workflow executions
+	StageMarkDeleted = "mark_deleted"
+	// StageTerminateExecutions is the stage terminating the open workflow executions
+	StageTerminateExecutions = "terminate_executions"
+	// StageWaitExecutions is the stage waiting for the open workflow executions to close
+	StageWaitExecutions = "wait_executions"
+	// StageDeleteExecutions is the stage deleting the workflow executions along with their history and visibility records
+	StageDeleteExecutions = "delete_executions"
+	// StageDeleteTaskLists is the stage deleting the task lists
+	StageDeleteTaskLists = "delete_task_lists"
+	// StageDeleteNamespace is the stage removing the namespace
+	StageDeleteNamespace = "delete_namespace"
+	// StageDeleted is the stage of a completed namespace deletion
+	StageDeleted = "deleted"
+)
+
+type (
+	// Params is the parameters of the namespace deletion workflow
+	Params struct {
+		Namespace   string
+		NamespaceID string
+		Reason      string
+		Identity    string
+		// Open workflow executions are terminated if set, otherwise the deletion waits for them to close
+		TerminateOpenExecutions bool
+		// RPS of the operations on workflow executions and task lists. Default to DefaultRPS
+		RPS int
+	}
+
+	// Progress is the progress of the namespace deletion workflow
+	Progress struct {
+		Stage                    string
+		TerminatedExecutionCount int64
+		DeletedExecutionCount    int64
+		DeletedTaskListCount     int64
+	}
+)
+
+var (
+	activityRetryPolicy = temporal.RetryPolicy{
+		InitialInterval:    10 * time.Second,
+		BackoffCoefficient: 1.7,
+		MaximumInterval:    5 * time.Minute,
+		ExpirationInterval: InfiniteDuration,
+	}
+	activityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    InfiniteDuration,
+		HeartbeatTimeout:       5 * time.Minute,
+		RetryPolicy:            &activityRetryPolicy,
+	}
+)
+
+// GetWorkflowID returns the id of the workflow deleting the namespace, there is at most one deletion running for a namespace
+func GetWorkflowID(namespace string) string {
+	return workflowIDPrefix + namespace
+}
+
+// DeleteNamespaceWorkflow is the workflow deleting a namespace along with all its workflow executions and task lists.
+// Every stage is idempotent, so a failed deletion is resumed by starting the workflow again.
+func DeleteNamespaceWorkflow(ctx workflow.Context, params Params) (Progress, error) {
+	params = setDefaultParams(params)
+	progress := Progress{}
+	if err := validateParams(params); err != nil {
+		return progress, err
+	}
+	if err := workflow.SetQueryHandler(ctx, ProgressQueryType, func() (Progress, error) {
+		return progress, nil
+	}); err != nil {
+		return progress, err
+	}
+	ctx = workflow.WithActivityOptions(ctx, activityOptions)
+
+	progress.Stage = StageMarkDeleted
+	if err := workflow.ExecuteActivity(ctx, markNamespaceDeletedActivityName, params).Get(ctx, nil); err != nil {
+		return progress, err
+	}
+
+	if params.TerminateOpenExecutions {
+		progress.Stage = StageTerminateExecutions
+		if err := workflow.ExecuteActivity(ctx, terminateExecutionsActivityName, params, progress).Get(ctx, &progress); err != nil {
+			return progress, err
+		}
+	} else {
+		progress.Stage = StageWaitExecutions
+		if err := workflow.ExecuteActivity(ctx, waitExecutionsActivityName, params).Get(ctx, nil); err != nil {
+			return progress, err
+		}
+	}
+
+	progress.Stage = StageDeleteExecutions
+	if err := workflow.ExecuteActivity(ctx, deleteExecutionsActivityName, params, progress).Get(ctx, &progress); err != nil {
+		return progress, err
+	}
+
+	progress.Stage = StageDeleteTaskLists
+	if err := workflow.ExecuteActivity(ctx, deleteTaskListsActivityName, params, progress).Get(ctx, &progress); err != nil {
+		return progress, err
+	}
+
+	progress.Stage = StageDeleteNamespace
+	if err := workflow.ExecuteActivity(ctx, deleteNamespaceActivityName, params).Get(ctx, nil); err != nil {
+		return progress, err
+	}
+
+	progress.Stage = StageDeleted
+	workflow.GetLogger(ctx).Info("Namespace deleted.")
+	return progress, nil
+}
+
+func validateParams(params Params) error {
+	if params.Namespace == "" || params.NamespaceID == "" {
+		return fmt.Errorf("must provide required parameters: Namespace/NamespaceID")
+	}
+	return nil
+}
+
+func setDefaultParams(params Params) Params {
+	if params.RPS <= 0 {
+		params.RPS = DefaultRPS
+	}
+	return params
+}
@@ -0,0 +1,121 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package deletenamespace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/temporal/activity"
+	"go.temporal.io/temporal/testsuite"
+	"go.temporal.io/temporal/workflow"
+)
+
+type workflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+}
+
+func TestWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(workflowTestSuite))
+}
+
+func (s *workflowTestSuite) newTestWorkflowEnvironment() *testsuite.TestWorkflowEnvironment {
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(DeleteNamespaceWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	env.RegisterActivityWithOptions(MarkNamespaceDeletedActivity, activity.RegisterOptions{Name: markNamespaceDeletedActivityName})
+	env.RegisterActivityWithOptions(TerminateExecutionsActivity, activity.RegisterOptions{Name: terminateExecutionsActivityName})
+	env.RegisterActivityWithOptions(WaitExecutionsActivity, activity.RegisterOptions{Name: waitExecutionsActivityName})
+	env.RegisterActivityWithOptions(DeleteExecutionsActivity, activity.RegisterOptions{Name: deleteExecutionsActivityName})
+	env.RegisterActivityWithOptions(DeleteTaskListsActivity, activity.RegisterOptions{Name: deleteTaskListsActivityName})
+	env.RegisterActivityWithOptions(DeleteNamespaceActivity, activity.RegisterOptions{Name: deleteNamespaceActivityName})
+	return env
+}
+
+func (s *workflowTestSuite) TestWorkflow_TerminateOpenExecutions() {
+	env := s.newTestWorkflowEnvironment()
+	params := Params{
+		Namespace:               "test-namespace",
+		NamespaceID:             "deadd0d0-c001-face-d00d-000000000000",
+		TerminateOpenExecutions: true,
+	}
+	env.OnActivity(markNamespaceDeletedActivityName, mock.Anything, mock.Anything).Return(nil).Once()
+	env.OnActivity(terminateExecutionsActivityName, mock.Anything, mock.Anything, mock.Anything).Return(Progress{
+		Stage:                    StageTerminateExecutions,
+		TerminatedExecutionCount: 2,
+	}, nil).Once()
+	env.OnActivity(deleteExecutionsActivityName, mock.Anything, mock.Anything, mock.Anything).Return(Progress{
+		Stage:                    StageDeleteExecutions,
+		TerminatedExecutionCount: 2,
+		DeletedExecutionCount:    5,
+	}, nil).Once()
+	env.OnActivity(deleteTaskListsActivityName, mock.Anything, mock.Anything, mock.Anything).Return(Progress{
+		Stage:                    StageDeleteTaskLists,
+		TerminatedExecutionCount: 2,
+		DeletedExecutionCount:    5,
+		DeletedTaskListCount:     3,
+	}, nil).Once()
+	env.OnActivity(deleteNamespaceActivityName, mock.Anything, mock.Anything).Return(nil).Once()
+
+	env.ExecuteWorkflow(WorkflowTypeName, params)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var progress Progress
+	s.NoError(env.GetWorkflowResult(&progress))
+	s.Equal(Progress{
+		Stage:                    StageDeleted,
+		TerminatedExecutionCount: 2,
+		DeletedExecutionCount:    5,
+		DeletedTaskListCount:     3,
+	}, progress)
+	env.AssertExpectations(s.T())
+}
+
+func (s *workflowTestSuite) TestWorkflow_WaitOpenExecutions() {
+	env := s.newTestWorkflowEnvironment()
+	params := Params{
+		Namespace:   "test-namespace",
+		NamespaceID: "deadd0d0-c001-face-d00d-000000000000",
+	}
+	env.OnActivity(markNamespaceDeletedActivityName, mock.Anything, mock.Anything).Return(nil).Once()
+	env.OnActivity(waitExecutionsActivityName, mock.Anything, mock.Anything).Return(nil).Once()
+	env.OnActivity(deleteExecutionsActivityName, mock.Anything, mock.Anything, mock.Anything).Return(Progress{Stage: StageDeleteExecutions}, nil).Once()
+	env.OnActivity(deleteTaskListsActivityName, mock.Anything, mock.Anything, mock.Anything).Return(Progress{Stage: StageDeleteTaskLists}, nil).Once()
+	env.OnActivity(deleteNamespaceActivityName, mock.Anything, mock.Anything).Return(nil).Once()
+
+	env.ExecuteWorkflow(WorkflowTypeName, params)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	env.AssertExpectations(s.T())
+}
+
+func (s *workflowTestSuite) TestWorkflow_InvalidParams() {
+	env := s.newTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(WorkflowTypeName, Params{Namespace: "test-namespace"})
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+}
@@ -0,0 +1,704 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"hash/crc32"
+	"math"
+
+	"go.temporal.io/temporal"
+	commonpb "go.temporal.io/temporal-proto/common"
+	eventpb "go.temporal.io/temporal-proto/event"
+	executionpb "go.temporal.io/temporal-proto/execution"
+	filterpb "go.temporal.io/temporal-proto/filter"
+	"go.temporal.io/temporal-proto/serviceerror"
+	"go.temporal.io/temporal-proto/workflowservice"
+	"go.temporal.io/temporal/activity"
+	"golang.org/x/time/rate"
+
+	"github.com/temporalio/temporal/.gen/proto/adminservice"
+	eventgenpb "github.com/temporalio/temporal/.gen/proto/event"
+	"github.com/temporalio/temporal/.gen/proto/historyservice"
+	"github.com/temporalio/temporal/client/admin"
+	"github.com/temporalio/temporal/client/frontend"
+	"github.com/temporalio/temporal/common"
+	"github.com/temporalio/temporal/common/headers"
+	"github.com/temporalio/temporal/common/log"
+	"github.com/temporalio/temporal/common/log/tag"
+	"github.com/temporalio/temporal/common/persistence"
+)
+
+const (
+	listPageSize    = 100
+	historyPageSize = 100
+
+	pauseReason    = "temporal-sys-migration: workflow execution is being migrated"
+	rollbackReason = "temporal-sys-migration: migration rolled back"
+)
+
+var (
+	errHistoryMismatch = errors.New("history of the workflow execution in the target namespace does not match the source")
+	errVersionTooHigh  = errors.New("source history has a higher version than the failover version of the target namespace")
+	errNoHistoryEvents = errors.New("no history events in the source workflow execution")
+)
+
+type (
+	// executionMigrator moves a workflow execution from the source namespace to the target namespace.
+	// Every step is idempotent, so a failed migration is resumed by retrying it.
+	executionMigrator struct {
+		mc             migratorContext
+		params         Params
+		execution      *executionpb.WorkflowExecution
+		sourceAdmin    admin.Client
+		sourceFrontend frontend.Client
+		targetAdmin    admin.Client
+		limiter        *rate.Limiter
+		logger         log.Logger
+	}
+
+	// historySummary is the checksum of the events of a history, along with the activity events used to copy
+	// the state of the pending activities
+	historySummary struct {
+		checksum       uint32
+		lastEventID    int64
+		versionHistory *eventgenpb.VersionHistory
+		// scheduledEvents is keyed by activity id, startedEventIDs by scheduled event id
+		scheduledEvents map[string]*eventpb.HistoryEvent
+		startedEventIDs map[int64]int64
+	}
+
+	// historyBatchFn returns false to stop reading the history
+	historyBatchFn func(batch *commonpb.DataBlob, versionHistory *eventgenpb.VersionHistory) (bool, error)
+)
+
+// ListExecutionsActivity returns a page of the workflow executions of the source namespace to migrate
+func ListExecutionsActivity(ctx context.Context, params Params, pageToken []byte) (ExecutionsPage, error) {
+	mc := ctx.Value(migratorContextKey).(migratorContext)
+	client := mc.GetClientBean().GetRemoteFrontendClient(params.SourceCluster)
+	ctx = headers.SetVersions(ctx)
+
+	page := ExecutionsPage{}
+	var infos []*executionpb.WorkflowExecutionInfo
+	if params.Query == "" {
+		resp, err := client.ListOpenWorkflowExecutions(ctx, &workflowservice.ListOpenWorkflowExecutionsRequest{
+			Namespace:       params.SourceNamespace,
+			MaximumPageSize: listPageSize,
+			NextPageToken:   pageToken,
+			StartTimeFilter: &filterpb.StartTimeFilter{
+				EarliestTime: 0,
+				LatestTime:   math.MaxInt64,
+			},
+		})
+		if err != nil {
+			return page, err
+		}
+		infos, page.NextPageToken = resp.GetExecutions(), resp.GetNextPageToken()
+	} else {
+		resp, err := client.ListWorkflowExecutions(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace:     params.SourceNamespace,
+			PageSize:      listPageSize,
+			NextPageToken: pageToken,
+			Query:         params.Query,
+		})
+		if err != nil {
+			return page, err
+		}
+		infos, page.NextPageToken = resp.GetExecutions(), resp.GetNextPageToken()
+	}
+
+	for _, info := range infos {
+		page.Executions = append(page.Executions, Execution{
+			WorkflowID: info.GetExecution().GetWorkflowId(),
+			RunID:      info.GetExecution().GetRunId(),
+		})
+	}
+	return page, nil
+}
+
+// MigrateExecutionActivity moves a workflow execution to the target namespace and returns the outcome.
+// The source is paused while its history is copied and verified, then it is terminated with a Redirect
+// to the target, which is unpaused once it has all the events before the termination.
+func MigrateExecutionActivity(ctx context.Context, params Params, execution Execution) (string, error) {
+	m := newExecutionMigrator(ctx, params, execution)
+	outcome, err := m.migrate(headers.SetVersions(ctx))
+	if err != nil {
+		return "", toActivityError(err)
+	}
+	return outcome, nil
+}
+
+// RollbackExecutionActivity undoes the failed migration of a workflow execution, the partial copy in the
+// target namespace is deleted and the source is unpaused. A migration cannot be rolled back once the source
+// is terminated, the copy is left paused in the target namespace for the operator then.
+func RollbackExecutionActivity(ctx context.Context, params Params, execution Execution) error {
+	m := newExecutionMigrator(ctx, params, execution)
+	ctx = headers.SetVersions(ctx)
+
+	resp, err := m.describeSource(ctx)
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		if m.execution.GetRunId() == "" {
+			return nil
+		}
+		return m.deleteTargetCopy(ctx)
+	default:
+		return err
+	}
+	m.execution.RunId = resp.GetWorkflowExecutionInfo().GetExecution().GetRunId()
+
+	if resp.GetWorkflowExecutionInfo().GetStatus() != executionpb.WorkflowExecutionStatus_Running {
+		_, migrated, err := m.getSourceRedirect(ctx)
+		if err != nil {
+			return err
+		}
+		if migrated {
+			m.logger.Error("Migration cannot be rolled back, the source workflow execution is terminated. The workflow execution is left paused in the target namespace.")
+			return nil
+		}
+		return m.deleteTargetCopy(ctx)
+	}
+
+	if err := m.deleteTargetCopy(ctx); err != nil {
+		return err
+	}
+	return m.unpauseSource(ctx)
+}
+
+func newExecutionMigrator(ctx context.Context, params Params, execution Execution) *executionMigrator {
+	mc := ctx.Value(migratorContextKey).(migratorContext)
+	clientBean := mc.GetClientBean()
+	return &executionMigrator{
+		mc:     mc,
+		params: params,
+		execution: &executionpb.WorkflowExecution{
+			WorkflowId: execution.WorkflowID,
+			RunId:      execution.RunID,
+		},
+		sourceAdmin:    clientBean.GetRemoteAdminClient(params.SourceCluster),
+		sourceFrontend: clientBean.GetRemoteFrontendClient(params.SourceCluster),
+		targetAdmin:    clientBean.GetRemoteAdminClient(mc.GetClusterMetadata().GetCurrentClusterName()),
+		limiter:        rate.NewLimiter(rate.Limit(params.RPS), params.RPS),
+		logger: mc.logger.WithTags(
+			tag.WorkflowID(execution.WorkflowID),
+			tag.WorkflowRunID(execution.RunID),
+		),
+	}
+}
+
+func (m *executionMigrator) migrate(ctx context.Context) (string, error) {
+	resp, err := m.describeSource(ctx)
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		return OutcomeSkipped, nil
+	default:
+		return "", err
+	}
+	m.execution.RunId = resp.GetWorkflowExecutionInfo().GetExecution().GetRunId()
+
+	firstEventVersion, err := m.getFirstEventVersion(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.GetWorkflowExecutionInfo().GetStatus() != executionpb.WorkflowExecutionStatus_Running {
+		closeEventID, migrated, err := m.getSourceRedirect(ctx)
+		if err != nil {
+			return "", err
+		}
+		if !migrated {
+			// the source closed on its own, a partial copy left by an earlier attempt is removed
+			return OutcomeSkipped, m.deleteTargetCopy(ctx)
+		}
+		// the source was terminated by an earlier attempt, which is completed
+		return OutcomeMigrated, m.complete(ctx, firstEventVersion, closeEventID)
+	}
+
+	if err := m.pauseSource(ctx); err != nil {
+		return "", err
+	}
+	if err := m.copyHistory(ctx, firstEventVersion, common.EmptyEventID); err != nil {
+		return "", err
+	}
+	summary, err := m.verifyHistory(ctx, firstEventVersion, common.EmptyEventID)
+	if err != nil {
+		return "", err
+	}
+	if err := m.syncActivities(ctx, summary); err != nil {
+		return "", err
+	}
+
+	// the termination flips the ownership, from then on the source rejects any update
+	if err := m.terminateSource(ctx); err != nil {
+		return "", err
+	}
+	closeEventID, migrated, err := m.getSourceRedirect(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !migrated {
+		return OutcomeSkipped, m.deleteTargetCopy(ctx)
+	}
+	return OutcomeMigrated, m.complete(ctx, firstEventVersion, closeEventID)
+}
+
+// complete copies the events the source got while it was paused, which precede its termination,
+// and unpauses the workflow execution in the target namespace
+func (m *executionMigrator) complete(ctx context.Context, firstEventVersion int64, closeEventID int64) error {
+	if err := m.copyHistory(ctx, firstEventVersion, closeEventID); err != nil {
+		return err
+	}
+	if _, err := m.verifyHistory(ctx, firstEventVersion, closeEventID-1); err != nil {
+		return err
+	}
+	if err := m.unpauseTarget(ctx); err != nil {
+		return err
+	}
+
+	m.logger.Info("Workflow execution migrated.",
+		tag.SourceCluster(m.params.SourceCluster),
+		tag.WorkflowNamespace(m.params.TargetNamespace),
+	)
+	return nil
+}
+
+// copyHistory replicates the events of the source missing in the target namespace, up to endEventID exclusively
+// if it is set. The workflow execution is created paused in the target namespace.
+func (m *executionMigrator) copyHistory(ctx context.Context, firstEventVersion int64, endEventID int64) error {
+	startEventID, startEventVersion := common.FirstEventID-1, firstEventVersion
+	resp, err := m.mc.GetHistoryClient().GetMutableState(ctx, &historyservice.GetMutableStateRequest{
+		NamespaceId: m.params.TargetNamespaceID,
+		Execution:   m.execution,
+	})
+	switch err.(type) {
+	case nil:
+		versionHistory, err := persistence.NewVersionHistoriesFromProto(resp.GetVersionHistories()).GetCurrentVersionHistory()
+		if err != nil {
+			return err
+		}
+		lastItem, err := versionHistory.GetLastItem()
+		if err != nil {
+			return err
+		}
+		startEventID, startEventVersion = lastItem.GetEventID(), lastItem.GetVersion()
+	case *serviceerror.NotFound:
+	default:
+		return err
+	}
+	if endEventID != common.EmptyEventID && startEventID >= endEventID-1 {
+		return nil
+	}
+
+	targetNamespace, err := m.mc.GetNamespaceCache().GetNamespaceByID(m.params.TargetNamespaceID)
+	if err != nil {
+		return err
+	}
+	return m.readHistory(ctx, m.sourceAdmin, m.params.SourceNamespace, startEventID, startEventVersion,
+		func(batch *commonpb.DataBlob, versionHistory *eventgenpb.VersionHistory) (bool, error) {
+			lastItem, err := persistence.NewVersionHistoryFromProto(versionHistory).GetLastItem()
+			if err != nil {
+				return false, err
+			}
+			// events of the target namespace are written with its failover version, which must not go back
+			if lastItem.GetVersion() > targetNamespace.GetFailoverVersion() {
+				return false, errVersionTooHigh
+			}
+
+			more := true
+			if endEventID != common.EmptyEventID {
+				if batch, more, err = m.truncateBatch(batch, endEventID); err != nil || batch == nil {
+					return false, err
+				}
+			}
+			if _, err := m.mc.GetHistoryClient().ReplicateEventsV2(ctx, &historyservice.ReplicateEventsV2Request{
+				NamespaceId:         m.params.TargetNamespaceID,
+				WorkflowExecution:   m.execution,
+				VersionHistoryItems: versionHistory.GetItems(),
+				Events:              batch,
+				CreatePaused:        true,
+			}); err != nil {
+				return false, err
+			}
+			activity.RecordHeartbeat(ctx)
+			return more, nil
+		})
+}
+
+// truncateBatch drops the events from endEventID on, it returns nil if no event is left
+// and false if events were dropped
+func (m *executionMigrator) truncateBatch(batch *commonpb.DataBlob, endEventID int64) (*commonpb.DataBlob, bool, error) {
+	blob := persistence.NewDataBlobFromProto(batch)
+	serializer := m.mc.GetPayloadSerializer()
+	events, err := serializer.DeserializeBatchEvents(blob)
+	if err != nil {
+		return nil, false, err
+	}
+
+	count := 0
+	for count < len(events) && events[count].GetEventId() < endEventID {
+		count++
+	}
+	switch count {
+	case len(events):
+		return batch, true, nil
+	case 0:
+		return nil, false, nil
+	}
+
+	blob, err = serializer.SerializeBatchEvents(events[:count], blob.Encoding)
+	if err != nil {
+		return nil, false, err
+	}
+	return blob.ToProto(), false, nil
+}
+
+// verifyHistory compares the checksum of the history in the target namespace with the one of the same events
+// in the source. The target must end with lastEventID if it is set.
+func (m *executionMigrator) verifyHistory(ctx context.Context, firstEventVersion int64, lastEventID int64) (*historySummary, error) {
+	target, err := m.summarizeHistory(ctx, m.targetAdmin, m.params.TargetNamespace, firstEventVersion, common.EmptyEventID)
+	if err != nil {
+		return nil, err
+	}
+	if lastEventID != common.EmptyEventID && target.lastEventID != lastEventID {
+		return nil, errHistoryMismatch
+	}
+	source, err := m.summarizeHistory(ctx, m.sourceAdmin, m.params.SourceNamespace, firstEventVersion, target.lastEventID)
+	if err != nil {
+		return nil, err
+	}
+	if source.lastEventID != target.lastEventID || source.checksum != target.checksum {
+		return nil, errHistoryMismatch
+	}
+	return source, nil
+}
+
+// summarizeHistory reads the history from the first event, up to lastEventID if it is set
+func (m *executionMigrator) summarizeHistory(
+	ctx context.Context,
+	client admin.Client,
+	namespace string,
+	firstEventVersion int64,
+	lastEventID int64,
+) (*historySummary, error) {
+
+	summary := &historySummary{
+		lastEventID:     common.FirstEventID - 1,
+		scheduledEvents: make(map[string]*eventpb.HistoryEvent),
+		startedEventIDs: make(map[int64]int64),
+	}
+	err := m.readHistory(ctx, client, namespace, common.FirstEventID-1, firstEventVersion,
+		func(batch *commonpb.DataBlob, versionHistory *eventgenpb.VersionHistory) (bool, error) {
+			summary.versionHistory = versionHistory
+			events, err := m.mc.GetPayloadSerializer().DeserializeBatchEvents(persistence.NewDataBlobFromProto(batch))
+			if err != nil {
+				return false, err
+			}
+			for _, event := range events {
+				if lastEventID != common.EmptyEventID && event.GetEventId() > lastEventID {
+					return false, nil
+				}
+				if err := summary.add(event); err != nil {
+					return false, err
+				}
+			}
+			activity.RecordHeartbeat(ctx)
+			return true, nil
+		})
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// readHistory pages through the current branch of the history, from the event after the given one
+func (m *executionMigrator) readHistory(
+	ctx context.Context,
+	client admin.Client,
+	namespace string,
+	startEventID int64,
+	startEventVersion int64,
+	fn historyBatchFn,
+) error {
+
+	var pageToken []byte
+	for {
+		if err := m.limiter.Wait(ctx); err != nil {
+			return err
+		}
+		resp, err := client.GetWorkflowExecutionRawHistoryV2(ctx, &adminservice.GetWorkflowExecutionRawHistoryV2Request{
+			Namespace:         namespace,
+			Execution:         m.execution,
+			StartEventId:      startEventID,
+			StartEventVersion: startEventVersion,
+			EndEventId:        common.EmptyEventID,
+			EndEventVersion:   common.EmptyVersion,
+			MaximumPageSize:   historyPageSize,
+			NextPageToken:     pageToken,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, batch := range resp.GetHistoryBatches() {
+			more, err := fn(batch, resp.GetVersionHistory())
+			if err != nil || !more {
+				return err
+			}
+		}
+
+		pageToken = resp.GetNextPageToken()
+		if len(pageToken) == 0 {
+			return nil
+		}
+	}
+}
+
+// syncActivities copies the state of the pending activities which is not in the history,
+// like the heartbeat details and the attempt
+func (m *executionMigrator) syncActivities(ctx context.Context, summary *historySummary) error {
+	resp, err := m.describeSource(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, pendingActivity := range resp.GetPendingActivities() {
+		scheduledEvent, ok := summary.scheduledEvents[pendingActivity.GetActivityId()]
+		if !ok {
+			// scheduled after the history was verified, the activity is copied with the rest of the events
+			continue
+		}
+		startedID := common.EmptyEventID
+		if pendingActivity.GetState() != executionpb.PendingActivityState_Scheduled {
+			startedID = common.TransientEventID
+			if eventID, ok := summary.startedEventIDs[scheduledEvent.GetEventId()]; ok {
+				startedID = eventID
+			}
+		}
+
+		if _, err := m.mc.GetHistoryClient().SyncActivity(ctx, &historyservice.SyncActivityRequest{
+			NamespaceId:        m.params.TargetNamespaceID,
+			WorkflowId:         m.execution.GetWorkflowId(),
+			RunId:              m.execution.GetRunId(),
+			Version:            scheduledEvent.GetVersion(),
+			ScheduledId:        scheduledEvent.GetEventId(),
+			ScheduledTime:      pendingActivity.GetScheduledTimestamp(),
+			StartedId:          startedID,
+			StartedTime:        pendingActivity.GetLastStartedTimestamp(),
+			LastHeartbeatTime:  pendingActivity.GetLastHeartbeatTimestamp(),
+			Details:            pendingActivity.GetHeartbeatDetails(),
+			Attempt:            pendingActivity.GetAttempt(),
+			LastFailureReason:  pendingActivity.GetLastFailureReason(),
+			LastWorkerIdentity: pendingActivity.GetLastWorkerIdentity(),
+			LastFailureDetails: pendingActivity.GetLastFailureDetails(),
+			VersionHistory:     summary.versionHistory,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *executionMigrator) describeSource(ctx context.Context) (*workflowservice.DescribeWorkflowExecutionResponse, error) {
+	if err := m.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return m.sourceFrontend.DescribeWorkflowExecution(ctx, &workflowservice.DescribeWorkflowExecutionRequest{
+		Namespace: m.params.SourceNamespace,
+		Execution: m.execution,
+	})
+}
+
+func (m *executionMigrator) getFirstEventVersion(ctx context.Context) (int64, error) {
+	if err := m.limiter.Wait(ctx); err != nil {
+		return 0, err
+	}
+	resp, err := m.sourceFrontend.GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+		Namespace:       m.params.SourceNamespace,
+		Execution:       m.execution,
+		MaximumPageSize: 1,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.GetHistory().GetEvents()) == 0 {
+		return 0, errNoHistoryEvents
+	}
+	return resp.GetHistory().GetEvents()[0].GetVersion(), nil
+}
+
+// getSourceRedirect returns the close event id of the source and whether it was terminated by a migration
+// to the target namespace
+func (m *executionMigrator) getSourceRedirect(ctx context.Context) (int64, bool, error) {
+	if err := m.limiter.Wait(ctx); err != nil {
+		return 0, false, err
+	}
+	resp, err := m.sourceFrontend.GetWorkflowExecutionHistory(ctx, &workflowservice.GetWorkflowExecutionHistoryRequest{
+		Namespace:              m.params.SourceNamespace,
+		Execution:              m.execution,
+		HistoryEventFilterType: filterpb.HistoryEventFilterType_CloseEvent,
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	events := resp.GetHistory().GetEvents()
+	if len(events) == 0 {
+		return 0, false, errNoHistoryEvents
+	}
+
+	closeEvent := events[len(events)-1]
+	attributes := closeEvent.GetWorkflowExecutionTerminatedEventAttributes()
+	if closeEvent.GetEventType() != eventpb.EventType_WorkflowExecutionTerminated || attributes.GetReason() != MigratedReason {
+		return closeEvent.GetEventId(), false, nil
+	}
+	redirect := Redirect{}
+	if err := json.Unmarshal(attributes.GetDetails(), &redirect); err != nil {
+		return closeEvent.GetEventId(), false, nil
+	}
+	migrated := redirect.Cluster == m.mc.GetClusterMetadata().GetCurrentClusterName() &&
+		redirect.Namespace == m.params.TargetNamespace
+	return closeEvent.GetEventId(), migrated, nil
+}
+
+func (m *executionMigrator) pauseSource(ctx context.Context) error {
+	if err := m.limiter.Wait(ctx); err != nil {
+		return err
+	}
+	_, err := m.sourceAdmin.PauseWorkflowExecution(ctx, &adminservice.PauseWorkflowExecutionRequest{
+		Namespace: m.params.SourceNamespace,
+		Execution: m.execution,
+		Reason:    pauseReason,
+		Identity:  m.params.Identity,
+	})
+	return err
+}
+
+func (m *executionMigrator) unpauseSource(ctx context.Context) error {
+	if err := m.limiter.Wait(ctx); err != nil {
+		return err
+	}
+	_, err := m.sourceAdmin.UnpauseWorkflowExecution(ctx, &adminservice.UnpauseWorkflowExecutionRequest{
+		Namespace: m.params.SourceNamespace,
+		Execution: m.execution,
+		Reason:    rollbackReason,
+		Identity:  m.params.Identity,
+	})
+	return err
+}
+
+// terminateSource terminates the source with the Redirect to the target namespace as the details
+func (m *executionMigrator) terminateSource(ctx context.Context) error {
+	details, err := json.Marshal(Redirect{
+		MigrationID: m.params.MigrationID,
+		Cluster:     m.mc.GetClusterMetadata().GetCurrentClusterName(),
+		Namespace:   m.params.TargetNamespace,
+		WorkflowID:  m.execution.GetWorkflowId(),
+		RunID:       m.execution.GetRunId(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := m.limiter.Wait(ctx); err != nil {
+		return err
+	}
+	_, err = m.sourceFrontend.TerminateWorkflowExecution(ctx, &workflowservice.TerminateWorkflowExecutionRequest{
+		Namespace:         m.params.SourceNamespace,
+		WorkflowExecution: m.execution,
+		Reason:            MigratedReason,
+		Details:           details,
+		Identity:          m.params.Identity,
+	})
+	if _, ok := err.(*serviceerror.NotFound); ok {
+		// the source closed in the meantime, the close event tells how
+		return nil
+	}
+	return err
+}
+
+func (m *executionMigrator) unpauseTarget(ctx context.Context) error {
+	_, err := m.mc.GetHistoryClient().UnpauseWorkflowExecution(ctx, &historyservice.UnpauseWorkflowExecutionRequest{
+		NamespaceId: m.params.TargetNamespaceID,
+		Request: &adminservice.UnpauseWorkflowExecutionRequest{
+			Namespace: m.params.TargetNamespace,
+			Execution: m.execution,
+			Reason:    m.params.Reason,
+			Identity:  m.params.Identity,
+		},
+	})
+	return err
+}
+
+func (m *executionMigrator) deleteTargetCopy(ctx context.Context) error {
+	_, err := m.mc.GetHistoryClient().DeleteWorkflowExecution(ctx, &historyservice.DeleteWorkflowExecutionRequest{
+		NamespaceId: m.params.TargetNamespaceID,
+		Request: &adminservice.DeleteWorkflowExecutionRequest{
+			Namespace: m.params.TargetNamespace,
+			Execution: m.execution,
+			Reason:    rollbackReason,
+			Identity:  m.params.Identity,
+		},
+	})
+	if _, ok := err.(*serviceerror.NotFound); ok {
+		return nil
+	}
+	return err
+}
+
+// add adds the event to the checksum, the events must be consecutive
+func (s *historySummary) add(event *eventpb.HistoryEvent) error {
+	if event.GetEventId() != s.lastEventID+1 {
+		return errHistoryMismatch
+	}
+	data, err := event.Marshal()
+	if err != nil {
+		return err
+	}
+	s.checksum = crc32.Update(s.checksum, crc32.IEEETable, data)
+	s.lastEventID = event.GetEventId()
+
+	switch event.GetEventType() {
+	case eventpb.EventType_ActivityTaskScheduled:
+		s.scheduledEvents[event.GetActivityTaskScheduledEventAttributes().GetActivityId()] = event
+	case eventpb.EventType_ActivityTaskStarted:
+		s.startedEventIDs[event.GetActivityTaskStartedEventAttributes().GetScheduledEventId()] = event.GetEventId()
+	}
+	return nil
+}
+
+// toActivityError makes the errors which do not go away on retry non-retryable
+func toActivityError(err error) error {
+	switch err.(type) {
+	case *serviceerror.InvalidArgument, *serviceerror.NamespaceNotActive:
+		return temporal.NewCustomError(nonRetryableReason, err.Error())
+	}
+	if err == errHistoryMismatch || err == errVersionTooHigh {
+		return temporal.NewCustomError(nonRetryableReason, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,78 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"context"
+
+	"go.temporal.io/temporal/activity"
+	"go.temporal.io/temporal/worker"
+	"go.temporal.io/temporal/workflow"
+
+	"github.com/temporalio/temporal/common/log"
+	"github.com/temporalio/temporal/common/log/tag"
+	"github.com/temporalio/temporal/common/resource"
+)
+
+type (
+	// migratorContext is the context object that gets
+	// passed around within the migration workflow / activities
+	migratorContext struct {
+		resource.Resource
+		logger log.Logger
+	}
+
+	// Migrator is the background sub-system that executes the workflows migrating workflow executions
+	Migrator struct {
+		context migratorContext
+	}
+)
+
+// New returns a new instance of workflow migrator
+func New(
+	resource resource.Resource,
+) *Migrator {
+
+	return &Migrator{
+		context: migratorContext{
+			Resource: resource,
+			logger:   resource.GetLogger().WithTags(tag.ComponentMigrator),
+		},
+	}
+}
+
+// Start starts the worker of the migration workflow
+func (m *Migrator) Start() error {
+	workerOpts := worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), migratorContextKey, m.context),
+	}
+	migratorWorker := worker.New(m.context.GetSDKClient(), TaskListName, workerOpts)
+	migratorWorker.RegisterWorkflowWithOptions(MigrationWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	migratorWorker.RegisterActivityWithOptions(ListExecutionsActivity, activity.RegisterOptions{Name: listExecutionsActivityName})
+	migratorWorker.RegisterActivityWithOptions(MigrateExecutionActivity, activity.RegisterOptions{Name: migrateExecutionActivityName})
+	migratorWorker.RegisterActivityWithOptions(RollbackExecutionActivity, activity.RegisterOptions{Name: rollbackExecutionActivityName})
+
+	return migratorWorker.Start()
+}
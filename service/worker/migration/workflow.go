@@ -0,0 +1,268 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"fmt"
+	"time"
+
+	"go.temporal.io/temporal"
+	"go.temporal.io/temporal/workflow"
+	"go.uber.org/zap"
+)
+
+type (
+	contextKey int
+)
+
+const (
+	migratorContextKey = contextKey(0)
+
+	// TaskListName is the task list of the migration workflow
+	TaskListName = "temporal-sys-migration-tasklist"
+	// WorkflowTypeName is the workflow type of the migration workflow
+	WorkflowTypeName = "temporal-sys-migration-workflow"
+	// ProgressQueryType is the query type returning the Progress of a migration
+	ProgressQueryType = "progress"
+	// MigratedReason is the termination reason of the source workflow executions moved by a migration,
+	// the termination details are the Redirect to the workflow execution in the target namespace
+	MigratedReason = "temporal-sys-migration: workflow execution migrated"
+
+	// SourceClusterMemo is the memo of a migration recording its source cluster
+	SourceClusterMemo = "SourceCluster"
+	// SourceNamespaceMemo is the memo of a migration recording its source namespace
+	SourceNamespaceMemo = "SourceNamespace"
+	// TargetNamespaceMemo is the memo of a migration recording its target namespace
+	TargetNamespaceMemo = "TargetNamespace"
+
+	workflowIDPrefix              = "temporal-sys-migration-"
+	listExecutionsActivityName    = "temporal-sys-migration-list-executions-activity"
+	migrateExecutionActivityName  = "temporal-sys-migration-migrate-execution-activity"
+	rollbackExecutionActivityName = "temporal-sys-migration-rollback-execution-activity"
+
+	// nonRetryableReason is the reason of the errors which fail the migration of a workflow execution right away
+	nonRetryableReason = "temporal-sys-migration-non-retryable"
+	// pagesPerRun is the number of pages of workflow executions migrated before the workflow continues as new
+	pagesPerRun = 10
+
+	// InfiniteDuration is a long duration(20 yrs) we used for infinite workflow running
+	InfiniteDuration = 20 * 365 * 24 * time.Hour
+	// DefaultRPS is the default rate of the requests to the source cluster and of the history pages copied
+	DefaultRPS = 20
+)
+
+const (
+	// OutcomeMigrated is the outcome of a workflow execution moved to the target namespace
+	OutcomeMigrated = "migrated"
+	// OutcomeSkipped is the outcome of a workflow execution which was closed or not found in the source namespace
+	OutcomeSkipped = "skipped"
+)
+
+type (
+	// Params is the parameters of the migration workflow
+	Params struct {
+		MigrationID string
+		// SourceCluster is the cluster owning the source namespace, it may be the current cluster
+		SourceCluster   string
+		SourceNamespace string
+		// TargetNamespace is a namespace of the current cluster, where the migration workflow runs
+		TargetNamespace   string
+		TargetNamespaceID string
+		// Execution is migrated if set, otherwise the workflow executions matching Query
+		Execution *Execution
+		// Empty Query migrates all the open workflow executions of the source namespace
+		Query    string
+		Reason   string
+		Identity string
+		// RPS of the requests to the source cluster and of the history pages copied. Default to DefaultRPS
+		RPS int
+
+		// Progress and PageToken are carried over when the workflow continues as new
+		Progress  Progress
+		PageToken []byte
+	}
+
+	// Execution is a workflow execution to migrate
+	Execution struct {
+		WorkflowID string
+		RunID      string
+	}
+
+	// ExecutionsPage is a page of the workflow executions to migrate
+	ExecutionsPage struct {
+		Executions    []Execution
+		NextPageToken []byte
+	}
+
+	// Progress is the progress of the migration workflow
+	Progress struct {
+		MigratedExecutionCount int64
+		SkippedExecutionCount  int64
+		FailedExecutionCount   int64
+		// LastFailure is the error of the last workflow execution which failed to migrate
+		LastFailure string
+	}
+
+	// Redirect is the marker left on a migrated source workflow execution,
+	// it is the details of the termination with MigratedReason
+	Redirect struct {
+		MigrationID string
+		Cluster     string
+		Namespace   string
+		WorkflowID  string
+		RunID       string
+	}
+)
+
+var (
+	activityRetryPolicy = temporal.RetryPolicy{
+		InitialInterval:    10 * time.Second,
+		BackoffCoefficient: 1.7,
+		MaximumInterval:    5 * time.Minute,
+		ExpirationInterval: InfiniteDuration,
+	}
+	listActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    5 * time.Minute,
+		RetryPolicy:            &activityRetryPolicy,
+	}
+
+	// the migration of a workflow execution is given up after an hour of failures,
+	// the failed execution is rolled back and the migration moves on
+	executionRetryPolicy = temporal.RetryPolicy{
+		InitialInterval:          10 * time.Second,
+		BackoffCoefficient:       1.7,
+		MaximumInterval:          5 * time.Minute,
+		ExpirationInterval:       time.Hour,
+		NonRetriableErrorReasons: []string{nonRetryableReason},
+	}
+	executionActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: 5 * time.Minute,
+		StartToCloseTimeout:    InfiniteDuration,
+		HeartbeatTimeout:       5 * time.Minute,
+		RetryPolicy:            &executionRetryPolicy,
+	}
+)
+
+// GetWorkflowID returns the id of the workflow executing the migration
+func GetWorkflowID(migrationID string) string {
+	return workflowIDPrefix + migrationID
+}
+
+// MigrationWorkflow is the workflow moving workflow executions from the source namespace to the target namespace.
+// The workflow executions are migrated one at a time, a failed one is rolled back and counted in the Progress.
+func MigrationWorkflow(ctx workflow.Context, params Params) (Progress, error) {
+	params = setDefaultParams(params)
+	progress := params.Progress
+	if err := validateParams(params); err != nil {
+		return progress, err
+	}
+	if err := workflow.SetQueryHandler(ctx, ProgressQueryType, func() (Progress, error) {
+		return progress, nil
+	}); err != nil {
+		return progress, err
+	}
+	listCtx := workflow.WithActivityOptions(ctx, listActivityOptions)
+	executionCtx := workflow.WithActivityOptions(ctx, executionActivityOptions)
+	logger := workflow.GetLogger(ctx)
+
+	pageToken := params.PageToken
+	for page := 0; ; page++ {
+		if page == pagesPerRun {
+			params.Progress = progress
+			params.PageToken = pageToken
+			return progress, workflow.NewContinueAsNewError(ctx, WorkflowTypeName, params)
+		}
+
+		executions := ExecutionsPage{}
+		if params.Execution != nil {
+			executions.Executions = []Execution{*params.Execution}
+		} else if err := workflow.ExecuteActivity(listCtx, listExecutionsActivityName, params, pageToken).Get(ctx, &executions); err != nil {
+			return progress, err
+		}
+
+		for _, execution := range executions.Executions {
+			var outcome string
+			err := workflow.ExecuteActivity(executionCtx, migrateExecutionActivityName, params, execution).Get(ctx, &outcome)
+			if err == nil {
+				if outcome == OutcomeMigrated {
+					progress.MigratedExecutionCount++
+				} else {
+					progress.SkippedExecutionCount++
+				}
+				continue
+			}
+
+			progress.FailedExecutionCount++
+			progress.LastFailure = getErrorMessage(err)
+			logger.Warn("Failed to migrate workflow execution.",
+				zap.String("WorkflowID", execution.WorkflowID),
+				zap.String("RunID", execution.RunID),
+				zap.String("Error", progress.LastFailure),
+			)
+			if err := workflow.ExecuteActivity(executionCtx, rollbackExecutionActivityName, params, execution).Get(ctx, nil); err != nil {
+				logger.Error("Failed to roll back the migration of workflow execution.",
+					zap.String("WorkflowID", execution.WorkflowID),
+					zap.String("RunID", execution.RunID),
+					zap.String("Error", getErrorMessage(err)),
+				)
+			}
+		}
+
+		pageToken = executions.NextPageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+
+	logger.Info("Migration completed.")
+	return progress, nil
+}
+
+func validateParams(params Params) error {
+	if params.MigrationID == "" || params.SourceCluster == "" || params.SourceNamespace == "" ||
+		params.TargetNamespace == "" || params.TargetNamespaceID == "" {
+		return fmt.Errorf("must provide required parameters: MigrationID/SourceCluster/SourceNamespace/TargetNamespace/TargetNamespaceID")
+	}
+	return nil
+}
+
+func setDefaultParams(params Params) Params {
+	if params.RPS <= 0 {
+		params.RPS = DefaultRPS
+	}
+	return params
+}
+
+// getErrorMessage returns the message of the activity errors, which is in the details of the non-retryable ones
+func getErrorMessage(err error) string {
+	if customErr, ok := err.(*temporal.CustomError); ok && customErr.HasDetails() {
+		var message string
+		if customErr.Details(&message) == nil {
+			return message
+		}
+	}
+	return err.Error()
+}
@@ -0,0 +1,131 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package migration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/temporal"
+	"go.temporal.io/temporal/activity"
+	"go.temporal.io/temporal/testsuite"
+	"go.temporal.io/temporal/workflow"
+)
+
+type workflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+}
+
+func TestWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(workflowTestSuite))
+}
+
+func (s *workflowTestSuite) newTestWorkflowEnvironment() *testsuite.TestWorkflowEnvironment {
+	env := s.NewTestWorkflowEnvironment()
+	env.RegisterWorkflowWithOptions(MigrationWorkflow, workflow.RegisterOptions{Name: WorkflowTypeName})
+	env.RegisterActivityWithOptions(ListExecutionsActivity, activity.RegisterOptions{Name: listExecutionsActivityName})
+	env.RegisterActivityWithOptions(MigrateExecutionActivity, activity.RegisterOptions{Name: migrateExecutionActivityName})
+	env.RegisterActivityWithOptions(RollbackExecutionActivity, activity.RegisterOptions{Name: rollbackExecutionActivityName})
+	return env
+}
+
+func (s *workflowTestSuite) newParams() Params {
+	return Params{
+		MigrationID:       "test-migration",
+		SourceCluster:     "active",
+		SourceNamespace:   "source-namespace",
+		TargetNamespace:   "target-namespace",
+		TargetNamespaceID: "deadd0d0-c001-face-d00d-000000000000",
+	}
+}
+
+func (s *workflowTestSuite) TestWorkflow_Execution() {
+	env := s.newTestWorkflowEnvironment()
+	params := s.newParams()
+	params.Execution = &Execution{WorkflowID: "test-workflow-id", RunID: "test-run-id"}
+	env.OnActivity(migrateExecutionActivityName, mock.Anything, mock.Anything, *params.Execution).Return(OutcomeMigrated, nil).Once()
+
+	env.ExecuteWorkflow(WorkflowTypeName, params)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var progress Progress
+	s.NoError(env.GetWorkflowResult(&progress))
+	s.Equal(Progress{MigratedExecutionCount: 1}, progress)
+	env.AssertExpectations(s.T())
+}
+
+func (s *workflowTestSuite) TestWorkflow_Query() {
+	env := s.newTestWorkflowEnvironment()
+	params := s.newParams()
+	params.Query = "WorkflowType='test-workflow-type'"
+	executions := []Execution{
+		{WorkflowID: "test-workflow-id-1", RunID: "test-run-id-1"},
+		{WorkflowID: "test-workflow-id-2", RunID: "test-run-id-2"},
+		{WorkflowID: "test-workflow-id-3", RunID: "test-run-id-3"},
+	}
+	env.OnActivity(listExecutionsActivityName, mock.Anything, mock.Anything, []byte(nil)).Return(ExecutionsPage{
+		Executions:    executions[:2],
+		NextPageToken: []byte("next-page"),
+	}, nil).Once()
+	env.OnActivity(listExecutionsActivityName, mock.Anything, mock.Anything, []byte("next-page")).Return(ExecutionsPage{
+		Executions: executions[2:],
+	}, nil).Once()
+	env.OnActivity(migrateExecutionActivityName, mock.Anything, mock.Anything, executions[0]).Return(OutcomeMigrated, nil).Once()
+	env.OnActivity(migrateExecutionActivityName, mock.Anything, mock.Anything, executions[1]).Return(OutcomeSkipped, nil).Once()
+	env.OnActivity(migrateExecutionActivityName, mock.Anything, mock.Anything, executions[2]).Return(
+		"", temporal.NewCustomError(nonRetryableReason, errHistoryMismatch.Error())).Once()
+	env.OnActivity(rollbackExecutionActivityName, mock.Anything, mock.Anything, executions[2]).Return(nil).Once()
+
+	env.ExecuteWorkflow(WorkflowTypeName, params)
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	var progress Progress
+	s.NoError(env.GetWorkflowResult(&progress))
+	s.Equal(Progress{
+		MigratedExecutionCount: 1,
+		SkippedExecutionCount:  1,
+		FailedExecutionCount:   1,
+		LastFailure:            errHistoryMismatch.Error(),
+	}, progress)
+	env.AssertExpectations(s.T())
+}
+
+func (s *workflowTestSuite) TestWorkflow_InvalidParams() {
+	env := s.newTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(WorkflowTypeName, Params{SourceNamespace: "source-namespace"})
+	s.True(env.IsWorkflowCompleted())
+	s.Error(env.GetWorkflowError())
+}
+
+func (s *workflowTestSuite) TestToActivityError() {
+	s.True(temporal.IsCustomError(toActivityError(errHistoryMismatch)))
+	s.True(temporal.IsCustomError(toActivityError(errVersionTooHigh)))
+	err := errors.New("test error")
+	s.Equal(err, toActivityError(err))
+}
@@ -135,21 +135,30 @@ func newActivityReplicationTask(
 			metricsClient: metricsClient,
 		},
 		req: &historyservice.SyncActivityRequest{
-			NamespaceId:        attr.NamespaceId,
-			WorkflowId:         attr.WorkflowId,
-			RunId:              attr.RunId,
-			Version:            attr.Version,
-			ScheduledId:        attr.ScheduledId,
-			ScheduledTime:      attr.ScheduledTime,
-			StartedId:          attr.StartedId,
-			StartedTime:        attr.StartedTime,
-			LastHeartbeatTime:  attr.LastHeartbeatTime,
-			Details:            attr.Details,
-			Attempt:            attr.Attempt,
-			LastFailureReason:  attr.LastFailureReason,
-			LastWorkerIdentity: attr.LastWorkerIdentity,
-			LastFailureDetails: attr.LastFailureDetails,
-			VersionHistory:     attr.VersionHistory,
+			NamespaceId:                   attr.NamespaceId,
+			WorkflowId:                    attr.WorkflowId,
+			RunId:                         attr.RunId,
+			Version:                       attr.Version,
+			ScheduledId:                   attr.ScheduledId,
+			ScheduledTime:                 attr.ScheduledTime,
+			StartedId:                     attr.StartedId,
+			StartedTime:                   attr.StartedTime,
+			LastHeartbeatTime:             attr.LastHeartbeatTime,
+			Details:                       attr.Details,
+			Attempt:                       attr.Attempt,
+			LastFailureReason:             attr.LastFailureReason,
+			LastWorkerIdentity:            attr.LastWorkerIdentity,
+			LastFailureDetails:            attr.LastFailureDetails,
+			VersionHistory:                attr.VersionHistory,
+			ScheduleToStartTimeoutSeconds: attr.ScheduleToStartTimeoutSeconds,
+			ScheduleToCloseTimeoutSeconds: attr.ScheduleToCloseTimeoutSeconds,
+			StartToCloseTimeoutSeconds:    attr.StartToCloseTimeoutSeconds,
+			HeartbeatTimeoutSeconds:       attr.HeartbeatTimeoutSeconds,
+			TaskList:                      attr.TaskList,
+			RetryPolicy:                   attr.RetryPolicy,
+			ExpirationTime:                attr.ExpirationTime,
+			OptionsUpdateIdentity:         attr.OptionsUpdateIdentity,
+			OptionsUpdateTime:             attr.OptionsUpdateTime,
 		},
 		historyRereplicator: historyRereplicator,
 		nDCHistoryResender:  nDCHistoryResender,
@@ -41,7 +41,9 @@ import (
 	"github.com/temporalio/temporal/common/service/dynamicconfig"
 	"github.com/temporalio/temporal/service/worker/archiver"
 	"github.com/temporalio/temporal/service/worker/batcher"
+	"github.com/temporalio/temporal/service/worker/deletenamespace"
 	"github.com/temporalio/temporal/service/worker/indexer"
+	"github.com/temporalio/temporal/service/worker/migration"
 	"github.com/temporalio/temporal/service/worker/parentclosepolicy"
 	"github.com/temporalio/temporal/service/worker/replicator"
 	"github.com/temporalio/temporal/service/worker/scanner"
@@ -68,6 +70,7 @@ type (
 		IndexerCfg                    *indexer.Config
 		ScannerCfg                    *scanner.Config
 		BatcherCfg                    *batcher.Config
+		NamespaceDeleterCfg           *deletenamespace.Config
 		ThrottledLogRPS               dynamicconfig.IntPropertyFn
 		PersistenceGlobalMaxQPS       dynamicconfig.IntPropertyFn
 		EnableBatcher                 dynamicconfig.BoolPropertyFn
@@ -141,6 +144,9 @@ func NewConfig(params *resource.BootstrapParams) *Config {
 			AdminOperationToken: dc.GetStringProperty(dynamicconfig.AdminOperationToken, common.DefaultAdminOperationToken),
 			ClusterMetadata:     params.ClusterMetadata,
 		},
+		NamespaceDeleterCfg: &deletenamespace.Config{
+			Persistence: &params.PersistenceConfig,
+		},
 		EnableBatcher:                 dc.GetBoolProperty(dynamicconfig.EnableBatcher, false),
 		EnableParentClosePolicyWorker: dc.GetBoolProperty(dynamicconfig.EnableParentClosePolicyWorker, true),
 		ThrottledLogRPS:               dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
@@ -182,6 +188,7 @@ func (s *Service) Start() {
 
 	if s.GetClusterMetadata().IsGlobalNamespaceEnabled() {
 		s.startReplicator()
+		s.startMigrator()
 	}
 	if s.GetArchivalMetadata().GetHistoryConfig().ClusterConfiguredForArchival() {
 		s.startArchiver()
@@ -192,6 +199,7 @@ func (s *Service) Start() {
 	if s.config.EnableParentClosePolicyWorker() {
 		s.startParentClosePolicyProcessor()
 	}
+	s.startNamespaceDeleter()
 
 	logger.Info("worker started", tag.ComponentWorker)
 	<-s.stopC
@@ -236,6 +244,21 @@ func (s *Service) startBatcher() {
 	}
 }
 
+func (s *Service) startNamespaceDeleter() {
+	params := &deletenamespace.BootstrapParams{
+		Config: *s.config.NamespaceDeleterCfg,
+	}
+	if err := deletenamespace.New(s.Resource, params).Start(); err != nil {
+		s.GetLogger().Fatal("error starting namespace deleter", tag.Error(err))
+	}
+}
+
+func (s *Service) startMigrator() {
+	if err := migration.New(s.Resource).Start(); err != nil {
+		s.GetLogger().Fatal("error starting migrator", tag.Error(err))
+	}
+}
+
 func (s *Service) startScanner() {
 	params := &scanner.BootstrapParams{
 		Config: *s.config.ScannerCfg,
@@ -94,7 +94,7 @@ func newAdminWorkflowCommands() []cli.Command {
 		{
 			Name:    "delete",
 			Aliases: []string{"del"},
-			Usage:   "Delete current workflow execution and the mutableState record",
+			Usage:   "Delete current workflow execution and the mutableState record directly from database, use 'workflow delete' to delete all data of a workflow execution",
 			Flags: append(getDBFlags(),
 				cli.StringFlag{
 					Name:  FlagWorkflowIDWithAlias,
@@ -112,6 +112,28 @@ func newAdminWorkflowCommands() []cli.Command {
 				AdminDeleteWorkflow(c)
 			},
 		},
+		{
+			Name:  "import",
+			Usage: "Recreate a workflow execution exported by 'workflow export' in the namespace, through the replication of its history events",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagInputFileWithAlias,
+					Usage: "Input file of the exported workflow execution",
+				},
+				cli.IntFlag{
+					Name:  FlagBatchSizeWithAlias,
+					Value: 100,
+					Usage: "Number of history batches imported per request",
+				},
+				cli.BoolFlag{
+					Name:  FlagPaused,
+					Usage: "Create the workflow execution paused",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminImportWorkflow(c)
+			},
+		},
 	}
 }
 
@@ -588,6 +610,25 @@ func newAdminTaskListCommands() []cli.Command {
 				AdminDescribeTaskList(c)
 			},
 		},
+		{
+			Name:    "describe-versions",
+			Aliases: []string{"descv"},
+			Usage:   "Describe worker version sets and pollers by build ID of tasklist",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList description",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Value: "decision",
+					Usage: "Optional TaskList type [decision|activity]",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeTaskListVersions(c)
+			},
+		},
 	}
 }
 
@@ -808,6 +849,63 @@ func newDBCommands() []cli.Command {
 	}
 }
 
+func newAdminMigrationCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "start",
+			Aliases: []string{"s"},
+			Usage:   "Start migrating workflow executions of a source namespace to the target namespace",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagSourceCluster,
+					Usage: "Optional cluster owning the source namespace, default to the current cluster",
+				},
+				cli.StringFlag{
+					Name:  FlagSourceNamespace,
+					Usage: "Source namespace of the workflow executions",
+				},
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "Optional WorkflowId of the only workflow execution to migrate",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "Optional RunId of the only workflow execution to migrate",
+				},
+				cli.StringFlag{
+					Name:  FlagListQueryWithAlias,
+					Usage: "Optional visibility query of the workflow executions to migrate, default to all the open workflow executions",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "Reason of the migration",
+				},
+				cli.IntFlag{
+					Name:  FlagRPS,
+					Usage: "Optional history read rate per second",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminStartMigration(c)
+			},
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"d"},
+			Usage:   "Describe the progress of a migration",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagMigrationIDWithAlias,
+					Usage: "Migration Id",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeMigration(c)
+			},
+		},
+	}
+}
+
 // TODO need to support other database: https://github.com/uber/cadence/issues/2777
 func getDBFlags() []cli.Flag {
 	return []cli.Flag{
@@ -852,5 +950,9 @@ func getDBFlags() []cli.Flag {
 			Name:  FlagTLSEnableHostVerification,
 			Usage: "cassandra tls verify hostname and server cert (tls must be enabled)",
 		},
+		cli.StringFlag{
+			Name:  FlagEncryptionKeyFile,
+			Usage: "encryption keyfile of the server, required to read encrypted payloads",
+		},
 	}
 }
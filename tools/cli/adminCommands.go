@@ -37,6 +37,8 @@ import (
 	executionpb "go.temporal.io/temporal-proto/execution"
 
 	"github.com/temporalio/temporal/.gen/proto/adminservice"
+	eventgenpb "github.com/temporalio/temporal/.gen/proto/event"
+	executiongenpb "github.com/temporalio/temporal/.gen/proto/execution"
 	"github.com/temporalio/temporal/.gen/proto/persistenceblobs"
 	"github.com/temporalio/temporal/common"
 	"github.com/temporalio/temporal/common/auth"
@@ -60,7 +62,7 @@ func AdminShowWorkflow(c *cli.Context) {
 	outputFileName := c.String(FlagOutputFilename)
 
 	session := connectToCassandra(c)
-	serializer := persistence.NewPayloadSerializer()
+	serializer := getPayloadSerializer(c)
 	var history []*serialization.DataBlob
 	if len(tid) != 0 {
 		histV2 := cassp.NewHistoryV2PersistenceFromSession(session, loggerimpl.NewNopLogger())
@@ -266,6 +268,65 @@ func AdminDeleteWorkflow(c *cli.Context) {
 	fmt.Println("delete current row successfully")
 }
 
+// AdminImportWorkflow recreates a workflow execution exported by ExportWorkflow
+func AdminImportWorkflow(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	inputFileName := getRequiredOption(c, FlagInputFile)
+	batchSize := c.Int(FlagBatchSize)
+	if batchSize <= 0 {
+		ErrorAndExit("BatchSize must be positive.", nil)
+	}
+
+	data, err := ioutil.ReadFile(inputFileName)
+	if err != nil {
+		ErrorAndExit("Failed to read workflow execution file.", err)
+	}
+	export := &executiongenpb.WorkflowExecutionExport{}
+	if err := codec.NewJSONPBEncoder().Decode(data, export); err != nil {
+		ErrorAndExit("Failed to deserialize workflow execution.", err)
+	}
+	items, err := getVersionHistoryItems(export.GetHistoryBatches())
+	if err != nil {
+		ErrorAndExit("Invalid history events.", err)
+	}
+
+	batches := export.GetHistoryBatches()
+	for start := 0; start < len(batches); start += batchSize {
+		end := start + batchSize
+		if end > len(batches) {
+			end = len(batches)
+		}
+		ctx, cancel := newContext(c)
+		_, err := adminClient.ImportWorkflowExecution(ctx, &adminservice.ImportWorkflowExecutionRequest{
+			Namespace:           namespace,
+			Execution:           export.GetExecution(),
+			VersionHistoryItems: items,
+			HistoryBatches:      batches[start:end],
+			Paused:              c.Bool(FlagPaused),
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Import workflow failed.", err)
+		}
+	}
+	fmt.Printf("Imported %v history batches of run %v.\n", len(batches), export.GetExecution().GetRunId())
+}
+
+// getVersionHistoryItems returns the version history items of consecutive history batches
+func getVersionHistoryItems(batches []*eventpb.History) ([]*eventgenpb.VersionHistoryItem, error) {
+	versionHistory := persistence.NewVersionHistory(nil, nil)
+	for _, batch := range batches {
+		for _, event := range batch.GetEvents() {
+			if err := versionHistory.AddOrUpdateItem(persistence.NewVersionHistoryItem(event.GetEventId(), event.GetVersion())); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return versionHistory.ToProto().GetItems(), nil
+}
+
 func readOneRow(query *gocql.Query) (map[string]interface{}, error) {
 	result := make(map[string]interface{})
 	err := query.MapScan(result)
@@ -310,6 +371,18 @@ func connectToCassandra(c *cli.Context) *gocql.Session {
 	return session
 }
 
+func getPayloadSerializer(c *cli.Context) persistence.PayloadSerializer {
+	keyFile := c.String(FlagEncryptionKeyFile)
+	if keyFile == "" {
+		return persistence.NewPayloadSerializer()
+	}
+	keyProvider, err := persistence.NewFileEncryptionKeyProvider(keyFile, "", nil)
+	if err != nil {
+		ErrorAndExit("Failed to load encryption keyfile", err)
+	}
+	return persistence.NewEncryptingPayloadSerializer(keyProvider)
+}
+
 // AdminGetNamespaceIDOrName map namespace
 func AdminGetNamespaceIDOrName(c *cli.Context) {
 	namespaceID := c.String(FlagNamespaceID)
@@ -201,7 +201,7 @@ func AdminDBScan(c *cli.Context) {
 		scanWorkerCount = numShards
 	}
 
-	payloadSerializer := persistence.NewPayloadSerializer()
+	payloadSerializer := getPayloadSerializer(c)
 	rateLimiter := getRateLimiter(startingRPS, targetRPS, scaleUpSeconds)
 	session := connectToCassandra(c)
 	defer session.Close()
@@ -483,7 +483,7 @@ type ClustersConfig struct {
 	TLS      auth.TLS
 }
 
-func doRereplicate(shardID int, namespaceID, wid, rid string, minID, maxID int64, targets []string, producer messaging.Producer, session *gocql.Session) {
+func doRereplicate(shardID int, namespaceID, wid, rid string, minID, maxID int64, targets []string, producer messaging.Producer, session *gocql.Session, serializer persistence.PayloadSerializer) {
 	if minID <= 0 {
 		minID = 1
 	}
@@ -492,10 +492,10 @@ func doRereplicate(shardID int, namespaceID, wid, rid string, minID, maxID int64
 	}
 
 	histV2 := cassandra.NewHistoryV2PersistenceFromSession(session, loggerimpl.NewNopLogger())
-	historyV2Mgr := persistence.NewHistoryV2ManagerImpl(histV2, loggerimpl.NewNopLogger(), dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit))
+	historyV2Mgr := persistence.NewHistoryV2ManagerImpl(histV2, serializer, loggerimpl.NewNopLogger(), dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit))
 
 	exeM, _ := cassandra.NewWorkflowExecutionPersistence(shardID, session, loggerimpl.NewNopLogger())
-	exeMgr := persistence.NewExecutionManagerImpl(exeM, loggerimpl.NewNopLogger())
+	exeMgr := persistence.NewExecutionManagerImpl(exeM, serializer, loggerimpl.NewNopLogger())
 
 	for {
 		fmt.Printf("Start rereplicate for wid: %v, rid:%v \n", wid, rid)
@@ -595,6 +595,7 @@ func AdminRereplicate(c *cli.Context) {
 
 	producer := newKafkaProducer(c)
 	session := connectToCassandra(c)
+	serializer := getPayloadSerializer(c)
 
 	if c.IsSet(FlagInputFile) {
 		inFile := c.String(FlagInputFile)
@@ -641,7 +642,7 @@ func AdminRereplicate(c *cli.Context) {
 			}
 
 			shardID := common.WorkflowIDToHistoryShard(wid, numberOfShards)
-			doRereplicate(shardID, namespaceID, wid, rid, minID, maxID, targets, producer, session)
+			doRereplicate(shardID, namespaceID, wid, rid, minID, maxID, targets, producer, session, serializer)
 			fmt.Printf("Done processing line %v ...\n", idx)
 		}
 		if err := scanner.Err(); err != nil {
@@ -655,7 +656,7 @@ func AdminRereplicate(c *cli.Context) {
 		maxID := c.Int64(FlagMaxEventID)
 
 		shardID := common.WorkflowIDToHistoryShard(wid, numberOfShards)
-		doRereplicate(shardID, namespaceID, wid, rid, minID, maxID, targets, producer, session)
+		doRereplicate(shardID, namespaceID, wid, rid, minID, maxID, targets, producer, session, serializer)
 	}
 }
 
@@ -0,0 +1,112 @@
+// The MIT License
+//
+// Copyright (c) 2020 Temporal Technologies Inc.  All rights reserved.
+//
+// Copyright (c) 2020 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"github.com/urfave/cli"
+	executionpb "go.temporal.io/temporal-proto/execution"
+
+	"github.com/temporalio/temporal/.gen/proto/adminservice"
+	commongenpb "github.com/temporalio/temporal/.gen/proto/common"
+)
+
+// AdminStartMigration starts migrating workflow executions to the target namespace
+func AdminStartMigration(c *cli.Context) {
+	targetNamespace := getRequiredGlobalOption(c, FlagNamespace)
+	sourceNamespace := getRequiredOption(c, FlagSourceNamespace)
+	reason := getRequiredOption(c, FlagReason)
+
+	request := &adminservice.StartMigrationRequest{
+		SourceCluster:   c.String(FlagSourceCluster),
+		SourceNamespace: sourceNamespace,
+		TargetNamespace: targetNamespace,
+		Query:           c.String(FlagListQuery),
+		Reason:          reason,
+		Identity:        getCliIdentity(),
+		Rps:             int32(c.Int(FlagRPS)),
+	}
+	if c.IsSet(FlagWorkflowID) {
+		request.Execution = &executionpb.WorkflowExecution{
+			WorkflowId: c.String(FlagWorkflowID),
+			RunId:      c.String(FlagRunID),
+		}
+	} else if c.IsSet(FlagRunID) {
+		ErrorAndExit("RunId is set without WorkflowId.", nil)
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.StartMigration(ctx, request)
+	if err != nil {
+		ErrorAndExit("Failed to start migration", err)
+	}
+	output := map[string]interface{}{
+		"msg":         "migration is started",
+		"migrationID": resp.GetMigrationId(),
+	}
+	prettyPrintJSONObject(output)
+}
+
+// AdminDescribeMigration describes the progress of a migration
+func AdminDescribeMigration(c *cli.Context) {
+	migrationID := getRequiredOption(c, FlagMigrationID)
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.DescribeMigration(ctx, &adminservice.DescribeMigrationRequest{
+		MigrationId: migrationID,
+	})
+	if err != nil {
+		ErrorAndExit("Failed to describe migration", err)
+	}
+
+	output := map[string]interface{}{}
+	switch resp.GetState() {
+	case commongenpb.BatchOperationState_Running:
+		output["msg"] = "migration is running"
+	case commongenpb.BatchOperationState_Completed:
+		output["msg"] = "migration is finished successfully"
+	default:
+		output["msg"] = "migration stopped status: " + resp.GetState().String()
+	}
+	output["sourceCluster"] = resp.GetSourceCluster()
+	output["sourceNamespace"] = resp.GetSourceNamespace()
+	output["targetNamespace"] = resp.GetTargetNamespace()
+	output["progress"] = map[string]int64{
+		"migrated": resp.GetMigratedExecutionCount(),
+		"skipped":  resp.GetSkippedExecutionCount(),
+		"failed":   resp.GetFailedExecutionCount(),
+	}
+	if resp.GetLastFailure() != "" {
+		output["lastFailure"] = resp.GetLastFailure()
+	}
+	output["startTime"] = convertTime(resp.GetStartTime(), false)
+	if resp.GetState() != commongenpb.BatchOperationState_Running {
+		output["closeTime"] = convertTime(resp.GetCloseTime(), false)
+	}
+	prettyPrintJSONObject(output)
+}
@@ -34,6 +34,8 @@ import (
 	"github.com/urfave/cli"
 	tasklistpb "go.temporal.io/temporal-proto/tasklist"
 	"go.temporal.io/temporal-proto/workflowservice"
+
+	"github.com/temporalio/temporal/.gen/proto/adminservice"
 )
 
 // AdminDescribeTaskList displays poller and status information of task list.
@@ -74,18 +76,70 @@ func AdminDescribeTaskList(c *cli.Context) {
 	printPollerInfo(pollers, taskListType)
 }
 
+// AdminDescribeTaskListVersions displays the worker version sets of the namespace and the pollers of task list by build ID.
+func AdminDescribeTaskListVersions(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	taskList := getRequiredOption(c, FlagTaskList)
+	taskListType := tasklistpb.TaskListType_Decision
+	if strings.ToLower(c.String(FlagTaskListType)) == "activity" {
+		taskListType = tasklistpb.TaskListType_Activity
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.DescribeTaskListVersions(ctx, &adminservice.DescribeTaskListVersionsRequest{
+		Namespace:    namespace,
+		TaskList:     &tasklistpb.TaskList{Name: taskList},
+		TaskListType: taskListType,
+	})
+	if err != nil {
+		ErrorAndExit("Operation DescribeTaskListVersions failed.", err)
+	}
+
+	if len(response.GetVersionSets()) == 0 {
+		fmt.Println(colorMagenta("No worker version sets declared for namespace: " + namespace))
+	} else {
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetBorder(false)
+		table.SetColumnSeparator("|")
+		table.SetHeader([]string{"Version Set", "ID", "Build IDs"})
+		table.SetHeaderLine(false)
+		table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+		for i, versionSet := range response.GetVersionSets() {
+			table.Append([]string{strconv.Itoa(i), versionSet.GetId(), strings.Join(versionSet.GetBuildIds(), ", ")})
+		}
+		table.Render()
+	}
+	fmt.Printf("\n")
+
+	if len(response.GetPollers()) == 0 {
+		ErrorAndExit(colorMagenta("No poller for tasklist: "+taskList), nil)
+	}
+	for _, versionPollers := range response.GetPollers() {
+		buildID := versionPollers.GetBuildId()
+		if buildID == "" {
+			buildID = "<none>"
+		}
+		fmt.Printf("Build ID: %s\n", buildID)
+		printPollerInfo(versionPollers.GetPollers(), taskListType)
+		fmt.Printf("\n")
+	}
+}
+
 func printTaskListStatus(taskListStatus *tasklistpb.TaskListStatus) {
 	taskIDBlock := taskListStatus.GetTaskIdBlock()
 
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetBorder(false)
 	table.SetColumnSeparator("|")
-	table.SetHeader([]string{"Read Level", "Ack Level", "Backlog", "Lease Start TaskId", "Lease End TaskId"})
+	table.SetHeader([]string{"Read Level", "Ack Level", "Backlog", "Rate Per Second", "Lease Start TaskId", "Lease End TaskId"})
 	table.SetHeaderLine(false)
-	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
 	table.Append([]string{strconv.FormatInt(taskListStatus.GetReadLevel(), 10),
 		strconv.FormatInt(taskListStatus.GetAckLevel(), 10),
 		strconv.FormatInt(taskListStatus.GetBacklogCountHint(), 10),
+		strconv.FormatFloat(taskListStatus.GetRatePerSecond(), 'f', -1, 64),
 		strconv.FormatInt(taskIDBlock.GetStartId(), 10),
 		strconv.FormatInt(taskIDBlock.GetEndId(), 10)})
 	table.Render()
@@ -64,6 +64,41 @@ func NewCliApp() *cli.App {
 			Usage:  "optional timeout for context of RPC call in seconds",
 			EnvVar: "TEMPORAL_CONTEXT_TIMEOUT",
 		},
+		cli.BoolFlag{
+			Name:   FlagFrontendTLS,
+			Usage:  "connect to the frontend service with TLS, implied by the other TLS flags",
+			EnvVar: "TEMPORAL_CLI_TLS",
+		},
+		cli.StringFlag{
+			Name:   FlagFrontendTLSCertPath,
+			Usage:  "path to the client certificate for mutual TLS",
+			EnvVar: "TEMPORAL_CLI_TLS_CERT",
+		},
+		cli.StringFlag{
+			Name:   FlagFrontendTLSKeyPath,
+			Usage:  "path to the client key for mutual TLS",
+			EnvVar: "TEMPORAL_CLI_TLS_KEY",
+		},
+		cli.StringFlag{
+			Name:   FlagFrontendTLSCaPath,
+			Usage:  "path to the CA certificate the frontend certificate is verified with, the system roots are used by default",
+			EnvVar: "TEMPORAL_CLI_TLS_CA",
+		},
+		cli.StringFlag{
+			Name:   FlagFrontendTLSServerName,
+			Usage:  "override of the host name the frontend certificate is verified against",
+			EnvVar: "TEMPORAL_CLI_TLS_SERVER_NAME",
+		},
+		cli.BoolFlag{
+			Name:   FlagFrontendTLSSkipHostVerification,
+			Usage:  "skip verifying the host name of the frontend certificate",
+			EnvVar: "TEMPORAL_CLI_TLS_SKIP_HOST_VERIFICATION",
+		},
+		cli.StringFlag{
+			Name:   FlagAuthToken,
+			Usage:  "bearer token sent with every call, required when the server authorizes calls with JWT",
+			EnvVar: "TEMPORAL_CLI_AUTH_TOKEN",
+		},
 	}
 	app.Commands = []cli.Command{
 		{
@@ -143,6 +178,12 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on DLQ",
 					Subcommands: newAdminDLQCommands(),
 				},
+				{
+					Name:        "migration",
+					Aliases:     []string{"mig"},
+					Usage:       "Run admin operation on workflow migration",
+					Subcommands: newAdminMigrationCommands(),
+				},
 				{
 					Name:        "db",
 					Aliases:     []string{"db"},
@@ -25,6 +25,10 @@
 package cli
 
 import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
 	"strings"
 	"testing"
 	"time"
@@ -47,9 +51,16 @@ import (
 	"go.temporal.io/temporal-proto/workflowservicemock"
 	sdkclient "go.temporal.io/temporal/client"
 	sdkmocks "go.temporal.io/temporal/mocks"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/temporalio/temporal/.gen/proto/adminservice"
 	"github.com/temporalio/temporal/.gen/proto/adminservicemock"
+	commongenpb "github.com/temporalio/temporal/.gen/proto/common"
+	eventgenpb "github.com/temporalio/temporal/.gen/proto/event"
+	executiongenpb "github.com/temporalio/temporal/.gen/proto/execution"
+	namespacegenpb "github.com/temporalio/temporal/.gen/proto/namespace"
+	"github.com/temporalio/temporal/common/headers"
 )
 
 type cliAppSuite struct {
@@ -231,6 +242,70 @@ func (s *cliAppSuite) TestNamespaceDescribe_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestNamespaceDelete() {
+	s.serverAdminClient.EXPECT().DeleteNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.DeleteNamespaceRequest, _ ...grpc.CallOption) (*adminservice.DeleteNamespaceResponse, error) {
+			s.Equal(cliTestNamespace, request.GetNamespace())
+			s.Equal("test", request.GetReason())
+			s.True(request.GetTerminateOpenExecutions())
+			s.Equal(int32(10), request.GetRps())
+			return &adminservice.DeleteNamespaceResponse{}, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "namespace", "delete",
+		"--re", "test", "--terminate_open_executions", "--rps", "10", "--yes"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestNamespaceDelete_Failed() {
+	s.serverAdminClient.EXPECT().DeleteNamespace(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewInvalidArgument("faked error"))
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "namespace", "delete", "--yes"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestNamespaceRename() {
+	s.serverAdminClient.EXPECT().RenameNamespace(gomock.Any(), &adminservice.RenameNamespaceRequest{
+		Namespace:               cliTestNamespace,
+		NewName:                 "new-namespace",
+		AliasGracePeriodSeconds: 3600,
+	}).Return(&adminservice.RenameNamespaceResponse{}, nil)
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "namespace", "rename", "--new_name", "new-namespace", "--alias_grace_period_seconds", "3600"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestNamespaceRename_Failed() {
+	s.serverAdminClient.EXPECT().RenameNamespace(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNamespaceAlreadyExists("faked error"))
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "namespace", "rename", "--new_name", "new-namespace"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestNamespaceUpdateWorkerVersionSets() {
+	s.serverAdminClient.EXPECT().UpdateWorkerVersionSets(gomock.Any(), &adminservice.UpdateWorkerVersionSetsRequest{
+		Namespace: cliTestNamespace,
+		VersionSets: []*namespacegenpb.WorkerVersionSet{
+			{BuildIds: []string{"1.0", "1.1"}},
+			{BuildIds: []string{"2.0"}},
+		},
+	}).Return(&adminservice.UpdateWorkerVersionSetsResponse{}, nil)
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "namespace", "worker-versions", "--version_sets", "1.0, 1.1;2.0"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestNamespaceUpdateWorkerVersionSets_Failed() {
+	s.serverAdminClient.EXPECT().UpdateWorkerVersionSets(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewInvalidArgument("faked error"))
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "namespace", "worker-versions", "--version_sets", "1.0;1.0"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestNamespaceDeletionStatus() {
+	s.serverAdminClient.EXPECT().DescribeNamespaceDeletion(gomock.Any(), gomock.Any()).Return(&adminservice.DescribeNamespaceDeletionResponse{
+		State:                 commongenpb.BatchOperationState_Running,
+		Stage:                 "delete_executions",
+		DeletedExecutionCount: 5,
+	}, nil)
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "namespace", "deletion-status"})
+	s.Nil(err)
+}
+
 var (
 	eventType = eventpb.EventType_WorkflowExecutionStarted
 
@@ -292,6 +367,30 @@ func (s *cliAppSuite) TestStartWorkflow() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestStartWorkflow_WithDelay() {
+	resp := &workflowservice.StartWorkflowExecutionResponse{RunId: uuid.New()}
+	s.frontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ *workflowservice.StartWorkflowExecutionRequest, _ ...grpc.CallOption) (*workflowservice.StartWorkflowExecutionResponse, error) {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			s.Equal([]string{"30"}, md.Get(headers.StartDelayHeaderName))
+			return resp, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "start", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "-w", "wid", "--delay", "30"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestStartWorkflow_WithPriority() {
+	resp := &workflowservice.StartWorkflowExecutionResponse{RunId: uuid.New()}
+	s.frontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ *workflowservice.StartWorkflowExecutionRequest, _ ...grpc.CallOption) (*workflowservice.StartWorkflowExecutionResponse, error) {
+			md, _ := metadata.FromOutgoingContext(ctx)
+			s.Equal([]string{"high"}, md.Get(headers.PriorityHeaderName))
+			return resp, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "start", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "-w", "wid", "--priority", "high"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestStartWorkflow_Failed() {
 	resp := &workflowservice.StartWorkflowExecutionResponse{RunId: uuid.New()}
 	s.frontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, serviceerror.NewInvalidArgument("faked error"))
@@ -303,28 +402,24 @@ func (s *cliAppSuite) TestStartWorkflow_Failed() {
 func (s *cliAppSuite) TestRunWorkflow() {
 	resp := &workflowservice.StartWorkflowExecutionResponse{RunId: uuid.New()}
 	s.frontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil).Times(2)
-	s.sdkClient.On("GetWorkflowHistory", mock.Anything, "wid", mock.Anything, mock.Anything, mock.Anything).Return(historyEventIterator()).Once()
+	s.expectHistoryStream("wid")
 
 	// start with wid
 	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "run", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "-w", "wid", "wrp", "2"})
 	s.Nil(err)
-	s.sdkClient.AssertExpectations(s.T())
 
-	s.sdkClient.On("GetWorkflowHistory", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(historyEventIterator()).Once()
+	s.expectHistoryStream("")
 	// start without wid
 	err = s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "run", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "wrp", "2"})
 	s.Nil(err)
-	s.sdkClient.AssertExpectations(s.T())
 }
 
 func (s *cliAppSuite) TestRunWorkflow_Failed() {
 	resp := &workflowservice.StartWorkflowExecutionResponse{RunId: uuid.New()}
 	s.frontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, serviceerror.NewInvalidArgument("faked error"))
-	s.sdkClient.On("GetWorkflowHistory", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(historyEventIterator()).Once()
 	// start with wid
 	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "workflow", "run", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "-w", "wid"})
 	s.Equal(1, errorCode)
-	s.sdkClient.AssertExpectations(s.T())
 }
 
 func (s *cliAppSuite) TestTerminateWorkflow() {
@@ -342,6 +437,162 @@ func (s *cliAppSuite) TestTerminateWorkflow_Failed() {
 	s.sdkClient.AssertExpectations(s.T())
 }
 
+func (s *cliAppSuite) TestDeleteWorkflow() {
+	s.serverAdminClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).Return(&adminservice.DeleteWorkflowExecutionResponse{}, nil)
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "delete", "-w", "wid", "--re", "cleanup"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDeleteWorkflow_Failed() {
+	s.serverAdminClient.EXPECT().DeleteWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("faked error"))
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "workflow", "delete", "-w", "wid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestExportImportWorkflow() {
+	file, err := ioutil.TempFile("", "export")
+	s.NoError(err)
+	file.Close()
+	defer os.Remove(file.Name())
+
+	execution := &executionpb.WorkflowExecution{WorkflowId: "wid", RunId: uuid.New()}
+	batches := []*eventpb.History{
+		{Events: []*eventpb.HistoryEvent{{EventId: 1, Version: 1}, {EventId: 2, Version: 1}}},
+		{Events: []*eventpb.HistoryEvent{{EventId: 3, Version: 11}}},
+	}
+	s.serverAdminClient.EXPECT().ExportWorkflowExecution(gomock.Any(), gomock.Any()).Return(&adminservice.ExportWorkflowExecutionResponse{
+		Export: &executiongenpb.WorkflowExecutionExport{
+			Namespace:      cliTestNamespace,
+			Execution:      execution,
+			HistoryBatches: batches[:1],
+		},
+		NextPageToken: []byte{1},
+	}, nil)
+	s.serverAdminClient.EXPECT().ExportWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.ExportWorkflowExecutionRequest, _ ...grpc.CallOption) (*adminservice.ExportWorkflowExecutionResponse, error) {
+			s.Equal(execution, request.GetExecution())
+			s.Equal([]byte{1}, request.GetNextPageToken())
+			return &adminservice.ExportWorkflowExecutionResponse{
+				Export: &executiongenpb.WorkflowExecutionExport{HistoryBatches: batches[1:]},
+			}, nil
+		})
+	err = s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "export", "-w", "wid", "--of", file.Name()})
+	s.Nil(err)
+
+	s.serverAdminClient.EXPECT().ImportWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.ImportWorkflowExecutionRequest, _ ...grpc.CallOption) (*adminservice.ImportWorkflowExecutionResponse, error) {
+			s.Equal("target-namespace", request.GetNamespace())
+			s.Equal(execution, request.GetExecution())
+			s.Equal([]*eventgenpb.VersionHistoryItem{{EventId: 2, Version: 1}, {EventId: 3, Version: 11}}, request.GetVersionHistoryItems())
+			s.Len(request.GetHistoryBatches(), 1)
+			s.True(request.GetPaused())
+			return &adminservice.ImportWorkflowExecutionResponse{}, nil
+		}).Times(2)
+	err = s.app.Run([]string{"", "--ns", "target-namespace", "admin", "wf", "import", "--if", file.Name(), "--bs", "1", "--paused"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestExportWorkflow_Failed() {
+	s.serverAdminClient.EXPECT().ExportWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound("faked error"))
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "workflow", "export", "-w", "wid", "--of", "export.json"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestPauseWorkflow() {
+	s.serverAdminClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(&adminservice.PauseWorkflowExecutionResponse{}, nil)
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "pause", "-w", "wid", "--re", "investigating"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestPauseWorkflow_Failed() {
+	s.serverAdminClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewInvalidArgument("faked error"))
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "workflow", "pause", "-w", "wid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestUpdateWorkflow() {
+	s.serverAdminClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.UpdateWorkflowExecutionRequest, _ ...grpc.CallOption) (*adminservice.UpdateWorkflowExecutionResponse, error) {
+			s.Equal("setPrice", request.GetName())
+			s.Equal([]byte("10"), request.GetInput())
+			s.NotEmpty(request.GetUpdateId())
+			return &adminservice.UpdateWorkflowExecutionResponse{UpdateId: request.GetUpdateId(), Result: []byte("true")}, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "update", "-w", "wid", "-n", "setPrice", "-i", "10"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUpdateWorkflow_Rejected() {
+	s.serverAdminClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(&adminservice.UpdateWorkflowExecutionResponse{FailureMessage: "price must be positive"}, nil)
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "workflow", "update", "-w", "wid", "-n", "setPrice", "-i", "0"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestUnpauseWorkflow() {
+	s.serverAdminClient.EXPECT().UnpauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(&adminservice.UnpauseWorkflowExecutionResponse{}, nil)
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "unpause", "-w", "wid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestStartWorkflow_WithCronOptions() {
+	resp := &workflowservice.StartWorkflowExecutionResponse{RunId: uuid.New()}
+	s.frontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *workflowservice.StartWorkflowExecutionRequest, _ ...grpc.CallOption) (*workflowservice.StartWorkflowExecutionResponse, error) {
+			s.Equal("CRON_TZ=Europe/Berlin CRON_JITTER=5m CRON_OVERLAP=buffer_one 0 10 * * *", request.GetCronSchedule())
+			return resp, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "start", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "-w", "wid",
+		"--cron", "0 10 * * *", "--cron_tz", "Europe/Berlin", "--cron_jitter", "5m", "--cron_overlap", "buffer_one"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestTriggerCronRun() {
+	s.serverAdminClient.EXPECT().TriggerCronRun(gomock.Any(), gomock.Any()).Return(&adminservice.TriggerCronRunResponse{}, nil)
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "trigger-cron", "-w", "wid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestSkipCronRuns() {
+	s.serverAdminClient.EXPECT().SkipCronRuns(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.SkipCronRunsRequest, _ ...grpc.CallOption) (*adminservice.SkipCronRunsResponse, error) {
+			s.Equal(int32(3), request.GetCount())
+			return &adminservice.SkipCronRunsResponse{NextRunTime: time.Now().UnixNano()}, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "skip-cron", "-w", "wid", "--count", "3"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestSkipCronRuns_InvalidCount() {
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "workflow", "skip-cron", "-w", "wid", "--count", "0"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestUpdateActivityOptions() {
+	s.serverAdminClient.EXPECT().UpdateActivityOptions(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.UpdateActivityOptionsRequest, _ ...grpc.CallOption) (*adminservice.UpdateActivityOptionsResponse, error) {
+			s.Equal("aid", request.GetActivityId())
+			s.Equal(int32(600), request.GetStartToCloseTimeoutSeconds())
+			s.Equal("slow-tl", request.GetTaskList())
+			s.Equal(int32(5), request.GetRetryPolicy().GetInitialIntervalInSeconds())
+			s.Equal([]string{"a", "b"}, request.GetRetryPolicy().GetNonRetriableErrorReasons())
+			return &adminservice.UpdateActivityOptionsResponse{}, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "activity", "update-options", "-w", "wid", "-aid", "aid",
+		"--start_to_close_timeout", "600", "--tl", "slow-tl", "--retry_initial_interval", "5", "--retry_non_retriable_errors", "a, b"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestResetActivity() {
+	s.serverAdminClient.EXPECT().ResetActivity(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.ResetActivityRequest, _ ...grpc.CallOption) (*adminservice.ResetActivityResponse, error) {
+			s.Equal("aid", request.GetActivityId())
+			s.True(request.GetResetAttempts())
+			return &adminservice.ResetActivityResponse{}, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "activity", "reset", "-w", "wid", "-aid", "aid", "--reset_attempts"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestCancelWorkflow() {
 	s.sdkClient.On("CancelWorkflow", mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "cancel", "-w", "wid"})
@@ -510,6 +761,51 @@ func (s *cliAppSuite) TestCountWorkflow() {
 	s.sdkClient.AssertExpectations(s.T())
 }
 
+func (s *cliAppSuite) TestStartBatchJob() {
+	s.sdkClient.On("CountWorkflow", mock.Anything, mock.Anything).Return(&workflowservice.CountWorkflowExecutionsResponse{Count: 10}, nil).Once()
+	s.serverAdminClient.EXPECT().StartBatchOperation(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.StartBatchOperationRequest, _ ...grpc.CallOption) (*adminservice.StartBatchOperationResponse, error) {
+			s.Equal(cliTestNamespace, request.GetNamespace())
+			s.Equal("terminate", request.GetBatchType())
+			s.Equal("WorkflowType = 'test'", request.GetQuery())
+			s.Equal(int32(10), request.GetRps())
+			return &adminservice.StartBatchOperationResponse{JobId: "jid"}, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "batch", "start", "-q", "WorkflowType = 'test'",
+		"--re", "test", "--bt", "terminate", "--rps", "10", "--yes"})
+	s.Nil(err)
+	s.sdkClient.AssertExpectations(s.T())
+}
+
+func (s *cliAppSuite) TestDescribeBatchJob() {
+	s.serverAdminClient.EXPECT().DescribeBatchOperation(gomock.Any(), gomock.Any()).Return(&adminservice.DescribeBatchOperationResponse{
+		OperationInfo: &adminservice.BatchOperationInfo{
+			JobId:                  "jid",
+			State:                  commongenpb.BatchOperationState_Running,
+			TotalOperationCount:    10,
+			CompleteOperationCount: 5,
+		},
+	}, nil)
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "batch", "describe", "--jid", "jid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestListBatchJobs() {
+	s.serverAdminClient.EXPECT().ListBatchOperations(gomock.Any(), gomock.Any()).Return(&adminservice.ListBatchOperationsResponse{
+		OperationInfo: []*adminservice.BatchOperationInfo{
+			{JobId: "jid", State: commongenpb.BatchOperationState_Completed},
+		},
+	}, nil)
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "batch", "list"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestTerminateBatchJob() {
+	s.serverAdminClient.EXPECT().StopBatchOperation(gomock.Any(), gomock.Any()).Return(&adminservice.StopBatchOperationResponse{}, nil)
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "batch", "terminate", "--jid", "jid", "--re", "test"})
+	s.Nil(err)
+}
+
 var describeTaskListResponse = &workflowservice.DescribeTaskListResponse{
 	Pollers: []*tasklistpb.PollerInfo{
 		{
@@ -542,6 +838,40 @@ func (s *cliAppSuite) TestAdminAddSearchAttribute() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestAdminStartMigration() {
+	s.serverAdminClient.EXPECT().StartMigration(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.StartMigrationRequest, _ ...grpc.CallOption) (*adminservice.StartMigrationResponse, error) {
+			s.Equal("standby", request.GetSourceCluster())
+			s.Equal("source-namespace", request.GetSourceNamespace())
+			s.Equal(cliTestNamespace, request.GetTargetNamespace())
+			s.Equal("wid", request.GetExecution().GetWorkflowId())
+			s.Equal("test", request.GetReason())
+			s.Equal(int32(10), request.GetRps())
+			return &adminservice.StartMigrationResponse{MigrationId: "mid"}, nil
+		})
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "admin", "mig", "start", "--source_cluster", "standby",
+		"--source_namespace", "source-namespace", "-w", "wid", "--re", "test", "--rps", "10"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestAdminStartMigration_Failed() {
+	s.serverAdminClient.EXPECT().StartMigration(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewInvalidArgument("faked error"))
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "admin", "mig", "start",
+		"--source_namespace", "source-namespace", "--re", "test"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminDescribeMigration() {
+	s.serverAdminClient.EXPECT().DescribeMigration(gomock.Any(), &adminservice.DescribeMigrationRequest{MigrationId: "mid"}).Return(&adminservice.DescribeMigrationResponse{
+		State:                  commongenpb.BatchOperationState_Running,
+		SourceNamespace:        "source-namespace",
+		TargetNamespace:        cliTestNamespace,
+		MigratedExecutionCount: 5,
+	}, nil)
+	err := s.app.Run([]string{"", "admin", "mig", "describe", "--mid", "mid"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestDescribeTaskList() {
 	s.sdkClient.On("DescribeTaskList", mock.Anything, mock.Anything, mock.Anything).Return(describeTaskListResponse, nil).Once()
 	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "tasklist", "describe", "-tl", "test-taskList"})
@@ -556,28 +886,56 @@ func (s *cliAppSuite) TestDescribeTaskList_Activity() {
 	s.sdkClient.AssertExpectations(s.T())
 }
 
+func (s *cliAppSuite) TestUpdateTaskListRateLimit() {
+	s.serverAdminClient.EXPECT().UpdateTaskListRateLimit(gomock.Any(), &adminservice.UpdateTaskListRateLimitRequest{
+		Namespace:         cliTestNamespace,
+		TaskList:          &tasklistpb.TaskList{Name: "test-taskList"},
+		TaskListType:      tasklistpb.TaskListType_Activity,
+		MaxTasksPerSecond: 2.5,
+	}).Return(&adminservice.UpdateTaskListRateLimitResponse{}, nil)
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "tasklist", "update-ratelimit", "-tl", "test-taskList", "-tlt", "activity", "--rps", "2.5"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUpdateTaskListRateLimit_Failed() {
+	s.serverAdminClient.EXPECT().UpdateTaskListRateLimit(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewInvalidArgument("faked error"))
+	errorCode := s.RunErrorExitCode([]string{"", "--ns", cliTestNamespace, "tasklist", "update-ratelimit", "-tl", "test-taskList", "--rps", "10"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestAdminDescribeTaskListVersions() {
+	s.serverAdminClient.EXPECT().DescribeTaskListVersions(gomock.Any(), &adminservice.DescribeTaskListVersionsRequest{
+		Namespace:    cliTestNamespace,
+		TaskList:     &tasklistpb.TaskList{Name: "test-taskList"},
+		TaskListType: tasklistpb.TaskListType_Decision,
+	}).Return(&adminservice.DescribeTaskListVersionsResponse{
+		VersionSets: []*namespacegenpb.WorkerVersionSet{{BuildIds: []string{"1.0"}}},
+		Pollers: []*adminservice.WorkerVersionPollers{
+			{BuildId: "1.0", Pollers: describeTaskListResponse.Pollers},
+		},
+	}, nil)
+	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "admin", "tl", "describe-versions", "-tl", "test-taskList"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestObserveWorkflow() {
-	s.sdkClient.On("GetWorkflowHistory", mock.Anything, "wid", "", mock.Anything, mock.Anything).Return(historyEventIterator()).Once()
+	s.expectHistoryStream("wid")
 	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "observe", "-w", "wid"})
 	s.Nil(err)
-	s.sdkClient.AssertExpectations(s.T())
 
-	s.sdkClient.On("GetWorkflowHistory", mock.Anything, "wid", "", mock.Anything, mock.Anything).Return(historyEventIterator()).Once()
+	s.expectHistoryStream("wid")
 	err = s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "observe", "-w", "wid", "-sd"})
 	s.Nil(err)
-	s.sdkClient.AssertExpectations(s.T())
 }
 
 func (s *cliAppSuite) TestObserveWorkflowWithID() {
-	s.sdkClient.On("GetWorkflowHistory", mock.Anything, "wid", "", mock.Anything, mock.Anything).Return(historyEventIterator()).Once()
+	s.expectHistoryStream("wid")
 	err := s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "observeid", "wid"})
 	s.Nil(err)
-	s.sdkClient.AssertExpectations(s.T())
 
-	s.sdkClient.On("GetWorkflowHistory", mock.Anything, "wid", "", mock.Anything, mock.Anything).Return(historyEventIterator()).Once()
+	s.expectHistoryStream("wid")
 	err = s.app.Run([]string{"", "--ns", cliTestNamespace, "workflow", "observeid", "wid", "-sd"})
 	s.Nil(err)
-	s.sdkClient.AssertExpectations(s.T())
 }
 
 // TestParseTime tests the parsing of date argument in UTC and UnixNano formats
@@ -882,6 +1240,37 @@ func (s *cliAppSuite) TestConvertArray() {
 	}
 }
 
+// expectHistoryStream expects the history of the workflow to be streamed, any workflow if wid is empty
+func (s *cliAppSuite) expectHistoryStream(wid string) {
+	stream := adminservicemock.NewMockAdminService_StreamWorkflowExecutionHistoryClient(s.mockCtrl)
+	gomock.InOrder(
+		stream.EXPECT().Recv().Return(&adminservice.StreamWorkflowExecutionHistoryResponse{
+			Events: []*eventpb.HistoryEvent{
+				{
+					EventId:   1,
+					EventType: eventType,
+					Attributes: &eventpb.HistoryEvent_WorkflowExecutionStartedEventAttributes{WorkflowExecutionStartedEventAttributes: &eventpb.WorkflowExecutionStartedEventAttributes{
+						WorkflowType:                        &commonpb.WorkflowType{Name: "TestWorkflow"},
+						TaskList:                            &tasklistpb.TaskList{Name: "taskList"},
+						ExecutionStartToCloseTimeoutSeconds: 60,
+						TaskStartToCloseTimeoutSeconds:      10,
+						Identity:                            "tester",
+					}},
+				},
+			},
+		}, nil),
+		stream.EXPECT().Recv().Return(nil, io.EOF),
+	)
+	s.serverAdminClient.EXPECT().StreamWorkflowExecutionHistory(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *adminservice.StreamWorkflowExecutionHistoryRequest, _ ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowExecutionHistoryClient, error) {
+			s.Equal(cliTestNamespace, request.GetNamespace())
+			if wid != "" {
+				s.Equal(wid, request.GetExecution().GetWorkflowId())
+			}
+			return stream, nil
+		})
+}
+
 func historyEventIterator() sdkclient.HistoryEventIterator {
 	iteratorMock := &sdkmocks.HistoryEventIterator{}
 
@@ -30,8 +30,11 @@ import (
 	sdkclient "go.temporal.io/temporal/client"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
 	"github.com/temporalio/temporal/.gen/proto/adminservice"
+	"github.com/temporalio/temporal/common/auth"
+	"github.com/temporalio/temporal/common/authorization"
 	"github.com/temporalio/temporal/common/rpc"
 )
 
@@ -60,14 +63,14 @@ func NewClientFactory() ClientFactory {
 
 // FrontendClient builds a frontend client
 func (b *clientFactory) FrontendClient(c *cli.Context) workflowservice.WorkflowServiceClient {
-	connection := b.createGRPCConnection(c.GlobalString(FlagAddress))
+	connection := b.createGRPCConnection(c)
 
 	return workflowservice.NewWorkflowServiceClient(connection)
 }
 
 // AdminClient builds an admin client (based on server side thrift interface)
 func (b *clientFactory) AdminClient(c *cli.Context) adminservice.AdminServiceClient {
-	connection := b.createGRPCConnection(c.GlobalString(FlagAddress))
+	connection := b.createGRPCConnection(c)
 
 	return adminservice.NewAdminServiceClient(connection)
 }
@@ -80,8 +83,9 @@ func (b *clientFactory) SDKClient(c *cli.Context, namespace string) sdkclient.Cl
 	}
 
 	sdkClient, err := sdkclient.NewClient(sdkclient.Options{
-		HostPort:  hostPort,
-		Namespace: namespace,
+		HostPort:   hostPort,
+		Namespace:  namespace,
+		GRPCDialer: rpc.NewSDKDialer(b.getTransportCredentials(c), b.getCallOptions(c)...),
 	})
 	if err != nil {
 		b.logger.Fatal("Failed to create SDK client", zap.Error(err))
@@ -90,12 +94,13 @@ func (b *clientFactory) SDKClient(c *cli.Context, namespace string) sdkclient.Cl
 	return sdkClient
 }
 
-func (b *clientFactory) createGRPCConnection(hostPort string) *grpc.ClientConn {
+func (b *clientFactory) createGRPCConnection(c *cli.Context) *grpc.ClientConn {
+	hostPort := c.GlobalString(FlagAddress)
 	if hostPort == "" {
 		hostPort = localHostPort
 	}
 
-	connection, err := rpc.Dial(hostPort)
+	connection, err := rpc.Dial(hostPort, b.getTransportCredentials(c), b.getCallOptions(c)...)
 	if err != nil {
 		b.logger.Fatal("Failed to create connection", zap.Error(err))
 		return nil
@@ -103,3 +108,35 @@ func (b *clientFactory) createGRPCConnection(hostPort string) *grpc.ClientConn {
 
 	return connection
 }
+
+// getCallOptions returns the dial options which attach the auth token to every call
+func (b *clientFactory) getCallOptions(c *cli.Context) []grpc.DialOption {
+	token := c.GlobalString(FlagAuthToken)
+	if token == "" {
+		return nil
+	}
+	return rpc.PerRPCCredentialsOptions(authorization.NewTokenCredentials(token))
+}
+
+func (b *clientFactory) getTransportCredentials(c *cli.Context) credentials.TransportCredentials {
+	tlsConfig := &auth.ClientTLS{
+		CertFile:                c.GlobalString(FlagFrontendTLSCertPath),
+		KeyFile:                 c.GlobalString(FlagFrontendTLSKeyPath),
+		ServerName:              c.GlobalString(FlagFrontendTLSServerName),
+		DisableHostVerification: c.GlobalBool(FlagFrontendTLSSkipHostVerification),
+	}
+	if caFile := c.GlobalString(FlagFrontendTLSCaPath); caFile != "" {
+		tlsConfig.RootCAFiles = []string{caFile}
+	}
+	tlsConfig.Enabled = c.GlobalBool(FlagFrontendTLS) ||
+		tlsConfig.CertFile != "" ||
+		len(tlsConfig.RootCAFiles) > 0 ||
+		tlsConfig.ServerName != "" ||
+		tlsConfig.DisableHostVerification
+
+	creds, err := rpc.NewClientCredentials(tlsConfig, 0)
+	if err != nil {
+		b.logger.Fatal("Failed to load TLS config", zap.Error(err))
+	}
+	return creds
+}
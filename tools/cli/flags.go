@@ -59,6 +59,12 @@ const (
 	FlagWorkflowIDReusePolicy             = "workflowidreusepolicy"
 	FlagWorkflowIDReusePolicyAlias        = FlagWorkflowIDReusePolicy + ", wrp"
 	FlagCronSchedule                      = "cron"
+	FlagStartDelay                        = "delay"
+	FlagPriority                          = "priority"
+	FlagCronTimeZone                      = "cron_tz"
+	FlagCronJitter                        = "cron_jitter"
+	FlagCronOverlapPolicy                 = "cron_overlap"
+	FlagCronSkipCount                     = "count"
 	FlagWorkflowType                      = "workflow_type"
 	FlagWorkflowTypeWithAlias             = FlagWorkflowType + ", wt"
 	FlagWorkflowStatus                    = "status"
@@ -144,6 +150,7 @@ const (
 	FlagQueryTypeWithAlias                = FlagQueryType + ", qt"
 	FlagQueryRejectCondition              = "query_reject_condition"
 	FlagQueryRejectConditionWithAlias     = FlagQueryRejectCondition + ", qrc"
+	FlagUpdateID                          = "update_id"
 	FlagQueryConsistencyLevel             = "query_consistency_level"
 	FlagQueryConsistencyLevelWithAlias    = FlagQueryConsistencyLevel + ", qcl"
 	FlagShowDetail                        = "show_detail"
@@ -160,6 +167,17 @@ const (
 	FlagEventIDWithAlias                  = FlagEventID + ", eid"
 	FlagActivityID                        = "activity_id"
 	FlagActivityIDWithAlias               = FlagActivityID + ", aid"
+	FlagScheduleToStartTimeout            = "schedule_to_start_timeout"
+	FlagScheduleToCloseTimeout            = "schedule_to_close_timeout"
+	FlagStartToCloseTimeout               = "start_to_close_timeout"
+	FlagHeartbeatTimeout                  = "heartbeat_timeout"
+	FlagRetryInitialInterval              = "retry_initial_interval"
+	FlagRetryBackoffCoefficient           = "retry_backoff_coefficient"
+	FlagRetryMaximumInterval              = "retry_maximum_interval"
+	FlagRetryMaximumAttempts              = "retry_maximum_attempts"
+	FlagRetryExpirationInterval           = "retry_expiration_interval"
+	FlagRetryNonRetriableErrors           = "retry_non_retriable_errors"
+	FlagResetAttempts                     = "reset_attempts"
 	FlagMaxFieldLength                    = "max_field_length"
 	FlagMaxFieldLengthWithAlias           = FlagMaxFieldLength + ", maxl"
 	FlagSecurityToken                     = "security_token"
@@ -200,6 +218,10 @@ const (
 	FlagJobID                             = "job_id"
 	FlagJobIDWithAlias                    = FlagJobID + ", jid"
 	FlagYes                               = "yes"
+	FlagTerminateOpenExecutions           = "terminate_open_executions"
+	FlagNewName                           = "new_name"
+	FlagAliasGracePeriodSeconds           = "alias_grace_period_seconds"
+	FlagWorkerVersionSets                 = "version_sets"
 	FlagServiceConfigDir                  = "service_config_dir"
 	FlagServiceConfigDirWithAlias         = FlagServiceConfigDir + ", scd"
 	FlagServiceEnv                        = "service_env"
@@ -211,6 +233,14 @@ const (
 	FlagTLSKeyPath                        = "tls_key_path"
 	FlagTLSCaPath                         = "tls_ca_path"
 	FlagTLSEnableHostVerification         = "tls_enable_host_verification"
+	FlagEncryptionKeyFile                 = "encryption_key_file"
+	FlagFrontendTLS                       = "frontend_tls"
+	FlagFrontendTLSCertPath               = "frontend_tls_cert_path"
+	FlagFrontendTLSKeyPath                = "frontend_tls_key_path"
+	FlagFrontendTLSCaPath                 = "frontend_tls_ca_path"
+	FlagFrontendTLSServerName             = "frontend_tls_server_name"
+	FlagFrontendTLSSkipHostVerification   = "frontend_tls_skip_host_verification"
+	FlagAuthToken                         = "auth_token"
 	FlagDLQType                           = "dlq_type"
 	FlagDLQTypeWithAlias                  = FlagDLQType + ", dt"
 	FlagMaxMessageCount                   = "max_message_count"
@@ -222,6 +252,11 @@ const (
 	FlagLowerShardBound                   = "lower_shard_bound"
 	FlagUpperShardBound                   = "upper_shard_bound"
 	FlagInputDirectory                    = "input_directory"
+	FlagSourceCluster                     = "source_cluster"
+	FlagSourceNamespace                   = "source_namespace"
+	FlagMigrationID                       = "migration_id"
+	FlagMigrationIDWithAlias              = FlagMigrationID + ", mid"
+	FlagPaused                            = "paused"
 )
 
 var flagsForExecution = []cli.Flag{
@@ -311,6 +346,27 @@ func getFlagsForStart() []cli.Flag {
 				"\t│ │ │ │ │ \n" +
 				"\t* * * * *",
 		},
+		cli.StringFlag{
+			Name:  FlagCronTimeZone,
+			Usage: "Optional time zone of the cron schedule, e.g. Europe/Berlin. UTC is used by default",
+		},
+		cli.StringFlag{
+			Name:  FlagCronJitter,
+			Usage: "Optional maximum random delay added to each cron run, e.g. 30s or 5m",
+		},
+		cli.StringFlag{
+			Name: FlagCronOverlapPolicy,
+			Usage: "Optional policy when a cron run is still running at the next schedule. " +
+				"Available options: skip (default), buffer_one, cancel_previous, terminate_previous",
+		},
+		cli.IntFlag{
+			Name:  FlagStartDelay,
+			Usage: "Optional delay in seconds before the first decision task of the workflow is scheduled",
+		},
+		cli.StringFlag{
+			Name:  FlagPriority,
+			Usage: "Optional priority of the workflow tasks. Available options: high, normal (default), low",
+		},
 		cli.IntFlag{
 			Name: FlagWorkflowIDReusePolicyAlias,
 			Usage: "Optional input to configure if the same workflow Id is allow to use for new workflow execution. " +
@@ -104,5 +104,41 @@ func newNamespaceCommands() []cli.Command {
 				newNamespaceCLI(c, false).ListNamespaces(c)
 			},
 		},
+		{
+			Name:    "delete",
+			Aliases: []string{"del"},
+			Usage:   "Delete workflow namespace along with all its workflow executions, history and task lists",
+			Flags:   deleteNamespaceFlags,
+			Action: func(c *cli.Context) {
+				DeleteNamespace(c)
+			},
+		},
+		{
+			Name:    "deletion-status",
+			Aliases: []string{"ds"},
+			Usage:   "Describe the progress of the deletion of workflow namespace",
+			Flags:   describeNamespaceDeletionFlags,
+			Action: func(c *cli.Context) {
+				DescribeNamespaceDeletion(c)
+			},
+		},
+		{
+			Name:    "rename",
+			Aliases: []string{"rn"},
+			Usage:   "Rename workflow namespace, keeping its ID and all its workflow executions",
+			Flags:   renameNamespaceFlags,
+			Action: func(c *cli.Context) {
+				RenameNamespace(c)
+			},
+		},
+		{
+			Name:    "worker-versions",
+			Aliases: []string{"wv"},
+			Usage:   "Update the sets of compatible worker build IDs decision tasks of workflow namespace are routed by",
+			Flags:   updateWorkerVersionSetsFlags,
+			Action: func(c *cli.Context) {
+				UpdateWorkerVersionSets(c)
+			},
+		},
 	}
 }
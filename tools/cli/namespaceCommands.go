@@ -25,11 +25,13 @@
 package cli
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/types"
@@ -40,6 +42,9 @@ import (
 	"go.temporal.io/temporal-proto/serviceerror"
 	"go.temporal.io/temporal-proto/workflowservice"
 
+	"github.com/temporalio/temporal/.gen/proto/adminservice"
+	commongenpb "github.com/temporalio/temporal/.gen/proto/common"
+	namespacegenpb "github.com/temporalio/temporal/.gen/proto/namespace"
 	"github.com/temporalio/temporal/common/namespace"
 )
 
@@ -385,6 +390,119 @@ func (d *namespaceCLIImpl) getAllNamespaces(c *cli.Context) []*workflowservice.D
 	return res
 }
 
+// DeleteNamespace starts the deletion of a namespace
+func DeleteNamespace(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	if !c.Bool(FlagYes) {
+		fmt.Printf("All workflow executions, history and task lists of namespace %s will be deleted permanently.\n", namespace)
+		fmt.Print("Please type the namespace name to confirm:")
+		reader := bufio.NewReader(os.Stdin)
+		text, err := reader.ReadString('\n')
+		if err != nil {
+			ErrorAndExit("Failed to get confirmation for deleting the namespace.", err)
+		}
+		if strings.TrimSpace(text) != namespace {
+			fmt.Println("Namespace deletion is canceled.")
+			return
+		}
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.DeleteNamespace(ctx, &adminservice.DeleteNamespaceRequest{
+		Namespace:               namespace,
+		Reason:                  c.String(FlagReason),
+		Identity:                getCliIdentity(),
+		TerminateOpenExecutions: c.Bool(FlagTerminateOpenExecutions),
+		Rps:                     int32(c.Int(FlagRPS)),
+	})
+	if err != nil {
+		ErrorAndExit("Operation DeleteNamespace failed.", err)
+	}
+	fmt.Printf("Deletion of namespace %s started, use 'namespace deletion-status' to check its progress.\n", namespace)
+}
+
+// DescribeNamespaceDeletion describes the progress of the deletion of a namespace
+func DescribeNamespaceDeletion(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.DescribeNamespaceDeletion(ctx, &adminservice.DescribeNamespaceDeletionRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		ErrorAndExit("Operation DescribeNamespaceDeletion failed.", err)
+	}
+
+	output := map[string]interface{}{}
+	switch resp.GetState() {
+	case commongenpb.BatchOperationState_Running:
+		output["msg"] = "namespace deletion is running"
+	case commongenpb.BatchOperationState_Completed:
+		output["msg"] = "namespace is deleted"
+	default:
+		output["msg"] = "namespace deletion stopped status: " + resp.GetState().String() + ", run 'namespace delete' again to resume it"
+	}
+	output["stage"] = resp.GetStage()
+	output["progress"] = map[string]int64{
+		"terminatedExecutions": resp.GetTerminatedExecutionCount(),
+		"deletedExecutions":    resp.GetDeletedExecutionCount(),
+		"deletedTaskLists":     resp.GetDeletedTaskListCount(),
+	}
+	prettyPrintJSONObject(output)
+}
+
+// RenameNamespace renames a namespace
+func RenameNamespace(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	newName := getRequiredOption(c, FlagNewName)
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.RenameNamespace(ctx, &adminservice.RenameNamespaceRequest{
+		Namespace:               namespace,
+		NewName:                 newName,
+		AliasGracePeriodSeconds: int32(c.Int(FlagAliasGracePeriodSeconds)),
+	})
+	if err != nil {
+		ErrorAndExit("Operation RenameNamespace failed.", err)
+	}
+	fmt.Printf("Namespace %s successfully renamed to %s.\n", namespace, newName)
+}
+
+// UpdateWorkerVersionSets updates the worker version sets of a namespace
+func UpdateWorkerVersionSets(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+
+	var versionSets []*namespacegenpb.WorkerVersionSet
+	for _, versionSetStr := range strings.Split(c.String(FlagWorkerVersionSets), ";") {
+		if strings.TrimSpace(versionSetStr) == "" {
+			continue
+		}
+		versionSet := &namespacegenpb.WorkerVersionSet{}
+		for _, buildID := range strings.Split(versionSetStr, ",") {
+			versionSet.BuildIds = append(versionSet.BuildIds, strings.TrimSpace(buildID))
+		}
+		versionSets = append(versionSets, versionSet)
+	}
+
+	adminClient := cFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.UpdateWorkerVersionSets(ctx, &adminservice.UpdateWorkerVersionSetsRequest{
+		Namespace:   namespace,
+		VersionSets: versionSets,
+	})
+	if err != nil {
+		ErrorAndExit("Operation UpdateWorkerVersionSets failed.", err)
+	}
+	fmt.Printf("Worker version sets of namespace %s successfully updated.\n", namespace)
+}
+
 func (d *namespaceCLIImpl) listNamespaces(
 	ctx context.Context,
 	request *workflowservice.ListNamespacesRequest,
@@ -177,6 +177,46 @@ var (
 
 	listNamespacesFlags = []cli.Flag{}
 
+	deleteNamespaceFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagReasonWithAlias,
+			Usage: "Reason of deleting the namespace, also recorded on the terminated workflow executions",
+		},
+		cli.BoolFlag{
+			Name:  FlagTerminateOpenExecutions,
+			Usage: "Terminate open workflow executions, otherwise the deletion waits for them to close",
+		},
+		cli.IntFlag{
+			Name:  FlagRPS,
+			Usage: "RPS of deleting workflow executions and task lists",
+		},
+		cli.BoolFlag{
+			Name:  FlagYes,
+			Usage: "Optional flag to delete the namespace without confirmation",
+		},
+	}
+
+	describeNamespaceDeletionFlags = []cli.Flag{}
+
+	renameNamespaceFlags = []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagNewName,
+			Usage: "New name of the namespace",
+		},
+		cli.IntFlag{
+			Name:  FlagAliasGracePeriodSeconds,
+			Usage: "Optional period in seconds the old name keeps resolving to the namespace",
+		},
+	}
+
+	updateWorkerVersionSetsFlags = []cli.Flag{
+		cli.StringFlag{
+			Name: FlagWorkerVersionSets,
+			Usage: "Sets of compatible worker build IDs from the oldest to the newest, separated by semicolons, " +
+				"with comma separated build IDs, e.g. \"1.0,1.1;2.0\". Empty to turn build ID routing off",
+		},
+	}
+
 	adminNamespaceCommonFlags = []cli.Flag{
 		cli.StringFlag{
 			Name:  FlagServiceConfigDirWithAlias,
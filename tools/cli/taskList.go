@@ -61,5 +61,28 @@ func newTaskListCommands() []cli.Command {
 				ListTaskListPartitions(c)
 			},
 		},
+		{
+			Name:    "update-ratelimit",
+			Aliases: []string{"ur"},
+			Usage:   "Update the rate at which tasks of tasklist are dispatched across all its partitions, overriding the rate set by workers",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList description",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListTypeWithAlias,
+					Value: "decision",
+					Usage: "Optional TaskList type [decision|activity]",
+				},
+				cli.Float64Flag{
+					Name:  FlagRPS,
+					Usage: "Tasks dispatched per second, 0 to let workers set the rate again",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UpdateTaskListRateLimit(c)
+			},
+		},
 	}
 }
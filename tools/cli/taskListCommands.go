@@ -25,6 +25,7 @@
 package cli
 
 import (
+	"fmt"
 	"os"
 
 	tasklistpb "go.temporal.io/temporal-proto/tasklist"
@@ -32,6 +33,8 @@ import (
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
+
+	"github.com/temporalio/temporal/.gen/proto/adminservice"
 )
 
 // DescribeTaskList show pollers info of a given tasklist
@@ -68,6 +71,31 @@ func DescribeTaskList(c *cli.Context) {
 	table.Render()
 }
 
+// UpdateTaskListRateLimit updates the dispatch rate limit of a tasklist
+func UpdateTaskListRateLimit(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	taskList := getRequiredOption(c, FlagTaskList)
+	taskListType := strToTaskListType(c.String(FlagTaskListType)) // default type is decision
+	if !c.IsSet(FlagRPS) {
+		ErrorAndExit(fmt.Sprintf("Option %s is required", FlagRPS), nil)
+	}
+	rps := c.Float64(FlagRPS)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.UpdateTaskListRateLimit(ctx, &adminservice.UpdateTaskListRateLimitRequest{
+		Namespace:         namespace,
+		TaskList:          &tasklistpb.TaskList{Name: taskList},
+		TaskListType:      taskListType,
+		MaxTasksPerSecond: rps,
+	})
+	if err != nil {
+		ErrorAndExit("Operation UpdateTaskListRateLimit failed.", err)
+	}
+	fmt.Printf("Rate limit of tasklist %s successfully updated.\n", taskList)
+}
+
 // ListTaskListPartitions gets all the tasklist partition and host information.
 func ListTaskListPartitions(c *cli.Context) {
 	frontendClient := cFactory.FrontendClient(c)
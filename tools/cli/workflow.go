@@ -112,6 +112,39 @@ func newWorkflowCommands() []cli.Command {
 				SignalWorkflow(c)
 			},
 		},
+		{
+			Name:  "update",
+			Usage: "update a workflow execution and wait for the workflow to return the result",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name:  FlagNameWithAlias,
+					Usage: "UpdateName",
+				},
+				cli.StringFlag{
+					Name:  FlagInputWithAlias,
+					Usage: "Input for the update, in JSON format.",
+				},
+				cli.StringFlag{
+					Name:  FlagInputFileWithAlias,
+					Usage: "Input for the update from JSON file.",
+				},
+				cli.StringFlag{
+					Name:  FlagUpdateID,
+					Usage: "Optional id of the update, retrying with the same id waits for the update already in progress or returns its recorded result",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UpdateWorkflow(c)
+			},
+		},
 		{
 			Name:    "terminate",
 			Aliases: []string{"term"},
@@ -134,6 +167,135 @@ func newWorkflowCommands() []cli.Command {
 				TerminateWorkflow(c)
 			},
 		},
+		{
+			Name:    "delete",
+			Aliases: []string{"del"},
+			Usage:   "delete a workflow execution along with its history and visibility records, a running workflow execution is terminated first",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to terminate the workflow if it is running",
+				},
+			},
+			Action: func(c *cli.Context) {
+				DeleteWorkflow(c)
+			},
+		},
+		{
+			Name:  "export",
+			Usage: "export a workflow execution with its history and mutable state summary to a file, which can be imported by 'admin workflow import'",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId, default to the current run",
+				},
+				cli.StringFlag{
+					Name:  FlagOutputFilenameWithAlias,
+					Usage: "Output file",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 100,
+					Usage: "Number of history batches read per request",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ExportWorkflow(c)
+			},
+		},
+		{
+			Name:  "pause",
+			Usage: "pause a workflow execution, its decision and activity tasks are not dispatched and its timers do not fire until it is unpaused",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to pause the workflow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PauseWorkflow(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "unpause a paused workflow execution",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to unpause the workflow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UnpauseWorkflow(c)
+			},
+		},
+		{
+			Name:  "trigger-cron",
+			Usage: "start the pending run of a cron workflow immediately instead of waiting for its schedule",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+			},
+			Action: func(c *cli.Context) {
+				TriggerCronRun(c)
+			},
+		},
+		{
+			Name:  "skip-cron",
+			Usage: "skip upcoming runs of a cron workflow",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.IntFlag{
+					Name:  FlagCronSkipCount,
+					Value: 1,
+					Usage: "Number of upcoming cron runs to skip",
+				},
+			},
+			Action: func(c *cli.Context) {
+				SkipCronRuns(c)
+			},
+		},
 		{
 			Name:        "list",
 			Aliases:     []string{"l"},
@@ -404,6 +566,97 @@ func newActivityCommands() []cli.Command {
 				FailActivity(c)
 			},
 		},
+		{
+			Name:  "update-options",
+			Usage: "update the timeouts, task list or retry policy of a pending activity",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "The activityId to operate on",
+				},
+				cli.IntFlag{
+					Name:  FlagScheduleToStartTimeout,
+					Usage: "Optional new schedule to start timeout in seconds",
+				},
+				cli.IntFlag{
+					Name:  FlagScheduleToCloseTimeout,
+					Usage: "Optional new schedule to close timeout in seconds",
+				},
+				cli.IntFlag{
+					Name:  FlagStartToCloseTimeout,
+					Usage: "Optional new start to close timeout in seconds",
+				},
+				cli.IntFlag{
+					Name:  FlagHeartbeatTimeout,
+					Usage: "Optional new heartbeat timeout in seconds",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "Optional new task list of the activity",
+				},
+				cli.IntFlag{
+					Name:  FlagRetryInitialInterval,
+					Usage: "Initial interval of the new retry policy in seconds, required to update the retry policy",
+				},
+				cli.Float64Flag{
+					Name:  FlagRetryBackoffCoefficient,
+					Value: 2.0,
+					Usage: "Backoff coefficient of the new retry policy",
+				},
+				cli.IntFlag{
+					Name:  FlagRetryMaximumInterval,
+					Usage: "Maximum interval of the new retry policy in seconds",
+				},
+				cli.IntFlag{
+					Name:  FlagRetryMaximumAttempts,
+					Usage: "Maximum attempts of the new retry policy",
+				},
+				cli.IntFlag{
+					Name:  FlagRetryExpirationInterval,
+					Usage: "Expiration interval of the new retry policy in seconds",
+				},
+				cli.StringFlag{
+					Name:  FlagRetryNonRetriableErrors,
+					Usage: "Non retriable error reasons of the new retry policy, separated by comma",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UpdateActivityOptions(c)
+			},
+		},
+		{
+			Name:  "reset",
+			Usage: "retry an activity waiting for its retry backoff immediately, optionally resetting its attempts",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowId",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunId",
+				},
+				cli.StringFlag{
+					Name:  FlagActivityIDWithAlias,
+					Usage: "The activityId to operate on",
+				},
+				cli.BoolFlag{
+					Name:  FlagResetAttempts,
+					Usage: "Reset the attempt count and the retry expiration of the activity, the activity must not be running",
+				},
+			},
+			Action: func(c *cli.Context) {
+				ResetActivity(c)
+			},
+		},
 	}
 }
 
@@ -485,6 +738,43 @@ func newBatchCommands() []cli.Command {
 					Value: batcher.DefaultRPS,
 					Usage: "RPS of processing",
 				},
+				cli.IntFlag{
+					Name:  FlagConcurrency,
+					Value: batcher.DefaultConcurrency,
+					Usage: "Number of workflows processed in parallel",
+				},
+				cli.StringFlag{
+					Name:  FlagResetType,
+					Usage: "Required for batch reset, where to reset. Support one of these: " + strings.Join(batcher.AllResetTypes, ","),
+				},
+				cli.StringFlag{
+					Name:  FlagResetBadBinaryChecksum,
+					Usage: "Binary checksum for resetType of BadBinary",
+				},
+				cli.StringFlag{
+					Name: FlagSearchAttributesKey,
+					Usage: "Search attributes keys to upsert for batch upsert_attributes. If there are multiple keys, concatenate them and separate by |. " +
+						"Use 'cluster get-search-attr' cmd to list legal keys.",
+				},
+				cli.StringFlag{
+					Name: FlagSearchAttributesVal,
+					Usage: "Search attributes values to upsert for batch upsert_attributes. If there are multiple keys, concatenate them and separate by |. " +
+						"The order must be same as search_attr_key",
+				},
+				cli.StringFlag{
+					Name:  FlagMemoKey,
+					Usage: "Memo keys to upsert for batch upsert_attributes. If there are multiple keys, concatenate them and separate by space",
+				},
+				cli.StringFlag{
+					Name: FlagMemo,
+					Usage: "Memo values to upsert for batch upsert_attributes, in JSON format. If there are multiple JSON, concatenate them and separate by space. " +
+						"The order must be same as memo_key",
+				},
+				cli.StringFlag{
+					Name: FlagMemoFile,
+					Usage: "Memo values to upsert for batch upsert_attributes, from JSON format file. If there are multiple JSON, concatenate them and separate by space or newline. " +
+						"The order must be same as memo_key",
+				},
 				cli.BoolFlag{
 					Name:  FlagYes,
 					Usage: "Optional flag to disable confirmation prompt",
@@ -26,28 +26,33 @@ package cli
 
 import (
 	"bufio"
-	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
 
 	"github.com/urfave/cli"
-	executionpb "go.temporal.io/temporal-proto/execution"
+	commonpb "go.temporal.io/temporal-proto/common"
 	"go.temporal.io/temporal-proto/workflowservice"
-	sdkclient "go.temporal.io/temporal/client"
 
-	"github.com/temporalio/temporal/common"
+	"github.com/temporalio/temporal/.gen/proto/adminservice"
+	commongenpb "github.com/temporalio/temporal/.gen/proto/common"
 	"github.com/temporalio/temporal/service/worker/batcher"
 )
 
 // TerminateBatchJob stops abatch job
 func TerminateBatchJob(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
 	jobID := getRequiredOption(c, FlagJobID)
 	reason := getRequiredOption(c, FlagReason)
-	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
+	adminClient := cFactory.AdminClient(c)
 	tcCtx, cancel := newContext(c)
 	defer cancel()
-	err := client.TerminateWorkflow(tcCtx, jobID, "", reason, nil)
+	_, err := adminClient.StopBatchOperation(tcCtx, &adminservice.StopBatchOperationRequest{
+		Namespace: namespace,
+		JobId:     jobID,
+		Reason:    reason,
+		Identity:  getCliIdentity(),
+	})
 	if err != nil {
 		ErrorAndExit("Failed to terminate batch job", err)
 	}
@@ -59,34 +64,36 @@ func TerminateBatchJob(c *cli.Context) {
 
 // DescribeBatchJob describe the status of the batch job
 func DescribeBatchJob(c *cli.Context) {
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
 	jobID := getRequiredOption(c, FlagJobID)
 
-	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
+	adminClient := cFactory.AdminClient(c)
 	tcCtx, cancel := newContext(c)
 	defer cancel()
-	wf, err := client.DescribeWorkflowExecution(tcCtx, jobID, "")
+	resp, err := adminClient.DescribeBatchOperation(tcCtx, &adminservice.DescribeBatchOperationRequest{
+		Namespace: namespace,
+		JobId:     jobID,
+	})
 	if err != nil {
 		ErrorAndExit("Failed to describe batch job", err)
 	}
 
+	info := resp.GetOperationInfo()
 	output := map[string]interface{}{}
-	if wf.WorkflowExecutionInfo.GetStatus() != executionpb.WorkflowExecutionStatus_Running {
-		if wf.WorkflowExecutionInfo.GetStatus() != executionpb.WorkflowExecutionStatus_Completed {
-			output["msg"] = "batch job stopped status: " + wf.WorkflowExecutionInfo.GetStatus().String()
-		} else {
-			output["msg"] = "batch job is finished successfully"
-		}
-	} else {
+	switch info.GetState() {
+	case commongenpb.BatchOperationState_Running:
 		output["msg"] = "batch job is running"
-		if len(wf.PendingActivities) > 0 {
-			hbdBinary := wf.PendingActivities[0].HeartbeatDetails
-			hbd := batcher.HeartBeatDetails{}
-			err := json.Unmarshal(hbdBinary, &hbd)
-			if err != nil {
-				ErrorAndExit("Failed to describe batch job", err)
-			}
-			output["progress"] = hbd
-		}
+	case commongenpb.BatchOperationState_Completed:
+		output["msg"] = "batch job is finished successfully"
+	default:
+		output["msg"] = "batch job stopped status: " + info.GetState().String()
+	}
+	output["batchType"] = info.GetBatchType()
+	output["query"] = info.GetQuery()
+	output["progress"] = map[string]int64{
+		"total":     info.GetTotalOperationCount(),
+		"processed": info.GetCompleteOperationCount(),
+		"failed":    info.GetFailureOperationCount(),
 	}
 	prettyPrintJSONObject(output)
 }
@@ -95,31 +102,28 @@ func DescribeBatchJob(c *cli.Context) {
 func ListBatchJobs(c *cli.Context) {
 	namespace := getRequiredGlobalOption(c, FlagNamespace)
 	pageSize := c.Int(FlagPageSize)
-	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
+	adminClient := cFactory.AdminClient(c)
 	tcCtx, cancel := newContext(c)
 	defer cancel()
-	resp, err := client.ListWorkflow(tcCtx, &workflowservice.ListWorkflowExecutionsRequest{
-		Namespace: common.SystemLocalNamespace,
+	resp, err := adminClient.ListBatchOperations(tcCtx, &adminservice.ListBatchOperationsRequest{
+		Namespace: namespace,
 		PageSize:  int32(pageSize),
-		Query:     fmt.Sprintf("CustomNamespace = '%v'", namespace),
 	})
 	if err != nil {
 		ErrorAndExit("Failed to list batch jobs", err)
 	}
-	output := make([]interface{}, 0, len(resp.Executions))
-	for _, wf := range resp.Executions {
+	output := make([]interface{}, 0, len(resp.GetOperationInfo()))
+	for _, info := range resp.GetOperationInfo() {
 		job := map[string]string{
-			"jobID":     wf.Execution.GetWorkflowId(),
-			"startTime": convertTime(wf.GetStartTime().GetValue(), false),
-			"reason":    string(wf.Memo.Fields["Reason"]),
-			"operator":  string(wf.SearchAttributes.IndexedFields["Operator"]),
+			"jobID":     info.GetJobId(),
+			"batchType": info.GetBatchType(),
+			"startTime": convertTime(info.GetStartTime(), false),
+			"reason":    info.GetReason(),
+			"operator":  info.GetIdentity(),
+			"status":    info.GetState().String(),
 		}
-
-		if wf.GetStatus() != executionpb.WorkflowExecutionStatus_Running {
-			job["status"] = wf.GetStatus().String()
-			job["closeTime"] = convertTime(wf.GetCloseTime().GetValue(), false)
-		} else {
-			job["status"] = "RUNNING"
+		if info.GetState() != commongenpb.BatchOperationState_Running {
+			job["closeTime"] = convertTime(info.GetCloseTime(), false)
 		}
 
 		output = append(output, job)
@@ -142,9 +146,30 @@ func StartBatchJob(c *cli.Context) {
 		sigName = getRequiredOption(c, FlagSignalName)
 		sigVal = getRequiredOption(c, FlagInput)
 	}
+	var resetType, badBinaryChecksum string
+	if batchType == batcher.BatchTypeReset {
+		resetType = getRequiredOption(c, FlagResetType)
+		if resetType == batcher.ResetTypeBadBinary {
+			badBinaryChecksum = getRequiredOption(c, FlagResetBadBinaryChecksum)
+		}
+	}
+	var searchAttributes *commonpb.SearchAttributes
+	var memo *commonpb.Memo
+	if batchType == batcher.BatchTypeUpsertAttributes {
+		if fields := processSearchAttr(c); len(fields) > 0 {
+			searchAttributes = &commonpb.SearchAttributes{IndexedFields: fields}
+		}
+		if fields := processMemo(c); len(fields) > 0 {
+			memo = &commonpb.Memo{Fields: fields}
+		}
+		if searchAttributes == nil && memo == nil {
+			ErrorAndExit("Search attributes or memo is required for batch upsert_attributes.", nil)
+		}
+	}
 	rps := c.Int(FlagRPS)
+	concurrency := c.Int(FlagConcurrency)
 
-	client := cFactory.SDKClient(c, common.SystemLocalNamespace)
+	client := cFactory.SDKClient(c, namespace)
 	tcCtx, cancel := newContext(c)
 	defer cancel()
 	resp, err := client.CountWorkflow(tcCtx, &workflowservice.CountWorkflowExecutionsRequest{
@@ -174,35 +199,28 @@ func StartBatchJob(c *cli.Context) {
 	}
 	tcCtx, cancel = newContext(c)
 	defer cancel()
-	options := sdkclient.StartWorkflowOptions{
-		TaskList:                     batcher.BatcherTaskListName,
-		ExecutionStartToCloseTimeout: batcher.InfiniteDuration,
-		Memo: map[string]interface{}{
-			"Reason": reason,
-		},
-		SearchAttributes: map[string]interface{}{
-			"CustomNamespace": namespace,
-			"Operator":        operator,
-		},
-	}
-	params := batcher.BatchParams{
-		Namespace: namespace,
-		Query:     query,
-		Reason:    reason,
-		BatchType: batchType,
-		SignalParams: batcher.SignalParams{
-			SignalName: sigName,
-			Input:      sigVal,
-		},
-		RPS: rps,
-	}
-	wf, err := client.ExecuteWorkflow(tcCtx, options, batcher.BatchWFTypeName, params)
+	adminClient := cFactory.AdminClient(c)
+	startResp, err := adminClient.StartBatchOperation(tcCtx, &adminservice.StartBatchOperationRequest{
+		Namespace:              namespace,
+		BatchType:              batchType,
+		Query:                  query,
+		Reason:                 reason,
+		SignalName:             sigName,
+		SignalInput:            sigVal,
+		Rps:                    int32(rps),
+		Concurrency:            int32(concurrency),
+		Identity:               operator,
+		ResetType:              resetType,
+		ResetBadBinaryChecksum: badBinaryChecksum,
+		SearchAttributes:       searchAttributes,
+		Memo:                   memo,
+	})
 	if err != nil {
 		ErrorAndExit("Failed to start batch job", err)
 	}
 	output := map[string]interface{}{
 		"msg":   "batch job is started",
-		"jobID": wf.GetID(),
+		"jobID": startResp.GetJobId(),
 	}
 	prettyPrintJSONObject(output)
 }
@@ -31,6 +31,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"math/rand"
 	"os"
@@ -55,10 +56,13 @@ import (
 	"go.temporal.io/temporal-proto/workflowservice"
 	"go.temporal.io/temporal/client"
 
+	"github.com/temporalio/temporal/.gen/proto/adminservice"
 	cliproto "github.com/temporalio/temporal/.gen/proto/cli"
+	executiongenpb "github.com/temporalio/temporal/.gen/proto/execution"
 	"github.com/temporalio/temporal/common"
 	"github.com/temporalio/temporal/common/clock"
 	"github.com/temporalio/temporal/common/codec"
+	"github.com/temporalio/temporal/common/headers"
 	"github.com/temporalio/temporal/service/history"
 )
 
@@ -213,7 +217,7 @@ func startWorkflowHelper(c *cli.Context, shouldPrintProgress bool) {
 		WorkflowIdReusePolicy:               reusePolicy,
 	}
 	if c.IsSet(FlagCronSchedule) {
-		startRequest.CronSchedule = c.String(FlagCronSchedule)
+		startRequest.CronSchedule = getCronSchedule(c)
 	}
 
 	memoFields := processMemo(c)
@@ -226,9 +230,27 @@ func startWorkflowHelper(c *cli.Context, shouldPrintProgress bool) {
 		startRequest.SearchAttributes = &commonpb.SearchAttributes{IndexedFields: searchAttrFields}
 	}
 
+	startDelay := int32(c.Int(FlagStartDelay))
+	if startDelay < 0 {
+		ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagStartDelay), nil)
+	}
+
+	priority := c.String(FlagPriority)
+	if priority != "" {
+		if _, err := common.ParseTaskPriority(priority); err != nil {
+			ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagPriority), err)
+		}
+	}
+
 	startFn := func() {
 		tcCtx, cancel := newContext(c)
 		defer cancel()
+		if startDelay > 0 {
+			tcCtx = headers.SetStartDelay(tcCtx, startDelay)
+		}
+		if priority != "" {
+			tcCtx = headers.SetPriority(tcCtx, priority)
+		}
 		resp, err := serviceClient.StartWorkflowExecution(tcCtx, startRequest)
 
 		if err != nil {
@@ -241,6 +263,12 @@ func startWorkflowHelper(c *cli.Context, shouldPrintProgress bool) {
 	runFn := func() {
 		tcCtx, cancel := newContextForLongPoll(c)
 		defer cancel()
+		if startDelay > 0 {
+			tcCtx = headers.SetStartDelay(tcCtx, startDelay)
+		}
+		if priority != "" {
+			tcCtx = headers.SetPriority(tcCtx, priority)
+		}
 		resp, err := serviceClient.StartWorkflowExecution(tcCtx, startRequest)
 
 		if err != nil {
@@ -357,7 +385,8 @@ func getPrintableSearchAttr(searchAttr *commonpb.SearchAttributes) string {
 func printWorkflowProgress(c *cli.Context, wid, rid string) {
 	fmt.Println(colorMagenta("Progress:"))
 
-	wfClient := getWorkflowClient(c)
+	adminClient := cFactory.AdminClient(c)
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
 	timeElapse := 1
 	isTimeElapseExist := false
 	doneChan := make(chan bool)
@@ -374,22 +403,36 @@ func printWorkflowProgress(c *cli.Context, wid, rid string) {
 	}
 
 	go func() {
-		iter := wfClient.GetWorkflowHistory(tcCtx, wid, rid, true, filterpb.HistoryEventFilterType_AllEvent)
-		for iter.HasNext() {
-			event, err := iter.Next()
+		stream, err := adminClient.StreamWorkflowExecutionHistory(tcCtx, &adminservice.StreamWorkflowExecutionHistoryRequest{
+			Namespace: namespace,
+			Execution: &executionpb.WorkflowExecution{
+				WorkflowId: wid,
+				RunId:      rid,
+			},
+		})
+		if err != nil {
+			ErrorAndExit("Unable to stream history.", err)
+		}
+		for {
+			resp, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
 			if err != nil {
 				ErrorAndExit("Unable to read event.", err)
 			}
-			if isTimeElapseExist {
-				removePrevious2LinesFromTerminal()
-				isTimeElapseExist = false
-			}
-			if showDetails {
-				fmt.Printf("  %d, %s, %s, %s\n", event.GetEventId(), convertTime(event.GetTimestamp(), false), ColorEvent(event), HistoryEventToString(event, true, maxFieldLength))
-			} else {
-				fmt.Printf("  %d, %s, %s\n", event.GetEventId(), convertTime(event.GetTimestamp(), false), ColorEvent(event))
+			for _, event := range resp.GetEvents() {
+				if isTimeElapseExist {
+					removePrevious2LinesFromTerminal()
+					isTimeElapseExist = false
+				}
+				if showDetails {
+					fmt.Printf("  %d, %s, %s, %s\n", event.GetEventId(), convertTime(event.GetTimestamp(), false), ColorEvent(event), HistoryEventToString(event, true, maxFieldLength))
+				} else {
+					fmt.Printf("  %d, %s, %s\n", event.GetEventId(), convertTime(event.GetTimestamp(), false), ColorEvent(event))
+				}
+				lastEvent = event
 			}
-			lastEvent = event
 		}
 		doneChan <- true
 	}()
@@ -431,6 +474,212 @@ func TerminateWorkflow(c *cli.Context) {
 	}
 }
 
+// DeleteWorkflow deletes a workflow execution
+func DeleteWorkflow(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.DeleteWorkflowExecution(ctx, &adminservice.DeleteWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &executionpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		Reason:   reason,
+		Identity: getCliIdentity(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Delete workflow failed.", err)
+	} else {
+		fmt.Println("Delete workflow succeeded.")
+	}
+}
+
+// ExportWorkflow writes the portable representation of a workflow execution to a file
+func ExportWorkflow(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	outputFileName := getRequiredOption(c, FlagOutputFilename)
+	pageSize := c.Int(FlagPageSize)
+
+	var export *executiongenpb.WorkflowExecutionExport
+	var token []byte
+	for {
+		ctx, cancel := newContext(c)
+		resp, err := adminClient.ExportWorkflowExecution(ctx, &adminservice.ExportWorkflowExecutionRequest{
+			Namespace: namespace,
+			Execution: &executionpb.WorkflowExecution{
+				WorkflowId: wid,
+				RunId:      rid,
+			},
+			MaximumPageSize: int32(pageSize),
+			NextPageToken:   token,
+		})
+		cancel()
+		if err != nil {
+			ErrorAndExit("Export workflow failed.", err)
+		}
+		if export == nil {
+			export = resp.GetExport()
+			// the following pages are read from the same run
+			rid = export.GetExecution().GetRunId()
+		} else {
+			export.HistoryBatches = append(export.HistoryBatches, resp.GetExport().GetHistoryBatches()...)
+		}
+		token = resp.GetNextPageToken()
+		if len(token) == 0 {
+			break
+		}
+	}
+
+	data, err := codec.NewJSONPBEncoder().Encode(export)
+	if err != nil {
+		ErrorAndExit("Failed to serialize workflow execution.", err)
+	}
+	if err := ioutil.WriteFile(outputFileName, data, 0666); err != nil {
+		ErrorAndExit("Failed to write workflow execution file.", err)
+	}
+	fmt.Printf("Exported %v history batches of run %v to %v.\n", len(export.GetHistoryBatches()), rid, outputFileName)
+}
+
+// PauseWorkflow pauses a workflow execution
+func PauseWorkflow(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.PauseWorkflowExecution(ctx, &adminservice.PauseWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &executionpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		Reason:   reason,
+		Identity: getCliIdentity(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Pause workflow failed.", err)
+	} else {
+		fmt.Println("Pause workflow succeeded.")
+	}
+}
+
+// UnpauseWorkflow unpauses a workflow execution
+func UnpauseWorkflow(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.UnpauseWorkflowExecution(ctx, &adminservice.UnpauseWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &executionpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		Reason:   reason,
+		Identity: getCliIdentity(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Unpause workflow failed.", err)
+	} else {
+		fmt.Println("Unpause workflow succeeded.")
+	}
+}
+
+// TriggerCronRun starts the pending run of a cron workflow immediately
+func TriggerCronRun(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.TriggerCronRun(ctx, &adminservice.TriggerCronRunRequest{
+		Namespace: namespace,
+		Execution: &executionpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		Identity: getCliIdentity(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Trigger cron run failed.", err)
+	} else {
+		fmt.Println("Trigger cron run succeeded.")
+	}
+}
+
+// SkipCronRuns skips upcoming runs of a cron workflow
+func SkipCronRuns(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	count := c.Int(FlagCronSkipCount)
+	if count <= 0 {
+		ErrorAndExit(fmt.Sprintf("Option %s must be positive.", FlagCronSkipCount), nil)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.SkipCronRuns(ctx, &adminservice.SkipCronRunsRequest{
+		Namespace: namespace,
+		Execution: &executionpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		Count:    int32(count),
+		Identity: getCliIdentity(),
+	})
+
+	if err != nil {
+		ErrorAndExit("Skip cron runs failed.", err)
+	} else {
+		fmt.Printf("Skip cron runs succeeded, next run is at %s.\n", convertTime(resp.GetNextRunTime(), false))
+	}
+}
+
+// getCronSchedule prefixes the cron schedule with its time zone, jitter and overlap policy options
+func getCronSchedule(c *cli.Context) string {
+	var options []string
+	if c.IsSet(FlagCronTimeZone) {
+		options = append(options, "CRON_TZ="+c.String(FlagCronTimeZone))
+	}
+	if c.IsSet(FlagCronJitter) {
+		options = append(options, "CRON_JITTER="+c.String(FlagCronJitter))
+	}
+	if c.IsSet(FlagCronOverlapPolicy) {
+		options = append(options, "CRON_OVERLAP="+c.String(FlagCronOverlapPolicy))
+	}
+	return strings.Join(append(options, c.String(FlagCronSchedule)), " ")
+}
+
 // CancelWorkflow cancels a workflow execution
 func CancelWorkflow(c *cli.Context) {
 	wfClient := getWorkflowClient(c)
@@ -479,6 +728,43 @@ func SignalWorkflow(c *cli.Context) {
 	}
 }
 
+// UpdateWorkflow updates a workflow execution and prints the result returned by the workflow
+func UpdateWorkflow(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	name := getRequiredOption(c, FlagName)
+	input := processJSONInput(c)
+	updateID := c.String(FlagUpdateID)
+	if updateID == "" {
+		updateID = uuid.New()
+	}
+
+	tcCtx, cancel := newContextForLongPoll(c)
+	defer cancel()
+	resp, err := adminClient.UpdateWorkflowExecution(tcCtx, &adminservice.UpdateWorkflowExecutionRequest{
+		Namespace: namespace,
+		Execution: &executionpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		UpdateId: updateID,
+		Name:     name,
+		Input:    []byte(input),
+		Identity: getCliIdentity(),
+	})
+
+	if err != nil {
+		ErrorAndExit(fmt.Sprintf("Update workflow failed, update id: %v.", updateID), err)
+	} else if resp.GetFailureMessage() != "" {
+		ErrorAndExit(fmt.Sprintf("Update was rejected by the workflow, update id: %v.", resp.GetUpdateId()), errors.New(resp.GetFailureMessage()))
+	} else {
+		fmt.Printf("Update result as JSON:\n%v\n", string(resp.GetResult()))
+	}
+}
+
 // QueryWorkflow query workflow execution
 func QueryWorkflow(c *cli.Context) {
 	getRequiredGlobalOption(c, FlagNamespace) // for pre-check and alert if not provided
@@ -881,6 +1167,7 @@ func convertDescribeWorkflowExecutionResponse(resp *workflowservice.DescribeWork
 		Type:              info.GetType(),
 		CloseTime:         convertTime(info.GetCloseTime().GetValue(), false),
 		StartTime:         convertTime(info.GetStartTime().GetValue(), false),
+		ExecutionTime:     convertTime(info.GetExecutionTime(), false),
 		Status:            info.GetStatus(),
 		HistoryLength:     info.GetHistoryLength(),
 		ParentNamespaceId: info.GetParentNamespaceId(),
@@ -1945,6 +2232,80 @@ func FailActivity(c *cli.Context) {
 	}
 }
 
+// UpdateActivityOptions updates the timeouts, task list or retry policy of a pending activity
+func UpdateActivityOptions(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	activityID := getRequiredOption(c, FlagActivityID)
+
+	request := &adminservice.UpdateActivityOptionsRequest{
+		Namespace: namespace,
+		Execution: &executionpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		ActivityId:                    activityID,
+		ScheduleToStartTimeoutSeconds: int32(c.Int(FlagScheduleToStartTimeout)),
+		ScheduleToCloseTimeoutSeconds: int32(c.Int(FlagScheduleToCloseTimeout)),
+		StartToCloseTimeoutSeconds:    int32(c.Int(FlagStartToCloseTimeout)),
+		HeartbeatTimeoutSeconds:       int32(c.Int(FlagHeartbeatTimeout)),
+		TaskList:                      c.String(FlagTaskList),
+		Identity:                      getCliIdentity(),
+	}
+	if c.IsSet(FlagRetryInitialInterval) {
+		request.RetryPolicy = &commonpb.RetryPolicy{
+			InitialIntervalInSeconds:    int32(c.Int(FlagRetryInitialInterval)),
+			BackoffCoefficient:          c.Float64(FlagRetryBackoffCoefficient),
+			MaximumIntervalInSeconds:    int32(c.Int(FlagRetryMaximumInterval)),
+			MaximumAttempts:             int32(c.Int(FlagRetryMaximumAttempts)),
+			ExpirationIntervalInSeconds: int32(c.Int(FlagRetryExpirationInterval)),
+		}
+		if c.IsSet(FlagRetryNonRetriableErrors) {
+			request.RetryPolicy.NonRetriableErrorReasons = trimSpace(strings.Split(c.String(FlagRetryNonRetriableErrors), ","))
+		}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.UpdateActivityOptions(ctx, request)
+	if err != nil {
+		ErrorAndExit("Update activity options failed.", err)
+	} else {
+		fmt.Println("Update activity options succeeded.")
+	}
+}
+
+// ResetActivity retries an activity waiting for its retry backoff immediately and optionally resets its attempts
+func ResetActivity(c *cli.Context) {
+	adminClient := cFactory.AdminClient(c)
+
+	namespace := getRequiredGlobalOption(c, FlagNamespace)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	activityID := getRequiredOption(c, FlagActivityID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	_, err := adminClient.ResetActivity(ctx, &adminservice.ResetActivityRequest{
+		Namespace: namespace,
+		Execution: &executionpb.WorkflowExecution{
+			WorkflowId: wid,
+			RunId:      rid,
+		},
+		ActivityId:    activityID,
+		ResetAttempts: c.Bool(FlagResetAttempts),
+		Identity:      getCliIdentity(),
+	})
+	if err != nil {
+		ErrorAndExit("Reset activity failed.", err)
+	} else {
+		fmt.Println("Reset activity succeeded.")
+	}
+}
+
 // ObserveHistoryWithID show the process of running workflow
 func ObserveHistoryWithID(c *cli.Context) {
 	if !c.Args().Present() {
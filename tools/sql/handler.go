@@ -35,25 +35,29 @@ import (
 	"github.com/temporalio/temporal/common/auth"
 	"github.com/temporalio/temporal/common/service/config"
 	"github.com/temporalio/temporal/schema/mysql"
+	"github.com/temporalio/temporal/schema/sqlite"
 	"github.com/temporalio/temporal/tools/common/schema"
 )
 
 // VerifyCompatibleVersion ensures that the installed version of temporal and visibility
-// is greater than or equal to the expected version.
+// is greater than or equal to the expected version. In-memory stores are skipped since
+// they start empty on every run and have no schema installed.
 func VerifyCompatibleVersion(
 	cfg config.Persistence,
 ) error {
 
 	ds, ok := cfg.DataStores[cfg.DefaultStore]
-	if ok && ds.SQL != nil {
-		err := CheckCompatibleVersion(*ds.SQL, mysql.Version)
+	if ok && ds.SQL != nil && ds.SQL.PluginName != memoryPluginName {
+		version, _ := expectedVersions(ds.SQL.PluginName)
+		err := CheckCompatibleVersion(*ds.SQL, version)
 		if err != nil {
 			return err
 		}
 	}
 	ds, ok = cfg.DataStores[cfg.VisibilityStore]
-	if ok && ds.SQL != nil {
-		err := CheckCompatibleVersion(*ds.SQL, mysql.VisibilityVersion)
+	if ok && ds.SQL != nil && ds.SQL.PluginName != memoryPluginName {
+		_, visibilityVersion := expectedVersions(ds.SQL.PluginName)
+		err := CheckCompatibleVersion(*ds.SQL, visibilityVersion)
 		if err != nil {
 			return err
 		}
@@ -61,6 +65,15 @@ func VerifyCompatibleVersion(
 	return nil
 }
 
+// expectedVersions returns the schema and visibility schema versions expected for the given plugin,
+// MySQL and Postgres share the same versions while SQLite schema is versioned on its own
+func expectedVersions(pluginName string) (string, string) {
+	if pluginName == sqlitePluginName {
+		return sqlite.Version, sqlite.VisibilityVersion
+	}
+	return mysql.Version, mysql.VisibilityVersion
+}
+
 // CheckCompatibleVersion check the version compatibility
 func CheckCompatibleVersion(
 	cfg config.SQL,
@@ -32,7 +32,14 @@ import (
 	"github.com/temporalio/temporal/tools/common/schema"
 )
 
-const defaultSQLPort = 3306
+const (
+	defaultSQLPort = 3306
+	// sqlitePluginName mirrors the name registered by the sqlite plugin, which is
+	// not imported here to keep this package free of cgo
+	sqlitePluginName = "sqlite"
+	// memoryPluginName mirrors the name registered by the memory plugin
+	memoryPluginName = "memory"
+)
 
 // RunTool runs the temporal-sql-tool command line tool
 func RunTool(args []string) error {